@@ -9,7 +9,7 @@ type Converter interface {
 	// If both currencies are the same, the amounts are also the same.
 	Convert(amount float64, base, term Currency) (converted, rate float64)
 
-	// ExchangeRate returns a direct exchange rate from the base currency to the term currency.
+	// ExchangeRate returns an exchange rate from the base currency to the term currency.
 	//
 	// X units of the base currency are equal to the X * ExchangeRate units of the term currency.
 	//
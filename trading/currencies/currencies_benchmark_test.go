@@ -23,3 +23,10 @@ func BenchmarkRoundString(b *testing.B) {
 		_ = instance.RoundString(123.456)
 	}
 }
+
+func BenchmarkRegistryLookup(b *testing.B) {
+	r := NewRegistry()
+	for i := 0; i < b.N; i++ {
+		_, _ = r.Lookup(EUR)
+	}
+}
@@ -0,0 +1,40 @@
+package forwards
+
+//nolint:gofumpt
+import (
+	"mbg/trading/currencies"
+	"mbg/trading/time/holidays"
+	"mbg/trading/time/holidays/calendars"
+)
+
+// Calendars maps currencies to the settlement holiday calendars.
+//
+// A currency without a calendar settles on all weekdays.
+type Calendars map[currencies.Currency]holidays.Calendarer
+
+// DefaultCalendars returns the settlement calendars for the currencies
+// having a predefined holiday calendar.
+//
+// The US dollar settles in the Federal Reserve (Fedwire) calendar and the euro in the TARGET calendar.
+// The calendars of the major exchanges are used as an approximation of the banking calendars
+// of the other currencies: SIX for CHF and the Nordic exchanges for SEK, DKK, NOK and ISK.
+func DefaultCalendars() Calendars {
+	return Calendars{
+		currencies.USD: calendars.FederalReserve{},
+		currencies.EUR: calendars.TARGET{},
+		currencies.CHF: calendars.Switzerland{},
+		currencies.SEK: calendars.Sweden{},
+		currencies.DKK: calendars.Denmark{},
+		currencies.NOK: calendars.Norway{},
+		currencies.ISK: calendars.Iceland{},
+	}
+}
+
+// calendar returns a calendar of a given currency.
+func (cs Calendars) calendar(c currencies.Currency) holidays.Calendarer {
+	if cal, ok := cs[c]; ok && cal != nil {
+		return cal
+	}
+
+	return calendars.WeekendsOnly{}
+}
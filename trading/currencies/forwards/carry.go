@@ -0,0 +1,94 @@
+package forwards
+
+//nolint:gofumpt
+import (
+	"sync"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments"
+	"mbg/trading/instruments/types"
+)
+
+// Carry returns the interest carry accrued in the term currency over a number of calendar days
+// by a position of a given signed quantity in the base currency, valued at the spot rate.
+//
+// A long position earns the base currency rate on the base amount and pays the term currency
+// rate on the equivalent term amount. A short position does the opposite.
+func Carry(p Pair, quantity, spot, baseRate, termRate float64, days int) float64 {
+	d := float64(days)
+	base := baseRate * d / float64(DayCountBasis(p.Base))
+	term := termRate * d / float64(DayCountBasis(p.Term))
+
+	return quantity * spot * (base - term)
+}
+
+// Rollover returns the roll accrued in the term currency when a position of a given signed quantity
+// in the base currency is rolled from one value date to the next one with a tom-next swap.
+//
+// The points are the TomorrowNext swap points scaled by a number of points in one unit of the term currency,
+// see Pair.PointsScale. Positive points mean a long position pays and a short position earns.
+func Rollover(quantity, points, scale float64) float64 {
+	return -quantity * points / scale
+}
+
+// PairOf returns the currency pair of a Forex instrument.
+//
+// The pair is parsed from the symbol of the instrument, like EURUSD or EUR/USD.
+// Returns false if the instrument is not a Forex instrument or the symbol is not a currency pair.
+func PairOf(instr instruments.Instrument) (Pair, bool) {
+	if instr.Type() != types.Forex {
+		return Pair{}, false
+	}
+
+	p, err := ParsePair(instr.Symbol())
+	if err != nil {
+		return Pair{}, false
+	}
+
+	return p, true
+}
+
+// RolloverCarrier is a thread-safe daily carry calculator for open Forex positions
+// using the latest known TomorrowNext swap points of the currency pairs.
+//
+// Use the NewRolloverCarrier to create a properly initialized new instance.
+type RolloverCarrier struct {
+	mu     sync.RWMutex
+	points map[Pair]float64
+}
+
+// NewRolloverCarrier creates a new RolloverCarrier without swap points.
+func NewRolloverCarrier() *RolloverCarrier {
+	return &RolloverCarrier{points: make(map[Pair]float64)}
+}
+
+// Update sets the TomorrowNext swap points of a given currency pair.
+func (rc *RolloverCarrier) Update(p Pair, tomNextPoints float64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.points[p] = tomNextPoints
+}
+
+// Carry returns the roll accrued by a position of a given signed quantity in a Forex instrument
+// and the term currency of the roll amount.
+//
+// Returns false if the instrument is not a Forex instrument or there are no points for its pair.
+func (rc *RolloverCarrier) Carry(instr instruments.Instrument, quantity float64,
+	_ time.Time,
+) (float64, currencies.Currency, bool) {
+	p, ok := PairOf(instr)
+	if !ok {
+		return 0, "", false
+	}
+
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	if points, ok := rc.points[p]; ok {
+		return Rollover(quantity, points, p.PointsScale()), p.Term, true
+	}
+
+	return 0, "", false
+}
@@ -0,0 +1,93 @@
+//nolint:testpackage
+package forwards
+
+//nolint:gofumpt
+import (
+	"math"
+	"testing"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments"
+	"mbg/trading/instruments/types"
+)
+
+func TestCarry(t *testing.T) {
+	t.Parallel()
+
+	const epsilon = 1e-9
+
+	tests := []struct {
+		quantity, spot, base, term float64
+		days                       int
+		carry                      float64
+	}{
+		{1e6, 1.2, 0.01, 0.02, 1, -1e6 * 1.2 * 0.01 / 360},
+		{-1e6, 1.2, 0.01, 0.02, 1, 1e6 * 1.2 * 0.01 / 360},
+		{1e6, 1.2, 0.02, 0.01, 3, 1e6 * 1.2 * 0.03 / 360},
+		{1e6, 1.2, 0.02, 0.01, 0, 0},
+	}
+
+	for _, tt := range tests {
+		act := Carry(eurusd, tt.quantity, tt.spot, tt.base, tt.term, tt.days)
+		if math.Abs(act-tt.carry) > epsilon {
+			t.Errorf("Carry(%v, %v days): expected %v, actual %v", tt.quantity, tt.days, tt.carry, act)
+		}
+	}
+
+	if act := Rollover(1e6, 0.5, 10000); act != -50 {
+		t.Errorf("Rollover(long): expected %v, actual %v", -50, act)
+	}
+
+	if act := Rollover(-1e6, 0.5, 10000); act != 50 {
+		t.Errorf("Rollover(short): expected %v, actual %v", 50, act)
+	}
+}
+
+//nolint:funlen
+func TestRolloverCarrier(t *testing.T) {
+	t.Parallel()
+
+	mi := func(symbol string, typ types.InstrumentType) instruments.Instrument {
+		m := instruments.MutableInstrument{Symbol: symbol, Type: typ, Currency: currencies.USD}
+
+		return m.Instrument()
+	}
+
+	rc := NewRolloverCarrier()
+	rc.Update(eurusd, 0.5)
+	rc.Update(usdjpy, -1.5)
+
+	tests := []struct {
+		instr    instruments.Instrument
+		quantity float64
+		amount   float64
+		currency currencies.Currency
+		ok       bool
+	}{
+		{mi("EURUSD", types.Forex), 1e6, -50, currencies.USD, true},
+		{mi("EUR/USD", types.Forex), -1e6, 50, currencies.USD, true},
+		{mi("USDJPY", types.Forex), 1e6, 15000, currencies.JPY, true},
+		{mi("USDEUR", types.Forex), 1e6, 0, "", false},
+		{mi("EURCHF", types.Forex), 1e6, 0, "", false},
+		{mi("EURUSD", types.Stock), 1e6, 0, "", false},
+		{mi("EURUSD1", types.Forex), 1e6, 0, "", false},
+	}
+
+	for _, tt := range tests {
+		amount, cur, ok := rc.Carry(tt.instr, tt.quantity, time.Time{})
+		if ok != tt.ok {
+			t.Errorf("Carry(%v): expected %v, actual %v", tt.instr.Symbol(), tt.ok, ok)
+		}
+
+		if amount != tt.amount || cur != tt.currency {
+			t.Errorf("Carry(%v): expected %v %v, actual %v %v", tt.instr.Symbol(), tt.amount, tt.currency, amount, cur)
+		}
+	}
+
+	rc.Update(eurusd, 0.25)
+
+	if amount, _, _ := rc.Carry(mi("EURUSD", types.Forex), 1e6, time.Time{}); amount != -25 {
+		t.Errorf("Carry(updated): expected %v, actual %v", -25, amount)
+	}
+}
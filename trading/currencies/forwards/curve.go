@@ -0,0 +1,193 @@
+package forwards
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"mbg/trading/currencies/forwards/tenors"
+	"mbg/trading/time/businessdays"
+	"mbg/trading/time/holidays"
+)
+
+// Quote is a swap points quote of a tenor.
+//
+// The Overnight and TomorrowNext points are the points of the swaps ending at the spot date,
+// all other points are the forward points of a value date after the spot date.
+type Quote struct {
+	// Tenor is the tenor of the quote.
+	Tenor tenors.Tenor `json:"tenor"`
+
+	// Points are the swap points in pips, see Pair.PointsScale.
+	Points float64 `json:"points"`
+}
+
+// pillar is a forward points value at a given value date.
+type pillar struct {
+	date   time.Time
+	points float64
+}
+
+// Curve is a forward points curve of a currency pair built from the swap points quotes
+// of the standard tenors on a given trade date.
+//
+// The forward points of a value date between the tenors are interpolated linearly in calendar days.
+// The points of the value dates before the spot date are derived from the Overnight and TomorrowNext quotes.
+//
+// Use the NewCurve to create a properly initialized new instance.
+type Curve struct {
+	pair     Pair
+	spot     float64
+	trade    time.Time
+	spotDate time.Time
+	pillars  []pillar
+}
+
+var (
+	errInvalidSpot     = errors.New("spot rate should be positive")
+	errDuplicateTenor  = errors.New("duplicate tenor")
+	errOutOfCurveRange = errors.New("value date is out of the curve range")
+)
+
+// NewCurve creates a new forward points curve from the spot rate and the swap points quotes.
+func NewCurve(p Pair, trade time.Time, spot float64, quotes []Quote, cals Calendars) (*Curve, error) {
+	if spot <= 0 {
+		return nil, errInvalidSpot
+	}
+
+	c := &Curve{
+		pair:     p,
+		spot:     spot,
+		trade:    date(trade),
+		spotDate: SpotDate(p, trade, cals),
+	}
+
+	c.pillars = append(c.pillars, pillar{date: c.spotDate})
+	quoted := make(map[tenors.Tenor]float64, len(quotes))
+
+	for _, q := range quotes {
+		if !q.Tenor.IsKnown() {
+			return nil, fmt.Errorf("%v: %w", q.Tenor, errUnknownTenor)
+		}
+
+		if _, ok := quoted[q.Tenor]; ok {
+			return nil, fmt.Errorf("%v: %w", q.Tenor, errDuplicateTenor)
+		}
+
+		quoted[q.Tenor] = q.Points
+
+		if q.Tenor == tenors.Overnight || q.Tenor == tenors.TomorrowNext {
+			continue
+		}
+
+		d, err := ValueDate(p, trade, q.Tenor, cals)
+		if err != nil {
+			return nil, err
+		}
+
+		c.pillars = append(c.pillars, pillar{date: d, points: q.Points})
+	}
+
+	c.pillars = append(c.pillars, preSpotPillars(c.trade, c.spotDate, settlement(p, cals), quoted)...)
+
+	sort.SliceStable(c.pillars, func(i, j int) bool {
+		return c.pillars[i].date.Before(c.pillars[j].date)
+	})
+
+	for i := 1; i < len(c.pillars); i++ {
+		if c.pillars[i].date.Equal(c.pillars[i-1].date) {
+			return nil, fmt.Errorf("%v: %w", c.pillars[i].date.Format("2006-01-02"), errDuplicateTenor)
+		}
+	}
+
+	return c, nil
+}
+
+// preSpotPillars returns the forward points of the value dates before the spot date.
+//
+// The forward points of the tomorrow date are the negated TomorrowNext points
+// and the forward points of the trade date also subtract the Overnight points.
+// If the tomorrow date is the spot date (a T+1 pair), the Overnight points cover the trade date.
+func preSpotPillars(trade, spot time.Time, cal holidays.Calendarer, quoted map[tenors.Tenor]float64) []pillar {
+	var (
+		ps     []pillar
+		points float64
+	)
+
+	if tomorrow := businessdays.Next(cal, trade); tomorrow.Before(spot) {
+		tn, ok := quoted[tenors.TomorrowNext]
+		if !ok {
+			return ps
+		}
+
+		points -= tn
+		ps = append(ps, pillar{date: tomorrow, points: points})
+	}
+
+	if on, ok := quoted[tenors.Overnight]; ok && trade.Before(spot) {
+		points -= on
+		ps = append(ps, pillar{date: trade, points: points})
+	}
+
+	return ps
+}
+
+// Pair returns the currency pair of the curve.
+func (c *Curve) Pair() Pair {
+	return c.pair
+}
+
+// Spot returns the spot rate of the curve.
+func (c *Curve) Spot() float64 {
+	return c.spot
+}
+
+// TradeDate returns the trade date of the curve at midnight in UTC.
+func (c *Curve) TradeDate() time.Time {
+	return c.trade
+}
+
+// SpotDate returns the spot value date of the curve at midnight in UTC.
+func (c *Curve) SpotDate() time.Time {
+	return c.spotDate
+}
+
+// Points returns the forward points of a given value date.
+//
+// Returns an error if the value date is before the first or after the last quoted value date.
+func (c *Curve) Points(valueDate time.Time) (float64, error) {
+	t := date(valueDate)
+	first, last := c.pillars[0], c.pillars[len(c.pillars)-1]
+
+	if t.Before(first.date) || t.After(last.date) {
+		return 0, fmt.Errorf("%v: %w", t.Format("2006-01-02"), errOutOfCurveRange)
+	}
+
+	i := sort.Search(len(c.pillars), func(i int) bool {
+		return !c.pillars[i].date.Before(t)
+	})
+
+	p := c.pillars[i]
+	if p.date.Equal(t) {
+		return p.points, nil
+	}
+
+	q := c.pillars[i-1]
+	w := t.Sub(q.date).Hours() / p.date.Sub(q.date).Hours()
+
+	return q.points + w*(p.points-q.points), nil
+}
+
+// Outright returns the forward outright rate of a given value date.
+//
+// Returns an error if the value date is before the first or after the last quoted value date.
+func (c *Curve) Outright(valueDate time.Time) (float64, error) {
+	points, err := c.Points(valueDate)
+	if err != nil {
+		return 0, err
+	}
+
+	return Outright(c.spot, points, c.pair.PointsScale()), nil
+}
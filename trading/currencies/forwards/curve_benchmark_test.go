@@ -0,0 +1,37 @@
+//nolint:testpackage
+package forwards
+
+//nolint:gofumpt
+import (
+	"testing"
+
+	"mbg/trading/currencies/forwards/tenors"
+)
+
+func BenchmarkCurvePoints(b *testing.B) {
+	quotes := []Quote{
+		{tenors.Overnight, 0.5},
+		{tenors.TomorrowNext, 0.6},
+		{tenors.OneWeek, 3},
+		{tenors.OneMonth, 12},
+		{tenors.ThreeMonths, 36},
+		{tenors.SixMonths, 70},
+		{tenors.OneYear, 140},
+	}
+
+	c, _ := NewCurve(eurusd, ymd(2021, 3, 31), 1.2, quotes, DefaultCalendars())
+	d := ymd(2021, 8, 17)
+
+	for i := 0; i < b.N; i++ {
+		_, _ = c.Points(d)
+	}
+}
+
+func BenchmarkSpotDate(b *testing.B) {
+	cals := DefaultCalendars()
+	trade := ymd(2021, 3, 31)
+
+	for i := 0; i < b.N; i++ {
+		_ = SpotDate(eurusd, trade, cals)
+	}
+}
@@ -0,0 +1,163 @@
+//nolint:testpackage
+package forwards
+
+//nolint:gofumpt
+import (
+	"math"
+	"testing"
+	"time"
+
+	"mbg/trading/currencies/forwards/tenors"
+)
+
+//nolint:funlen
+func TestCurve(t *testing.T) {
+	t.Parallel()
+
+	const epsilon = 1e-12
+
+	cals := DefaultCalendars()
+
+	// Trade date March 31st, tomorrow April 1st, spot date April 6th.
+	quotes := []Quote{
+		{tenors.Overnight, 0.5},
+		{tenors.TomorrowNext, 0.6},
+		{tenors.OneWeek, 3},
+		{tenors.OneMonth, 12},
+		{tenors.ThreeMonths, 36},
+	}
+
+	c, err := NewCurve(eurusd, ymd(2021, 3, 31), 1.2, quotes, cals)
+	if err != nil {
+		t.Fatalf("NewCurve(): expected success, got error %v", err)
+	}
+
+	if c.Pair() != eurusd || c.Spot() != 1.2 {
+		t.Errorf("Pair(), Spot(): expected %v %v, actual %v %v", eurusd, 1.2, c.Pair(), c.Spot())
+	}
+
+	if !c.TradeDate().Equal(ymd(2021, 3, 31)) || !c.SpotDate().Equal(ymd(2021, 4, 6)) {
+		t.Errorf("TradeDate(), SpotDate(): actual %v %v", c.TradeDate(), c.SpotDate())
+	}
+
+	tests := []struct {
+		t      time.Time
+		points float64
+	}{
+		{ymd(2021, 3, 31), -1.1},
+		{ymd(2021, 4, 1), -0.6},
+		{ymd(2021, 4, 2), -0.48},
+		{ymd(2021, 4, 6), 0},
+		{ymd(2021, 4, 9), 3. * 3 / 7},
+		{ymd(2021, 4, 13), 3},
+		{ymd(2021, 4, 20), 3 + 9.*7/23},
+		{ymd(2021, 5, 6), 12},
+		{ymd(2021, 7, 6), 36},
+		{time.Date(2021, 4, 13, 15, 0, 0, 0, time.UTC), 3},
+	}
+
+	for _, tt := range tests {
+		act, err := c.Points(tt.t)
+		if err != nil {
+			t.Errorf("Points(%v): expected success, got error %v", tt.t, err)
+		}
+
+		if math.Abs(act-tt.points) > epsilon {
+			t.Errorf("Points(%v): expected %v, actual %v", tt.t, tt.points, act)
+		}
+
+		out, err := c.Outright(tt.t)
+		if err != nil {
+			t.Errorf("Outright(%v): expected success, got error %v", tt.t, err)
+		}
+
+		if exp := 1.2 + tt.points/10000; math.Abs(out-exp) > epsilon {
+			t.Errorf("Outright(%v): expected %v, actual %v", tt.t, exp, out)
+		}
+	}
+
+	for _, d := range []time.Time{ymd(2021, 3, 30), ymd(2021, 7, 7)} {
+		if _, err := c.Points(d); err == nil {
+			t.Errorf("Points(%v): expected error, got success", d)
+		}
+
+		if _, err := c.Outright(d); err == nil {
+			t.Errorf("Outright(%v): expected error, got success", d)
+		}
+	}
+}
+
+func TestCurvePreSpot(t *testing.T) {
+	t.Parallel()
+
+	cals := DefaultCalendars()
+
+	t.Run("T+1 pair", func(t *testing.T) {
+		t.Parallel()
+
+		quotes := []Quote{{tenors.Overnight, 0.3}, {tenors.TomorrowNext, 0.4}, {tenors.OneWeek, 2}}
+
+		c, err := NewCurve(usdcad, ymd(2021, 3, 31), 1.25, quotes, cals)
+		if err != nil {
+			t.Fatalf("NewCurve(): expected success, got error %v", err)
+		}
+
+		if act, _ := c.Points(ymd(2021, 3, 31)); act != -0.3 {
+			t.Errorf("Points(trade date): expected %v, actual %v", -0.3, act)
+		}
+	})
+
+	t.Run("without tomorrow next", func(t *testing.T) {
+		t.Parallel()
+
+		quotes := []Quote{{tenors.Overnight, 0.3}, {tenors.OneWeek, 2}}
+
+		c, err := NewCurve(eurusd, ymd(2021, 3, 31), 1.2, quotes, cals)
+		if err != nil {
+			t.Fatalf("NewCurve(): expected success, got error %v", err)
+		}
+
+		if _, err := c.Points(ymd(2021, 4, 1)); err == nil {
+			t.Error("Points(tomorrow): expected error, got success")
+		}
+	})
+
+	t.Run("yen", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewCurve(usdjpy, ymd(2021, 3, 31), 110, []Quote{{tenors.OneMonth, 25}}, cals)
+		if err != nil {
+			t.Fatalf("NewCurve(): expected success, got error %v", err)
+		}
+
+		d, _ := ValueDate(usdjpy, ymd(2021, 3, 31), tenors.OneMonth, cals)
+		if act, _ := c.Outright(d); act != 110.25 {
+			t.Errorf("Outright(1M): expected %v, actual %v", 110.25, act)
+		}
+	})
+}
+
+func TestNewCurveErrors(t *testing.T) {
+	t.Parallel()
+
+	cals := DefaultCalendars()
+	trade := ymd(2021, 3, 31)
+
+	tests := []struct {
+		name   string
+		spot   float64
+		quotes []Quote
+	}{
+		{"zero spot", 0, nil},
+		{"negative spot", -1, nil},
+		{"unknown tenor", 1, []Quote{{tenors.Tenor(0), 1}}},
+		{"duplicate tenor", 1, []Quote{{tenors.OneWeek, 1}, {tenors.OneWeek, 2}}},
+		{"duplicate tomorrow next", 1, []Quote{{tenors.TomorrowNext, 1}, {tenors.TomorrowNext, 2}}},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewCurve(eurusd, trade, tt.spot, tt.quotes, cals); err == nil {
+			t.Errorf("NewCurve(%v): expected error, got success", tt.name)
+		}
+	}
+}
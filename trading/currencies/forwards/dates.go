@@ -0,0 +1,137 @@
+package forwards
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/currencies/forwards/tenors"
+	"mbg/trading/time/businessdays"
+	"mbg/trading/time/businessdays/conventions"
+	"mbg/trading/time/holidays"
+)
+
+const daysInWeek = 7
+
+var errUnknownTenor = errors.New("unknown tenor")
+
+// SpotDate returns the spot value date of a currency pair traded on a given date.
+//
+// The spot lag of a T+2 pair is counted in the business days of the currencies of the pair other than
+// the US dollar, so a US holiday on T+1 does not delay the spot. The spot lag of a T+1 pair, like USD/CAD,
+// is counted in the business days of both currencies and the US dollar, since T+1 is the settlement day.
+// The resulting date is then rolled forward to the first day which is a business day in both currencies
+// and in the US dollar.
+//
+// The returned date is at midnight in UTC.
+func SpotDate(p Pair, trade time.Time, cals Calendars) time.Time {
+	cal := settlement(p, cals)
+	lag := p.SpotLag()
+
+	if lag == 1 {
+		return businessdays.Add(cal, date(trade), lag)
+	}
+
+	counting := make(businessdays.Union, 0, 2) //nolint:gomnd
+	for _, c := range []currencies.Currency{p.Base, p.Term} {
+		if c != currencies.USD {
+			counting = append(counting, cals.calendar(c))
+		}
+	}
+
+	if len(counting) == 0 {
+		counting = append(counting, cals.calendar(currencies.USD))
+	}
+
+	t := businessdays.Add(counting, date(trade), lag)
+
+	return businessdays.Adjust(cal, t, conventions.Following)
+}
+
+// ValueDate returns the value date of a given tenor of a currency pair traded on a given date.
+//
+//   - Overnight is the first business day after the trade date.
+//   - TomorrowNext is the spot date.
+//   - SpotNext is the first business day after the spot date.
+//   - Week tenors are rolled to the following business day.
+//   - Month and year tenors are rolled using the modified following convention,
+//     and if the spot date is the last business day of a month,
+//     the value date is the last business day of the target month (the end-of-month rule).
+//
+// The returned date is at midnight in UTC.
+func ValueDate(p Pair, trade time.Time, tenor tenors.Tenor, cals Calendars) (time.Time, error) {
+	cal := settlement(p, cals)
+
+	if tenor == tenors.Overnight {
+		return businessdays.Next(cal, date(trade)), nil
+	}
+
+	spot := SpotDate(p, trade, cals)
+
+	switch tenor { //nolint:exhaustive
+	case tenors.TomorrowNext:
+		return spot, nil
+	case tenors.SpotNext:
+		return businessdays.Next(cal, spot), nil
+	case tenors.OneWeek:
+		return weeks(cal, spot, 1), nil
+	case tenors.TwoWeeks:
+		return weeks(cal, spot, 2), nil //nolint:gomnd
+	case tenors.ThreeWeeks:
+		return weeks(cal, spot, 3), nil //nolint:gomnd
+	case tenors.OneMonth:
+		return months(cal, spot, 1), nil
+	case tenors.TwoMonths:
+		return months(cal, spot, 2), nil //nolint:gomnd
+	case tenors.ThreeMonths:
+		return months(cal, spot, 3), nil //nolint:gomnd
+	case tenors.SixMonths:
+		return months(cal, spot, 6), nil //nolint:gomnd
+	case tenors.NineMonths:
+		return months(cal, spot, 9), nil //nolint:gomnd
+	case tenors.OneYear:
+		return months(cal, spot, 12), nil //nolint:gomnd
+	default:
+		return time.Time{}, fmt.Errorf("%v: %w", tenor, errUnknownTenor)
+	}
+}
+
+// settlement returns a joint calendar of both currencies of the pair and the US dollar.
+func settlement(p Pair, cals Calendars) holidays.Calendarer {
+	return businessdays.Union{
+		cals.calendar(p.Base),
+		cals.calendar(p.Term),
+		cals.calendar(currencies.USD),
+	}
+}
+
+func weeks(cal holidays.Calendarer, spot time.Time, n int) time.Time {
+	return businessdays.Adjust(cal, spot.AddDate(0, 0, n*daysInWeek), conventions.Following)
+}
+
+func months(cal holidays.Calendarer, spot time.Time, n int) time.Time {
+	y, m, d := spot.Date()
+
+	if businessdays.IsEndOfMonth(cal, spot) {
+		return businessdays.EndOfMonth(cal, time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	// Clamp the day to the length of the target month, so that January 31 + 1M is February 28.
+	last := time.Date(y, m+time.Month(n)+1, 0, 0, 0, 0, 0, time.UTC)
+	if d > last.Day() {
+		d = last.Day()
+	}
+
+	t := time.Date(y, m+time.Month(n), d, 0, 0, 0, 0, time.UTC)
+
+	return businessdays.Adjust(cal, t, conventions.ModifiedFollowing)
+}
+
+// date returns the date of a given time at midnight in UTC.
+func date(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,153 @@
+//nolint:testpackage
+package forwards
+
+//nolint:gofumpt
+import (
+	"testing"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/currencies/forwards/tenors"
+	"mbg/trading/time/holidays/calendars"
+)
+
+func ymd(y, m, d int) time.Time {
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+}
+
+var (
+	eurusd = Pair{currencies.EUR, currencies.USD}
+	usdcad = Pair{currencies.USD, currencies.CAD}
+	usdjpy = Pair{currencies.USD, currencies.JPY}
+	eurchf = Pair{currencies.EUR, currencies.CHF}
+)
+
+func TestSpotDate(t *testing.T) {
+	t.Parallel()
+
+	cals := DefaultCalendars()
+
+	tests := []struct {
+		p           Pair
+		trade, spot time.Time
+		cals        Calendars
+	}{
+		// Wednesday to Friday.
+		{eurusd, ymd(2021, 6, 9), ymd(2021, 6, 11), cals},
+		// Friday to Tuesday.
+		{eurusd, ymd(2021, 6, 11), ymd(2021, 6, 15), cals},
+		// Good Friday and Easter Monday are TARGET holidays.
+		{eurusd, ymd(2021, 3, 31), ymd(2021, 4, 6), cals},
+		// The US holiday on T+1 (Independence Day observed on Monday) does not delay the spot.
+		{eurusd, ymd(2021, 7, 2), ymd(2021, 7, 6), cals},
+		// The spot on the US holiday (Thanksgiving Day) is rolled to the next day.
+		{eurusd, ymd(2021, 11, 23), ymd(2021, 11, 26), cals},
+		// The T+1 spot on the US holiday is rolled to the next day.
+		{usdcad, ymd(2021, 7, 2), ymd(2021, 7, 6), cals},
+		{usdcad, ymd(2021, 6, 9), ymd(2021, 6, 10), cals},
+		// The T+1 spot should be a business day in the US: Memorial Day and Thanksgiving Day are US only.
+		{usdcad, ymd(2021, 5, 28), ymd(2021, 6, 1), cals},
+		{usdcad, ymd(2021, 11, 24), ymd(2021, 11, 26), cals},
+		// The trade date on the US holiday settles on the next day.
+		{usdcad, ymd(2021, 5, 31), ymd(2021, 6, 1), cals},
+		// The cross spot is counted in both non-US calendars.
+		{eurchf, ymd(2021, 5, 12), ymd(2021, 5, 17), cals},
+		// The currencies without a calendar settle on weekdays.
+		{usdjpy, ymd(2021, 3, 5), ymd(2021, 3, 9), cals},
+		// The US dollar settles on Good Friday but not on Columbus Day and Veterans Day.
+		{usdjpy, ymd(2024, 3, 27), ymd(2024, 3, 29), cals},
+		{usdjpy, ymd(2024, 10, 10), ymd(2024, 10, 15), cals},
+		{usdjpy, ymd(2024, 11, 7), ymd(2024, 11, 12), cals},
+		{eurusd, ymd(2021, 3, 31), ymd(2021, 4, 2), Calendars{}},
+		{eurusd, ymd(2021, 3, 31), ymd(2021, 4, 2), nil},
+		// The time of day and the location are ignored.
+		{eurusd, time.Date(2021, 6, 9, 23, 30, 0, 0, time.FixedZone("", 3600)), ymd(2021, 6, 11), cals},
+	}
+
+	for _, tt := range tests {
+		if act := SpotDate(tt.p, tt.trade, tt.cals); !act.Equal(tt.spot) {
+			t.Errorf("SpotDate(%v, %v): expected %v, actual %v", tt.p, tt.trade, tt.spot, act)
+		}
+	}
+}
+
+//nolint:funlen
+func TestValueDate(t *testing.T) {
+	t.Parallel()
+
+	cals := DefaultCalendars()
+
+	tests := []struct {
+		trade time.Time
+		tenor tenors.Tenor
+		value time.Time
+	}{
+		// Spot date April 6th.
+		{ymd(2021, 3, 31), tenors.Overnight, ymd(2021, 4, 1)},
+		{ymd(2021, 3, 31), tenors.TomorrowNext, ymd(2021, 4, 6)},
+		{ymd(2021, 3, 31), tenors.SpotNext, ymd(2021, 4, 7)},
+		{ymd(2021, 3, 31), tenors.OneWeek, ymd(2021, 4, 13)},
+		{ymd(2021, 3, 31), tenors.TwoWeeks, ymd(2021, 4, 20)},
+		{ymd(2021, 3, 31), tenors.ThreeWeeks, ymd(2021, 4, 27)},
+		{ymd(2021, 3, 31), tenors.OneMonth, ymd(2021, 5, 6)},
+		{ymd(2021, 3, 31), tenors.TwoMonths, ymd(2021, 6, 7)},
+		{ymd(2021, 3, 31), tenors.ThreeMonths, ymd(2021, 7, 6)},
+		{ymd(2021, 3, 31), tenors.SixMonths, ymd(2021, 10, 6)},
+		{ymd(2021, 3, 31), tenors.NineMonths, ymd(2022, 1, 6)},
+		{ymd(2021, 3, 31), tenors.OneYear, ymd(2022, 4, 6)},
+		// Spot date January 29th is the last business day of the month.
+		{ymd(2021, 1, 27), tenors.Overnight, ymd(2021, 1, 28)},
+		{ymd(2021, 1, 27), tenors.SpotNext, ymd(2021, 2, 1)},
+		{ymd(2021, 1, 27), tenors.OneWeek, ymd(2021, 2, 5)},
+		{ymd(2021, 1, 27), tenors.OneMonth, ymd(2021, 2, 26)},
+		{ymd(2021, 1, 27), tenors.ThreeMonths, ymd(2021, 4, 30)},
+		// Spot date April 30th, the end of May is the US Memorial Day.
+		{ymd(2021, 4, 28), tenors.OneMonth, ymd(2021, 5, 28)},
+		// Spot date June 21st, the modified following convention stays in the month.
+		{ymd(2021, 6, 17), tenors.OneWeek, ymd(2021, 6, 28)},
+		{ymd(2021, 6, 17), tenors.TwoMonths, ymd(2021, 8, 23)},
+	}
+
+	for _, tt := range tests {
+		act, err := ValueDate(eurusd, tt.trade, tt.tenor, cals)
+		if err != nil {
+			t.Errorf("ValueDate(%v, %v): expected success, got error %v", tt.trade, tt.tenor, err)
+		}
+
+		if !act.Equal(tt.value) {
+			t.Errorf("ValueDate(%v, %v): expected %v, actual %v", tt.trade, tt.tenor, tt.value, act)
+		}
+	}
+
+	if _, err := ValueDate(eurusd, ymd(2021, 3, 31), tenors.Tenor(0), cals); err == nil {
+		t.Error("ValueDate(unknown tenor): expected error, got success")
+	}
+}
+
+func TestMonths(t *testing.T) {
+	t.Parallel()
+
+	cal := calendars.WeekendsOnly{}
+
+	tests := []struct {
+		spot  time.Time
+		n     int
+		value time.Time
+	}{
+		// Not the end of month, the day is clamped to the end of February.
+		{ymd(2021, 12, 30), 2, ymd(2022, 2, 28)},
+		// Modified following rolls back from Saturday, April 30th.
+		{ymd(2022, 3, 30), 1, ymd(2022, 4, 29)},
+		// Following rolls forward from Sunday, May 2nd.
+		{ymd(2021, 4, 2), 1, ymd(2021, 5, 3)},
+		// End of month.
+		{ymd(2021, 4, 30), 1, ymd(2021, 5, 31)},
+		{ymd(2021, 1, 29), 13, ymd(2022, 2, 28)},
+	}
+
+	for _, tt := range tests {
+		if act := months(cal, tt.spot, tt.n); !act.Equal(tt.value) {
+			t.Errorf("months(%v, %v): expected %v, actual %v", tt.spot, tt.n, tt.value, act)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Package forwards implements foreign exchange spot and forward value dates, forward outright rates
+// from swap points or from the interest rate parity, and the carry of open currency positions.
+package forwards
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"mbg/trading/currencies"
+)
+
+// Pair is a currency pair quoted as a number of units of the term (quote) currency
+// per one unit of the base currency, for instance EURUSD.
+type Pair struct {
+	// Base is the base currency of the pair.
+	Base currencies.Currency `json:"base"`
+
+	// Term is the term (quote) currency of the pair.
+	Term currencies.Currency `json:"term"`
+}
+
+const (
+	codeLength     = 3
+	pipsScale      = 10000
+	pipsScaleSmall = 100
+)
+
+var errInvalidPair = errors.New("invalid currency pair")
+
+// ParsePair parses a currency pair written as BASETERM (EURUSD) or BASE/TERM (EUR/USD).
+func ParsePair(s string) (Pair, error) {
+	s = strings.ReplaceAll(s, "/", "")
+	if len(s) != 2*codeLength {
+		return Pair{}, fmt.Errorf("'%s': %w", s, errInvalidPair)
+	}
+
+	s = strings.ToUpper(s)
+	p := Pair{Base: currencies.Currency(s[:codeLength]), Term: currencies.Currency(s[codeLength:])}
+
+	if p.Base == p.Term {
+		return Pair{}, fmt.Errorf("'%s': %w", s, errInvalidPair)
+	}
+
+	return p, nil
+}
+
+// String implements the fmt.Stringer interface.
+func (p Pair) String() string {
+	return string(p.Base) + string(p.Term)
+}
+
+// Inverse returns the pair with the base and the term currencies swapped.
+func (p Pair) Inverse() Pair {
+	return Pair{Base: p.Term, Term: p.Base}
+}
+
+// SpotLag is the number of business days between the trade date and the spot date.
+//
+// The spot is T+1 for the US dollar against the Canadian dollar, the Turkish lira,
+// the Russian rouble, the Philippine peso and the Kazakhstani tenge, and T+2 for all other pairs.
+func (p Pair) SpotLag() int {
+	var other currencies.Currency
+
+	switch {
+	case p.Base == currencies.USD:
+		other = p.Term
+	case p.Term == currencies.USD:
+		other = p.Base
+	default:
+		return 2 //nolint:gomnd
+	}
+
+	switch other { //nolint:exhaustive
+	case currencies.CAD, currencies.TRY, currencies.RUB, currencies.PHP, currencies.KZT:
+		return 1
+	default:
+		return 2 //nolint:gomnd
+	}
+}
+
+// PointsScale is the number of forward points (pips) in one unit of the term currency.
+//
+// It is 100 when the term currency is the Japanese yen and 10000 otherwise.
+func (p Pair) PointsScale() float64 {
+	if p.Term == currencies.JPY {
+		return pipsScaleSmall
+	}
+
+	return pipsScale
+}
@@ -0,0 +1,80 @@
+//nolint:testpackage
+package forwards
+
+//nolint:gofumpt
+import (
+	"testing"
+
+	"mbg/trading/currencies"
+)
+
+func TestParsePair(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s   string
+		p   Pair
+		err bool
+	}{
+		{"EURUSD", Pair{currencies.EUR, currencies.USD}, false},
+		{"EUR/USD", Pair{currencies.EUR, currencies.USD}, false},
+		{"usdjpy", Pair{currencies.USD, currencies.JPY}, false},
+		{"EUR", Pair{}, true},
+		{"EUR/USD/CHF", Pair{}, true},
+		{"USDUSD", Pair{}, true},
+		{"", Pair{}, true},
+	}
+
+	for _, tt := range tests {
+		p, err := ParsePair(tt.s)
+
+		switch {
+		case tt.err && err == nil:
+			t.Errorf("ParsePair('%v'): expected error, got success", tt.s)
+		case !tt.err && err != nil:
+			t.Errorf("ParsePair('%v'): expected success, got error %v", tt.s, err)
+		case p != tt.p:
+			t.Errorf("ParsePair('%v'): expected %v, actual %v", tt.s, tt.p, p)
+		}
+	}
+}
+
+func TestPair(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		p     Pair
+		s     string
+		lag   int
+		scale float64
+	}{
+		{Pair{currencies.EUR, currencies.USD}, "EURUSD", 2, 10000},
+		{Pair{currencies.USD, currencies.JPY}, "USDJPY", 2, 100},
+		{Pair{currencies.EUR, currencies.JPY}, "EURJPY", 2, 100},
+		{Pair{currencies.JPY, currencies.USD}, "JPYUSD", 2, 10000},
+		{Pair{currencies.USD, currencies.CAD}, "USDCAD", 1, 10000},
+		{Pair{currencies.CAD, currencies.USD}, "CADUSD", 1, 10000},
+		{Pair{currencies.USD, currencies.TRY}, "USDTRY", 1, 10000},
+		{Pair{currencies.USD, currencies.RUB}, "USDRUB", 1, 10000},
+		{Pair{currencies.EUR, currencies.CAD}, "EURCAD", 2, 10000},
+		{Pair{currencies.EUR, currencies.RUB}, "EURRUB", 2, 10000},
+	}
+
+	for _, tt := range tests {
+		if act := tt.p.String(); act != tt.s {
+			t.Errorf("String(): expected %v, actual %v", tt.s, act)
+		}
+
+		if act := tt.p.SpotLag(); act != tt.lag {
+			t.Errorf("%v SpotLag(): expected %v, actual %v", tt.p, tt.lag, act)
+		}
+
+		if act := tt.p.PointsScale(); act != tt.scale {
+			t.Errorf("%v PointsScale(): expected %v, actual %v", tt.p, tt.scale, act)
+		}
+
+		if inv := tt.p.Inverse(); inv.Base != tt.p.Term || inv.Term != tt.p.Base {
+			t.Errorf("%v Inverse(): actual %v", tt.p, inv)
+		}
+	}
+}
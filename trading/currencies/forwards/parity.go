@@ -0,0 +1,57 @@
+package forwards
+
+//nolint:gofumpt
+import (
+	"mbg/trading/currencies"
+)
+
+const (
+	basis360 = 360
+	basis365 = 365
+)
+
+// DayCountBasis returns the number of days in a year used to accrue the money market interest of a currency.
+//
+// It is 365 (Actual/365) for the pound sterling, the Australian, New Zealand, Canadian, Hong Kong
+// and Singapore dollars, the South African rand and the Japanese yen, and 360 (Actual/360) otherwise.
+func DayCountBasis(c currencies.Currency) int {
+	switch c { //nolint:exhaustive
+	case currencies.GBP, currencies.AUD, currencies.NZD, currencies.CAD,
+		currencies.HKD, currencies.SGD, currencies.ZAR, currencies.JPY:
+		return basis365
+	default:
+		return basis360
+	}
+}
+
+// Outright returns the forward outright rate from the spot rate and the forward points,
+// scaled by a number of points in one unit of the term currency, see Pair.PointsScale.
+func Outright(spot, points, scale float64) float64 {
+	return spot + points/scale
+}
+
+// Points returns the forward points from the spot and the forward outright rates,
+// scaled by a number of points in one unit of the term currency, see Pair.PointsScale.
+func Points(spot, outright, scale float64) float64 {
+	return (outright - spot) * scale
+}
+
+// ParityOutright returns the forward outright rate implied by the covered interest rate parity
+//
+//	F = S (1 + rterm d / Bterm) / (1 + rbase d / Bbase),
+//
+// where the rates are the simple annual money market rates of the base and the term currencies,
+// d is the number of calendar days between the spot and the forward value dates,
+// and B are the day count bases of the currencies.
+func ParityOutright(p Pair, spot, baseRate, termRate float64, days int) float64 {
+	d := float64(days)
+	base := 1 + baseRate*d/float64(DayCountBasis(p.Base))
+	term := 1 + termRate*d/float64(DayCountBasis(p.Term))
+
+	return spot * term / base
+}
+
+// ParityPoints returns the forward points implied by the covered interest rate parity, see ParityOutright.
+func ParityPoints(p Pair, spot, baseRate, termRate float64, days int) float64 {
+	return Points(spot, ParityOutright(p, spot, baseRate, termRate, days), p.PointsScale())
+}
@@ -0,0 +1,82 @@
+//nolint:testpackage
+package forwards
+
+//nolint:gofumpt
+import (
+	"math"
+	"testing"
+
+	"mbg/trading/currencies"
+)
+
+func TestDayCountBasis(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c     currencies.Currency
+		basis int
+	}{
+		{currencies.EUR, 360},
+		{currencies.USD, 360},
+		{currencies.CHF, 360},
+		{currencies.GBP, 365},
+		{currencies.JPY, 365},
+		{currencies.AUD, 365},
+	}
+
+	for _, tt := range tests {
+		if act := DayCountBasis(tt.c); act != tt.basis {
+			t.Errorf("DayCountBasis(%v): expected %v, actual %v", tt.c, tt.basis, act)
+		}
+	}
+}
+
+func TestOutrightPoints(t *testing.T) {
+	t.Parallel()
+
+	const epsilon = 1e-12
+
+	if act := Outright(1.2, 25, 10000); math.Abs(act-1.2025) > epsilon {
+		t.Errorf("Outright(): expected %v, actual %v", 1.2025, act)
+	}
+
+	if act := Points(1.2, 1.2025, 10000); math.Abs(act-25) > 1e-9 {
+		t.Errorf("Points(): expected %v, actual %v", 25, act)
+	}
+
+	if act := Outright(110, -15, 100); math.Abs(act-109.85) > epsilon {
+		t.Errorf("Outright(): expected %v, actual %v", 109.85, act)
+	}
+}
+
+func TestParity(t *testing.T) {
+	t.Parallel()
+
+	const epsilon = 1e-12
+
+	gbpusd := Pair{currencies.GBP, currencies.USD}
+
+	tests := []struct {
+		p                Pair
+		spot, base, term float64
+		days             int
+		outright         float64
+	}{
+		{eurusd, 1.2, 0.01, 0.02, 90, 1.2 * 1.005 / 1.0025},
+		{gbpusd, 1.5, 0.01, 0.02, 90, 1.5 * (1 + 0.02*90/360.) / (1 + 0.01*90/365.)},
+		{eurusd, 1.2, 0.01, 0.01, 0, 1.2},
+		{eurusd, 1.2, 0.03, 0.01, 360, 1.2 * 1.01 / 1.03},
+	}
+
+	for _, tt := range tests {
+		act := ParityOutright(tt.p, tt.spot, tt.base, tt.term, tt.days)
+		if math.Abs(act-tt.outright) > epsilon {
+			t.Errorf("ParityOutright(%v, %v days): expected %v, actual %v", tt.p, tt.days, tt.outright, act)
+		}
+
+		exp := (tt.outright - tt.spot) * tt.p.PointsScale()
+		if act := ParityPoints(tt.p, tt.spot, tt.base, tt.term, tt.days); math.Abs(act-exp) > 1e-8 {
+			t.Errorf("ParityPoints(%v, %v days): expected %v, actual %v", tt.p, tt.days, exp, act)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Package tenors enumerates standard foreign exchange forward and swap tenors.
+package tenors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Tenor enumerates standard foreign exchange forward and swap tenors.
+type Tenor int
+
+const (
+	// Overnight (ON) is a swap from today to tomorrow.
+	Overnight Tenor = iota + 1
+
+	// TomorrowNext (TN) is a swap from tomorrow to the spot date.
+	TomorrowNext
+
+	// SpotNext (SN) is a swap from the spot date to the next business day.
+	SpotNext
+
+	// OneWeek (1W) is one week after the spot date.
+	OneWeek
+
+	// TwoWeeks (2W) is two weeks after the spot date.
+	TwoWeeks
+
+	// ThreeWeeks (3W) is three weeks after the spot date.
+	ThreeWeeks
+
+	// OneMonth (1M) is one month after the spot date.
+	OneMonth
+
+	// TwoMonths (2M) is two months after the spot date.
+	TwoMonths
+
+	// ThreeMonths (3M) is three months after the spot date.
+	ThreeMonths
+
+	// SixMonths (6M) is six months after the spot date.
+	SixMonths
+
+	// NineMonths (9M) is nine months after the spot date.
+	NineMonths
+
+	// OneYear (1Y) is one year after the spot date.
+	OneYear
+	last
+)
+
+const (
+	unknown      = "unknown"
+	overnight    = "ON"
+	tomorrowNext = "TN"
+	spotNext     = "SN"
+	oneWeek      = "1W"
+	twoWeeks     = "2W"
+	threeWeeks   = "3W"
+	oneMonth     = "1M"
+	twoMonths    = "2M"
+	threeMonths  = "3M"
+	sixMonths    = "6M"
+	nineMonths   = "9M"
+	oneYear      = "1Y"
+)
+
+var errUnknownTenor = errors.New("unknown tenor")
+
+// String implements the fmt.Stringer interface.
+func (tn Tenor) String() string {
+	switch tn {
+	case Overnight:
+		return overnight
+	case TomorrowNext:
+		return tomorrowNext
+	case SpotNext:
+		return spotNext
+	case OneWeek:
+		return oneWeek
+	case TwoWeeks:
+		return twoWeeks
+	case ThreeWeeks:
+		return threeWeeks
+	case OneMonth:
+		return oneMonth
+	case TwoMonths:
+		return twoMonths
+	case ThreeMonths:
+		return threeMonths
+	case SixMonths:
+		return sixMonths
+	case NineMonths:
+		return nineMonths
+	case OneYear:
+		return oneYear
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this tenor is known.
+func (tn Tenor) IsKnown() bool {
+	return tn >= Overnight && tn < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (tn Tenor) MarshalJSON() ([]byte, error) {
+	str := tn.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownTenor)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (tn *Tenor) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case overnight:
+		*tn = Overnight
+	case tomorrowNext:
+		*tn = TomorrowNext
+	case spotNext:
+		*tn = SpotNext
+	case oneWeek:
+		*tn = OneWeek
+	case twoWeeks:
+		*tn = TwoWeeks
+	case threeWeeks:
+		*tn = ThreeWeeks
+	case oneMonth:
+		*tn = OneMonth
+	case twoMonths:
+		*tn = TwoMonths
+	case threeMonths:
+		*tn = ThreeMonths
+	case sixMonths:
+		*tn = SixMonths
+	case nineMonths:
+		*tn = NineMonths
+	case oneYear:
+		*tn = OneYear
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownTenor)
+	}
+
+	return nil
+}
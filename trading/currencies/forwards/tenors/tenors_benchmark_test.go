@@ -0,0 +1,29 @@
+//nolint:testpackage
+package tenors
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := OneYear
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := OneYear
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var tn Tenor
+
+	bs := []byte("\"1Y\"")
+	for i := 0; i < b.N; i++ {
+		_ = tn.UnmarshalJSON(bs)
+	}
+}
@@ -0,0 +1,176 @@
+//nolint:testpackage
+package tenors
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tn   Tenor
+		text string
+	}{
+		{Overnight, overnight},
+		{TomorrowNext, tomorrowNext},
+		{SpotNext, spotNext},
+		{OneWeek, oneWeek},
+		{TwoWeeks, twoWeeks},
+		{ThreeWeeks, threeWeeks},
+		{OneMonth, oneMonth},
+		{TwoMonths, twoMonths},
+		{ThreeMonths, threeMonths},
+		{SixMonths, sixMonths},
+		{NineMonths, nineMonths},
+		{OneYear, oneYear},
+		{last, unknown},
+		{Tenor(0), unknown},
+		{Tenor(9999), unknown},
+		{Tenor(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.tn.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.tn, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tn      Tenor
+		boolean bool
+	}{
+		{Overnight, true},
+		{TomorrowNext, true},
+		{SpotNext, true},
+		{OneWeek, true},
+		{TwoWeeks, true},
+		{ThreeWeeks, true},
+		{OneMonth, true},
+		{TwoMonths, true},
+		{ThreeMonths, true},
+		{SixMonths, true},
+		{NineMonths, true},
+		{OneYear, true},
+		{last, false},
+		{Tenor(0), false},
+		{Tenor(9999), false},
+		{Tenor(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.tn.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.tn, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		tn        Tenor
+		json      string
+		succeeded bool
+	}{
+		{Overnight, "\"ON\"", true},
+		{TomorrowNext, "\"TN\"", true},
+		{SpotNext, "\"SN\"", true},
+		{OneWeek, "\"1W\"", true},
+		{TwoWeeks, "\"2W\"", true},
+		{ThreeWeeks, "\"3W\"", true},
+		{OneMonth, "\"1M\"", true},
+		{TwoMonths, "\"2M\"", true},
+		{ThreeMonths, "\"3M\"", true},
+		{SixMonths, "\"6M\"", true},
+		{NineMonths, "\"9M\"", true},
+		{OneYear, "\"1Y\"", true},
+		{last, nilstr, false},
+		{Tenor(9999), nilstr, false},
+		{Tenor(-9999), nilstr, false},
+		{Tenor(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.tn.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.tn, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.tn)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.tn, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Tenor
+	tests := []struct {
+		tn        Tenor
+		json      string
+		succeeded bool
+	}{
+		{Overnight, "\"ON\"", true},
+		{TomorrowNext, "\"TN\"", true},
+		{SpotNext, "\"SN\"", true},
+		{OneWeek, "\"1W\"", true},
+		{TwoWeeks, "\"2W\"", true},
+		{ThreeWeeks, "\"3W\"", true},
+		{OneMonth, "\"1M\"", true},
+		{TwoMonths, "\"2M\"", true},
+		{ThreeMonths, "\"3M\"", true},
+		{SixMonths, "\"6M\"", true},
+		{NineMonths, "\"9M\"", true},
+		{OneYear, "\"1Y\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.tn
+		bs := []byte(tt.json)
+
+		var tn Tenor
+
+		err := tn.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != tn {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, tn)
+		}
+	}
+}
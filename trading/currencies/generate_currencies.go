@@ -274,6 +274,16 @@ func printCurrencyGo(filename string, cs []currency) {
 	printf(&b, "\t}\n")
 	printf(&b, "}\n")
 
+	printf(&b, "\n//nolint:gochecknoglobals,lll\n")
+	printf(&b, "// predefined contains the predefined currencies in the order of definition.\n")
+	printf(&b, "var predefined = []definition{\n")
+
+	for _, c := range cs {
+		printf(&b, "\t{%v, %v, %q, %q},\n", c.code, c.decimals, c.symbol, c.description)
+	}
+
+	printf(&b, "}\n")
+
 	printBuffer(&b, filename, true)
 }
 
@@ -0,0 +1,211 @@
+package currencies
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ratePoint is an exchange rate effective from a given time.
+type ratePoint struct {
+	time time.Time
+	rate float64
+}
+
+// HistoricalConverter is a thread-safe Converter keeping a time-stamped history of exchange rates.
+//
+// The rates are resolved like in the UpdatableConverter, using the inverse rates, the cross rates
+// through the pivot currencies and the minor currency units.
+//
+// The Converter methods use the latest known rates, the ExchangeRateAt and ConvertAt methods
+// and the converters returned by the At method use the rates effective at a given time,
+// which is what a backtest needs.
+//
+// Use the NewHistoricalConverter to create a properly initialized new instance.
+type HistoricalConverter struct {
+	mu      sync.RWMutex
+	history map[Currency]map[Currency][]ratePoint
+	pivots  []Currency
+}
+
+//nolint:exhaustivestruct
+// NewHistoricalConverter creates a new empty HistoricalConverter without exchange rates.
+//
+// The pivot currencies are tried in the given order to triangulate cross rates.
+// If no pivots are given, USD and EUR are used.
+func NewHistoricalConverter(pivots ...Currency) *HistoricalConverter {
+	return &HistoricalConverter{
+		history: make(map[Currency]map[Currency][]ratePoint),
+		pivots:  defaultPivots(pivots),
+	}
+}
+
+// Update adds a direct exchange rate from the base currency to the term currency,
+// effective from the given time until the time of the next update of the same pair.
+//
+// An update at the same time as an existing one replaces its rate.
+// This method does not validate the value of the rate.
+func (hc *HistoricalConverter) Update(base, term Currency, rate float64, t time.Time) {
+	if base == term {
+		return
+	}
+
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	m, ok := hc.history[base]
+	if !ok {
+		m = make(map[Currency][]ratePoint)
+		hc.history[base] = m
+	}
+
+	ps := m[term]
+	i := sort.Search(len(ps), func(i int) bool { return !ps[i].time.Before(t) })
+
+	if i < len(ps) && ps[i].time.Equal(t) {
+		ps[i].rate = rate
+
+		return
+	}
+
+	ps = append(ps, ratePoint{})
+	copy(ps[i+1:], ps[i:])
+	ps[i] = ratePoint{time: t, rate: rate}
+	m[term] = ps
+}
+
+// ExchangeRateAt returns an exchange rate from the base currency to the term currency effective at a given time.
+//
+// If the rate cannot be resolved, the exchange rate is zero.
+// If both currencies are the same, the exchange rate is 1.
+func (hc *HistoricalConverter) ExchangeRateAt(base, term Currency, t time.Time) float64 {
+	if base == term {
+		return 1
+	}
+
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+
+	return resolveRate(func(b, q Currency) (float64, bool) { return hc.direct(b, q, &t) }, hc.pivots, base, term)
+}
+
+// ConvertAt converts the amount in the base currency to the converted amount in the term currency
+// using the exchange rate effective at a given time.
+func (hc *HistoricalConverter) ConvertAt(amount float64, base, term Currency, t time.Time) (converted, rate float64) {
+	rate = hc.ExchangeRateAt(base, term, t)
+	converted = amount * rate
+
+	return
+}
+
+// At returns a Converter using the exchange rates effective at a given time.
+func (hc *HistoricalConverter) At(t time.Time) Converter {
+	return &historicalView{hc: hc, t: t}
+}
+
+// Convert implements Converter using the latest exchange rates.
+func (hc *HistoricalConverter) Convert(amount float64, base, term Currency) (converted, rate float64) {
+	rate = hc.ExchangeRate(base, term)
+	converted = amount * rate
+
+	return
+}
+
+// ExchangeRate implements Converter using the latest exchange rates.
+func (hc *HistoricalConverter) ExchangeRate(base, term Currency) float64 {
+	if base == term {
+		return 1
+	}
+
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+
+	return resolveRate(func(b, q Currency) (float64, bool) { return hc.direct(b, q, nil) }, hc.pivots, base, term)
+}
+
+// KnownBaseCurrencies implements Converter.
+func (hc *HistoricalConverter) KnownBaseCurrencies(term Currency) []Currency {
+	return hc.knownBaseCurrencies(term, nil)
+}
+
+// KnownTermCurrencies implements Converter.
+func (hc *HistoricalConverter) KnownTermCurrencies(base Currency) []Currency {
+	return hc.knownTermCurrencies(base, nil)
+}
+
+// direct returns a direct exchange rate effective at a given time or the latest one if the time is nil.
+// The caller should hold the lock.
+func (hc *HistoricalConverter) direct(base, term Currency, t *time.Time) (float64, bool) {
+	ps := hc.history[base][term]
+	if len(ps) == 0 {
+		return 0, false
+	}
+
+	if t == nil {
+		return ps[len(ps)-1].rate, true
+	}
+
+	i := sort.Search(len(ps), func(i int) bool { return ps[i].time.After(*t) })
+	if i == 0 {
+		return 0, false
+	}
+
+	return ps[i-1].rate, true
+}
+
+// isKnown checks if a direct rate is effective at a given time or at any time if the time is nil.
+func isKnown(ps []ratePoint, t *time.Time) bool {
+	return len(ps) > 0 && (t == nil || !ps[0].time.After(*t))
+}
+
+func (hc *HistoricalConverter) knownBaseCurrencies(term Currency, t *time.Time) []Currency {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+
+	cs := make([]Currency, 0, len(hc.history))
+
+	for bc, m := range hc.history {
+		if isKnown(m[term], t) {
+			cs = append(cs, bc)
+		}
+	}
+
+	return cs
+}
+
+func (hc *HistoricalConverter) knownTermCurrencies(base Currency, t *time.Time) []Currency {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+
+	cs := make([]Currency, 0, len(hc.history[base]))
+
+	for tc, ps := range hc.history[base] {
+		if isKnown(ps, t) {
+			cs = append(cs, tc)
+		}
+	}
+
+	return cs
+}
+
+// historicalView is a Converter using the exchange rates of a HistoricalConverter effective at a given time.
+type historicalView struct {
+	hc *HistoricalConverter
+	t  time.Time
+}
+
+func (v *historicalView) Convert(amount float64, base, term Currency) (converted, rate float64) {
+	return v.hc.ConvertAt(amount, base, term, v.t)
+}
+
+func (v *historicalView) ExchangeRate(base, term Currency) float64 {
+	return v.hc.ExchangeRateAt(base, term, v.t)
+}
+
+func (v *historicalView) KnownBaseCurrencies(term Currency) []Currency {
+	return v.hc.knownBaseCurrencies(term, &v.t)
+}
+
+func (v *historicalView) KnownTermCurrencies(base Currency) []Currency {
+	return v.hc.knownTermCurrencies(base, &v.t)
+}
@@ -0,0 +1,22 @@
+//nolint:testpackage
+package currencies
+
+import (
+	"testing"
+	"time"
+)
+
+func BenchmarkConvertAt(b *testing.B) {
+	hc := NewHistoricalConverter()
+	t := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 1000; i++ {
+		hc.Update(EUR, USD, 1+float64(i)/1000, t.AddDate(0, 0, i))
+	}
+
+	at := t.AddDate(0, 0, 500)
+
+	for i := 0; i < b.N; i++ {
+		_, _ = hc.ConvertAt(1, USD, EUR, at)
+	}
+}
@@ -0,0 +1,95 @@
+//nolint:testpackage
+package currencies
+
+import (
+	"testing"
+	"time"
+)
+
+func day(d int) time.Time {
+	return time.Date(2021, time.June, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestHistoricalConverter(t *testing.T) {
+	t.Parallel()
+
+	hc := NewHistoricalConverter()
+	hc.Update(EUR, USD, 1.2, day(3))
+	hc.Update(EUR, USD, 1.5, day(1))
+	hc.Update(EUR, USD, 1.3, day(5))
+	hc.Update(EUR, USD, 1.25, day(3))
+	hc.Update(USD, JPY, 110, day(2))
+	hc.Update(EUR, EUR, 2, day(2))
+
+	tests := []struct {
+		t          time.Time
+		base, term Currency
+		exp        float64
+	}{
+		{day(0), EUR, USD, 0},
+		{day(1), EUR, USD, 1.5},
+		{day(2).Add(-time.Nanosecond), EUR, USD, 1.5},
+		{day(3), EUR, USD, 1.25},
+		{day(4), USD, EUR, 1 / 1.25},
+		{day(9), EUR, USD, 1.3},
+		{day(1), EUR, JPY, 0},
+		{day(2), EUR, JPY, 1.5 * 110},
+		{day(4), GBP, USD, 0},
+		{day(4), EUR, EUR, 1},
+		{day(4), EUX, USX, 1.25},
+	}
+
+	for _, tt := range tests {
+		if act := hc.ExchangeRateAt(tt.base, tt.term, tt.t); act != tt.exp {
+			t.Errorf("ExchangeRateAt(%v, %v, %v): expected %v, actual %v", tt.base, tt.term, tt.t, tt.exp, act)
+		}
+
+		if conv, rate := hc.At(tt.t).Convert(amount, tt.base, tt.term); rate != tt.exp || conv != amount*tt.exp {
+			t.Errorf("At(%v).Convert(%v, %v, %v): expected rate %v, actual %v and %v",
+				tt.t, amount, tt.base, tt.term, tt.exp, rate, conv)
+		}
+	}
+
+	if rate := hc.ExchangeRate(EUR, USD); rate != 1.3 {
+		t.Errorf("ExchangeRate(EUR, USD): expected the latest rate 1.3, actual %v", rate)
+	}
+
+	if conv, rate := hc.Convert(2, USD, JPY); rate != 110 || conv != 220 {
+		t.Errorf("Convert(2, USD, JPY): expected 220 at 110, actual %v at %v", conv, rate)
+	}
+
+	if conv, rate := hc.ConvertAt(2, EUR, USD, day(1)); rate != 1.5 || conv != 3 {
+		t.Errorf("ConvertAt(2, EUR, USD, June 1st): expected 3 at 1.5, actual %v at %v", conv, rate)
+	}
+}
+
+func TestHistoricalConverterKnownCurrencies(t *testing.T) {
+	t.Parallel()
+
+	hc := NewHistoricalConverter()
+	hc.Update(GBP, EUR, gbpEur, day(1))
+	hc.Update(GBP, CHF, gbpChf, day(3))
+	hc.Update(CHF, EUR, chfEur, day(3))
+
+	if known := hc.KnownTermCurrencies(GBP); !hasTwo(known, EUR, CHF) {
+		t.Errorf("KnownTermCurrencies(GBP): expected {EUR, CHF}, actual %v", known)
+	}
+
+	if known := hc.KnownBaseCurrencies(EUR); !hasTwo(known, GBP, CHF) {
+		t.Errorf("KnownBaseCurrencies(EUR): expected {GBP, CHF}, actual %v", known)
+	}
+
+	v := hc.At(day(2))
+
+	if known := v.KnownTermCurrencies(GBP); len(known) != 1 || known[0] != EUR {
+		t.Errorf("At(June 2nd).KnownTermCurrencies(GBP): expected {EUR}, actual %v", known)
+	}
+
+	if known := v.KnownBaseCurrencies(EUR); len(known) != 1 || known[0] != GBP {
+		t.Errorf("At(June 2nd).KnownBaseCurrencies(EUR): expected {GBP}, actual %v", known)
+	}
+
+	if known := v.KnownTermCurrencies(USD); len(known) != 0 {
+		t.Errorf("At(June 2nd).KnownTermCurrencies(USD): expected empty collection, actual %v", known)
+	}
+}
@@ -0,0 +1,122 @@
+package currencies
+
+import (
+	"strings"
+)
+
+// Locale describes how to format amounts of money in a region.
+type Locale struct {
+	// DecimalSeparator separates the integer part from the fractional part.
+	DecimalSeparator string `json:"decimalSeparator"`
+
+	// GroupSeparator separates the groups of three digits of the integer part.
+	// If empty, the digits are not grouped.
+	GroupSeparator string `json:"groupSeparator"`
+
+	// SymbolFirst places the currency symbol before the amount, otherwise it is placed after.
+	SymbolFirst bool `json:"symbolFirst"`
+
+	// SymbolSpace separates the currency symbol from the amount with a no-break space.
+	SymbolSpace bool `json:"symbolSpace"`
+}
+
+const noBreakSpace = "\u00a0"
+
+//nolint:gochecknoglobals
+var (
+	// LocaleEnUS formats amounts like $1,234.56 (English, United States).
+	LocaleEnUS = Locale{DecimalSeparator: ".", GroupSeparator: ",", SymbolFirst: true}
+
+	// LocaleEnGB formats amounts like £1,234.56 (English, United Kingdom).
+	LocaleEnGB = Locale{DecimalSeparator: ".", GroupSeparator: ",", SymbolFirst: true}
+
+	// LocaleDeDE formats amounts like 1.234,56 € (German, Germany).
+	LocaleDeDE = Locale{DecimalSeparator: ",", GroupSeparator: ".", SymbolSpace: true}
+
+	// LocaleFrFR formats amounts like 1 234,56 € with a no-break space between the groups (French, France).
+	LocaleFrFR = Locale{DecimalSeparator: ",", GroupSeparator: noBreakSpace, SymbolSpace: true}
+
+	// LocaleNlNL formats amounts like € 1.234,56 (Dutch, Netherlands).
+	LocaleNlNL = Locale{DecimalSeparator: ",", GroupSeparator: ".", SymbolFirst: true, SymbolSpace: true}
+
+	// LocaleDeCH formats amounts like Fr 1'234.56 (German, Switzerland).
+	LocaleDeCH = Locale{DecimalSeparator: ".", GroupSeparator: "'", SymbolFirst: true, SymbolSpace: true}
+
+	// LocaleSvSE formats amounts like 1 234,56 kr with a no-break space between the groups (Swedish, Sweden).
+	LocaleSvSE = Locale{DecimalSeparator: ",", GroupSeparator: noBreakSpace, SymbolSpace: true}
+)
+
+// Format formats the amount with all decimals of the currency using a locale.
+//
+// The currency symbol is used if the currency has one, otherwise the currency code
+// is used and always separated from the amount with a no-break space.
+// A negative amount is prefixed with a minus sign, like -$1,234.56.
+func (m Money) Format(l Locale) string {
+	s := m.Abs().decimal()
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+
+	var b strings.Builder
+
+	if m.Sign() < 0 {
+		b.WriteByte('-')
+	}
+
+	sym, space := m.currency.Symbol(), l.SymbolSpace
+	if sym == "" {
+		sym, space = string(m.currency), true
+	}
+
+	if l.SymbolFirst {
+		b.WriteString(sym)
+
+		if space {
+			b.WriteString(noBreakSpace)
+		}
+	}
+
+	b.WriteString(group(intPart, l.GroupSeparator))
+
+	if fracPart != "" {
+		b.WriteString(l.DecimalSeparator)
+		b.WriteString(fracPart)
+	}
+
+	if !l.SymbolFirst {
+		if space {
+			b.WriteString(noBreakSpace)
+		}
+
+		b.WriteString(sym)
+	}
+
+	return b.String()
+}
+
+// group inserts a separator between the groups of three digits.
+func group(digits, sep string) string {
+	const size = 3
+
+	if sep == "" || len(digits) <= size {
+		return digits
+	}
+
+	var b strings.Builder
+
+	first := len(digits) % size
+	if first == 0 {
+		first = size
+	}
+
+	b.WriteString(digits[:first])
+
+	for i := first; i < len(digits); i += size {
+		b.WriteString(sep)
+		b.WriteString(digits[i : i+size])
+	}
+
+	return b.String()
+}
@@ -0,0 +1,375 @@
+package currencies
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money is an immutable amount of money in a currency.
+//
+// The amount is kept as an exact integer number of minor units of the currency,
+// see Currency.Decimals, so the arithmetic never accumulates floating point errors.
+// The amounts having more decimal places than the currency are rounded half to even (banker's rounding).
+//
+// The zero value is a zero amount without a currency.
+type Money struct {
+	currency Currency
+	units    *big.Int
+}
+
+var (
+	errCurrencyMismatch = errors.New("currency mismatch")
+	errInvalidAmount    = errors.New("invalid amount")
+	errInvalidParts     = errors.New("number of parts should be positive")
+	errInvalidRatios    = errors.New("ratios should be non-negative with a positive sum")
+	errUnknownRate      = errors.New("unknown exchange rate")
+)
+
+// NewMoney creates a new amount of money from a floating point number.
+//
+// The number is taken as its shortest decimal representation, so 2.675 is 2.675 and not
+// 2.67499999999999982236431605997495353221893310546875, and then rounded half to even
+// to the number of decimals of the currency. Not-a-number and infinities give a zero amount.
+func NewMoney(amount float64, currency Currency) Money {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(amount, 'g', -1, 64))
+	if !ok {
+		return Money{currency: currency, units: new(big.Int)}
+	}
+
+	return fromRat(r, currency)
+}
+
+// NewMoneyMinor creates a new amount of money from an integer number of minor units of the currency,
+// for instance 1234 cents are 12.34 EUR.
+func NewMoneyMinor(units int64, currency Currency) Money {
+	return Money{currency: currency, units: big.NewInt(units)}
+}
+
+// ParseMoney parses a decimal amount of money, like "-1234.567", rounding it half to even
+// to the number of decimals of the currency.
+func ParseMoney(amount string, currency Currency) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok || strings.ContainsRune(amount, '/') {
+		return Money{}, fmt.Errorf("'%s': %w", amount, errInvalidAmount)
+	}
+
+	return fromRat(r, currency), nil
+}
+
+// Currency returns the currency of the amount.
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// Amount returns the nearest floating point number to the amount.
+func (m Money) Amount() float64 {
+	f, _ := m.rat().Float64()
+
+	return f
+}
+
+// MinorUnits returns the amount as an integer number of minor units of the currency.
+func (m Money) MinorUnits() *big.Int {
+	return new(big.Int).Set(m.int())
+}
+
+// Sign returns -1 if the amount is negative, 0 if it is zero and 1 if it is positive.
+func (m Money) Sign() int {
+	return m.int().Sign()
+}
+
+// IsZero checks if the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Equal checks if both amounts have the same currency and value.
+func (m Money) Equal(o Money) bool {
+	return m.currency == o.currency && m.int().Cmp(o.int()) == 0
+}
+
+// Cmp compares two amounts in the same currency and returns -1, 0 or 1
+// if this amount is less than, equal to or greater than the other one.
+func (m Money) Cmp(o Money) (int, error) {
+	if err := m.check(o); err != nil {
+		return 0, err
+	}
+
+	return m.int().Cmp(o.int()), nil
+}
+
+// Add returns the sum of two amounts in the same currency.
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+
+	return Money{currency: m.currency, units: new(big.Int).Add(m.int(), o.int())}, nil
+}
+
+// Sub returns the difference of two amounts in the same currency.
+func (m Money) Sub(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+
+	return Money{currency: m.currency, units: new(big.Int).Sub(m.int(), o.int())}, nil
+}
+
+// Neg returns the negated amount.
+func (m Money) Neg() Money {
+	return Money{currency: m.currency, units: new(big.Int).Neg(m.int())}
+}
+
+// Abs returns the absolute amount.
+func (m Money) Abs() Money {
+	return Money{currency: m.currency, units: new(big.Int).Abs(m.int())}
+}
+
+// Mul returns the amount multiplied by a factor and rounded half to even to the number of decimals of the currency.
+//
+// The factor is taken as its shortest decimal representation.
+// Not-a-number and infinite factors give a zero amount.
+func (m Money) Mul(factor float64) Money {
+	f, ok := new(big.Rat).SetString(strconv.FormatFloat(factor, 'g', -1, 64))
+	if !ok {
+		return Money{currency: m.currency, units: new(big.Int)}
+	}
+
+	return Money{currency: m.currency, units: roundHalfEven(f.Mul(f, new(big.Rat).SetInt(m.int())))}
+}
+
+// Split splits the amount into a number of parts which differ by at most one minor unit
+// and sum exactly to the amount. The larger parts come first.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, errInvalidParts
+	}
+
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+
+	return m.Allocate(ratios...)
+}
+
+// Allocate splits the amount into parts proportional to the given ratios,
+// so that the parts sum exactly to the amount.
+//
+// Each part is first truncated to the whole minor units and the remaining minor units
+// are then distributed one by one to the parts in the order of the ratios.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	total := int64(0)
+
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, errInvalidRatios
+		}
+
+		total += int64(r)
+	}
+
+	if total == 0 {
+		return nil, errInvalidRatios
+	}
+
+	abs := new(big.Int).Abs(m.int())
+	den := big.NewInt(total)
+	rest := new(big.Int).Set(abs)
+	parts := make([]*big.Int, len(ratios))
+
+	for i, r := range ratios {
+		parts[i] = new(big.Int).Mul(abs, big.NewInt(int64(r)))
+		parts[i].Quo(parts[i], den)
+		rest.Sub(rest, parts[i])
+	}
+
+	one := big.NewInt(1)
+
+	for i := 0; rest.Sign() > 0; i = (i + 1) % len(parts) {
+		if ratios[i] == 0 {
+			continue
+		}
+
+		parts[i].Add(parts[i], one)
+		rest.Sub(rest, one)
+	}
+
+	ms := make([]Money, len(parts))
+	for i, p := range parts {
+		if m.Sign() < 0 {
+			p.Neg(p)
+		}
+
+		ms[i] = Money{currency: m.currency, units: p}
+	}
+
+	return ms, nil
+}
+
+// Convert converts the amount to a term currency using the exchange rate of a converter,
+// rounding the result half to even to the number of decimals of the term currency.
+//
+// Returns an error if the exchange rate is unknown.
+func (m Money) Convert(converter Converter, term Currency) (Money, error) {
+	if m.currency == term {
+		return m, nil
+	}
+
+	rate := converter.ExchangeRate(m.currency, term)
+	if rate == 0 {
+		return Money{}, fmt.Errorf("%s%s: %w", m.currency, term, errUnknownRate)
+	}
+
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(rate, 'g', -1, 64))
+	if !ok {
+		return Money{}, fmt.Errorf("%s%s: %w", m.currency, term, errUnknownRate)
+	}
+
+	return fromRat(r.Mul(r, m.rat()), term), nil
+}
+
+// String implements the fmt.Stringer interface.
+//
+// The amount is formatted with all decimals of the currency followed by the currency code,
+// for instance "-1234.50 EUR".
+func (m Money) String() string {
+	return m.decimal() + " " + string(m.currency)
+}
+
+// Sum returns the sum of amounts in the same currency.
+//
+// An empty sum is a zero amount without a currency.
+func Sum(amounts ...Money) (Money, error) {
+	if len(amounts) == 0 {
+		return Money{}, nil
+	}
+
+	s := amounts[0]
+
+	for _, a := range amounts[1:] {
+		var err error
+		if s, err = s.Add(a); err != nil {
+			return Money{}, err
+		}
+	}
+
+	return s, nil
+}
+
+// moneyJSON is a JSON representation of Money.
+// The amount is a decimal string to keep it exact.
+type moneyJSON struct {
+	Amount   string   `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: m.decimal(), Currency: m.currency})
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var mj moneyJSON
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&mj); err != nil {
+		return fmt.Errorf("cannot unmarshal money: %w", err)
+	}
+
+	v, err := ParseMoney(mj.Amount, mj.Currency)
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal money: %w", err)
+	}
+
+	*m = v
+
+	return nil
+}
+
+// check returns an error if the currencies of the amounts differ.
+func (m Money) check(o Money) error {
+	if m.currency != o.currency {
+		return fmt.Errorf("%s and %s: %w", m.currency, o.currency, errCurrencyMismatch)
+	}
+
+	return nil
+}
+
+// int returns the number of minor units, the nil units of the zero value are zero.
+func (m Money) int() *big.Int {
+	if m.units == nil {
+		return new(big.Int)
+	}
+
+	return m.units
+}
+
+// rat returns the amount in major units.
+func (m Money) rat() *big.Rat {
+	return new(big.Rat).SetFrac(m.int(), pow10(m.currency.Decimals()))
+}
+
+// decimal returns the amount as a decimal string with all decimals of the currency.
+func (m Money) decimal() string {
+	d := m.currency.Decimals()
+	s := new(big.Int).Abs(m.int()).String()
+
+	if d > 0 {
+		if len(s) <= d {
+			s = strings.Repeat("0", d-len(s)+1) + s
+		}
+
+		s = s[:len(s)-d] + "." + s[len(s)-d:]
+	}
+
+	if m.Sign() < 0 {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// fromRat returns an amount in major units rounded half to even to the minor units.
+func fromRat(r *big.Rat, currency Currency) Money {
+	r = new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(currency.Decimals())))
+
+	return Money{currency: currency, units: roundHalfEven(r)}
+}
+
+// roundHalfEven rounds a rational number to the nearest integer, the halves are rounded to the even integer.
+func roundHalfEven(r *big.Rat) *big.Int {
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return q
+	}
+
+	// Compare the doubled remainder with the denominator.
+	c := new(big.Int).Abs(rem)
+	c.Lsh(c, 1)
+
+	if cmp := c.Cmp(r.Denom()); cmp > 0 || (cmp == 0 && q.Bit(0) == 1) {
+		if rem.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return q
+}
+
+func pow10(n int) *big.Int {
+	const ten = 10
+
+	return new(big.Int).Exp(big.NewInt(ten), big.NewInt(int64(n)), nil)
+}
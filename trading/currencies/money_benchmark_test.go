@@ -0,0 +1,29 @@
+//nolint:testpackage
+package currencies
+
+import (
+	"testing"
+)
+
+func BenchmarkMoneyAdd(b *testing.B) {
+	x := NewMoney(1234.56, EUR)
+	y := NewMoney(0.01, EUR)
+
+	for i := 0; i < b.N; i++ {
+		_, _ = x.Add(y)
+	}
+}
+
+func BenchmarkNewMoney(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = NewMoney(2.675, EUR)
+	}
+}
+
+func BenchmarkMoneyFormat(b *testing.B) {
+	m := NewMoney(1234567.89, USD)
+
+	for i := 0; i < b.N; i++ {
+		_ = m.Format(LocaleEnUS)
+	}
+}
@@ -0,0 +1,331 @@
+//nolint:testpackage
+package currencies
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestNewMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount   float64
+		currency Currency
+		str      string
+	}{
+		{0, EUR, "0.00 EUR"},
+		{1234.5, EUR, "1234.50 EUR"},
+		{-1234.5, EUR, "-1234.50 EUR"},
+		{0.01, EUR, "0.01 EUR"},
+		{-0.07, USD, "-0.07 USD"},
+		// Banker's rounding of the shortest decimal representation.
+		{2.675, EUR, "2.68 EUR"},
+		{2.665, EUR, "2.66 EUR"},
+		{0.125, EUR, "0.12 EUR"},
+		{-0.125, EUR, "-0.12 EUR"},
+		{-0.135, EUR, "-0.14 EUR"},
+		{0.005, EUR, "0.00 EUR"},
+		{0.0051, EUR, "0.01 EUR"},
+		{1234.5, JPY, "1234 JPY"},
+		{1235.5, JPY, "1236 JPY"},
+		{1.0005, KWD, "1.000 KWD"},
+		{0.1, BTC, "0.10000000 BTC"},
+		{1e20, USD, "100000000000000000000.00 USD"},
+		{1.5, ETH, "1.500000000000000000 ETH"},
+		{math.NaN(), EUR, "0.00 EUR"},
+		{math.Inf(1), EUR, "0.00 EUR"},
+	}
+
+	for _, tt := range tests {
+		if act := NewMoney(tt.amount, tt.currency).String(); act != tt.str {
+			t.Errorf("NewMoney(%v, %v): expected '%v', actual '%v'", tt.amount, tt.currency, tt.str, act)
+		}
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount   string
+		currency Currency
+		str      string
+		err      bool
+	}{
+		{"1234.56", EUR, "1234.56 EUR", false},
+		{" -0.5 ", EUR, "-0.50 EUR", false},
+		{"0.115", EUR, "0.12 EUR", false},
+		{"0.105", EUR, "0.10 EUR", false},
+		{"12345678901234567890.123456789", EUR, "12345678901234567890.12 EUR", false},
+		{"1e2", EUR, "100.00 EUR", false},
+		{"", EUR, "", true},
+		{"abc", EUR, "", true},
+		{"1/3", EUR, "", true},
+	}
+
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.amount, tt.currency)
+
+		switch {
+		case tt.err && err == nil:
+			t.Errorf("ParseMoney('%v'): expected error, got success", tt.amount)
+		case !tt.err && err != nil:
+			t.Errorf("ParseMoney('%v'): expected success, got error %v", tt.amount, err)
+		case !tt.err && m.String() != tt.str:
+			t.Errorf("ParseMoney('%v'): expected '%v', actual '%v'", tt.amount, tt.str, m.String())
+		}
+	}
+}
+
+//nolint:funlen,cyclop
+func TestMoneyArithmetic(t *testing.T) {
+	t.Parallel()
+
+	a := NewMoneyMinor(1234, EUR)
+	b := NewMoneyMinor(-34, EUR)
+	c := NewMoneyMinor(100, USD)
+
+	if a.Currency() != EUR || a.Amount() != 12.34 || a.MinorUnits().Int64() != 1234 {
+		t.Errorf("accessors: actual %v %v %v", a.Currency(), a.Amount(), a.MinorUnits())
+	}
+
+	if s, err := a.Add(b); err != nil || s.String() != "12.00 EUR" {
+		t.Errorf("Add(): expected '12.00 EUR', actual '%v', %v", s, err)
+	}
+
+	if s, err := a.Sub(b); err != nil || s.String() != "12.68 EUR" {
+		t.Errorf("Sub(): expected '12.68 EUR', actual '%v', %v", s, err)
+	}
+
+	if _, err := a.Add(c); err == nil {
+		t.Error("Add(USD): expected error, got success")
+	}
+
+	if _, err := a.Sub(c); err == nil {
+		t.Error("Sub(USD): expected error, got success")
+	}
+
+	if _, err := a.Cmp(c); err == nil {
+		t.Error("Cmp(USD): expected error, got success")
+	}
+
+	if r, err := a.Cmp(b); err != nil || r != 1 {
+		t.Errorf("Cmp(): expected 1, actual %v, %v", r, err)
+	}
+
+	if r, _ := b.Cmp(a); r != -1 {
+		t.Errorf("Cmp(): expected -1, actual %v", r)
+	}
+
+	if a.Neg().String() != "-12.34 EUR" || b.Abs().String() != "0.34 EUR" {
+		t.Errorf("Neg(), Abs(): actual '%v' '%v'", a.Neg(), b.Abs())
+	}
+
+	if a.Sign() != 1 || b.Sign() != -1 || NewMoney(0, EUR).Sign() != 0 || !(Money{}).IsZero() {
+		t.Error("Sign(), IsZero(): unexpected result")
+	}
+
+	if !a.Equal(NewMoney(12.34, EUR)) || a.Equal(NewMoneyMinor(1234, USD)) || a.Equal(b) {
+		t.Error("Equal(): unexpected result")
+	}
+
+	// Adding 0.1 ten times does not drift.
+	s := NewMoney(0, EUR)
+	for i := 0; i < 10; i++ {
+		s, _ = s.Add(NewMoney(0.1, EUR))
+	}
+
+	if !s.Equal(NewMoney(1, EUR)) {
+		t.Errorf("10 x 0.1: expected '1.00 EUR', actual '%v'", s)
+	}
+
+	if act := a.Mul(0.5).String(); act != "6.17 EUR" {
+		t.Errorf("Mul(0.5): expected '6.17 EUR', actual '%v'", act)
+	}
+
+	if act := NewMoneyMinor(1250, EUR).Mul(0.001).String(); act != "0.01 EUR" {
+		t.Errorf("Mul(0.001): expected '0.01 EUR', actual '%v'", act)
+	}
+
+	if act := a.Mul(-3).String(); act != "-37.02 EUR" {
+		t.Errorf("Mul(-3): expected '-37.02 EUR', actual '%v'", act)
+	}
+
+	if act := a.Mul(math.NaN()).String(); act != "0.00 EUR" {
+		t.Errorf("Mul(NaN): expected '0.00 EUR', actual '%v'", act)
+	}
+
+	if sum, err := Sum(a, b, NewMoney(0.66, EUR)); err != nil || sum.String() != "12.66 EUR" {
+		t.Errorf("Sum(): expected '12.66 EUR', actual '%v', %v", sum, err)
+	}
+
+	if sum, err := Sum(); err != nil || !sum.IsZero() {
+		t.Errorf("Sum(): expected zero, actual '%v', %v", sum, err)
+	}
+
+	if _, err := Sum(a, c); err == nil {
+		t.Error("Sum(EUR, USD): expected error, got success")
+	}
+
+	// The zero value is a zero amount without a currency.
+	if z, err := (Money{}).Add(Money{}); err != nil || !z.IsZero() || z.Currency() != "" {
+		t.Errorf("zero value Add(): actual '%v', %v", z, err)
+	}
+}
+
+//nolint:funlen
+func TestMoneyAllocate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		m      Money
+		ratios []int
+		parts  []string
+	}{
+		{NewMoney(100, EUR), []int{1, 1, 1}, []string{"33.34 EUR", "33.33 EUR", "33.33 EUR"}},
+		{NewMoney(-100, EUR), []int{1, 1, 1}, []string{"-33.34 EUR", "-33.33 EUR", "-33.33 EUR"}},
+		{NewMoney(0.05, EUR), []int{3, 7}, []string{"0.02 EUR", "0.03 EUR"}},
+		{NewMoney(0.05, EUR), []int{70, 30}, []string{"0.04 EUR", "0.01 EUR"}},
+		{NewMoney(10, EUR), []int{1, 0, 1}, []string{"5.00 EUR", "0.00 EUR", "5.00 EUR"}},
+		{NewMoney(0.01, EUR), []int{1, 0, 1}, []string{"0.01 EUR", "0.00 EUR", "0.00 EUR"}},
+		{NewMoney(7, JPY), []int{1, 1}, []string{"4 JPY", "3 JPY"}},
+	}
+
+	for _, tt := range tests {
+		ps, err := tt.m.Allocate(tt.ratios...)
+		if err != nil {
+			t.Errorf("Allocate(%v, %v): expected success, got error %v", tt.m, tt.ratios, err)
+
+			continue
+		}
+
+		if len(ps) != len(tt.parts) {
+			t.Errorf("Allocate(%v, %v): expected %v parts, actual %v", tt.m, tt.ratios, len(tt.parts), len(ps))
+
+			continue
+		}
+
+		for i, p := range ps {
+			if p.String() != tt.parts[i] {
+				t.Errorf("Allocate(%v, %v)[%d]: expected '%v', actual '%v'", tt.m, tt.ratios, i, tt.parts[i], p)
+			}
+		}
+
+		if s, _ := Sum(ps...); !s.Equal(tt.m) {
+			t.Errorf("Allocate(%v, %v): parts sum to %v", tt.m, tt.ratios, s)
+		}
+	}
+
+	for _, ratios := range [][]int{nil, {0, 0}, {1, -1}} {
+		if _, err := NewMoney(1, EUR).Allocate(ratios...); err == nil {
+			t.Errorf("Allocate(%v): expected error, got success", ratios)
+		}
+	}
+
+	ps, err := NewMoney(0.1, EUR).Split(3)
+	if err != nil || len(ps) != 3 || ps[0].String() != "0.04 EUR" || ps[2].String() != "0.03 EUR" {
+		t.Errorf("Split(3): actual %v, %v", ps, err)
+	}
+
+	if _, err := NewMoney(1, EUR).Split(0); err == nil {
+		t.Error("Split(0): expected error, got success")
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	t.Parallel()
+
+	uc := NewUpdatableConverter()
+	uc.Update(EUR, USD, 1.25)
+	uc.Update(USD, JPY, 110.5)
+
+	tests := []struct {
+		m    Money
+		term Currency
+		str  string
+		err  bool
+	}{
+		{NewMoney(10.01, EUR), USD, "12.51 USD", false},
+		{NewMoney(10.03, EUR), USD, "12.54 USD", false},
+		{NewMoney(12.5, USD), EUR, "10.00 EUR", false},
+		{NewMoney(10, USD), JPY, "1105 JPY", false},
+		{NewMoney(10, EUR), JPY, "1381 JPY", false},
+		{NewMoney(10, EUR), EUR, "10.00 EUR", false},
+		{NewMoney(10, EUR), CHF, "", true},
+	}
+
+	for _, tt := range tests {
+		act, err := tt.m.Convert(uc, tt.term)
+
+		switch {
+		case tt.err && err == nil:
+			t.Errorf("Convert(%v, %v): expected error, got success", tt.m, tt.term)
+		case !tt.err && err != nil:
+			t.Errorf("Convert(%v, %v): expected success, got error %v", tt.m, tt.term, err)
+		case !tt.err && act.String() != tt.str:
+			t.Errorf("Convert(%v, %v): expected '%v', actual '%v'", tt.m, tt.term, tt.str, act)
+		}
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	t.Parallel()
+
+	const nbsp = "\u00a0"
+
+	tests := []struct {
+		m   Money
+		l   Locale
+		str string
+	}{
+		{NewMoney(1234567.891, USD), LocaleEnUS, "$1,234,567.89"},
+		{NewMoney(-1234.5, USD), LocaleEnUS, "-$1,234.50"},
+		{NewMoney(0.5, GBP), LocaleEnGB, "£0.50"},
+		{NewMoney(123, GBP), LocaleEnGB, "£123.00"},
+		{NewMoney(1234.5, EUR), LocaleDeDE, "1.234,50" + nbsp + "€"},
+		{NewMoney(-1234567, EUR), LocaleFrFR, "-1" + nbsp + "234" + nbsp + "567,00" + nbsp + "€"},
+		{NewMoney(1234.5, EUR), LocaleNlNL, "€" + nbsp + "1.234,50"},
+		{NewMoney(1234.5, CHF), LocaleDeCH, "Fr" + nbsp + "1'234.50"},
+		{NewMoney(1234.5, SEK), LocaleSvSE, "1" + nbsp + "234,50" + nbsp + "kr"},
+		{NewMoney(1234567, JPY), LocaleEnUS, "¥1,234,567"},
+		// Currency without a symbol.
+		{NewMoney(1234.5, AED), LocaleEnUS, "AED" + nbsp + "1,234.50"},
+		{NewMoney(1234.5, AED), LocaleDeDE, "1.234,50" + nbsp + "AED"},
+		// No grouping.
+		{NewMoney(1234.5, EUR), Locale{DecimalSeparator: "."}, "1234.50€"},
+	}
+
+	for _, tt := range tests {
+		if act := tt.m.Format(tt.l); act != tt.str {
+			t.Errorf("Format(%v): expected '%v', actual '%v'", tt.m, tt.str, act)
+		}
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	t.Parallel()
+
+	m := NewMoney(-1234.5, EUR)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("MarshalJSON(): expected success, got error %v", err)
+	}
+
+	if exp := `{"amount":"-1234.50","currency":"EUR"}`; string(b) != exp {
+		t.Errorf("MarshalJSON(): expected '%v', actual '%s'", exp, b)
+	}
+
+	var u Money
+	if err := json.Unmarshal(b, &u); err != nil || !u.Equal(m) {
+		t.Errorf("UnmarshalJSON(): expected %v, actual %v, %v", m, u, err)
+	}
+
+	for _, s := range []string{`{"amount":"x","currency":"EUR"}`, `{"amount":1,"currency":"EUR"}`, `{"a":"1"}`, `[]`} {
+		if err := json.Unmarshal([]byte(s), &u); err == nil {
+			t.Errorf("UnmarshalJSON(%v): expected error, got success", s)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package currencies
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// definition is the compiled-in data of a predefined currency.
+type definition struct {
+	currency Currency
+	decimals int
+	symbol   string
+	name     string
+}
+
+// Entry is the reference data of a currency held by a Registry.
+type Entry struct {
+	// Currency is the ISO 4217 three-letter alphabetic code.
+	Currency Currency
+
+	// Name is the name of the currency.
+	Name string
+
+	// Numeric is the ISO 4217 three-digit numeric code or zero if not known.
+	Numeric int
+
+	// Decimals is the number of minor units (digits after the decimal separator).
+	Decimals int
+
+	// Symbol is the currency symbol to display.
+	Symbol string
+
+	// Withdrawn indicates if the currency is withdrawn from use.
+	Withdrawn bool
+}
+
+// Changes reports how a data release loaded into a Registry changed it.
+// The currencies are listed in the order of the data release.
+type Changes struct {
+	// Added are the currencies which were not known before.
+	Added []Currency
+
+	// Modified are the known currencies with a changed number of minor units or numeric code,
+	// except the Withdrawn ones.
+	Modified []Currency
+
+	// Withdrawn are the known currencies in use which are withdrawn in the data release.
+	Withdrawn []Currency
+}
+
+// Registry is a thread-safe registry of the ISO 4217 reference data of currencies.
+//
+// It starts with the compiled-in predefined currencies and merges newer ISO 4217 data releases
+// loaded at runtime, so a long-running service picks up new currencies without a rebuild.
+// The currencies not present in a data release, like the minor units or cryptocurrencies, are kept as they are.
+//
+// The Currency methods like Symbol or Decimals always use the compiled-in data.
+//
+// Use the NewRegistry to create a properly initialized new instance.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[Currency]*Entry
+}
+
+// The ISO 4217 CSV columns, in upper case without spaces.
+const (
+	columnName       = "CURRENCY"
+	columnCode       = "ALPHABETICCODE"
+	columnNumeric    = "NUMERICCODE"
+	columnMinorUnit  = "MINORUNIT"
+	columnWithdrawal = "WITHDRAWALDATE"
+	notApplicable    = "N.A."
+	codeLength       = 3
+)
+
+var (
+	errInvalidCSVHeader = errors.New("invalid CSV header")
+	errInvalidCode      = errors.New("alphabetic code should have 3 upper case letters")
+	errInvalidNumeric   = errors.New("numeric code should have 3 digits")
+	errInvalidMinorUnit = errors.New("minor unit should be a non-negative integer or N.A.")
+)
+
+// NewRegistry creates a new registry containing the compiled-in predefined currencies.
+func NewRegistry() *Registry {
+	r := Registry{entries: make(map[Currency]*Entry, len(predefined))}
+
+	for _, d := range predefined {
+		r.entries[d.currency] = &Entry{
+			Currency: d.currency,
+			Name:     d.name,
+			Decimals: d.decimals,
+			Symbol:   d.symbol,
+		}
+	}
+
+	return &r
+}
+
+// Lookup returns a copy of the reference data of a currency.
+// The boolean is false if the currency is not known to the registry.
+func (r *Registry) Lookup(c Currency) (Entry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if e, ok := r.entries[c]; ok {
+		return *e, true
+	}
+
+	return Entry{}, false
+}
+
+// Entries returns copies of all entries of the registry sorted by currency.
+func (r *Registry) Entries() []Entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	es := make([]Entry, 0, len(r.entries))
+	for _, e := range r.entries {
+		es = append(es, *e)
+	}
+
+	sort.Slice(es, func(i, j int) bool { return es[i].Currency < es[j].Currency })
+
+	return es
+}
+
+// Len returns the number of currencies known to the registry.
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.entries)
+}
+
+// LoadCSV merges an ISO 4217 data release in the CSV format and reports the changes.
+//
+// The first row is a header with the ISO 4217 column names ENTITY, Currency, Alphabetic Code,
+// Numeric Code, Minor unit and an optional Withdrawal Date, in any order; the case and the spaces
+// are ignored. The Alphabetic Code column is required.
+//
+// A currency is usually listed once per entity using it. It is withdrawn if all its rows
+// have a withdrawal date. The rows without an alphabetic code, like entities without
+// a universal currency, are skipped. The minor unit N.A. keeps the known number of minor units.
+//
+// The known currencies keep their names and symbols. The compiled-in currencies have no numeric codes
+// and get them from the first data release without being reported as modified.
+//
+// The data release is merged atomically: if any row is invalid, the registry is not changed.
+func (r *Registry) LoadCSV(rd io.Reader) (Changes, error) {
+	cr := csv.NewReader(rd)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return Changes{}, fmt.Errorf("cannot read currencies: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return Changes{}, errInvalidCSVHeader
+	}
+
+	columns, err := parseHeader(rows[0])
+	if err != nil {
+		return Changes{}, err
+	}
+
+	var es []*releaseEntry
+
+	byCurrency := make(map[Currency]*releaseEntry)
+
+	for i, row := range rows[1:] {
+		e, err := parseRow(columns, row)
+		if err != nil {
+			return Changes{}, fmt.Errorf("cannot parse currency at row %d: %w", i+2, err) //nolint:gomnd
+		}
+
+		if e == nil {
+			continue
+		}
+
+		if prev, ok := byCurrency[e.Currency]; ok {
+			prev.Withdrawn = prev.Withdrawn && e.Withdrawn
+
+			continue
+		}
+
+		byCurrency[e.Currency] = e
+		es = append(es, e)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.merge(es), nil
+}
+
+// releaseEntry is an entry parsed from a data release.
+type releaseEntry struct {
+	Entry
+	knownDecimals bool
+}
+
+// merge merges the entries and reports the changes, the caller should hold the lock.
+func (r *Registry) merge(es []*releaseEntry) Changes {
+	var c Changes
+
+	for _, re := range es {
+		old, ok := r.entries[re.Currency]
+		if !ok {
+			e := re.Entry
+			r.entries[e.Currency] = &e
+			c.Added = append(c.Added, e.Currency)
+
+			continue
+		}
+
+		e := *old
+		if re.knownDecimals {
+			e.Decimals = re.Decimals
+		}
+
+		if re.Numeric != 0 {
+			e.Numeric = re.Numeric
+		}
+
+		e.Withdrawn = re.Withdrawn
+
+		switch {
+		case !old.Withdrawn && e.Withdrawn:
+			c.Withdrawn = append(c.Withdrawn, e.Currency)
+		case e.Decimals != old.Decimals || (old.Numeric != 0 && e.Numeric != old.Numeric):
+			c.Modified = append(c.Modified, e.Currency)
+		}
+
+		*old = e
+	}
+
+	return c
+}
+
+// parseHeader returns the indices of the known columns.
+func parseHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+
+	for i, h := range header {
+		h = strings.ToUpper(strings.TrimPrefix(h, "\ufeff"))
+		columns[strings.NewReplacer(" ", "", "_", "").Replace(h)] = i
+	}
+
+	if _, ok := columns[columnCode]; !ok {
+		return nil, fmt.Errorf("missing column 'Alphabetic Code': %w", errInvalidCSVHeader)
+	}
+
+	return columns, nil
+}
+
+// parseRow parses a row or returns nil if the row has no alphabetic code.
+func parseRow(columns map[string]int, row []string) (*releaseEntry, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+
+		return ""
+	}
+
+	code := field(columnCode)
+	if code == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	if !isValidCode(code) {
+		return nil, fmt.Errorf("'%s': %w", code, errInvalidCode)
+	}
+
+	e := releaseEntry{Entry: Entry{
+		Currency:  Currency(code),
+		Name:      field(columnName),
+		Withdrawn: field(columnWithdrawal) != "",
+	}}
+
+	if s := field(columnNumeric); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 || n > 999 || len(s) != codeLength {
+			return nil, fmt.Errorf("%s '%s': %w", code, s, errInvalidNumeric)
+		}
+
+		e.Numeric = n
+	}
+
+	if s := field(columnMinorUnit); s != "" && !strings.EqualFold(s, notApplicable) {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("%s '%s': %w", code, s, errInvalidMinorUnit)
+		}
+
+		e.Decimals = n
+		e.knownDecimals = true
+	}
+
+	return &e, nil
+}
+
+func isValidCode(code string) bool {
+	if len(code) != codeLength {
+		return false
+	}
+
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,139 @@
+//nolint:testpackage
+package currencies
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegistryNew(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry()
+	if act := r.Len(); act != len(predefined) {
+		t.Errorf("Len(): expected %v, actual %v", len(predefined), act)
+	}
+
+	e, ok := r.Lookup(EUR)
+	exp := Entry{Currency: EUR, Name: "Euro (€).", Decimals: 2, Symbol: "€"}
+
+	if !ok || !reflect.DeepEqual(e, exp) {
+		t.Errorf("Lookup(EUR): expected %v, actual %v, %v", exp, e, ok)
+	}
+
+	for _, d := range predefined {
+		if e, _ := r.Lookup(d.currency); e.Decimals != d.currency.Decimals() || e.Symbol != d.currency.Symbol() {
+			t.Errorf("Lookup(%v): expected the compiled-in decimals and symbol, actual %v", d.currency, e)
+		}
+	}
+
+	if _, ok := r.Lookup("FOO"); ok {
+		t.Error("Lookup(FOO): expected not to be found")
+	}
+
+	es := r.Entries()
+	if len(es) != len(predefined) {
+		t.Errorf("Entries(): expected %v entries, actual %v", len(predefined), len(es))
+	}
+
+	for i := 1; i < len(es); i++ {
+		if es[i-1].Currency >= es[i].Currency {
+			t.Errorf("Entries(): expected sorted currencies, got %v before %v", es[i-1].Currency, es[i].Currency)
+		}
+	}
+}
+
+func TestRegistryLoadCSV(t *testing.T) {
+	t.Parallel()
+
+	const data = "\ufeffENTITY,Currency,Alphabetic Code,Numeric Code,Minor unit,Withdrawal Date\n" +
+		"AUSTRIA,Euro,EUR,978,2,\n" +
+		"FRANCE,Euro,EUR,978,2,\n" +
+		"ANTARCTICA,No universal currency,,,,\n" +
+		"GOLD,Gold,XAU,959,N.A.,\n" +
+		"ICELAND,Iceland Krona,ISK,352,2,\n" +
+		"NEWLAND,New Dollar,NWD,901,2,\n" +
+		"CROATIA,Kuna,HRK,191,2,2023-01\n" +
+		"VENEZUELA (BOLIVARIAN REPUBLIC OF),Bolívar Soberano,VES,928,2,\n"
+
+	r := NewRegistry()
+	n := r.Len()
+
+	c, err := r.LoadCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadCSV(): unexpected error: %v", err)
+	}
+
+	exp := Changes{Added: []Currency{"NWD"}, Modified: []Currency{ISK}, Withdrawn: []Currency{HRK}}
+	if !reflect.DeepEqual(c, exp) {
+		t.Errorf("LoadCSV(): expected %v, actual %v", exp, c)
+	}
+
+	if act := r.Len(); act != n+1 {
+		t.Errorf("Len(): expected %v, actual %v", n+1, act)
+	}
+
+	check := func(c Currency, exp Entry) {
+		t.Helper()
+
+		if act, _ := r.Lookup(c); !reflect.DeepEqual(act, exp) {
+			t.Errorf("Lookup(%v): expected %v, actual %v", c, exp, act)
+		}
+	}
+
+	check(EUR, Entry{Currency: EUR, Name: "Euro (€).", Numeric: 978, Decimals: 2, Symbol: "€"})
+	check(XAU, Entry{Currency: XAU, Name: "Gold (one troy ounce).", Numeric: 959, Decimals: 5, Symbol: XAU.Symbol()})
+	check("NWD", Entry{Currency: "NWD", Name: "New Dollar", Numeric: 901, Decimals: 2})
+	check(VES, Entry{Currency: VES, Name: "Venezuelan bolívar (Bs.S, B$).", Numeric: 928, Decimals: 2, Symbol: "Bs.S"})
+
+	if e, _ := r.Lookup(HRK); !e.Withdrawn {
+		t.Error("Lookup(HRK): expected withdrawn")
+	}
+
+	if ISK.Decimals() != 0 {
+		t.Error("ISK.Decimals(): expected compiled-in data to be unchanged")
+	}
+
+	c, err = r.LoadCSV(strings.NewReader(data))
+	if err != nil || !reflect.DeepEqual(c, Changes{}) {
+		t.Errorf("LoadCSV() again: expected no changes, actual %v, %v", c, err)
+	}
+
+	c, _ = r.LoadCSV(strings.NewReader("Alphabetic Code,Numeric Code\nEUR,999\n"))
+	if !reflect.DeepEqual(c, Changes{Modified: []Currency{EUR}}) {
+		t.Errorf("LoadCSV() numeric code: expected EUR modified, actual %v", c)
+	}
+}
+
+func TestRegistryLoadCSVErrors(t *testing.T) {
+	t.Parallel()
+
+	const header = "ENTITY,Currency,Alphabetic Code,Numeric Code,Minor unit\n"
+
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"empty", "", errInvalidCSVHeader},
+		{"missing column", "ENTITY,Currency\n", errInvalidCSVHeader},
+		{"invalid code", header + "X,Euro,eur,978,2\n", errInvalidCode},
+		{"invalid numeric code", header + "X,Euro,EUR,97,2\n", errInvalidNumeric},
+		{"invalid minor unit", header + "X,Euro,EUR,978,two\n", errInvalidMinorUnit},
+	}
+
+	for _, tt := range tests {
+		r := NewRegistry()
+
+		_, err := r.LoadCSV(strings.NewReader(tt.data + "NEWLAND,New Dollar,NWD,901,2\n"))
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: expected error %v, actual %v", tt.name, tt.err, err)
+		}
+
+		if _, ok := r.Lookup("NWD"); ok {
+			t.Errorf("%s: expected the registry not to be changed", tt.name)
+		}
+	}
+}
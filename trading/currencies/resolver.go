@@ -0,0 +1,73 @@
+package currencies
+
+// directRate returns a known direct exchange rate from the base currency to the term currency.
+type directRate func(base, term Currency) (float64, bool)
+
+// resolveRate resolves an exchange rate from the base currency to the term currency.
+//
+// The rate is taken as the first one found of
+//   - the direct or the inverse rate between the currencies as given, so that a rate
+//     known for a minor unit, e.g. GBX/USD, is used as is,
+//   - the direct or the inverse rate between the major units of the currencies,
+//   - the cross rate through the first pivot currency for which both legs are known,
+//     each leg being a direct or an inverse rate between the major units.
+//
+// Returns zero if the rate cannot be resolved.
+func resolveRate(direct directRate, pivots []Currency, base, term Currency) float64 {
+	if r, ok := pairRate(direct, base, term); ok {
+		return r
+	}
+
+	base, baseFactor := base.MajorUnit()
+	term, termFactor := term.MajorUnit()
+
+	if r, ok := pairRate(direct, base, term); ok {
+		return r * baseFactor / termFactor
+	}
+
+	for _, p := range pivots {
+		if p == base || p == term {
+			continue
+		}
+
+		r1, ok := pairRate(direct, base, p)
+		if !ok {
+			continue
+		}
+
+		if r2, ok := pairRate(direct, p, term); ok {
+			return r1 * r2 * baseFactor / termFactor
+		}
+	}
+
+	return 0
+}
+
+// pairRate returns a direct or an inverse exchange rate between two currencies.
+func pairRate(direct directRate, base, term Currency) (float64, bool) {
+	if base == term {
+		return 1, true
+	}
+
+	if r, ok := direct(base, term); ok && r > 0 {
+		return r, true
+	}
+
+	if r, ok := direct(term, base); ok && r > 0 {
+		return 1 / r, true
+	}
+
+	return 0, false
+}
+
+// defaultPivots returns the pivots or USD and EUR if none are given.
+func defaultPivots(pivots []Currency) []Currency {
+	if len(pivots) == 0 {
+		return []Currency{USD, EUR}
+	}
+
+	ps := make([]Currency, len(pivots))
+	copy(ps, pivots)
+
+	return ps
+}
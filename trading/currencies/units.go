@@ -0,0 +1,23 @@
+package currencies
+
+// minorUnitFactor is the number of major units in one minor unit.
+const minorUnitFactor = 0.01
+
+// MajorUnit returns the currency whose fractional (minor) unit is this currency and the number
+// of major units in one minor unit, e.g. GBX returns GBP and 0.01.
+//
+// A currency which is not a minor unit returns itself and 1.
+func (c Currency) MajorUnit() (Currency, float64) {
+	switch c {
+	case EUX:
+		return EUR, minorUnitFactor
+	case USX:
+		return USD, minorUnitFactor
+	case GBX:
+		return GBP, minorUnitFactor
+	case ZAC:
+		return ZAR, minorUnitFactor
+	default:
+		return c, 1
+	}
+}
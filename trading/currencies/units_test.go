@@ -0,0 +1,28 @@
+//nolint:testpackage
+package currencies
+
+import (
+	"testing"
+)
+
+func TestMajorUnit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c, major Currency
+		factor   float64
+	}{
+		{EUX, EUR, 0.01},
+		{USX, USD, 0.01},
+		{GBX, GBP, 0.01},
+		{ZAC, ZAR, 0.01},
+		{GBP, GBP, 1},
+		{JPY, JPY, 1},
+	}
+
+	for _, tt := range tests {
+		if major, factor := tt.c.MajorUnit(); major != tt.major || factor != tt.factor {
+			t.Errorf("'%v'.MajorUnit(): expected %v and %v, actual %v and %v", tt.c, tt.major, tt.factor, major, factor)
+		}
+	}
+}
@@ -4,17 +4,26 @@ import "sync"
 
 // UpdatableConverter is a thread-safe Converter with updatable currency exchange rates.
 //
+// When a direct exchange rate is not known, the inverse of the opposite direct rate is used,
+// or a cross rate is triangulated through one of the pivot currencies.
+// The minor currency units like GBX or USX are converted to and from their major units.
+//
 // Use the NewUpdatableConverter to create a properly initialized new instance.
 type UpdatableConverter struct {
 	mu         sync.RWMutex
 	knownRates map[Currency]map[Currency]float64
+	pivots     []Currency
 }
 
 //nolint:exhaustivestruct
 // NewUpdatableConverter creates a new empty UpdatableConverter without exchange rates.
-func NewUpdatableConverter() *UpdatableConverter {
+//
+// The pivot currencies are tried in the given order to triangulate cross rates.
+// If no pivots are given, USD and EUR are used.
+func NewUpdatableConverter(pivots ...Currency) *UpdatableConverter {
 	uc := UpdatableConverter{
 		knownRates: make(map[Currency]map[Currency]float64),
+		pivots:     defaultPivots(pivots),
 	}
 
 	return &uc
@@ -37,13 +46,18 @@ func (uc *UpdatableConverter) ExchangeRate(base, term Currency) float64 {
 	uc.mu.RLock()
 	defer uc.mu.RUnlock()
 
+	return resolveRate(uc.direct, uc.pivots, base, term)
+}
+
+// direct returns a known direct exchange rate, the caller should hold the lock.
+func (uc *UpdatableConverter) direct(base, term Currency) (float64, bool) {
 	if m, ok := uc.knownRates[base]; ok {
 		if r, ok := m[term]; ok {
-			return r
+			return r, true
 		}
 	}
 
-	return 0
+	return 0, false
 }
 
 // KnownBaseCurrencies implements Converter.
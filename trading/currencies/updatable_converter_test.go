@@ -2,6 +2,7 @@
 package currencies
 
 import (
+	"math"
 	"testing"
 )
 
@@ -86,3 +87,95 @@ func hasTwo(s []Currency, c1, c2 Currency) bool {
 
 	return false
 }
+
+func TestInverseAndCrossRates(t *testing.T) {
+	t.Parallel()
+
+	const (
+		eurUsd = 1.25
+		usdJpy = 110.0
+		gbpUsd = 1.4
+		sekEur = 0.1
+		tol    = 1e-12
+	)
+
+	uc := NewUpdatableConverter()
+	uc.Update(EUR, USD, eurUsd)
+	uc.Update(USD, JPY, usdJpy)
+	uc.Update(GBP, USD, gbpUsd)
+	uc.Update(SEK, EUR, sekEur)
+
+	tests := []struct {
+		base, term Currency
+		exp        float64
+	}{
+		{EUR, USD, eurUsd},
+		{USD, EUR, 1 / eurUsd},
+		{EUR, JPY, eurUsd * usdJpy},
+		{JPY, EUR, 1 / (eurUsd * usdJpy)},
+		{GBP, JPY, gbpUsd * usdJpy},
+		{GBP, EUR, gbpUsd / eurUsd},
+		{SEK, USD, sekEur * eurUsd},
+		{SEK, JPY, 0},
+		{CHF, USD, 0},
+		{GBX, USD, gbpUsd / 100},
+		{USD, GBX, 100 / gbpUsd},
+		{GBX, USX, gbpUsd},
+		{GBP, GBX, 100},
+		{USX, USD, 0.01},
+		{EUX, JPY, eurUsd * usdJpy / 100},
+	}
+
+	for _, tt := range tests {
+		if act := uc.ExchangeRate(tt.base, tt.term); math.Abs(act-tt.exp) > tol*math.Max(1, tt.exp) {
+			t.Errorf("ExchangeRate(%v, %v): expected %v, actual %v", tt.base, tt.term, tt.exp, act)
+		}
+	}
+
+	// With JPY as the only pivot, GBP to EUR cannot be triangulated through USD.
+	uc = NewUpdatableConverter(JPY)
+	uc.Update(EUR, USD, eurUsd)
+	uc.Update(USD, JPY, usdJpy)
+	uc.Update(GBP, USD, gbpUsd)
+
+	if act := uc.ExchangeRate(GBP, EUR); act != 0 {
+		t.Errorf("ExchangeRate(GBP, EUR) with JPY pivot: expected 0, actual %v", act)
+	}
+
+	if conv, rate := uc.Convert(amount, EUR, USD); rate != eurUsd || conv != amount*eurUsd {
+		t.Errorf("Convert(%v, EUR, USD): expected rate %v and amount %v, actual %v and %v",
+			amount, eurUsd, amount*eurUsd, rate, conv)
+	}
+}
+
+func TestMinorUnitDirectRate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		gbxUsd = 0.0125
+		gbpUsd = 1.4
+		tol    = 1e-12
+	)
+
+	// The direct minor unit rate takes precedence over the one derived from GBP/USD.
+	uc := NewUpdatableConverter()
+	uc.Update(GBP, USD, gbpUsd)
+	uc.Update(GBX, USD, gbxUsd)
+
+	if act := uc.ExchangeRate(GBX, USD); act != gbxUsd {
+		t.Errorf("ExchangeRate(GBX, USD): expected %v, actual %v", gbxUsd, act)
+	}
+
+	if act := uc.ExchangeRate(USD, GBX); math.Abs(act-1/gbxUsd) > tol/gbxUsd {
+		t.Errorf("ExchangeRate(USD, GBX): expected %v, actual %v", 1/gbxUsd, act)
+	}
+
+	if conv, rate := uc.Convert(amount, GBX, USD); rate != gbxUsd || math.Abs(conv-amount*gbxUsd) > tol*amount {
+		t.Errorf("Convert(%v, GBX, USD): expected rate %v and amount %v, actual %v and %v",
+			amount, gbxUsd, amount*gbxUsd, rate, conv)
+	}
+
+	if act := uc.ExchangeRate(GBP, USD); act != gbpUsd {
+		t.Errorf("ExchangeRate(GBP, USD): expected %v, actual %v", gbpUsd, act)
+	}
+}
@@ -26,6 +26,11 @@ type Bar struct {
 	Volume float64 `json:"volume"`
 }
 
+// DateTime implements the Temporal interface.
+func (b *Bar) DateTime() time.Time {
+	return b.Time
+}
+
 // IsRising indicates whether this is a rising bar, i.e. the opening price is less than the closing price.
 func (b *Bar) IsRising() bool {
 	return b.Open < b.Close
@@ -0,0 +1,89 @@
+// Package adjustments enumerates methods to adjust prices of a continuous futures series at roll dates.
+package adjustments
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Adjustment enumerates methods to adjust prices of a continuous futures series at roll dates.
+type Adjustment int
+
+const (
+	// Difference back-adjusts the prices before a roll by adding the price gap
+	// between the next and the front contracts on the roll date.
+	Difference Adjustment = iota + 1
+
+	// Ratio back-adjusts the prices before a roll by multiplying them by the price ratio
+	// of the next and the front contracts on the roll date.
+	Ratio
+
+	// None does not adjust prices, the series contains price gaps at roll dates.
+	None
+	last
+)
+
+const (
+	unknown    = "unknown"
+	difference = "difference"
+	ratio      = "ratio"
+	none       = "none"
+)
+
+var errUnknownAdjustment = errors.New("unknown adjustment method")
+
+// String implements the fmt.Stringer interface.
+func (a Adjustment) String() string {
+	switch a {
+	case Difference:
+		return difference
+	case Ratio:
+		return ratio
+	case None:
+		return none
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this adjustment method is known.
+func (a Adjustment) IsKnown() bool {
+	return a >= Difference && a < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (a Adjustment) MarshalJSON() ([]byte, error) {
+	str := a.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownAdjustment)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (a *Adjustment) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case difference:
+		*a = Difference
+	case ratio:
+		*a = Ratio
+	case none:
+		*a = None
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownAdjustment)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+//nolint:testpackage
+package adjustments
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := None
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := None
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var a Adjustment
+
+	bs := []byte("\"none\"")
+	for i := 0; i < b.N; i++ {
+		_ = a.UnmarshalJSON(bs)
+	}
+}
@@ -0,0 +1,140 @@
+//nolint:testpackage
+package adjustments
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a    Adjustment
+		text string
+	}{
+		{Difference, difference},
+		{Ratio, ratio},
+		{None, none},
+		{last, unknown},
+		{Adjustment(0), unknown},
+		{Adjustment(9999), unknown},
+		{Adjustment(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.a.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.a, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a       Adjustment
+		boolean bool
+	}{
+		{Difference, true},
+		{Ratio, true},
+		{None, true},
+		{last, false},
+		{Adjustment(0), false},
+		{Adjustment(9999), false},
+		{Adjustment(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.a.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.a, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		a         Adjustment
+		json      string
+		succeeded bool
+	}{
+		{Difference, "\"difference\"", true},
+		{Ratio, "\"ratio\"", true},
+		{None, "\"none\"", true},
+		{last, nilstr, false},
+		{Adjustment(9999), nilstr, false},
+		{Adjustment(-9999), nilstr, false},
+		{Adjustment(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.a.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.a, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.a)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.a, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Adjustment
+	tests := []struct {
+		a         Adjustment
+		json      string
+		succeeded bool
+	}{
+		{Difference, "\"difference\"", true},
+		{Ratio, "\"ratio\"", true},
+		{None, "\"none\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.a
+		bs := []byte(tt.json)
+
+		var a Adjustment
+
+		err := a.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != a {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, a)
+		}
+	}
+}
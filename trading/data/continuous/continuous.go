@@ -0,0 +1,301 @@
+// Package continuous stitches individual futures contract series into a continuous series.
+package continuous
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"mbg/trading/data"
+	"mbg/trading/data/continuous/adjustments"
+	"mbg/trading/data/continuous/rolls"
+)
+
+// Contract is a single futures contract with its price history.
+type Contract struct {
+	// Symbol is a mnemonic of the contract.
+	Symbol string
+
+	// Expiry is the expiration date of the contract.
+	Expiry time.Time
+
+	// Bars is the time-ordered bar history of the contract.
+	Bars []data.Bar
+
+	// OpenInterest is the time-ordered open interest history of the contract.
+	// Used by the OpenInterestCrossover roll rule only.
+	OpenInterest []data.Scalar
+}
+
+// Roll describes a roll from the front contract to the next one.
+type Roll struct {
+	// Time is the roll date, the date of the first bar taken from the next contract.
+	Time time.Time
+
+	// From is the symbol of the front contract.
+	From string
+
+	// To is the symbol of the next contract.
+	To string
+
+	// FromClose is the closing price of the front contract on the roll date.
+	FromClose float64
+
+	// ToClose is the closing price of the next contract on the roll date.
+	ToClose float64
+
+	// Adjustment is the adjustment introduced by this roll:
+	// the price gap ToClose - FromClose for the Difference method,
+	// the price ratio ToClose / FromClose for the Ratio method
+	// and zero for the None method.
+	Adjustment float64
+
+	// Cumulative is the adjustment applied to all bars before the roll date,
+	// combined from the adjustments of this and all subsequent rolls.
+	Cumulative float64
+}
+
+// Series is a continuous futures series.
+type Series struct {
+	// Bars is the time-ordered continuous bar series.
+	Bars []data.Bar
+
+	// Symbols contains the symbol of the source contract of each bar.
+	Symbols []string
+
+	// Rolls contains the time-ordered rolls.
+	Rolls []Roll
+}
+
+var (
+	errNoCommonBars     = errors.New("contracts have no common bars before the expiry")
+	errNonPositiveClose = errors.New("closing prices should be positive")
+)
+
+// Build constructs a continuous series from the individual contract series.
+//
+// The contracts are ordered by expiry. The last contract is never adjusted, the prices
+// of the preceding contracts are back-adjusted to remove the gaps at the roll dates.
+//
+// A roll date is always a date where both the front and the next contracts have bars,
+// not after the expiry of the front contract. When a roll rule cannot find a roll date,
+// the last common date is used.
+func Build(p *Params, contracts []Contract) (*Series, error) {
+	const (
+		invalid = "invalid continuous series parameters"
+		fmts    = "%s: %s"
+	)
+
+	if !p.Rule.IsKnown() {
+		return nil, fmt.Errorf(fmts, invalid, "unknown roll rule")
+	}
+
+	if !p.Adjustment.IsKnown() {
+		return nil, fmt.Errorf(fmts, invalid, "unknown adjustment method")
+	}
+
+	if p.DaysBeforeExpiry < 0 {
+		return nil, fmt.Errorf(fmts, invalid, "days before expiry should not be negative")
+	}
+
+	if p.MonthsBeforeExpiry < 0 {
+		return nil, fmt.Errorf(fmts, invalid, "months before expiry should not be negative")
+	}
+
+	if p.Rule == rolls.Calendar && (p.DayOfMonth < 1 || p.DayOfMonth > 31) {
+		return nil, fmt.Errorf(fmts, invalid, "day of month should be in the range [1, 31]")
+	}
+
+	if len(contracts) == 0 {
+		return nil, fmt.Errorf(fmts, invalid, "no contracts")
+	}
+
+	cs := make([]*Contract, len(contracts))
+	for i := range contracts {
+		cs[i] = &contracts[i]
+	}
+
+	sort.SliceStable(cs, func(i, j int) bool { return cs[i].Expiry.Before(cs[j].Expiry) })
+
+	rs := make([]Roll, len(cs)-1)
+
+	var prev time.Time
+
+	for i := range rs {
+		r, err := findRoll(p, cs[i], cs[i+1], prev)
+		if err != nil {
+			return nil, err
+		}
+
+		rs[i] = r
+		prev = r.Time
+	}
+
+	if err := adjust(p.Adjustment, rs); err != nil {
+		return nil, err
+	}
+
+	return stitch(p.Adjustment, cs, rs), nil
+}
+
+// findRoll finds the roll from the front to the next contract after the previous roll date.
+func findRoll(p *Params, front, next *Contract, prev time.Time) (Roll, error) {
+	type pair struct{ f, n int }
+
+	var ps []pair
+
+	for i, j := 0, 0; i < len(front.Bars) && j < len(next.Bars); {
+		tf, tn := front.Bars[i].Time, next.Bars[j].Time
+
+		switch {
+		case tf.Before(tn):
+			i++
+		case tn.Before(tf):
+			j++
+		default:
+			if tf.After(prev) && !tf.After(front.Expiry) {
+				ps = append(ps, pair{i, j})
+			}
+
+			i++
+			j++
+		}
+	}
+
+	if len(ps) == 0 {
+		return Roll{}, fmt.Errorf("cannot roll from '%s' to '%s': %w", front.Symbol, next.Symbol, errNoCommonBars)
+	}
+
+	k := len(ps) - 1
+
+	for i, pp := range ps {
+		fb, nb := &front.Bars[pp.f], &next.Bars[pp.n]
+
+		if isRollDate(p, front, next, fb, nb) {
+			k = i
+
+			break
+		}
+	}
+
+	fb, nb := &front.Bars[ps[k].f], &next.Bars[ps[k].n]
+
+	return Roll{Time: fb.Time, From: front.Symbol, To: next.Symbol, FromClose: fb.Close, ToClose: nb.Close}, nil
+}
+
+//nolint:exhaustive
+func isRollDate(p *Params, front, next *Contract, fb, nb *data.Bar) bool {
+	switch p.Rule {
+	case rolls.DaysBeforeExpiry:
+		return !fb.Time.Before(front.Expiry.AddDate(0, 0, -p.DaysBeforeExpiry))
+	case rolls.Calendar:
+		return !fb.Time.Before(calendarRollDate(front.Expiry, p.MonthsBeforeExpiry, p.DayOfMonth))
+	case rolls.VolumeCrossover:
+		return nb.Volume > fb.Volume
+	case rolls.OpenInterestCrossover:
+		return valueAt(next.OpenInterest, nb.Time) > valueAt(front.OpenInterest, fb.Time)
+	default:
+		return false
+	}
+}
+
+// calendarRollDate returns the given day in the month which is a number of months before
+// the expiry month. The day is clamped to the last day of that month.
+func calendarRollDate(expiry time.Time, months, day int) time.Time {
+	y, m, _ := expiry.Date()
+	first := time.Date(y, m, 1, 0, 0, 0, 0, expiry.Location()).AddDate(0, -months, 0)
+
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+
+	return first.AddDate(0, 0, day-1)
+}
+
+// valueAt returns the value at or immediately before the given time, or zero if there is none.
+func valueAt(s []data.Scalar, t time.Time) float64 {
+	i := sort.Search(len(s), func(i int) bool { return s[i].Time.After(t) })
+	if i > 0 {
+		return s[i-1].Value
+	}
+
+	return 0
+}
+
+// adjust calculates the adjustments and the cumulative adjustments of the rolls.
+//
+//nolint:exhaustive
+func adjust(a adjustments.Adjustment, rs []Roll) error {
+	switch a {
+	case adjustments.Difference:
+		cum := 0.
+		for i := len(rs) - 1; i >= 0; i-- {
+			r := &rs[i]
+			r.Adjustment = r.ToClose - r.FromClose
+			cum += r.Adjustment
+			r.Cumulative = cum
+		}
+	case adjustments.Ratio:
+		cum := 1.
+		for i := len(rs) - 1; i >= 0; i-- {
+			r := &rs[i]
+			if r.FromClose <= 0 || r.ToClose <= 0 {
+				return fmt.Errorf("cannot ratio-adjust roll from '%s' to '%s' on %s: %w",
+					r.From, r.To, r.Time.Format("2006-01-02"), errNonPositiveClose)
+			}
+
+			r.Adjustment = r.ToClose / r.FromClose
+			cum *= r.Adjustment
+			r.Cumulative = cum
+		}
+	}
+
+	return nil
+}
+
+// stitch concatenates the adjusted contract segments between the roll dates.
+func stitch(a adjustments.Adjustment, cs []*Contract, rs []Roll) *Series {
+	s := &Series{Rolls: rs}
+
+	for i, c := range cs {
+		bars := c.Bars
+		if i > 0 {
+			t := rs[i-1].Time
+			bars = bars[sort.Search(len(bars), func(j int) bool { return !bars[j].Time.Before(t) }):]
+		}
+
+		if i < len(rs) {
+			t := rs[i].Time
+			bars = bars[:sort.Search(len(bars), func(j int) bool { return !bars[j].Time.Before(t) })]
+		}
+
+		for _, b := range bars {
+			if i < len(rs) {
+				adjustBar(a, &b, rs[i].Cumulative)
+			}
+
+			s.Bars = append(s.Bars, b)
+			s.Symbols = append(s.Symbols, c.Symbol)
+		}
+	}
+
+	return s
+}
+
+//nolint:exhaustive
+func adjustBar(a adjustments.Adjustment, b *data.Bar, cum float64) {
+	switch a {
+	case adjustments.Difference:
+		b.Open += cum
+		b.High += cum
+		b.Low += cum
+		b.Close += cum
+	case adjustments.Ratio:
+		b.Open *= cum
+		b.High *= cum
+		b.Low *= cum
+		b.Close *= cum
+	}
+}
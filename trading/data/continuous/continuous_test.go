@@ -0,0 +1,187 @@
+//nolint:testpackage
+package continuous
+
+//nolint:gofumpt
+import (
+	"math"
+	"testing"
+	"time"
+
+	"mbg/trading/data"
+	"mbg/trading/data/continuous/adjustments"
+	"mbg/trading/data/continuous/rolls"
+)
+
+func day(d int) time.Time {
+	return time.Date(2021, time.March, d, 0, 0, 0, 0, time.UTC)
+}
+
+func bar(d int, c, v float64) data.Bar {
+	return data.Bar{Time: day(d), Open: c, High: c, Low: c, Close: c, Volume: v}
+}
+
+// testContracts returns three contracts, the second and the third are given in the reverse order.
+func testContracts() []Contract {
+	return []Contract{
+		{
+			Symbol: "A", Expiry: day(5),
+			Bars: []data.Bar{bar(1, 10, 100), bar(2, 11, 90), bar(3, 12, 50), bar(4, 13, 10), bar(5, 14, 5)},
+			OpenInterest: []data.Scalar{
+				{Time: day(1), Value: 1000}, {Time: day(3), Value: 500},
+			},
+		},
+		{
+			Symbol: "C", Expiry: day(15),
+			Bars: []data.Bar{bar(6, 40, 1), bar(7, 41, 1), bar(8, 42, 60), bar(9, 43, 100)},
+		},
+		{
+			Symbol: "B", Expiry: day(10),
+			Bars: []data.Bar{bar(2, 20, 10), bar(3, 21, 60), bar(4, 22, 80), bar(6, 23, 90), bar(7, 24, 50), bar(8, 25, 40)},
+			OpenInterest: []data.Scalar{
+				{Time: day(2), Value: 900}, {Time: day(3), Value: 700}, {Time: day(6), Value: 100},
+			},
+		},
+	}
+}
+
+func verifySeries(t *testing.T, s *Series, rollDays []int, closes []float64, symbols string) {
+	t.Helper()
+
+	if len(s.Rolls) != len(rollDays) {
+		t.Fatalf("rolls: expected %v, actual %v", len(rollDays), len(s.Rolls))
+	}
+
+	for i, d := range rollDays {
+		if !s.Rolls[i].Time.Equal(day(d)) {
+			t.Errorf("roll %v: expected %v, actual %v", i, day(d), s.Rolls[i].Time)
+		}
+	}
+
+	if len(s.Bars) != len(closes) || len(s.Symbols) != len(closes) {
+		t.Fatalf("bars: expected %v, actual %v bars and %v symbols", len(closes), len(s.Bars), len(s.Symbols))
+	}
+
+	for i, c := range closes {
+		if math.Abs(s.Bars[i].Close-c) > 1e-13 {
+			t.Errorf("bar %v close: expected %v, actual %v", i, c, s.Bars[i].Close)
+		}
+
+		if s.Symbols[i] != symbols[i:i+1] {
+			t.Errorf("bar %v symbol: expected %v, actual %v", i, symbols[i:i+1], s.Symbols[i])
+		}
+	}
+}
+
+func TestBuildDifference(t *testing.T) {
+	t.Parallel()
+
+	p := Params{Rule: rolls.DaysBeforeExpiry, Adjustment: adjustments.Difference, DaysBeforeExpiry: 2}
+
+	s, err := Build(&p, testContracts())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A→B on day 3 (gap 21-12=9), B→C on day 8 (gap 42-25=17).
+	verifySeries(t, s, []int{3, 8},
+		[]float64{10 + 26, 11 + 26, 21 + 17, 22 + 17, 23 + 17, 24 + 17, 42, 43}, "AABBBBCC")
+
+	if r := s.Rolls[0]; r.From != "A" || r.To != "B" || r.Adjustment != 9 || r.Cumulative != 26 {
+		t.Errorf("roll 0: expected A→B, 9, 26, actual %v→%v, %v, %v", r.From, r.To, r.Adjustment, r.Cumulative)
+	}
+
+	if r := s.Rolls[1]; r.FromClose != 25 || r.ToClose != 42 || r.Adjustment != 17 || r.Cumulative != 17 {
+		t.Errorf("roll 1: expected 25, 42, 17, 17, actual %v, %v, %v, %v",
+			r.FromClose, r.ToClose, r.Adjustment, r.Cumulative)
+	}
+}
+
+func TestBuildRatio(t *testing.T) {
+	t.Parallel()
+
+	p := Params{Rule: rolls.VolumeCrossover, Adjustment: adjustments.Ratio}
+
+	s, err := Build(&p, testContracts())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A→B on day 3 (volume 60 > 50, ratio 21/12), B→C on day 8 (volume 60 > 40, ratio 42/25).
+	r1, r2 := 21./12., 42./25.
+	verifySeries(t, s, []int{3, 8},
+		[]float64{10 * r1 * r2, 11 * r1 * r2, 21 * r2, 22 * r2, 23 * r2, 24 * r2, 42, 43}, "AABBBBCC")
+
+	if math.Abs(s.Rolls[0].Cumulative-r1*r2) > 1e-13 {
+		t.Errorf("roll 0 cumulative: expected %v, actual %v", r1*r2, s.Rolls[0].Cumulative)
+	}
+}
+
+func TestBuildNone(t *testing.T) {
+	t.Parallel()
+
+	p := Params{Rule: rolls.OpenInterestCrossover, Adjustment: adjustments.None}
+
+	s, err := Build(&p, testContracts())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A→B on day 3 (open interest 700 > 500); B→C has no open interest, rolls on the last common day 8.
+	verifySeries(t, s, []int{3, 8}, []float64{10, 11, 21, 22, 23, 24, 42, 43}, "AABBBBCC")
+
+	if r := s.Rolls[0]; r.Adjustment != 0 || r.Cumulative != 0 {
+		t.Errorf("roll 0: expected zero adjustments, actual %v, %v", r.Adjustment, r.Cumulative)
+	}
+}
+
+func TestBuildCalendar(t *testing.T) {
+	t.Parallel()
+
+	p := Params{Rule: rolls.Calendar, Adjustment: adjustments.None, DayOfMonth: 4}
+
+	s, err := Build(&p, testContracts())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// All contracts expire in March, the roll day is March 4 or the first common day after.
+	verifySeries(t, s, []int{4, 6}, []float64{10, 11, 12, 22, 40, 41, 42, 43}, "AAABCCCC")
+
+	if d := calendarRollDate(time.Date(2021, time.March, 19, 0, 0, 0, 0, time.UTC), 1, 31); !d.Equal(
+		time.Date(2021, time.February, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("calendarRollDate(): expected February 28, actual %v", d)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		p    Params
+		cs   []Contract
+	}{
+		{"unknown rule", Params{Adjustment: adjustments.None}, testContracts()},
+		{"unknown adjustment", Params{Rule: rolls.VolumeCrossover}, testContracts()},
+		{"negative days", Params{Rule: rolls.DaysBeforeExpiry, Adjustment: adjustments.None, DaysBeforeExpiry: -1},
+			testContracts()},
+		{"negative months", Params{Rule: rolls.Calendar, Adjustment: adjustments.None, MonthsBeforeExpiry: -1},
+			testContracts()},
+		{"invalid day", Params{Rule: rolls.Calendar, Adjustment: adjustments.None, DayOfMonth: 32}, testContracts()},
+		{"no contracts", Params{Rule: rolls.VolumeCrossover, Adjustment: adjustments.None}, nil},
+		{"no common bars", Params{Rule: rolls.VolumeCrossover, Adjustment: adjustments.None}, []Contract{
+			{Symbol: "A", Expiry: day(5), Bars: []data.Bar{bar(1, 1, 1)}},
+			{Symbol: "B", Expiry: day(9), Bars: []data.Bar{bar(2, 1, 1)}},
+		}},
+		{"non-positive close", Params{Rule: rolls.VolumeCrossover, Adjustment: adjustments.Ratio}, []Contract{
+			{Symbol: "A", Expiry: day(5), Bars: []data.Bar{bar(1, 0, 1)}},
+			{Symbol: "B", Expiry: day(9), Bars: []data.Bar{bar(1, 1, 1)}},
+		}},
+	}
+
+	for _, tt := range tests {
+		if _, err := Build(&tt.p, tt.cs); err == nil {
+			t.Errorf("%s: expected error, actual nil", tt.name)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package continuous
+
+//nolint:gofumpt
+import (
+	"mbg/trading/data/continuous/adjustments"
+	"mbg/trading/data/continuous/rolls"
+)
+
+// Params describes parameters to construct a continuous futures series.
+type Params struct {
+	// Rule is the rule to roll from the front contract to the next one.
+	Rule rolls.Rule
+
+	// Adjustment is the method to adjust the prices before the roll dates.
+	Adjustment adjustments.Adjustment
+
+	// DaysBeforeExpiry is the number of calendar days before the expiry of the front contract
+	// to roll on. Used by the DaysBeforeExpiry roll rule.
+	//
+	// The value should not be negative.
+	DaysBeforeExpiry int
+
+	// MonthsBeforeExpiry is the number of months before the expiry month of the front contract
+	// to roll in. Used by the Calendar roll rule.
+	//
+	// The value should not be negative.
+	MonthsBeforeExpiry int
+
+	// DayOfMonth is the day of month to roll on. Used by the Calendar roll rule.
+	//
+	// The value should be in the range [1, 31].
+	DayOfMonth int
+}
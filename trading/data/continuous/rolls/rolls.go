@@ -0,0 +1,98 @@
+// Package rolls enumerates rules to roll from one futures contract to the next one in a continuous series.
+package rolls
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Rule enumerates rules to roll from one futures contract to the next one in a continuous series.
+type Rule int
+
+const (
+	// DaysBeforeExpiry rolls a fixed number of calendar days before the expiry of the front contract.
+	DaysBeforeExpiry Rule = iota + 1
+
+	// OpenInterestCrossover rolls on the first date the open interest of the next contract
+	// exceeds the open interest of the front contract.
+	OpenInterestCrossover
+
+	// VolumeCrossover rolls on the first date the volume of the next contract
+	// exceeds the volume of the front contract.
+	VolumeCrossover
+
+	// Calendar rolls on a fixed day of month a fixed number of months before the expiry month
+	// of the front contract.
+	Calendar
+	last
+)
+
+const (
+	unknown               = "unknown"
+	daysBeforeExpiry      = "daysBeforeExpiry"
+	openInterestCrossover = "openInterestCrossover"
+	volumeCrossover       = "volumeCrossover"
+	calendar              = "calendar"
+)
+
+var errUnknownRule = errors.New("unknown roll rule")
+
+// String implements the fmt.Stringer interface.
+func (r Rule) String() string {
+	switch r {
+	case DaysBeforeExpiry:
+		return daysBeforeExpiry
+	case OpenInterestCrossover:
+		return openInterestCrossover
+	case VolumeCrossover:
+		return volumeCrossover
+	case Calendar:
+		return calendar
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this roll rule is known.
+func (r Rule) IsKnown() bool {
+	return r >= DaysBeforeExpiry && r < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (r Rule) MarshalJSON() ([]byte, error) {
+	str := r.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownRule)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (r *Rule) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case daysBeforeExpiry:
+		*r = DaysBeforeExpiry
+	case openInterestCrossover:
+		*r = OpenInterestCrossover
+	case volumeCrossover:
+		*r = VolumeCrossover
+	case calendar:
+		*r = Calendar
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownRule)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+//nolint:testpackage
+package rolls
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := Calendar
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := Calendar
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var r Rule
+
+	bs := []byte("\"calendar\"")
+	for i := 0; i < b.N; i++ {
+		_ = r.UnmarshalJSON(bs)
+	}
+}
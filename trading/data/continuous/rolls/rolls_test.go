@@ -0,0 +1,144 @@
+//nolint:testpackage
+package rolls
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r    Rule
+		text string
+	}{
+		{DaysBeforeExpiry, daysBeforeExpiry},
+		{OpenInterestCrossover, openInterestCrossover},
+		{VolumeCrossover, volumeCrossover},
+		{Calendar, calendar},
+		{last, unknown},
+		{Rule(0), unknown},
+		{Rule(9999), unknown},
+		{Rule(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.r.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.r, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r       Rule
+		boolean bool
+	}{
+		{DaysBeforeExpiry, true},
+		{OpenInterestCrossover, true},
+		{VolumeCrossover, true},
+		{Calendar, true},
+		{last, false},
+		{Rule(0), false},
+		{Rule(9999), false},
+		{Rule(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.r.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.r, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		r         Rule
+		json      string
+		succeeded bool
+	}{
+		{DaysBeforeExpiry, "\"daysBeforeExpiry\"", true},
+		{OpenInterestCrossover, "\"openInterestCrossover\"", true},
+		{VolumeCrossover, "\"volumeCrossover\"", true},
+		{Calendar, "\"calendar\"", true},
+		{last, nilstr, false},
+		{Rule(9999), nilstr, false},
+		{Rule(-9999), nilstr, false},
+		{Rule(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.r.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.r, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.r)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.r, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Rule
+	tests := []struct {
+		r         Rule
+		json      string
+		succeeded bool
+	}{
+		{DaysBeforeExpiry, "\"daysBeforeExpiry\"", true},
+		{OpenInterestCrossover, "\"openInterestCrossover\"", true},
+		{VolumeCrossover, "\"volumeCrossover\"", true},
+		{Calendar, "\"calendar\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.r
+		bs := []byte(tt.json)
+
+		var r Rule
+
+		err := r.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != r {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, r)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Package corporate adjusts raw price series for corporate actions like splits and cash dividends.
+package corporate
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"mbg/trading/data"
+	"mbg/trading/data/corporate/modes"
+)
+
+// Action is a corporate action event.
+type Action struct {
+	// Time is the ex-date of the action, the date of the first bar which reflects the action.
+	Time time.Time `json:"time"`
+
+	// SplitRatio is the number of new shares per one old share, e.g. 2 for a 2-for-1 split
+	// or 0.1 for a 1-for-10 reverse split. Zero if the action is not a split.
+	SplitRatio float64 `json:"splitRatio,omitempty"`
+
+	// Dividend is the cash dividend per share. Zero if the action is not a cash dividend.
+	Dividend float64 `json:"dividend,omitempty"`
+}
+
+// Adjuster back-adjusts raw bars for corporate actions, so that the most recent
+// prices stay unchanged and the earlier prices are comparable with them.
+//
+// The price adjustment factor of a split is 1/SplitRatio and the volume adjustment
+// factor is SplitRatio. The price adjustment factor of a cash dividend is
+//
+//	1 - Dividend / Close,
+//
+// where Close is the raw closing price of the last bar before the ex-date.
+//
+// Use the NewAdjuster to create a properly initialized new instance.
+type Adjuster struct {
+	mode    modes.Mode
+	times   []time.Time
+	prices  []float64 // Cumulative price factors of all actions at or after the index.
+	volumes []float64 // Cumulative volume factors of all actions at or after the index.
+}
+
+var (
+	errUnknownMode      = errors.New("unknown adjustment mode")
+	errNegativeSplit    = errors.New("split ratio should not be negative")
+	errNegativeDividend = errors.New("dividend should not be negative")
+	errLargeDividend    = errors.New("dividend should be less than the closing price before the ex-date")
+)
+
+// NewAdjuster creates a new adjuster from the corporate actions and the time-ordered raw bars
+// used to calculate the dividend adjustment factors.
+//
+// Dividends with the ex-date before the first bar do not affect the adjustment.
+func NewAdjuster(mode modes.Mode, actions []Action, bars []data.Bar) (*Adjuster, error) {
+	const invalid = "invalid corporate action"
+
+	if !mode.IsKnown() {
+		return nil, fmt.Errorf("cannot create adjuster: %w", errUnknownMode)
+	}
+
+	as := make([]Action, len(actions))
+	copy(as, actions)
+	sort.SliceStable(as, func(i, j int) bool { return as[i].Time.Before(as[j].Time) })
+
+	l := len(as)
+	a := &Adjuster{
+		mode:    mode,
+		times:   make([]time.Time, l),
+		prices:  make([]float64, l+1),
+		volumes: make([]float64, l+1),
+	}
+
+	a.prices[l] = 1
+	a.volumes[l] = 1
+
+	for i := l - 1; i >= 0; i-- {
+		act := &as[i]
+		date := act.Time.Format("2006-01-02")
+
+		if act.SplitRatio < 0 {
+			return nil, fmt.Errorf("%s on %s: %w", invalid, date, errNegativeSplit)
+		}
+
+		if act.Dividend < 0 {
+			return nil, fmt.Errorf("%s on %s: %w", invalid, date, errNegativeDividend)
+		}
+
+		pf, vf := 1., 1.
+
+		if act.SplitRatio > 0 {
+			pf /= act.SplitRatio
+			vf *= act.SplitRatio
+		}
+
+		if act.Dividend > 0 && mode == modes.TotalReturn {
+			j := sort.Search(len(bars), func(k int) bool { return !bars[k].Time.Before(act.Time) })
+			if j > 0 {
+				c := bars[j-1].Close
+				if act.Dividend >= c {
+					return nil, fmt.Errorf("%s on %s: %w", invalid, date, errLargeDividend)
+				}
+
+				pf *= 1 - act.Dividend/c
+			}
+		}
+
+		a.times[i] = act.Time
+		a.prices[i] = a.prices[i+1] * pf
+		a.volumes[i] = a.volumes[i+1] * vf
+	}
+
+	return a, nil
+}
+
+// Mode returns the adjustment mode.
+func (a *Adjuster) Mode() modes.Mode {
+	return a.mode
+}
+
+// PriceFactor returns the cumulative factor the raw price at the given time
+// should be multiplied by to get the adjusted price.
+func (a *Adjuster) PriceFactor(t time.Time) float64 {
+	return a.prices[a.index(t)]
+}
+
+// VolumeFactor returns the cumulative factor the raw volume at the given time
+// should be multiplied by to get the adjusted volume.
+func (a *Adjuster) VolumeFactor(t time.Time) float64 {
+	return a.volumes[a.index(t)]
+}
+
+// AdjustedPrice converts the raw price at the given time to the adjusted price.
+func (a *Adjuster) AdjustedPrice(raw float64, t time.Time) float64 {
+	return raw * a.PriceFactor(t)
+}
+
+// RawPrice converts the adjusted price at the given time back to the raw price.
+func (a *Adjuster) RawPrice(adjusted float64, t time.Time) float64 {
+	return adjusted / a.PriceFactor(t)
+}
+
+// AdjustBar returns the adjusted copy of the raw bar.
+func (a *Adjuster) AdjustBar(b *data.Bar) data.Bar {
+	i := a.index(b.Time)
+	pf, vf := a.prices[i], a.volumes[i]
+
+	return data.Bar{
+		Time:   b.Time,
+		Open:   b.Open * pf,
+		High:   b.High * pf,
+		Low:    b.Low * pf,
+		Close:  b.Close * pf,
+		Volume: b.Volume * vf,
+	}
+}
+
+// Adjust returns the adjusted copy of the raw bars.
+func (a *Adjuster) Adjust(bars []data.Bar) []data.Bar {
+	adj := make([]data.Bar, len(bars))
+	for i := range bars {
+		adj[i] = a.AdjustBar(&bars[i])
+	}
+
+	return adj
+}
+
+// index returns the index of the first action with the ex-date after the given time.
+func (a *Adjuster) index(t time.Time) int {
+	return sort.Search(len(a.times), func(i int) bool { return a.times[i].After(t) })
+}
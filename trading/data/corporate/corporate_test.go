@@ -0,0 +1,136 @@
+//nolint:testpackage
+package corporate
+
+//nolint:gofumpt
+import (
+	"math"
+	"testing"
+	"time"
+
+	"mbg/trading/data"
+	"mbg/trading/data/corporate/modes"
+)
+
+func day(d int) time.Time {
+	return time.Date(2021, time.April, d, 0, 0, 0, 0, time.UTC)
+}
+
+func testBars() []data.Bar {
+	return []data.Bar{
+		{Time: day(1), Open: 100, High: 102, Low: 98, Close: 100, Volume: 10},
+		{Time: day(2), Open: 100, High: 101, Low: 99, Close: 100, Volume: 10},
+		{Time: day(5), Open: 49, High: 51, Low: 48, Close: 50, Volume: 20},
+		{Time: day(6), Open: 50, High: 52, Low: 49, Close: 50, Volume: 20},
+		{Time: day(7), Open: 48, High: 50, Low: 47, Close: 48, Volume: 20},
+	}
+}
+
+func testActions() []Action {
+	return []Action{
+		{Time: day(7), Dividend: 2},   // Close before the ex-date is 50, the factor is 0.96.
+		{Time: day(5), SplitRatio: 2}, // A 2-for-1 split.
+	}
+}
+
+func verifyFloat(t *testing.T, what string, exp, act float64) {
+	t.Helper()
+
+	if math.Abs(exp-act) > 1e-13 {
+		t.Errorf("%s: expected %v, actual %v", what, exp, act)
+	}
+}
+
+func TestAdjusterPriceOnly(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewAdjuster(modes.PriceOnly, testActions(), testBars())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if m := a.Mode(); m != modes.PriceOnly {
+		t.Errorf("Mode(): expected %v, actual %v", modes.PriceOnly, m)
+	}
+
+	adj := a.Adjust(testBars())
+	closes := []float64{50, 50, 50, 50, 48}
+	volumes := []float64{20, 20, 20, 20, 20}
+
+	for i := range adj {
+		verifyFloat(t, "close", closes[i], adj[i].Close)
+		verifyFloat(t, "volume", volumes[i], adj[i].Volume)
+	}
+
+	verifyFloat(t, "high", 51, adj[0].High)
+	verifyFloat(t, "low", 49, adj[0].Low)
+	verifyFloat(t, "open", 50, adj[0].Open)
+}
+
+func TestAdjusterTotalReturn(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewAdjuster(modes.TotalReturn, testActions(), testBars())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	adj := a.Adjust(testBars())
+	closes := []float64{48, 48, 48, 48, 48}
+	volumes := []float64{20, 20, 20, 20, 20}
+
+	for i := range adj {
+		verifyFloat(t, "close", closes[i], adj[i].Close)
+		verifyFloat(t, "volume", volumes[i], adj[i].Volume)
+
+		if !adj[i].Time.Equal(testBars()[i].Time) {
+			t.Errorf("time: expected %v, actual %v", testBars()[i].Time, adj[i].Time)
+		}
+	}
+
+	verifyFloat(t, "PriceFactor(day 1)", 0.48, a.PriceFactor(day(1)))
+	verifyFloat(t, "PriceFactor(day 6)", 0.96, a.PriceFactor(day(6)))
+	verifyFloat(t, "PriceFactor(day 7)", 1, a.PriceFactor(day(7)))
+	verifyFloat(t, "VolumeFactor(day 4)", 2, a.VolumeFactor(day(4)))
+	verifyFloat(t, "VolumeFactor(day 5)", 1, a.VolumeFactor(day(5)))
+
+	verifyFloat(t, "AdjustedPrice(100, day 2)", 48, a.AdjustedPrice(100, day(2)))
+	verifyFloat(t, "RawPrice(48, day 2)", 100, a.RawPrice(48, day(2)))
+	verifyFloat(t, "RawPrice(48, day 6)", 50, a.RawPrice(48, day(6)))
+	verifyFloat(t, "RawPrice(48, day 8)", 48, a.RawPrice(48, day(8)))
+}
+
+func TestAdjusterDividendBeforeHistory(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewAdjuster(modes.TotalReturn, []Action{{Time: day(1), Dividend: 200}}, testBars())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	verifyFloat(t, "PriceFactor(day 0)", 1, a.PriceFactor(day(0)))
+}
+
+func TestAdjusterErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mode    modes.Mode
+		actions []Action
+	}{
+		{"unknown mode", modes.Mode(0), nil},
+		{"negative split", modes.PriceOnly, []Action{{Time: day(2), SplitRatio: -1}}},
+		{"negative dividend", modes.PriceOnly, []Action{{Time: day(2), Dividend: -1}}},
+		{"large dividend", modes.TotalReturn, []Action{{Time: day(2), Dividend: 100}}},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewAdjuster(tt.mode, tt.actions, testBars()); err == nil {
+			t.Errorf("%s: expected error, actual nil", tt.name)
+		}
+	}
+
+	if _, err := NewAdjuster(modes.PriceOnly, []Action{{Time: day(2), Dividend: 100}}, testBars()); err != nil {
+		t.Errorf("price-only large dividend: unexpected error %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+// Package modes enumerates modes of a price adjustment for corporate actions.
+package modes
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Mode enumerates modes of a price adjustment for corporate actions.
+type Mode int
+
+const (
+	// PriceOnly adjusts prices and volumes for splits only.
+	PriceOnly Mode = iota + 1
+
+	// TotalReturn adjusts prices and volumes for splits and prices for cash dividends,
+	// assuming the dividends are reinvested on the ex-dividend date.
+	TotalReturn
+)
+
+const (
+	unknown     = "unknown"
+	priceOnly   = "priceOnly"
+	totalReturn = "totalReturn"
+)
+
+var errUnknownMode = errors.New("unknown adjustment mode")
+
+// String implements the fmt.Stringer interface.
+func (m Mode) String() string {
+	switch m {
+	case PriceOnly:
+		return priceOnly
+	case TotalReturn:
+		return totalReturn
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this adjustment mode is known.
+func (m Mode) IsKnown() bool {
+	return m == PriceOnly || m == TotalReturn
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (m Mode) MarshalJSON() ([]byte, error) {
+	str := m.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownMode)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (m *Mode) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case priceOnly:
+		*m = PriceOnly
+	case totalReturn:
+		*m = TotalReturn
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownMode)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+//nolint:testpackage
+package modes
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := TotalReturn
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := TotalReturn
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var m Mode
+
+	bs := []byte("\"totalReturn\"")
+	for i := 0; i < b.N; i++ {
+		_ = m.UnmarshalJSON(bs)
+	}
+}
@@ -0,0 +1,133 @@
+//nolint:testpackage
+package modes
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		m    Mode
+		text string
+	}{
+		{PriceOnly, priceOnly},
+		{TotalReturn, totalReturn},
+		{Mode(0), unknown},
+		{Mode(9999), unknown},
+		{Mode(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.m.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.m, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		m       Mode
+		boolean bool
+	}{
+		{PriceOnly, true},
+		{TotalReturn, true},
+		{Mode(0), false},
+		{Mode(9999), false},
+		{Mode(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.m.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.m, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		m         Mode
+		json      string
+		succeeded bool
+	}{
+		{PriceOnly, "\"priceOnly\"", true},
+		{TotalReturn, "\"totalReturn\"", true},
+		{Mode(9999), nilstr, false},
+		{Mode(-9999), nilstr, false},
+		{Mode(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.m.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.m, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.m)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.m, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Mode
+	tests := []struct {
+		m         Mode
+		json      string
+		succeeded bool
+	}{
+		{PriceOnly, "\"priceOnly\"", true},
+		{TotalReturn, "\"totalReturn\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.m
+		bs := []byte(tt.json)
+
+		var m Mode
+
+		err := m.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != m {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, m)
+		}
+	}
+}
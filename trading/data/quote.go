@@ -14,6 +14,11 @@ type Quote struct {
 	AskSize float64   `json:"askSize"` // The ask size.
 }
 
+// DateTime implements the Temporal interface.
+func (q *Quote) DateTime() time.Time {
+	return q.Time
+}
+
 // Mid is the mid-price, calculated as
 //   (ask + bid) / 2.
 func (q *Quote) Mid() float64 {
@@ -0,0 +1,174 @@
+package streams
+
+//nolint:gofumpt
+import (
+	"container/heap"
+	"time"
+
+	"mbg/trading/data"
+)
+
+// Event is a single entity emitted by the Merger.
+type Event struct {
+	// Stream is the index of the source stream in the order the streams were given to the merger.
+	Stream int
+
+	// Name is the name of the source stream.
+	Name string
+
+	// Index is the index of the entity within the source stream.
+	Index int
+
+	// Entity is a *data.Bar, a *data.Quote, a *data.Trade or any other temporal entity.
+	Entity data.Temporal
+}
+
+// Time is the date and time of the event entity.
+func (e Event) Time() time.Time {
+	return e.Entity.DateTime()
+}
+
+// Merger is a k-way merging iterator which interleaves several time-ordered
+// streams into a single time-ordered sequence of events.
+//
+// Entities with equal times are emitted in the order of their streams as given to the NewMerger,
+// entities with equal times within the same stream are emitted in their original order.
+// This makes the merged sequence deterministic.
+//
+// The implementation is not thread-safe.
+type Merger struct {
+	streams []*Stream
+	pos     []int
+	queue   cursorQueue
+}
+
+// NewMerger creates a new merger positioned before the earliest event of the given streams.
+func NewMerger(streams ...*Stream) *Merger {
+	m := &Merger{
+		streams: streams,
+		pos:     make([]int, len(streams)),
+	}
+	m.queue.m = m
+	m.rebuild()
+
+	return m
+}
+
+// Len returns the number of events left to emit.
+func (m *Merger) Len() int {
+	n := 0
+	for i, s := range m.streams {
+		n += s.len - m.pos[i]
+	}
+
+	return n
+}
+
+// Peek returns the next event without advancing the merger.
+// Returns false if there are no events left.
+func (m *Merger) Peek() (Event, bool) {
+	if len(m.queue.items) == 0 {
+		return Event{}, false
+	}
+
+	return m.event(m.queue.items[0]), true
+}
+
+// Next returns the next event and advances the merger.
+// Returns false if there are no events left.
+func (m *Merger) Next() (Event, bool) {
+	if len(m.queue.items) == 0 {
+		return Event{}, false
+	}
+
+	i := m.queue.items[0]
+	e := m.event(i)
+
+	m.pos[i]++
+	if m.pos[i] < m.streams[i].len {
+		heap.Fix(&m.queue, 0)
+	} else {
+		heap.Pop(&m.queue)
+	}
+
+	return e, true
+}
+
+// Seek positions the merger so that the next event is the earliest one
+// with the time equal to or after the given time.
+func (m *Merger) Seek(t time.Time) {
+	for i, s := range m.streams {
+		m.pos[i] = s.search(t)
+	}
+
+	m.rebuild()
+}
+
+// Reset positions the merger before the earliest event.
+func (m *Merger) Reset() {
+	for i := range m.pos {
+		m.pos[i] = 0
+	}
+
+	m.rebuild()
+}
+
+func (m *Merger) event(i int) Event {
+	s := m.streams[i]
+	j := m.pos[i]
+
+	return Event{Stream: i, Name: s.name, Index: j, Entity: s.at(j)}
+}
+
+func (m *Merger) time(i int) time.Time {
+	return m.streams[i].at(m.pos[i]).DateTime()
+}
+
+func (m *Merger) rebuild() {
+	m.queue.items = m.queue.items[:0]
+
+	for i, s := range m.streams {
+		if m.pos[i] < s.len {
+			m.queue.items = append(m.queue.items, i)
+		}
+	}
+
+	heap.Init(&m.queue)
+}
+
+// cursorQueue is a min-heap of stream indices ordered by the time of the current stream entity.
+type cursorQueue struct {
+	m     *Merger
+	items []int
+}
+
+func (q *cursorQueue) Len() int {
+	return len(q.items)
+}
+
+func (q *cursorQueue) Less(i, j int) bool {
+	a, b := q.items[i], q.items[j]
+	ta, tb := q.m.time(a), q.m.time(b)
+
+	if ta.Equal(tb) {
+		return a < b
+	}
+
+	return ta.Before(tb)
+}
+
+func (q *cursorQueue) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+func (q *cursorQueue) Push(x interface{}) {
+	q.items = append(q.items, x.(int)) //nolint:forcetypeassert
+}
+
+func (q *cursorQueue) Pop() interface{} {
+	n := len(q.items) - 1
+	x := q.items[n]
+	q.items = q.items[:n]
+
+	return x
+}
@@ -0,0 +1,176 @@
+//nolint:testpackage
+package streams
+
+//nolint:gofumpt
+import (
+	"testing"
+	"time"
+
+	"mbg/trading/data"
+)
+
+func minute(m int) time.Time {
+	return time.Date(2021, time.June, 1, 10, m, 0, 0, time.UTC)
+}
+
+func testStreams() []*Stream {
+	bars := []data.Bar{
+		{Time: minute(1), Close: 1},
+		{Time: minute(3), Close: 3},
+		{Time: minute(5), Close: 5},
+	}
+
+	quotes := []data.Quote{
+		{Time: minute(0), Bid: 0},
+		{Time: minute(3), Bid: 3},
+		{Time: minute(3), Bid: 33},
+		{Time: minute(4), Bid: 4},
+	}
+
+	trades := []data.Trade{
+		{Time: minute(2), Price: 2},
+		{Time: minute(3), Price: 3},
+	}
+
+	return []*Stream{NewBarStream("a", bars), NewQuoteStream("b", quotes), NewTradeStream("c", trades)}
+}
+
+type expectedEvent struct {
+	stream int
+	index  int
+	time   time.Time
+}
+
+var expectedEvents = []expectedEvent{ //nolint:gochecknoglobals
+	{1, 0, minute(0)},
+	{0, 0, minute(1)},
+	{2, 0, minute(2)},
+	{0, 1, minute(3)},
+	{1, 1, minute(3)},
+	{1, 2, minute(3)},
+	{2, 1, minute(3)},
+	{1, 3, minute(4)},
+	{0, 2, minute(5)},
+}
+
+func verifyEvent(t *testing.T, i int, exp expectedEvent, act Event) {
+	t.Helper()
+
+	if act.Stream != exp.stream || act.Index != exp.index || !act.Time().Equal(exp.time) {
+		t.Errorf("event %v: expected {%v, %v, %v}, actual {%v, %v, %v}",
+			i, exp.stream, exp.index, exp.time, act.Stream, act.Index, act.Time())
+	}
+}
+
+func TestMergerNext(t *testing.T) {
+	t.Parallel()
+
+	m := NewMerger(testStreams()...)
+
+	if l := m.Len(); l != len(expectedEvents) {
+		t.Errorf("Len(): expected %v, actual %v", len(expectedEvents), l)
+	}
+
+	for i, exp := range expectedEvents {
+		p, ok := m.Peek()
+		if !ok {
+			t.Fatalf("Peek() %v: expected an event, actual none", i)
+		}
+
+		verifyEvent(t, i, exp, p)
+
+		e, ok := m.Next()
+		if !ok {
+			t.Fatalf("Next() %v: expected an event, actual none", i)
+		}
+
+		verifyEvent(t, i, exp, e)
+	}
+
+	if _, ok := m.Next(); ok {
+		t.Error("Next(): expected no events after the end")
+	}
+
+	if _, ok := m.Peek(); ok {
+		t.Error("Peek(): expected no events after the end")
+	}
+
+	if l := m.Len(); l != 0 {
+		t.Errorf("Len(): expected 0, actual %v", l)
+	}
+}
+
+func TestMergerEntities(t *testing.T) {
+	t.Parallel()
+
+	m := NewMerger(testStreams()...)
+
+	e, _ := m.Next()
+	if q, ok := e.Entity.(*data.Quote); !ok || e.Name != "b" || q.Bid != 0 {
+		t.Errorf("expected quote from stream 'b', actual %v from stream '%v'", e.Entity, e.Name)
+	}
+
+	e, _ = m.Next()
+	if b, ok := e.Entity.(*data.Bar); !ok || e.Name != "a" || b.Close != 1 {
+		t.Errorf("expected bar from stream 'a', actual %v from stream '%v'", e.Entity, e.Name)
+	}
+
+	e, _ = m.Next()
+	if tr, ok := e.Entity.(*data.Trade); !ok || e.Name != "c" || tr.Price != 2 {
+		t.Errorf("expected trade from stream 'c', actual %v from stream '%v'", e.Entity, e.Name)
+	}
+}
+
+func TestMergerSeekReset(t *testing.T) {
+	t.Parallel()
+
+	m := NewMerger(testStreams()...)
+
+	m.Seek(minute(3))
+
+	for i, exp := range expectedEvents[3:] {
+		e, ok := m.Next()
+		if !ok {
+			t.Fatalf("Next() %v after Seek(): expected an event, actual none", i)
+		}
+
+		verifyEvent(t, i, exp, e)
+	}
+
+	m.Seek(minute(3).Add(time.Second))
+
+	if e, _ := m.Next(); e.Stream != 1 || e.Index != 3 {
+		t.Errorf("Seek(): expected stream 1 index 3, actual stream %v index %v", e.Stream, e.Index)
+	}
+
+	m.Seek(minute(6))
+
+	if _, ok := m.Next(); ok {
+		t.Error("Seek() after the end: expected no events")
+	}
+
+	m.Reset()
+
+	if l := m.Len(); l != len(expectedEvents) {
+		t.Errorf("Len() after Reset(): expected %v, actual %v", len(expectedEvents), l)
+	}
+
+	e, _ := m.Next()
+	verifyEvent(t, 0, expectedEvents[0], e)
+}
+
+func TestMergerEmpty(t *testing.T) {
+	t.Parallel()
+
+	m := NewMerger(NewBarStream("a", nil), NewStream("b", []data.Temporal{}))
+
+	if _, ok := m.Next(); ok {
+		t.Error("Next(): expected no events")
+	}
+
+	m = NewMerger()
+
+	if _, ok := m.Peek(); ok {
+		t.Error("Peek(): expected no events")
+	}
+}
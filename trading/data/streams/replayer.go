@@ -0,0 +1,163 @@
+package streams
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"mbg/trading/time/timepieces"
+)
+
+var errInvalidSpeed = errors.New("replay speed should be positive")
+
+// Replayer replays merged events against a timepiece at N× speed.
+//
+// The replay clock maps the timepiece time to the data time: when the
+// timepiece advances by a duration d, the replay clock advances by speed×d.
+// An event is due when its time is not after the replay clock.
+//
+// The replay can be paused, stepped event by event and seeked.
+// The implementation is not thread-safe.
+type Replayer struct {
+	merger    *Merger
+	timepiece timepieces.Timepiece
+	speed     float64
+	paused    bool
+	wall      time.Time // The timepiece time of the anchor.
+	clock     time.Time // The replay clock at the anchor.
+}
+
+// NewReplayer creates a new running replayer with the replay clock set to the time of the next merger event.
+//
+// The speed is a positive multiplier of the timepiece time, e.g. 1 replays in real time
+// and 60 replays an hour of data in a minute.
+func NewReplayer(merger *Merger, timepiece timepieces.Timepiece, speed float64) (*Replayer, error) {
+	if speed <= 0 {
+		return nil, fmt.Errorf("cannot create replayer with speed %v: %w", speed, errInvalidSpeed)
+	}
+
+	r := &Replayer{merger: merger, timepiece: timepiece, speed: speed}
+
+	var t time.Time
+	if e, ok := merger.Peek(); ok {
+		t = e.Time()
+	}
+
+	r.anchor(t)
+
+	return r, nil
+}
+
+// Speed returns the replay speed.
+func (r *Replayer) Speed() float64 {
+	return r.speed
+}
+
+// SetSpeed changes the replay speed starting from the current replay clock.
+func (r *Replayer) SetSpeed(speed float64) error {
+	if speed <= 0 {
+		return fmt.Errorf("cannot set replay speed %v: %w", speed, errInvalidSpeed)
+	}
+
+	r.anchor(r.Clock())
+	r.speed = speed
+
+	return nil
+}
+
+// IsPaused indicates whether the replay is paused.
+func (r *Replayer) IsPaused() bool {
+	return r.paused
+}
+
+// Pause stops the replay clock. Does nothing if the replay is already paused.
+func (r *Replayer) Pause() {
+	if r.paused {
+		return
+	}
+
+	r.anchor(r.Clock())
+	r.paused = true
+}
+
+// Resume restarts the stopped replay clock. Does nothing if the replay is not paused.
+func (r *Replayer) Resume() {
+	if !r.paused {
+		return
+	}
+
+	r.anchor(r.clock)
+	r.paused = false
+}
+
+// Clock returns the current replay clock.
+func (r *Replayer) Clock() time.Time {
+	if r.paused {
+		return r.clock
+	}
+
+	d := r.timepiece.Now().Sub(r.wall)
+
+	return r.clock.Add(time.Duration(float64(d) * r.speed))
+}
+
+// Poll returns all events which are due at the current replay clock, in the merged order.
+// Returns an empty slice if there are no due events.
+func (r *Replayer) Poll() []Event {
+	c := r.Clock()
+	es := []Event{}
+
+	for {
+		e, ok := r.merger.Peek()
+		if !ok || e.Time().After(c) {
+			return es
+		}
+
+		r.merger.Next()
+		es = append(es, e)
+	}
+}
+
+// NextDue returns the timepiece time when the next event becomes due.
+// Returns false if there are no events left or the replay is paused.
+func (r *Replayer) NextDue() (time.Time, bool) {
+	e, ok := r.merger.Peek()
+	if !ok || r.paused {
+		return time.Time{}, false
+	}
+
+	d := e.Time().Sub(r.clock)
+	if d <= 0 {
+		return r.wall, true
+	}
+
+	return r.wall.Add(time.Duration(float64(d) / r.speed)), true
+}
+
+// Step returns the next event regardless of the replay clock and moves the replay clock
+// forward to the time of this event. Returns false if there are no events left.
+func (r *Replayer) Step() (Event, bool) {
+	e, ok := r.merger.Next()
+	if !ok {
+		return e, false
+	}
+
+	if t := e.Time(); t.After(r.Clock()) {
+		r.anchor(t)
+	}
+
+	return e, true
+}
+
+// Seek positions the replay so that the next event is the earliest one with the time equal
+// to or after the given time, and sets the replay clock to the given time.
+func (r *Replayer) Seek(t time.Time) {
+	r.merger.Seek(t)
+	r.anchor(t)
+}
+
+func (r *Replayer) anchor(clock time.Time) {
+	r.wall = r.timepiece.Now()
+	r.clock = clock
+}
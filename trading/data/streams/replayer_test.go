@@ -0,0 +1,139 @@
+//nolint:testpackage
+package streams
+
+//nolint:gofumpt
+import (
+	"testing"
+	"time"
+)
+
+// manualTimepiece is a timepiece which time is advanced manually.
+type manualTimepiece struct {
+	now time.Time
+}
+
+func (mt *manualTimepiece) Now() time.Time                        { return mt.now }
+func (mt *manualTimepiece) IsHoliday() bool                       { return false }
+func (mt *manualTimepiece) AddReminder(string, func(), time.Time) {}
+func (mt *manualTimepiece) RemoveReminder(string, func())         {}
+
+func (mt *manualTimepiece) advance(d time.Duration) {
+	mt.now = mt.now.Add(d)
+}
+
+func TestReplayerInvalidSpeed(t *testing.T) {
+	t.Parallel()
+
+	tp := &manualTimepiece{now: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	if _, err := NewReplayer(NewMerger(testStreams()...), tp, 0); err == nil {
+		t.Error("NewReplayer(speed 0): expected error, actual nil")
+	}
+
+	r, err := NewReplayer(NewMerger(testStreams()...), tp, 1)
+	if err != nil {
+		t.Fatalf("NewReplayer(): unexpected error %v", err)
+	}
+
+	if err := r.SetSpeed(-1); err == nil {
+		t.Error("SetSpeed(-1): expected error, actual nil")
+	}
+
+	if s := r.Speed(); s != 1 {
+		t.Errorf("Speed(): expected 1, actual %v", s)
+	}
+}
+
+//nolint:funlen,cyclop
+func TestReplayerPoll(t *testing.T) {
+	t.Parallel()
+
+	tp := &manualTimepiece{now: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	r, err := NewReplayer(NewMerger(testStreams()...), tp, 60)
+	if err != nil {
+		t.Fatalf("NewReplayer(): unexpected error %v", err)
+	}
+
+	if c := r.Clock(); !c.Equal(minute(0)) {
+		t.Errorf("Clock(): expected %v, actual %v", minute(0), c)
+	}
+
+	if es := r.Poll(); len(es) != 1 {
+		t.Errorf("Poll() at start: expected 1 event, actual %v", len(es))
+	}
+
+	if due, ok := r.NextDue(); !ok || !due.Equal(tp.now.Add(time.Second)) {
+		t.Errorf("NextDue(): expected %v, actual %v", tp.now.Add(time.Second), due)
+	}
+
+	// One second of timepiece time is one minute of data time.
+	tp.advance(time.Second)
+
+	if es := r.Poll(); len(es) != 1 || es[0].Stream != 0 {
+		t.Errorf("Poll() after 1s: expected 1 bar event, actual %v", es)
+	}
+
+	tp.advance(2 * time.Second)
+
+	if es := r.Poll(); len(es) != 5 {
+		t.Errorf("Poll() after 3s: expected 5 events, actual %v", len(es))
+	}
+
+	r.Pause()
+
+	if !r.IsPaused() {
+		t.Error("IsPaused(): expected true, actual false")
+	}
+
+	tp.advance(time.Hour)
+
+	if es := r.Poll(); len(es) != 0 {
+		t.Errorf("Poll() when paused: expected 0 events, actual %v", len(es))
+	}
+
+	if _, ok := r.NextDue(); ok {
+		t.Error("NextDue() when paused: expected false, actual true")
+	}
+
+	e, ok := r.Step()
+	if !ok || !e.Time().Equal(minute(4)) {
+		t.Errorf("Step(): expected event at %v, actual %v", minute(4), e.Time())
+	}
+
+	if c := r.Clock(); !c.Equal(minute(4)) {
+		t.Errorf("Clock() after Step(): expected %v, actual %v", minute(4), c)
+	}
+
+	r.Resume()
+
+	if err := r.SetSpeed(30); err != nil {
+		t.Errorf("SetSpeed(30): unexpected error %v", err)
+	}
+
+	tp.advance(time.Second)
+
+	if es := r.Poll(); len(es) != 0 {
+		t.Errorf("Poll() after 30s of data: expected 0 events, actual %v", len(es))
+	}
+
+	tp.advance(time.Second)
+
+	if es := r.Poll(); len(es) != 1 || !es[0].Time().Equal(minute(5)) {
+		t.Errorf("Poll() after 60s of data: expected 1 event at %v, actual %v", minute(5), es)
+	}
+
+	if _, ok := r.Step(); ok {
+		t.Error("Step() after the end: expected false, actual true")
+	}
+
+	r.Seek(minute(3))
+
+	if c := r.Clock(); !c.Equal(minute(3)) {
+		t.Errorf("Clock() after Seek(): expected %v, actual %v", minute(3), c)
+	}
+
+	if es := r.Poll(); len(es) != 4 {
+		t.Errorf("Poll() after Seek(): expected 4 events, actual %v", len(es))
+	}
+}
@@ -0,0 +1,61 @@
+// Package streams merges time-ordered data streams of many instruments into a single
+// time-ordered sequence and replays it against a timepiece.
+package streams
+
+//nolint:gofumpt
+import (
+	"sort"
+	"time"
+
+	"mbg/trading/data"
+)
+
+// Stream is a named time-ordered sequence of temporal data entities,
+// for instance bars, quotes or trades of a single instrument.
+//
+// The entities are expected to be sorted by time in the ascending order.
+type Stream struct {
+	name string
+	len  int
+	at   func(i int) data.Temporal
+}
+
+// NewBarStream creates a new stream from a time-ordered slice of bars.
+func NewBarStream(name string, bars []data.Bar) *Stream {
+	return &Stream{name: name, len: len(bars), at: func(i int) data.Temporal { return &bars[i] }}
+}
+
+// NewQuoteStream creates a new stream from a time-ordered slice of quotes.
+func NewQuoteStream(name string, quotes []data.Quote) *Stream {
+	return &Stream{name: name, len: len(quotes), at: func(i int) data.Temporal { return &quotes[i] }}
+}
+
+// NewTradeStream creates a new stream from a time-ordered slice of trades.
+func NewTradeStream(name string, trades []data.Trade) *Stream {
+	return &Stream{name: name, len: len(trades), at: func(i int) data.Temporal { return &trades[i] }}
+}
+
+// NewStream creates a new stream from a time-ordered slice of arbitrary temporal entities.
+func NewStream(name string, entities []data.Temporal) *Stream {
+	return &Stream{name: name, len: len(entities), at: func(i int) data.Temporal { return entities[i] }}
+}
+
+// Name is the name of the stream, usually a symbol of the instrument.
+func (s *Stream) Name() string {
+	return s.name
+}
+
+// Len is the number of entities in the stream.
+func (s *Stream) Len() int {
+	return s.len
+}
+
+// At returns the entity at the given index.
+func (s *Stream) At(i int) data.Temporal {
+	return s.at(i)
+}
+
+// search returns the index of the first entity with the time not before the given time.
+func (s *Stream) search(t time.Time) int {
+	return sort.Search(s.len, func(i int) bool { return !s.at(i).DateTime().Before(t) })
+}
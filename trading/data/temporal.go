@@ -2,6 +2,8 @@ package data
 
 import "time"
 
+// Temporal is an entity having a date and time.
 type Temporal interface {
+	// DateTime is the date and time of the entity.
 	DateTime() time.Time
 }
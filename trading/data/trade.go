@@ -8,3 +8,8 @@ type Trade struct {
 	Price  float64   `json:"price"` // The price.
 	Volume float64   `json:"vavue"` // The volume.
 }
+
+// DateTime implements the Temporal interface.
+func (t *Trade) DateTime() time.Time {
+	return t.Time
+}
@@ -0,0 +1,30 @@
+//nolint:testpackage
+package contracts
+
+import (
+	"testing"
+)
+
+func BenchmarkScheduleActive(b *testing.B) {
+	t := ymd(2021, 11, 1)
+
+	for i := 0; i < b.N; i++ {
+		_, _ = quarterly.Active(t, 4)
+	}
+}
+
+func BenchmarkScheduleExpiries(b *testing.B) {
+	from, to := ymd(2021, 1, 1), ymd(2021, 12, 31)
+
+	for i := 0; i < b.N; i++ {
+		_, _ = weekly.Expiries(from, to)
+	}
+}
+
+func BenchmarkFutureSymbol(b *testing.B) {
+	f := emini().Contract(ymd(2021, 12, 17))
+
+	for i := 0; i < b.N; i++ {
+		_ = f.Symbol()
+	}
+}
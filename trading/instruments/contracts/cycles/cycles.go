@@ -0,0 +1,87 @@
+// Package cycles enumerates expiry cycles of derivative contracts.
+package cycles
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Cycle enumerates expiry cycles of derivative contracts.
+type Cycle int
+
+const (
+	// Monthly cycle has a contract expiring every month.
+	Monthly Cycle = iota + 1
+
+	// Quarterly cycle has contracts expiring in March, June, September and December.
+	Quarterly
+
+	// Weekly cycle has a contract expiring every week.
+	Weekly
+	last
+)
+
+const (
+	unknown   = "unknown"
+	monthly   = "monthly"
+	quarterly = "quarterly"
+	weekly    = "weekly"
+)
+
+var errUnknownCycle = errors.New("unknown expiry cycle")
+
+// String implements the fmt.Stringer interface.
+func (c Cycle) String() string {
+	switch c {
+	case Monthly:
+		return monthly
+	case Quarterly:
+		return quarterly
+	case Weekly:
+		return weekly
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this expiry cycle is known.
+func (c Cycle) IsKnown() bool {
+	return c >= Monthly && c < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (c Cycle) MarshalJSON() ([]byte, error) {
+	str := c.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownCycle)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (c *Cycle) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case monthly:
+		*c = Monthly
+	case quarterly:
+		*c = Quarterly
+	case weekly:
+		*c = Weekly
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownCycle)
+	}
+
+	return nil
+}
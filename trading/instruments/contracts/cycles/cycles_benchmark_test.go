@@ -0,0 +1,29 @@
+//nolint:testpackage
+package cycles
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := Weekly
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := Weekly
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var c Cycle
+
+	bs := []byte("\"weekly\"")
+	for i := 0; i < b.N; i++ {
+		_ = c.UnmarshalJSON(bs)
+	}
+}
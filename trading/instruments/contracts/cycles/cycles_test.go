@@ -0,0 +1,140 @@
+//nolint:testpackage
+package cycles
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    Cycle
+		text string
+	}{
+		{Monthly, monthly},
+		{Quarterly, quarterly},
+		{Weekly, weekly},
+		{last, unknown},
+		{Cycle(0), unknown},
+		{Cycle(9999), unknown},
+		{Cycle(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.c.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.c, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c       Cycle
+		boolean bool
+	}{
+		{Monthly, true},
+		{Quarterly, true},
+		{Weekly, true},
+		{last, false},
+		{Cycle(0), false},
+		{Cycle(9999), false},
+		{Cycle(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.c.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.c, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		c         Cycle
+		json      string
+		succeeded bool
+	}{
+		{Monthly, "\"monthly\"", true},
+		{Quarterly, "\"quarterly\"", true},
+		{Weekly, "\"weekly\"", true},
+		{last, nilstr, false},
+		{Cycle(9999), nilstr, false},
+		{Cycle(-9999), nilstr, false},
+		{Cycle(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.c.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.c, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.c)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.c, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Cycle
+	tests := []struct {
+		c         Cycle
+		json      string
+		succeeded bool
+	}{
+		{Monthly, "\"monthly\"", true},
+		{Quarterly, "\"quarterly\"", true},
+		{Weekly, "\"weekly\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.c
+		bs := []byte(tt.json)
+
+		var c Cycle
+
+		err := c.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != c {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, c)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package contracts
+
+//nolint:gofumpt
+import (
+	"fmt"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments"
+	"mbg/trading/instruments/contracts/cycles"
+	"mbg/trading/instruments/contracts/settlements"
+	"mbg/trading/instruments/status"
+	"mbg/trading/instruments/types"
+	"mbg/trading/markets/mics"
+)
+
+// FutureSpec is a contract specification of a futures product, e.g. the E-mini S&P 500 futures.
+type FutureSpec struct {
+	// Root is a root symbol of the product, e.g. ES.
+	Root string `json:"root"`
+
+	// Name is a short name of the product.
+	Name string `json:"name,omitempty"`
+
+	// Underlying is a symbol of the underlying asset.
+	Underlying string `json:"underlying"`
+
+	// MIC is an ISO 10383 Market Identifier Code where the contracts are traded.
+	MIC mics.MIC `json:"mic,omitempty"`
+
+	// Currency is an ISO 4217 three-letter currency code which the price of the contracts is denominated.
+	Currency currencies.Currency `json:"currency"`
+
+	// Multiplier is a contract multiplier (contract size), the PriceFactor of the contracts:
+	//   Nominal Value = Quantity * Price * Multiplier.
+	Multiplier float64 `json:"multiplier"`
+
+	// TickSize is the minimum price increment of the contracts.
+	TickSize float64 `json:"tickSize"`
+
+	// PricePrecision is the number of decimal places in the contract price.
+	PricePrecision int `json:"pricePrecision,omitempty"`
+
+	// Margin is an initial margin of a contract.
+	Margin float64 `json:"margin,omitempty"`
+
+	// Settlement is a settlement type of the contracts.
+	Settlement settlements.Settlement `json:"settlement"`
+
+	// Schedule defines the expiry dates of the contracts.
+	Schedule Schedule `json:"schedule"`
+}
+
+// TickValue is the value of one tick of a contract in the contract currency.
+func (s *FutureSpec) TickValue() float64 {
+	return s.TickSize * s.Multiplier
+}
+
+// Contract returns a contract expiring on a given date.
+//
+// The expiry date is not checked against the schedule.
+func (s *FutureSpec) Contract(expiry time.Time) Future {
+	return Future{Spec: s, Expiry: date(expiry)}
+}
+
+// Contracts returns the contracts expiring in the inclusive date range [from, to].
+func (s *FutureSpec) Contracts(from, to time.Time) ([]Future, error) {
+	ts, err := s.Schedule.Expiries(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.futures(ts), nil
+}
+
+// Active returns the first n contracts not expired on a given date, the front contract first.
+func (s *FutureSpec) Active(t time.Time, n int) ([]Future, error) {
+	ts, err := s.Schedule.Active(t, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.futures(ts), nil
+}
+
+func (s *FutureSpec) futures(ts []time.Time) []Future {
+	fs := make([]Future, len(ts))
+	for i, t := range ts {
+		fs[i] = s.Contract(t)
+	}
+
+	return fs
+}
+
+// Future is a futures contract of a given product expiring on a given date.
+type Future struct {
+	// Spec is a contract specification of the product.
+	Spec *FutureSpec
+
+	// Expiry is the expiry (last trading) date at midnight in UTC.
+	Expiry time.Time
+}
+
+// Symbol returns a symbol of the contract.
+//
+// The Monthly and Quarterly contracts are denoted by the root, the month code and the two-digit year,
+// e.g. ESZ21 for the December 2021 contract. The Weekly contracts are denoted by the root
+// and the expiry date in the YYMMDD format, e.g. ES211217.
+func (f Future) Symbol() string {
+	return contractSymbol(f.Spec.Root, f.Spec.Schedule.Cycle, f.Expiry)
+}
+
+// MutableInstrument returns the contract as a mutable instrument of the Future type,
+// e.g. to register it in an instrument Master.
+//
+// The PriceFactor is the contract multiplier and the MinPriceIncrement is the tick size.
+func (f Future) MutableInstrument() instruments.MutableInstrument {
+	s := f.Spec
+
+	return instruments.MutableInstrument{
+		Name:              s.Name,
+		Description:       fmt.Sprintf("%s future expiring on %s", s.Underlying, f.Expiry.Format(isoDate)),
+		Symbol:            f.Symbol(),
+		MIC:               s.MIC,
+		Currency:          s.Currency,
+		Type:              types.Future,
+		Status:            status.Active,
+		HolidayCalendar:   s.Schedule.Calendar,
+		PricePrecision:    s.PricePrecision,
+		MinPriceIncrement: s.TickSize,
+		PriceFactor:       s.Multiplier,
+		Margin:            s.Margin,
+	}
+}
+
+const isoDate = "2006-01-02"
+
+// monthCodes are the futures delivery month codes from January to December.
+const monthCodes = "FGHJKMNQUVXZ"
+
+// contractSymbol returns a symbol of a contract expiring on a given date.
+func contractSymbol(root string, c cycles.Cycle, expiry time.Time) string {
+	if c == cycles.Weekly {
+		return root + expiry.Format("060102")
+	}
+
+	return fmt.Sprintf("%s%c%s", root, monthCodes[expiry.Month()-1], expiry.Format("06"))
+}
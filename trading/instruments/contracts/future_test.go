@@ -0,0 +1,88 @@
+//nolint:testpackage
+package contracts
+
+//nolint:gofumpt
+import (
+	"testing"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments"
+	"mbg/trading/instruments/contracts/settlements"
+	"mbg/trading/instruments/status"
+	"mbg/trading/instruments/types"
+	"mbg/trading/markets/mics"
+	"mbg/trading/time/holidays"
+)
+
+func emini() *FutureSpec {
+	return &FutureSpec{
+		Root: "ES", Name: "E-mini S&P 500", Underlying: "SPX", MIC: mics.XCME, Currency: currencies.USD,
+		Multiplier: 50, TickSize: 0.25, PricePrecision: 2, Margin: 12000,
+		Settlement: settlements.Cash, Schedule: quarterly,
+	}
+}
+
+//nolint:funlen
+func TestFuture(t *testing.T) {
+	t.Parallel()
+
+	s := emini()
+
+	if s.TickValue() != 12.5 {
+		t.Errorf("TickValue(): expected 12.5, actual %v", s.TickValue())
+	}
+
+	fs, err := s.Active(ymd(2021, 11, 1), 2)
+	if err != nil || len(fs) != 2 {
+		t.Fatalf("Active(): expected 2 contracts, actual %v, %v", len(fs), err)
+	}
+
+	if fs[0].Symbol() != "ESZ21" || fs[1].Symbol() != "ESH22" {
+		t.Errorf("Symbol(): expected ESZ21 ESH22, actual %v %v", fs[0].Symbol(), fs[1].Symbol())
+	}
+
+	if !fs[0].Expiry.Equal(ymd(2021, 12, 17)) || fs[0].Spec != s {
+		t.Errorf("Active()[0]: unexpected %v", fs[0])
+	}
+
+	mi := fs[0].MutableInstrument()
+	if mi.Symbol != "ESZ21" || mi.Type != types.Future || mi.PriceFactor != 50 || mi.MinPriceIncrement != 0.25 ||
+		mi.Currency != currencies.USD || mi.MIC != mics.XCME || mi.Status != status.Active || mi.Margin != 12000 ||
+		mi.HolidayCalendar != holidays.UnitedStates || mi.PricePrecision != 2 {
+		t.Errorf("MutableInstrument(): unexpected %+v", mi)
+	}
+
+	if mi.Description != "SPX future expiring on 2021-12-17" {
+		t.Errorf("MutableInstrument(): unexpected description '%v'", mi.Description)
+	}
+
+	// The contracts can be registered in the instrument master.
+	m := instruments.NewMaster()
+	if _, err := m.Add(mi); err != nil {
+		t.Errorf("Master.Add(): expected success, got error %v", err)
+	}
+
+	cs, err := s.Contracts(ymd(2022, 1, 1), ymd(2022, 12, 31))
+	if err != nil || len(cs) != 4 || cs[3].Symbol() != "ESZ22" {
+		t.Errorf("Contracts(2022): unexpected %v, %v", cs, err)
+	}
+
+	if _, err := s.Contracts(ymd(2022, 1, 1), ymd(2021, 12, 31)); err == nil {
+		t.Error("Contracts(from after to): expected error, got success")
+	}
+
+	if _, err := s.Active(ymd(2022, 1, 1), 0); err == nil {
+		t.Error("Active(n=0): expected error, got success")
+	}
+
+	w := emini()
+	w.Schedule = weekly
+
+	if act := w.Contract(ymd(2021, 4, 9)).Symbol(); act != "ES210409" {
+		t.Errorf("Symbol(weekly): expected ES210409, actual %v", act)
+	}
+
+	if act := s.Contract(ymd(2021, 1, 15)).Symbol(); act != "ESF21" {
+		t.Errorf("Symbol(January): expected ESF21, actual %v", act)
+	}
+}
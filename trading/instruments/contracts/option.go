@@ -0,0 +1,150 @@
+package contracts
+
+//nolint:gofumpt
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments"
+	"mbg/trading/instruments/contracts/rights"
+	"mbg/trading/instruments/contracts/settlements"
+	"mbg/trading/instruments/contracts/styles"
+	"mbg/trading/instruments/status"
+	"mbg/trading/instruments/types"
+	"mbg/trading/markets/mics"
+)
+
+// OptionSpec is a contract specification of an options product, e.g. the S&P 500 index options.
+type OptionSpec struct {
+	// Root is a root symbol of the product, e.g. SPX.
+	Root string `json:"root"`
+
+	// Name is a short name of the product.
+	Name string `json:"name,omitempty"`
+
+	// Underlying is a symbol of the underlying asset.
+	Underlying string `json:"underlying"`
+
+	// MIC is an ISO 10383 Market Identifier Code where the contracts are traded.
+	MIC mics.MIC `json:"mic,omitempty"`
+
+	// Currency is an ISO 4217 three-letter currency code which the price of the contracts is denominated.
+	Currency currencies.Currency `json:"currency"`
+
+	// Multiplier is a contract multiplier (contract size), the PriceFactor of the contracts:
+	//   Nominal Value = Quantity * Price * Multiplier.
+	Multiplier float64 `json:"multiplier"`
+
+	// TickSize is the minimum price increment of the contracts.
+	TickSize float64 `json:"tickSize"`
+
+	// PricePrecision is the number of decimal places in the contract price.
+	PricePrecision int `json:"pricePrecision,omitempty"`
+
+	// Settlement is a settlement type of the contracts.
+	Settlement settlements.Settlement `json:"settlement"`
+
+	// Style is an exercise style of the contracts.
+	Style styles.Style `json:"style"`
+
+	// Schedule defines the expiry dates of the contracts.
+	Schedule Schedule `json:"schedule"`
+}
+
+// TickValue is the value of one tick of a contract in the contract currency.
+func (s *OptionSpec) TickValue() float64 {
+	return s.TickSize * s.Multiplier
+}
+
+// Contract returns a contract with a given right and strike price expiring on a given date.
+//
+// The expiry date is not checked against the schedule.
+func (s *OptionSpec) Contract(expiry time.Time, right rights.Right, strike float64) Option {
+	return Option{Spec: s, Expiry: date(expiry), Right: right, Strike: strike}
+}
+
+// Chain returns the call and put contracts with given strike prices expiring on a given date,
+// ordered by the strike price as given, a call before a put.
+func (s *OptionSpec) Chain(expiry time.Time, strikes []float64) []Option {
+	os := make([]Option, 0, 2*len(strikes)) //nolint:gomnd
+	for _, k := range strikes {
+		os = append(os, s.Contract(expiry, rights.Call, k), s.Contract(expiry, rights.Put, k))
+	}
+
+	return os
+}
+
+// Expiries returns the expiry dates in the inclusive date range [from, to].
+func (s *OptionSpec) Expiries(from, to time.Time) ([]time.Time, error) {
+	return s.Schedule.Expiries(from, to)
+}
+
+// Active returns the first n expiry dates of the contracts not expired on a given date.
+func (s *OptionSpec) Active(t time.Time, n int) ([]time.Time, error) {
+	return s.Schedule.Active(t, n)
+}
+
+// Option is an options contract of a given product with a given right and strike price
+// expiring on a given date.
+type Option struct {
+	// Spec is a contract specification of the product.
+	Spec *OptionSpec
+
+	// Expiry is the expiry date at midnight in UTC.
+	Expiry time.Time
+
+	// Right is a right of the contract.
+	Right rights.Right
+
+	// Strike is a strike price of the contract.
+	Strike float64
+}
+
+// Symbol returns a symbol of the contract.
+//
+// The symbol is the contract symbol as for the futures followed by a space,
+// C for a call or P for a put and the strike price, e.g. SPXZ21 C4500.
+func (o Option) Symbol() string {
+	r := "C"
+	if o.Right == rights.Put {
+		r = "P"
+	}
+
+	return contractSymbol(o.Spec.Root, o.Spec.Schedule.Cycle, o.Expiry) + " " + r +
+		strconv.FormatFloat(o.Strike, 'f', -1, 64)
+}
+
+// Intrinsic returns the intrinsic value of the contract per unit of the underlying at a given underlying price.
+func (o Option) Intrinsic(underlying float64) float64 {
+	if o.Right == rights.Put {
+		return math.Max(o.Strike-underlying, 0)
+	}
+
+	return math.Max(underlying-o.Strike, 0)
+}
+
+// MutableInstrument returns the contract as a mutable instrument of the Option type,
+// e.g. to register it in an instrument Master.
+//
+// The PriceFactor is the contract multiplier and the MinPriceIncrement is the tick size.
+func (o Option) MutableInstrument() instruments.MutableInstrument {
+	s := o.Spec
+
+	return instruments.MutableInstrument{
+		Name: s.Name,
+		Description: fmt.Sprintf("%s %s %s option with strike %s expiring on %s", s.Underlying, s.Style, o.Right,
+			strconv.FormatFloat(o.Strike, 'f', -1, 64), o.Expiry.Format(isoDate)),
+		Symbol:            o.Symbol(),
+		MIC:               s.MIC,
+		Currency:          s.Currency,
+		Type:              types.Option,
+		Status:            status.Active,
+		HolidayCalendar:   s.Schedule.Calendar,
+		PricePrecision:    s.PricePrecision,
+		MinPriceIncrement: s.TickSize,
+		PriceFactor:       s.Multiplier,
+	}
+}
@@ -0,0 +1,86 @@
+//nolint:testpackage
+package contracts
+
+//nolint:gofumpt
+import (
+	"testing"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments/contracts/rights"
+	"mbg/trading/instruments/contracts/settlements"
+	"mbg/trading/instruments/contracts/styles"
+	"mbg/trading/instruments/types"
+	"mbg/trading/markets/mics"
+)
+
+func spx() *OptionSpec {
+	return &OptionSpec{
+		Root: "SPX", Underlying: "SPX", MIC: mics.XCBO, Currency: currencies.USD,
+		Multiplier: 100, TickSize: 0.05, Settlement: settlements.Cash, Style: styles.European, Schedule: monthly,
+	}
+}
+
+//nolint:funlen
+func TestOption(t *testing.T) {
+	t.Parallel()
+
+	s := spx()
+
+	if s.TickValue() != 5 {
+		t.Errorf("TickValue(): expected 5, actual %v", s.TickValue())
+	}
+
+	ts, err := s.Active(ymd(2022, 4, 1), 2)
+	if err != nil || len(ts) != 2 || !ts[0].Equal(ymd(2022, 4, 14)) {
+		t.Fatalf("Active(): unexpected %v, %v", ts, err)
+	}
+
+	if es, err := s.Expiries(ymd(2022, 1, 1), ymd(2022, 3, 31)); err != nil || len(es) != 3 {
+		t.Errorf("Expiries(): expected 3 dates, actual %v, %v", es, err)
+	}
+
+	chain := s.Chain(ts[0], []float64{4400, 4412.5})
+	if len(chain) != 4 {
+		t.Fatalf("Chain(): expected 4 contracts, actual %v", len(chain))
+	}
+
+	symbols := []string{"SPXJ22 C4400", "SPXJ22 P4400", "SPXJ22 C4412.5", "SPXJ22 P4412.5"}
+	for i, o := range chain {
+		if o.Symbol() != symbols[i] {
+			t.Errorf("Chain()[%d].Symbol(): expected %v, actual %v", i, symbols[i], o.Symbol())
+		}
+	}
+
+	call, put := chain[0], chain[1]
+
+	tests := []struct {
+		o          Option
+		underlying float64
+		intrinsic  float64
+	}{
+		{call, 4450, 50},
+		{call, 4400, 0},
+		{call, 4300, 0},
+		{put, 4300, 100},
+		{put, 4450, 0},
+	}
+
+	for _, tt := range tests {
+		if act := tt.o.Intrinsic(tt.underlying); act != tt.intrinsic {
+			t.Errorf("%v Intrinsic(%v): expected %v, actual %v", tt.o.Symbol(), tt.underlying, tt.intrinsic, act)
+		}
+	}
+
+	mi := put.MutableInstrument()
+	if mi.Symbol != "SPXJ22 P4400" || mi.Type != types.Option || mi.PriceFactor != 100 || mi.MinPriceIncrement != 0.05 {
+		t.Errorf("MutableInstrument(): unexpected %+v", mi)
+	}
+
+	if exp := "SPX european put option with strike 4400 expiring on 2022-04-14"; mi.Description != exp {
+		t.Errorf("MutableInstrument(): expected description '%v', actual '%v'", exp, mi.Description)
+	}
+
+	if o := s.Contract(ts[1], rights.Call, 4500); !o.Expiry.Equal(ymd(2022, 5, 20)) || o.Right != rights.Call {
+		t.Errorf("Contract(): unexpected %v", o)
+	}
+}
@@ -0,0 +1,78 @@
+// Package rights enumerates rights of an option contract.
+package rights
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Right enumerates rights of an option contract.
+type Right int
+
+const (
+	// Call is a right to buy the underlying asset at the strike price.
+	Call Right = iota + 1
+
+	// Put is a right to sell the underlying asset at the strike price.
+	Put
+)
+
+const (
+	unknown = "unknown"
+	call    = "call"
+	put     = "put"
+)
+
+var errUnknownRight = errors.New("unknown option right")
+
+// String implements the fmt.Stringer interface.
+func (r Right) String() string {
+	switch r {
+	case Call:
+		return call
+	case Put:
+		return put
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this option right is known.
+func (r Right) IsKnown() bool {
+	return r == Call || r == Put
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (r Right) MarshalJSON() ([]byte, error) {
+	str := r.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownRight)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (r *Right) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case call:
+		*r = Call
+	case put:
+		*r = Put
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownRight)
+	}
+
+	return nil
+}
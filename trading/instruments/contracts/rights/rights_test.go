@@ -0,0 +1,133 @@
+//nolint:testpackage
+package rights
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r    Right
+		text string
+	}{
+		{Call, call},
+		{Put, put},
+		{Right(0), unknown},
+		{Right(9999), unknown},
+		{Right(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.r.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.r, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r       Right
+		boolean bool
+	}{
+		{Call, true},
+		{Put, true},
+		{Right(0), false},
+		{Right(9999), false},
+		{Right(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.r.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.r, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		r         Right
+		json      string
+		succeeded bool
+	}{
+		{Call, "\"call\"", true},
+		{Put, "\"put\"", true},
+		{Right(9999), nilstr, false},
+		{Right(-9999), nilstr, false},
+		{Right(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.r.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.r, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.r)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.r, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Right
+	tests := []struct {
+		r         Right
+		json      string
+		succeeded bool
+	}{
+		{Call, "\"call\"", true},
+		{Put, "\"put\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.r
+		bs := []byte(tt.json)
+
+		var r Right
+
+		err := r.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != r {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, r)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// Package contracts implements futures and options contract specifications
+// and the generation of their expiry cycles.
+package contracts
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"mbg/trading/instruments/contracts/cycles"
+	"mbg/trading/time/businessdays"
+	"mbg/trading/time/holidays"
+	"mbg/trading/time/holidays/calendars"
+)
+
+// Schedule defines the expiry dates of the contracts of a derivative product.
+//
+// The expiry is a given weekday: the n-th (or the last) one in a month for the Monthly and Quarterly cycles,
+// and every one for the Weekly cycle. An expiry falling on a holiday is moved to the preceding business day.
+type Schedule struct {
+	// Cycle is an expiry cycle.
+	Cycle cycles.Cycle `json:"cycle"`
+
+	// Weekday is an expiry weekday, 0 is Sunday.
+	Weekday time.Weekday `json:"weekday"`
+
+	// Nth is an ordinal of the expiry weekday in a month, from 1 to 5, for the Monthly and Quarterly cycles.
+	// The value -1 denotes the last weekday of a month.
+	Nth int `json:"nth,omitempty"`
+
+	// Calendar is a holiday calendar of the expiry dates.
+	// If zero, only weekends are holidays.
+	Calendar holidays.Calendar `json:"calendar,omitempty"`
+}
+
+const (
+	daysInWeek = 7
+	lastNth    = -1
+	maxNth     = 5
+)
+
+var (
+	errUnknownCycle   = errors.New("unknown expiry cycle")
+	errInvalidWeekday = errors.New("invalid expiry weekday")
+	errInvalidNth     = errors.New("nth should be from 1 to 5 or -1")
+	errInvalidCount   = errors.New("number of contracts should be positive")
+	errInvalidRange   = errors.New("from should not be after to")
+)
+
+// validate checks if the schedule is consistent and returns its holiday calendar.
+func (s *Schedule) validate() (holidays.Calendarer, error) {
+	if !s.Cycle.IsKnown() {
+		return nil, fmt.Errorf("%v: %w", s.Cycle, errUnknownCycle)
+	}
+
+	if s.Weekday < time.Sunday || s.Weekday > time.Saturday {
+		return nil, errInvalidWeekday
+	}
+
+	if s.Cycle != cycles.Weekly && s.Nth != lastNth && (s.Nth < 1 || s.Nth > maxNth) {
+		return nil, errInvalidNth
+	}
+
+	if s.Calendar == 0 {
+		return calendars.WeekendsOnly{}, nil
+	}
+
+	return calendars.Lookup(s.Calendar)
+}
+
+// Expiries returns the expiry dates in the inclusive date range [from, to] in ascending order.
+//
+// The dates are at midnight in UTC.
+func (s *Schedule) Expiries(from, to time.Time) ([]time.Time, error) {
+	cal, err := s.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to = date(from), date(to)
+	if from.After(to) {
+		return nil, errInvalidRange
+	}
+
+	var ts []time.Time
+
+	s.iterate(cal, from, func(t time.Time) bool {
+		if t.After(to) {
+			return false
+		}
+
+		ts = append(ts, t)
+
+		return true
+	})
+
+	return ts, nil
+}
+
+// Active returns the first n expiry dates on or after a given date in ascending order,
+// which are the expiries of the contracts listed on that date.
+//
+// The dates are at midnight in UTC.
+func (s *Schedule) Active(t time.Time, n int) ([]time.Time, error) {
+	cal, err := s.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if n <= 0 {
+		return nil, errInvalidCount
+	}
+
+	ts := make([]time.Time, 0, n)
+
+	s.iterate(cal, date(t), func(t time.Time) bool {
+		ts = append(ts, t)
+
+		return len(ts) < n
+	})
+
+	return ts, nil
+}
+
+// iterate calls a function for the expiry dates on or after a given date in ascending order
+// while the function returns true.
+func (s *Schedule) iterate(cal holidays.Calendarer, from time.Time, f func(time.Time) bool) {
+	if s.Cycle == cycles.Weekly {
+		// Start from the first expiry weekday on or after the date and skip the expiries adjusted before it.
+		d := (int(s.Weekday) - int(from.Weekday()) + daysInWeek) % daysInWeek
+		for t := from.AddDate(0, 0, d); ; t = t.AddDate(0, 0, daysInWeek) {
+			e := adjust(cal, t)
+			if e.Before(from) {
+				continue
+			}
+
+			if !f(e) {
+				return
+			}
+		}
+	}
+
+	step := 1
+	if s.Cycle == cycles.Quarterly {
+		step = 3 //nolint:gomnd
+	}
+
+	// An adjusted expiry moves only backwards, possibly into the previous month, so the expiries
+	// of the cycle months before the month of the date are before the date. Start from the first
+	// cycle month on or after the month of the date and skip the expiries adjusted before the date.
+	y, m, _ := from.Date()
+	if s.Cycle == cycles.Quarterly {
+		m = (m + 2) / 3 * 3 //nolint:gomnd
+	}
+
+	for t := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC); ; t = t.AddDate(0, step, 0) {
+		e := adjust(cal, nthWeekday(t.Year(), t.Month(), s.Weekday, s.Nth))
+		if e.Before(from) {
+			continue
+		}
+
+		if !f(e) {
+			return
+		}
+	}
+}
+
+// adjust moves a holiday to the preceding business day.
+func adjust(cal holidays.Calendarer, t time.Time) time.Time {
+	if cal.IsHoliday(t) {
+		return businessdays.Previous(cal, t)
+	}
+
+	return t
+}
+
+// nthWeekday returns the n-th (or the last if n is -1) weekday of a month.
+// The fifth weekday which does not exist in a month is the last one.
+func nthWeekday(y int, m time.Month, wd time.Weekday, n int) time.Time {
+	last := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC)
+	if n == lastNth {
+		d := (int(last.Weekday()) - int(wd) + daysInWeek) % daysInWeek
+
+		return last.AddDate(0, 0, -d)
+	}
+
+	t := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	t = t.AddDate(0, 0, (int(wd)-int(t.Weekday())+daysInWeek)%daysInWeek+(n-1)*daysInWeek)
+
+	if t.After(last) {
+		t = t.AddDate(0, 0, -daysInWeek)
+	}
+
+	return t
+}
+
+// date returns the date of a given time at midnight in UTC.
+func date(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
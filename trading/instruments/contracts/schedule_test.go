@@ -0,0 +1,144 @@
+//nolint:testpackage
+package contracts
+
+//nolint:gofumpt
+import (
+	"testing"
+	"time"
+
+	"mbg/trading/instruments/contracts/cycles"
+	"mbg/trading/time/holidays"
+)
+
+func ymd(y, m, d int) time.Time {
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+}
+
+func equalDates(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var (
+	quarterly = Schedule{Cycle: cycles.Quarterly, Weekday: time.Friday, Nth: 3, Calendar: holidays.UnitedStates}
+	monthly   = Schedule{Cycle: cycles.Monthly, Weekday: time.Friday, Nth: 3, Calendar: holidays.UnitedStates}
+	weekly    = Schedule{Cycle: cycles.Weekly, Weekday: time.Friday, Calendar: holidays.UnitedStates}
+)
+
+//nolint:funlen
+func TestScheduleActive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s     Schedule
+		t     time.Time
+		n     int
+		dates []time.Time
+	}{
+		// The expiry date itself is active.
+		{quarterly, ymd(2021, 3, 19), 2, []time.Time{ymd(2021, 3, 19), ymd(2021, 6, 18)}},
+		{quarterly, ymd(2021, 3, 20), 2, []time.Time{ymd(2021, 6, 18), ymd(2021, 9, 17)}},
+		{quarterly, ymd(2021, 1, 4), 1, []time.Time{ymd(2021, 3, 19)}},
+		{quarterly, ymd(2021, 11, 1), 3, []time.Time{ymd(2021, 12, 17), ymd(2022, 3, 18), ymd(2022, 6, 17)}},
+		{quarterly, ymd(2021, 12, 20), 1, []time.Time{ymd(2022, 3, 18)}},
+		// The third Friday of April 2022 is Good Friday.
+		{monthly, ymd(2022, 4, 1), 2, []time.Time{ymd(2022, 4, 14), ymd(2022, 5, 20)}},
+		{monthly, ymd(2022, 4, 15), 1, []time.Time{ymd(2022, 5, 20)}},
+		// Good Friday 2021 is April 2nd.
+		{weekly, ymd(2021, 3, 31), 3, []time.Time{ymd(2021, 4, 1), ymd(2021, 4, 9), ymd(2021, 4, 16)}},
+		{weekly, ymd(2021, 4, 2), 1, []time.Time{ymd(2021, 4, 9)}},
+		{weekly, time.Date(2021, 4, 9, 22, 0, 0, 0, time.UTC), 1, []time.Time{ymd(2021, 4, 9)}},
+		// The last Thursday of November 2021 is Thanksgiving Day.
+		{
+			Schedule{Cycle: cycles.Monthly, Weekday: time.Thursday, Nth: -1, Calendar: holidays.UnitedStates},
+			ymd(2021, 11, 1), 2, []time.Time{ymd(2021, 11, 24), ymd(2021, 12, 30)},
+		},
+		// There is no fifth Monday in February 2021, the last one is used.
+		{
+			Schedule{Cycle: cycles.Monthly, Weekday: time.Monday, Nth: 5},
+			ymd(2021, 2, 1), 2, []time.Time{ymd(2021, 2, 22), ymd(2021, 3, 29)},
+		},
+	}
+
+	for _, tt := range tests {
+		act, err := tt.s.Active(tt.t, tt.n)
+		if err != nil {
+			t.Errorf("Active(%v, %v): expected success, got error %v", tt.t, tt.n, err)
+		}
+
+		if !equalDates(act, tt.dates) {
+			t.Errorf("Active(%v, %v): expected %v, actual %v", tt.t, tt.n, tt.dates, act)
+		}
+	}
+}
+
+func TestScheduleExpiries(t *testing.T) {
+	t.Parallel()
+
+	act, err := quarterly.Expiries(ymd(2021, 1, 1), ymd(2021, 12, 17))
+	exp := []time.Time{ymd(2021, 3, 19), ymd(2021, 6, 18), ymd(2021, 9, 17), ymd(2021, 12, 17)}
+
+	if err != nil || !equalDates(act, exp) {
+		t.Errorf("Expiries(): expected %v, actual %v, %v", exp, act, err)
+	}
+
+	act, err = weekly.Expiries(ymd(2021, 3, 29), ymd(2021, 4, 8))
+	exp = []time.Time{ymd(2021, 4, 1)}
+
+	if err != nil || !equalDates(act, exp) {
+		t.Errorf("Expiries(): expected %v, actual %v, %v", exp, act, err)
+	}
+
+	// The first Monday of January 2024 is New Year's Day, the expiry moves to December.
+	firstMonday := Schedule{Cycle: cycles.Monthly, Weekday: time.Monday, Nth: 1, Calendar: holidays.UnitedStates}
+	act, err = firstMonday.Expiries(ymd(2023, 12, 28), ymd(2024, 2, 1))
+	exp = []time.Time{ymd(2023, 12, 29)}
+
+	if err != nil || !equalDates(act, exp) {
+		t.Errorf("Expiries(): expected %v, actual %v, %v", exp, act, err)
+	}
+
+	act, err = monthly.Expiries(ymd(2021, 3, 20), ymd(2021, 4, 15))
+	if err != nil || len(act) != 0 {
+		t.Errorf("Expiries(): expected none, actual %v, %v", act, err)
+	}
+
+	if _, err := monthly.Expiries(ymd(2021, 3, 20), ymd(2021, 3, 19)); err == nil {
+		t.Error("Expiries(from after to): expected error, got success")
+	}
+}
+
+func TestScheduleErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []Schedule{
+		{Cycle: cycles.Cycle(0), Weekday: time.Friday, Nth: 3},
+		{Cycle: cycles.Monthly, Weekday: time.Friday, Nth: 0},
+		{Cycle: cycles.Quarterly, Weekday: time.Friday, Nth: 6},
+		{Cycle: cycles.Weekly, Weekday: time.Weekday(7)},
+		{Cycle: cycles.Monthly, Weekday: time.Friday, Nth: 3, Calendar: holidays.Calendar(999)},
+	}
+
+	for _, s := range tests {
+		if _, err := s.Active(ymd(2021, 1, 1), 1); err == nil {
+			t.Errorf("Active(%+v): expected error, got success", s)
+		}
+
+		if _, err := s.Expiries(ymd(2021, 1, 1), ymd(2021, 12, 31)); err == nil {
+			t.Errorf("Expiries(%+v): expected error, got success", s)
+		}
+	}
+
+	if _, err := quarterly.Active(ymd(2021, 1, 1), 0); err == nil {
+		t.Error("Active(n=0): expected error, got success")
+	}
+}
@@ -0,0 +1,78 @@
+// Package settlements enumerates settlement types of a derivative contract.
+package settlements
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Settlement enumerates settlement types of a derivative contract.
+type Settlement int
+
+const (
+	// Cash settlement pays the difference between the contract price and the final settlement price in cash.
+	Cash Settlement = iota + 1
+
+	// Physical settlement delivers the underlying asset.
+	Physical
+)
+
+const (
+	unknown  = "unknown"
+	cash     = "cash"
+	physical = "physical"
+)
+
+var errUnknownSettlement = errors.New("unknown settlement type")
+
+// String implements the fmt.Stringer interface.
+func (s Settlement) String() string {
+	switch s {
+	case Cash:
+		return cash
+	case Physical:
+		return physical
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this settlement type is known.
+func (s Settlement) IsKnown() bool {
+	return s == Cash || s == Physical
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (s Settlement) MarshalJSON() ([]byte, error) {
+	str := s.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownSettlement)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (s *Settlement) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case cash:
+		*s = Cash
+	case physical:
+		*s = Physical
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownSettlement)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+//nolint:testpackage
+package settlements
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := Physical
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := Physical
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var s Settlement
+
+	bs := []byte("\"physical\"")
+	for i := 0; i < b.N; i++ {
+		_ = s.UnmarshalJSON(bs)
+	}
+}
@@ -0,0 +1,133 @@
+//nolint:testpackage
+package settlements
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    Settlement
+		text string
+	}{
+		{Cash, cash},
+		{Physical, physical},
+		{Settlement(0), unknown},
+		{Settlement(9999), unknown},
+		{Settlement(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.s.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.s, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       Settlement
+		boolean bool
+	}{
+		{Cash, true},
+		{Physical, true},
+		{Settlement(0), false},
+		{Settlement(9999), false},
+		{Settlement(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.s.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.s, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		s         Settlement
+		json      string
+		succeeded bool
+	}{
+		{Cash, "\"cash\"", true},
+		{Physical, "\"physical\"", true},
+		{Settlement(9999), nilstr, false},
+		{Settlement(-9999), nilstr, false},
+		{Settlement(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.s.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.s, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.s)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.s, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Settlement
+	tests := []struct {
+		s         Settlement
+		json      string
+		succeeded bool
+	}{
+		{Cash, "\"cash\"", true},
+		{Physical, "\"physical\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.s
+		bs := []byte(tt.json)
+
+		var s Settlement
+
+		err := s.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != s {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, s)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Package styles enumerates exercise styles of an option contract.
+package styles
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Style enumerates exercise styles of an option contract.
+type Style int
+
+const (
+	// European option can only be exercised on the expiry date.
+	European Style = iota + 1
+
+	// American option can be exercised on any business day up to and including the expiry date.
+	American
+
+	// Bermudan option can be exercised on a set of predetermined dates up to the expiry date.
+	Bermudan
+	last
+)
+
+const (
+	unknown  = "unknown"
+	european = "european"
+	american = "american"
+	bermudan = "bermudan"
+)
+
+var errUnknownStyle = errors.New("unknown exercise style")
+
+// String implements the fmt.Stringer interface.
+func (s Style) String() string {
+	switch s {
+	case European:
+		return european
+	case American:
+		return american
+	case Bermudan:
+		return bermudan
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this exercise style is known.
+func (s Style) IsKnown() bool {
+	return s >= European && s < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (s Style) MarshalJSON() ([]byte, error) {
+	str := s.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownStyle)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (s *Style) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case european:
+		*s = European
+	case american:
+		*s = American
+	case bermudan:
+		*s = Bermudan
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownStyle)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+//nolint:testpackage
+package styles
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    Style
+		text string
+	}{
+		{European, european},
+		{American, american},
+		{Bermudan, bermudan},
+		{last, unknown},
+		{Style(0), unknown},
+		{Style(9999), unknown},
+		{Style(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.s.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.s, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s       Style
+		boolean bool
+	}{
+		{European, true},
+		{American, true},
+		{Bermudan, true},
+		{last, false},
+		{Style(0), false},
+		{Style(9999), false},
+		{Style(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.s.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.s, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		s         Style
+		json      string
+		succeeded bool
+	}{
+		{European, "\"european\"", true},
+		{American, "\"american\"", true},
+		{Bermudan, "\"bermudan\"", true},
+		{last, nilstr, false},
+		{Style(9999), nilstr, false},
+		{Style(-9999), nilstr, false},
+		{Style(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.s.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.s, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.s)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.s, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Style
+	tests := []struct {
+		s         Style
+		json      string
+		succeeded bool
+	}{
+		{European, "\"european\"", true},
+		{American, "\"american\"", true},
+		{Bermudan, "\"bermudan\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.s
+		bs := []byte(tt.json)
+
+		var s Style
+
+		err := s.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != s {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, s)
+		}
+	}
+}
@@ -23,6 +23,12 @@ type Instrument interface {
 	// ISIN is an ISO6166 (International Securities Identifying Number) code of the instrument.
 	ISIN() symbology.ISIN
 
+	// CUSIP is a Committee on Uniform Security Identification Procedures code of the instrument.
+	CUSIP() symbology.CUSIP
+
+	// SEDOL is a Stock Exchange Daily Official List code of the instrument.
+	SEDOL() symbology.SEDOL
+
 	// CFI is an ISO 10962 (Classification of Financial Instruments) code of the instrument.
 	CFI() string
 
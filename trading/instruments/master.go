@@ -0,0 +1,480 @@
+package instruments
+
+//nolint:gofumpt
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"mbg/trading/instruments/status"
+	"mbg/trading/instruments/symbology"
+	"mbg/trading/markets/mics"
+)
+
+// Master is a thread-safe instrument master, a registry of instruments which brokers,
+// portfolios and data stores share as a single source of truth.
+//
+// The instruments can be looked up by a symbol and a MIC, by an ISIN, a CUSIP or a SEDOL.
+// The identifiers are validated when an instrument is added, the CUSIP and the SEDOL
+// should match the ones embedded in the ISIN, if any.
+// An instrument without a type gets the type inferred from its CFI code, if any.
+//
+// A registered instrument is always returned as the same Instrument value, so it can be used as a map key.
+// All its properties but the status are immutable. The status is changed with versioned updates.
+//
+// Use the NewMaster to create a properly initialized new instance.
+type Master struct {
+	mu       sync.RWMutex
+	records  []*record
+	bySymbol map[symbolKey]*record
+	byISIN   map[symbology.ISIN]*record
+	byCUSIP  map[symbology.CUSIP]*record
+	bySEDOL  map[symbology.SEDOL]*record
+}
+
+// symbolKey identifies an instrument by a symbol and a MIC.
+type symbolKey struct {
+	symbol string
+	mic    mics.MIC
+}
+
+const micLength = 4
+
+var (
+	errNoIdentifier     = errors.New("instrument should have a symbol, an ISIN, a CUSIP or a SEDOL")
+	errInvalidMIC       = errors.New("MIC should have 4 upper case alphanumeric symbols")
+	errUnknownType      = errors.New("unknown instrument type")
+	errUnknownStatus    = errors.New("unknown instrument status")
+	errUnknownCalendar  = errors.New("unknown holiday calendar")
+	errDuplicate        = errors.New("duplicate instrument")
+	errNotRegistered    = errors.New("instrument is not registered")
+	errVersionConflict  = errors.New("version conflict")
+	errInvalidCSVHeader = errors.New("invalid CSV header")
+	errMismatch         = errors.New("identifier does not match the one embedded in ISIN")
+)
+
+// NewMaster creates a new empty instrument master.
+func NewMaster() *Master {
+	return &Master{
+		records:  make([]*record, 0),
+		bySymbol: make(map[symbolKey]*record),
+		byISIN:   make(map[symbology.ISIN]*record),
+		byCUSIP:  make(map[symbology.CUSIP]*record),
+		bySEDOL:  make(map[symbology.SEDOL]*record),
+	}
+}
+
+// Add validates and registers a copy of a mutable instrument and returns the registered instrument.
+//
+// Returns an error if the instrument is invalid or if its symbol and MIC,
+// ISIN, CUSIP or SEDOL are already registered.
+func (m *Master) Add(mi MutableInstrument) (Instrument, error) {
+	instrs, err := m.AddAll([]MutableInstrument{mi})
+	if err != nil {
+		return nil, err
+	}
+
+	return instrs[0], nil
+}
+
+// AddAll validates and registers copies of several mutable instruments and returns the registered instruments.
+//
+// The instruments are registered atomically: if any of them is invalid or duplicate, none is registered.
+func (m *Master) AddAll(mis []MutableInstrument) ([]Instrument, error) {
+	for i := range mis {
+		if err := validate(&mis[i]); err != nil {
+			return nil, fmt.Errorf("instrument %d '%s': %w", i, mis[i].Symbol, err)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Check the duplicates against the registry and within the batch using a staging master.
+	staging := NewMaster()
+	for i := range mis {
+		r := newRecord(&mis[i])
+		if err := m.checkDuplicate(r); err != nil {
+			return nil, fmt.Errorf("instrument %d '%s': %w", i, mis[i].Symbol, err)
+		}
+
+		if err := staging.checkDuplicate(r); err != nil {
+			return nil, fmt.Errorf("instrument %d '%s': %w", i, mis[i].Symbol, err)
+		}
+
+		staging.insert(r)
+	}
+
+	instrs := make([]Instrument, len(staging.records))
+	for i, r := range staging.records {
+		m.insert(r)
+		instrs[i] = r
+	}
+
+	return instrs, nil
+}
+
+// LoadJSON registers the instruments from a JSON array of mutable instruments
+// and returns the number of registered instruments.
+//
+// The instruments are registered atomically, see AddAll.
+func (m *Master) LoadJSON(r io.Reader) (int, error) {
+	var mis []MutableInstrument
+
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&mis); err != nil {
+		return 0, fmt.Errorf("cannot decode instruments: %w", err)
+	}
+
+	if _, err := m.AddAll(mis); err != nil {
+		return 0, err
+	}
+
+	return len(mis), nil
+}
+
+// LoadCSV registers the instruments from a CSV table and returns the number of registered instruments.
+//
+// The first row is a header containing the JSON names of the MutableInstrument fields,
+// like symbol, mic, isin, currency, type or minPriceIncrement, in any order.
+// The enumerations are written as in JSON without quotes, e.g. stock or active.
+// Empty cells are left as zero values.
+//
+// The instruments are registered atomically, see AddAll.
+func (m *Master) LoadCSV(r io.Reader) (int, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return 0, fmt.Errorf("cannot read instruments: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return 0, errInvalidCSVHeader
+	}
+
+	header := rows[0]
+	mis := make([]MutableInstrument, len(rows)-1)
+
+	for i, row := range rows[1:] {
+		if err := parseCSVRow(header, row, &mis[i]); err != nil {
+			return 0, fmt.Errorf("cannot parse instrument at row %d: %w", i+2, err) //nolint:gomnd
+		}
+	}
+
+	if _, err := m.AddAll(mis); err != nil {
+		return 0, err
+	}
+
+	return len(mis), nil
+}
+
+// BySymbol returns a registered instrument with a given symbol traded on a given MIC.
+func (m *Master) BySymbol(symbol string, mic mics.MIC) (Instrument, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return found(m.bySymbol[symbolKey{symbol: symbol, mic: mic}])
+}
+
+// ByISIN returns a registered instrument with a given ISIN.
+func (m *Master) ByISIN(isin symbology.ISIN) (Instrument, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return found(m.byISIN[isin])
+}
+
+// ByCUSIP returns a registered instrument with a given CUSIP.
+func (m *Master) ByCUSIP(cusip symbology.CUSIP) (Instrument, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return found(m.byCUSIP[cusip])
+}
+
+// BySEDOL returns a registered instrument with a given SEDOL.
+func (m *Master) BySEDOL(sedol symbology.SEDOL) (Instrument, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return found(m.bySEDOL[sedol])
+}
+
+// Instruments returns all registered instruments in the order of registration.
+func (m *Master) Instruments() []Instrument {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	instrs := make([]Instrument, len(m.records))
+	for i, r := range m.records {
+		instrs[i] = r
+	}
+
+	return instrs
+}
+
+// Len returns the number of registered instruments.
+func (m *Master) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.records)
+}
+
+// Version returns the current version of a registered instrument.
+//
+// The version is 1 after registration and is incremented by every status update.
+func (m *Master) Version(instr Instrument) (int, error) {
+	r, err := m.record(instr)
+	if err != nil {
+		return 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.current().Version, nil
+}
+
+// UpdateStatus changes the status of a registered instrument at a given time
+// if its current version is equal to the expected one, and returns the new version.
+//
+// Returns an error if the versions differ, so that concurrent updates based
+// on the same version do not silently overwrite each other.
+func (m *Master) UpdateStatus(instr Instrument, s status.InstrumentStatus, t time.Time, version int) (int, error) {
+	if !s.IsKnown() {
+		return 0, fmt.Errorf("%v: %w", s, errUnknownStatus)
+	}
+
+	r, err := m.record(instr)
+	if err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cur := r.current().Version
+	if cur != version {
+		return 0, fmt.Errorf("expected version %d, current version %d: %w", version, cur, errVersionConflict)
+	}
+
+	r.history = append(r.history, StatusChange{Version: cur + 1, Status: s, Time: t})
+
+	return cur + 1, nil
+}
+
+// StatusHistory returns all status changes of a registered instrument, the first one is the registration.
+func (m *Master) StatusHistory(instr Instrument) ([]StatusChange, error) {
+	r, err := m.record(instr)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	h := make([]StatusChange, len(r.history))
+	copy(h, r.history)
+
+	return h, nil
+}
+
+// record returns the record of an instrument registered in this master.
+func (m *Master) record(instr Instrument) (*record, error) {
+	if r, ok := instr.(*record); ok && r.owner == m {
+		return r, nil
+	}
+
+	return nil, errNotRegistered
+}
+
+// checkDuplicate returns an error if any identifier of the record is already registered.
+// The caller should hold the lock.
+func (m *Master) checkDuplicate(r *record) error {
+	mi := &r.mi
+
+	if _, ok := m.bySymbol[symbolKey{symbol: mi.Symbol, mic: mi.MIC}]; ok && mi.Symbol != "" {
+		return fmt.Errorf("symbol '%s' on MIC '%s': %w", mi.Symbol, mi.MIC, errDuplicate)
+	}
+
+	if _, ok := m.byISIN[mi.ISIN]; ok && mi.ISIN != "" {
+		return fmt.Errorf("ISIN '%s': %w", mi.ISIN, errDuplicate)
+	}
+
+	if _, ok := m.byCUSIP[mi.CUSIP]; ok && mi.CUSIP != "" {
+		return fmt.Errorf("CUSIP '%s': %w", mi.CUSIP, errDuplicate)
+	}
+
+	if _, ok := m.bySEDOL[mi.SEDOL]; ok && mi.SEDOL != "" {
+		return fmt.Errorf("SEDOL '%s': %w", mi.SEDOL, errDuplicate)
+	}
+
+	return nil
+}
+
+// insert indexes a record by all its identifiers.
+// The caller should hold the lock.
+func (m *Master) insert(r *record) {
+	mi := &r.mi
+	r.owner = m
+	m.records = append(m.records, r)
+
+	if mi.Symbol != "" {
+		m.bySymbol[symbolKey{symbol: mi.Symbol, mic: mi.MIC}] = r
+	}
+
+	if mi.ISIN != "" {
+		m.byISIN[mi.ISIN] = r
+	}
+
+	if mi.CUSIP != "" {
+		m.byCUSIP[mi.CUSIP] = r
+	}
+
+	if mi.SEDOL != "" {
+		m.bySEDOL[mi.SEDOL] = r
+	}
+}
+
+func found(r *record) (Instrument, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	return r, true
+}
+
+//nolint:cyclop
+// validate checks the identifiers and the enumerations of a mutable instrument.
+func validate(mi *MutableInstrument) error {
+	if mi.Symbol == "" && mi.ISIN == "" && mi.CUSIP == "" && mi.SEDOL == "" {
+		return errNoIdentifier
+	}
+
+	if mi.MIC != "" && !isValidMIC(mi.MIC) {
+		return fmt.Errorf("'%s': %w", mi.MIC, errInvalidMIC)
+	}
+
+	if mi.ISIN != "" {
+		if err := mi.ISIN.Validate(); err != nil {
+			return fmt.Errorf("ISIN '%s': %w", mi.ISIN, err)
+		}
+	}
+
+	if mi.CUSIP != "" {
+		if err := mi.CUSIP.Validate(); err != nil {
+			return fmt.Errorf("CUSIP '%s': %w", mi.CUSIP, err)
+		}
+	}
+
+	if mi.SEDOL != "" {
+		if err := mi.SEDOL.Validate(); err != nil {
+			return fmt.Errorf("SEDOL '%s': %w", mi.SEDOL, err)
+		}
+	}
+
+	if err := reconcile(mi); err != nil {
+		return err
+	}
+
+	if mi.CFI != "" {
+		if err := symbology.CFI(mi.CFI).Validate(); err != nil {
+			return fmt.Errorf("CFI '%s': %w", mi.CFI, err)
+		}
+	}
+
+	if mi.Type != 0 && !mi.Type.IsKnown() {
+		return fmt.Errorf("%v: %w", mi.Type, errUnknownType)
+	}
+
+	if mi.Status != 0 && !mi.Status.IsKnown() {
+		return fmt.Errorf("%v: %w", mi.Status, errUnknownStatus)
+	}
+
+	if mi.HolidayCalendar != 0 && !mi.HolidayCalendar.IsKnown() {
+		return fmt.Errorf("%v: %w", mi.HolidayCalendar, errUnknownCalendar)
+	}
+
+	return nil
+}
+
+// reconcile checks that the CUSIP and the SEDOL match the ones embedded in the ISIN, if any.
+func reconcile(mi *MutableInstrument) error {
+	if mi.ISIN == "" {
+		return nil
+	}
+
+	if mi.CUSIP != "" {
+		if cusip, err := mi.ISIN.CUSIP(); err == nil && cusip != mi.CUSIP {
+			return fmt.Errorf("CUSIP '%s', ISIN '%s': %w", mi.CUSIP, mi.ISIN, errMismatch)
+		}
+	}
+
+	if mi.SEDOL != "" {
+		if sedol, err := mi.ISIN.SEDOL(); err == nil && sedol != mi.SEDOL {
+			return fmt.Errorf("SEDOL '%s', ISIN '%s': %w", mi.SEDOL, mi.ISIN, errMismatch)
+		}
+	}
+
+	return nil
+}
+
+func isValidMIC(mic mics.MIC) bool {
+	if len(mic) != micLength {
+		return false
+	}
+
+	for i := 0; i < micLength; i++ {
+		if c := mic[i]; (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// csvNumbers are the MutableInstrument fields having numeric JSON values.
+//
+//nolint:gochecknoglobals
+var csvNumbers = map[string]bool{"pricePrecision": true, "minPriceIncrement": true, "factor": true, "margin": true}
+
+// parseCSVRow parses a CSV row by converting it to a JSON object.
+func parseCSVRow(header, row []string, mi *MutableInstrument) error {
+	obj := make(map[string]interface{}, len(header))
+
+	for i, h := range header {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			return errInvalidCSVHeader
+		}
+
+		v := strings.TrimSpace(row[i])
+		if v == "" {
+			continue
+		}
+
+		if csvNumbers[h] {
+			obj[h] = json.Number(v)
+		} else {
+			obj[h] = v
+		}
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(mi)
+}
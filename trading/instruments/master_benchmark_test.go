@@ -0,0 +1,26 @@
+//nolint:testpackage
+package instruments
+
+import (
+	"testing"
+)
+
+func BenchmarkMasterByISIN(b *testing.B) {
+	m := NewMaster()
+	_, _ = m.Add(apple())
+	_, _ = m.Add(microsoft())
+
+	for i := 0; i < b.N; i++ {
+		_, _ = m.ByISIN("US0378331005")
+	}
+}
+
+func BenchmarkMasterBySymbol(b *testing.B) {
+	m := NewMaster()
+	_, _ = m.Add(apple())
+	_, _ = m.Add(microsoft())
+
+	for i := 0; i < b.N; i++ {
+		_, _ = m.BySymbol("MSFT", "XNAS")
+	}
+}
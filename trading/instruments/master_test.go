@@ -0,0 +1,414 @@
+//nolint:testpackage
+package instruments
+
+//nolint:gofumpt
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments/status"
+	"mbg/trading/instruments/types"
+	"mbg/trading/markets/mics"
+	"mbg/trading/time/holidays"
+)
+
+func apple() MutableInstrument {
+	return MutableInstrument{
+		Name: "Apple", Symbol: "AAPL", MIC: mics.XNAS, Currency: currencies.USD,
+		ISIN: "US0378331005", CUSIP: "037833100", SEDOL: "2046251",
+		Type: types.Stock, Status: status.Active, HolidayCalendar: holidays.UnitedStates,
+		PricePrecision: 2, MinPriceIncrement: 0.01,
+	}
+}
+
+func microsoft() MutableInstrument {
+	return MutableInstrument{
+		Name: "Microsoft", Symbol: "MSFT", MIC: mics.XNAS, Currency: currencies.USD,
+		ISIN: "US5949181045", CUSIP: "594918104", Type: types.Stock, Status: status.Active,
+	}
+}
+
+//nolint:funlen,cyclop
+func TestMasterAddLookup(t *testing.T) {
+	t.Parallel()
+
+	m := NewMaster()
+	mi := apple()
+
+	aapl, err := m.Add(mi)
+	if err != nil {
+		t.Fatalf("Add(): expected success, got error %v", err)
+	}
+
+	// The registered instrument is a copy.
+	mi.Name = "changed"
+	if aapl.Name() != "Apple" {
+		t.Errorf("Name(): expected 'Apple', actual '%v'", aapl.Name())
+	}
+
+	if aapl.Symbol() != "AAPL" || aapl.MIC() != mics.XNAS || aapl.Currency() != currencies.USD ||
+		aapl.ISIN() != "US0378331005" || aapl.CUSIP() != "037833100" || aapl.SEDOL() != "2046251" ||
+		aapl.Type() != types.Stock || aapl.Status() != status.Active || aapl.PricePrecision() != 2 ||
+		aapl.MinPriceIncrement() != 0.01 || aapl.HolidayCalendar() != holidays.UnitedStates {
+		t.Errorf("unexpected properties of the registered instrument")
+	}
+
+	lookups := []func() (Instrument, bool){
+		func() (Instrument, bool) { return m.BySymbol("AAPL", mics.XNAS) },
+		func() (Instrument, bool) { return m.ByISIN("US0378331005") },
+		func() (Instrument, bool) { return m.ByCUSIP("037833100") },
+		func() (Instrument, bool) { return m.BySEDOL("2046251") },
+	}
+
+	for i, lookup := range lookups {
+		if instr, ok := lookup(); !ok || instr != aapl {
+			t.Errorf("lookup %d: expected the registered instrument", i)
+		}
+	}
+
+	if _, ok := m.BySymbol("AAPL", mics.XNYS); ok {
+		t.Error("BySymbol(AAPL, XNYS): expected not found")
+	}
+
+	if _, ok := m.ByISIN("US5949181045"); ok {
+		t.Error("ByISIN(MSFT): expected not found")
+	}
+
+	if _, ok := m.ByCUSIP("594918104"); ok {
+		t.Error("ByCUSIP(MSFT): expected not found")
+	}
+
+	if _, ok := m.BySEDOL("2588173"); ok {
+		t.Error("BySEDOL(MSFT): expected not found")
+	}
+
+	msft, err := m.Add(microsoft())
+	if err != nil {
+		t.Fatalf("Add(MSFT): expected success, got error %v", err)
+	}
+
+	if m.Len() != 2 {
+		t.Errorf("Len(): expected 2, actual %v", m.Len())
+	}
+
+	if all := m.Instruments(); len(all) != 2 || all[0] != aapl || all[1] != msft {
+		t.Errorf("Instruments(): unexpected %v", all)
+	}
+}
+
+//nolint:funlen
+func TestMasterAddErrors(t *testing.T) {
+	t.Parallel()
+
+	m := NewMaster()
+	if _, err := m.Add(apple()); err != nil {
+		t.Fatalf("Add(): expected success, got error %v", err)
+	}
+
+	modify := func(f func(mi *MutableInstrument)) MutableInstrument {
+		mi := microsoft()
+		f(&mi)
+
+		return mi
+	}
+
+	tests := []struct {
+		name string
+		mi   MutableInstrument
+		err  error
+	}{
+		{"no identifier", MutableInstrument{Name: "x"}, errNoIdentifier},
+		{"invalid MIC", modify(func(mi *MutableInstrument) { mi.MIC = "xnas" }), errInvalidMIC},
+		{"short MIC", modify(func(mi *MutableInstrument) { mi.MIC = "XNA" }), errInvalidMIC},
+		{"invalid ISIN", modify(func(mi *MutableInstrument) { mi.ISIN = "US5949181046" }), nil},
+		{"invalid CUSIP", modify(func(mi *MutableInstrument) { mi.CUSIP = "594918105" }), nil},
+		{"invalid SEDOL", modify(func(mi *MutableInstrument) { mi.SEDOL = "2588174" }), nil},
+		{"invalid CFI", modify(func(mi *MutableInstrument) { mi.CFI = "EXVUFR" }), nil},
+		{"unknown type", modify(func(mi *MutableInstrument) { mi.Type = types.InstrumentType(999) }), errUnknownType},
+		{"unknown status", modify(func(mi *MutableInstrument) { mi.Status = status.InstrumentStatus(999) }), errUnknownStatus},
+		{"unknown calendar", modify(func(mi *MutableInstrument) { mi.HolidayCalendar = holidays.Calendar(999) }), errUnknownCalendar},
+		{"duplicate symbol", modify(func(mi *MutableInstrument) { mi.Symbol = "AAPL" }), errDuplicate},
+		{"duplicate ISIN", modify(func(mi *MutableInstrument) { mi.ISIN, mi.CUSIP = "US0378331005", "" }), errDuplicate},
+		{"duplicate CUSIP", modify(func(mi *MutableInstrument) { mi.ISIN, mi.CUSIP = "", "037833100" }), errDuplicate},
+		{"mismatched CUSIP", modify(func(mi *MutableInstrument) { mi.CUSIP = "037833100" }), errMismatch},
+		{"mismatched SEDOL", modify(func(mi *MutableInstrument) { mi.ISIN, mi.SEDOL = "GB0002634946", "2588173" }), errMismatch},
+		{"duplicate SEDOL", modify(func(mi *MutableInstrument) { mi.SEDOL = "2046251" }), errDuplicate},
+	}
+
+	for _, tt := range tests {
+		_, err := m.Add(tt.mi)
+
+		switch {
+		case err == nil:
+			t.Errorf("Add(%v): expected error, got success", tt.name)
+		case tt.err != nil && !errors.Is(err, tt.err):
+			t.Errorf("Add(%v): expected error %v, actual %v", tt.name, tt.err, err)
+		}
+	}
+
+	// The same symbol on another MIC is not a duplicate.
+	if _, err := m.Add(MutableInstrument{Symbol: "AAPL", MIC: mics.XNYS}); err != nil {
+		t.Errorf("Add(AAPL, XNYS): expected success, got error %v", err)
+	}
+
+	if m.Len() != 2 {
+		t.Errorf("Len(): expected 2, actual %v", m.Len())
+	}
+}
+
+func TestMasterAddAllAtomic(t *testing.T) {
+	t.Parallel()
+
+	m := NewMaster()
+
+	_, err := m.AddAll([]MutableInstrument{apple(), microsoft(), apple()})
+	if !errors.Is(err, errDuplicate) {
+		t.Errorf("AddAll(duplicate in batch): expected error %v, actual %v", errDuplicate, err)
+	}
+
+	if m.Len() != 0 {
+		t.Errorf("Len(): expected 0, actual %v", m.Len())
+	}
+
+	instrs, err := m.AddAll([]MutableInstrument{apple(), microsoft()})
+	if err != nil || len(instrs) != 2 || m.Len() != 2 {
+		t.Errorf("AddAll(): expected 2 instruments, actual %v, %v", len(instrs), err)
+	}
+}
+
+//nolint:funlen
+func TestMasterUpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	m := NewMaster()
+
+	aapl, err := m.Add(apple())
+	if err != nil {
+		t.Fatalf("Add(): expected success, got error %v", err)
+	}
+
+	if v, err := m.Version(aapl); err != nil || v != 1 {
+		t.Errorf("Version(): expected 1, actual %v, %v", v, err)
+	}
+
+	t1 := time.Date(2021, time.June, 1, 9, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	v, err := m.UpdateStatus(aapl, status.Suspended, t1, 1)
+	if err != nil || v != 2 {
+		t.Errorf("UpdateStatus(): expected version 2, actual %v, %v", v, err)
+	}
+
+	if aapl.Status() != status.Suspended {
+		t.Errorf("Status(): expected %v, actual %v", status.Suspended, aapl.Status())
+	}
+
+	if _, err := m.UpdateStatus(aapl, status.Active, t2, 1); !errors.Is(err, errVersionConflict) {
+		t.Errorf("UpdateStatus(stale version): expected error %v, actual %v", errVersionConflict, err)
+	}
+
+	if _, err := m.UpdateStatus(aapl, status.InstrumentStatus(0), t2, 2); !errors.Is(err, errUnknownStatus) {
+		t.Errorf("UpdateStatus(unknown): expected error %v, actual %v", errUnknownStatus, err)
+	}
+
+	if v, err := m.UpdateStatus(aapl, status.Active, t2, 2); err != nil || v != 3 {
+		t.Errorf("UpdateStatus(): expected version 3, actual %v, %v", v, err)
+	}
+
+	h, err := m.StatusHistory(aapl)
+	if err != nil {
+		t.Fatalf("StatusHistory(): expected success, got error %v", err)
+	}
+
+	exp := []StatusChange{
+		{Version: 1, Status: status.Active},
+		{Version: 2, Status: status.Suspended, Time: t1},
+		{Version: 3, Status: status.Active, Time: t2},
+	}
+
+	if len(h) != len(exp) {
+		t.Fatalf("StatusHistory(): expected length %v, actual %v", len(exp), len(h))
+	}
+
+	for i := range exp {
+		if h[i] != exp[i] {
+			t.Errorf("StatusHistory()[%d]: expected %v, actual %v", i, exp[i], h[i])
+		}
+	}
+
+	// Instruments not registered in this master.
+	other := NewMaster()
+	msft, _ := other.Add(microsoft())
+	mi := apple()
+
+	for _, instr := range []Instrument{msft, mi.Instrument()} {
+		if _, err := m.Version(instr); !errors.Is(err, errNotRegistered) {
+			t.Errorf("Version(): expected error %v, actual %v", errNotRegistered, err)
+		}
+
+		if _, err := m.UpdateStatus(instr, status.Active, t1, 1); !errors.Is(err, errNotRegistered) {
+			t.Errorf("UpdateStatus(): expected error %v, actual %v", errNotRegistered, err)
+		}
+
+		if _, err := m.StatusHistory(instr); !errors.Is(err, errNotRegistered) {
+			t.Errorf("StatusHistory(): expected error %v, actual %v", errNotRegistered, err)
+		}
+	}
+}
+
+func TestMasterConcurrentUpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	const n = 20
+
+	m := NewMaster()
+	aapl, _ := m.Add(apple())
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := m.UpdateStatus(aapl, status.Suspended, time.Time{}, 1); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Errorf("concurrent updates of the same version: expected 1 success, actual %v", succeeded)
+	}
+}
+
+func TestMasterLoadJSON(t *testing.T) {
+	t.Parallel()
+
+	const data = `[
+		{"symbol": "AAPL", "mic": "XNAS", "isin": "US0378331005", "currency": "USD", "type": "stock", "status": "active"},
+		{"symbol": "MSFT", "mic": "XNAS", "cusip": "594918104", "minPriceIncrement": 0.01, "factor": 1}
+	]`
+
+	m := NewMaster()
+
+	n, err := m.LoadJSON(strings.NewReader(data))
+	if err != nil || n != 2 {
+		t.Fatalf("LoadJSON(): expected 2, actual %v, %v", n, err)
+	}
+
+	msft, ok := m.ByCUSIP("594918104")
+	if !ok || msft.Symbol() != "MSFT" || msft.MinPriceIncrement() != 0.01 || msft.PriceFactor() != 1 {
+		t.Errorf("ByCUSIP(MSFT): unexpected instrument")
+	}
+
+	bad := []string{
+		`[{"symbol": "X", "unknown": 1}]`,
+		`[{"symbol": "X", "type": "nonsense"}]`,
+		`[{"symbol": "X", "isin": "US0378331006"}]`,
+		`{}`,
+	}
+
+	for _, s := range bad {
+		if _, err := NewMaster().LoadJSON(strings.NewReader(s)); err == nil {
+			t.Errorf("LoadJSON(%v): expected error, got success", s)
+		}
+	}
+}
+
+func TestMasterInferTypeFromCFI(t *testing.T) {
+	t.Parallel()
+
+	const data = `symbol,mic,cfi,type
+AAPL,XNAS,ESVUFR,
+SPY,ARCX,CEOGSU,
+ESZ21,XCME,FFICSX,
+SPX,XCBO,TIXXXX,
+MSFT,XNAS,ESVUFR,index
+`
+
+	m := NewMaster()
+	if _, err := m.LoadCSV(strings.NewReader(data)); err != nil {
+		t.Fatalf("LoadCSV(): expected success, got error %v", err)
+	}
+
+	tests := []struct {
+		symbol string
+		mic    mics.MIC
+		typ    types.InstrumentType
+	}{
+		{"AAPL", mics.XNAS, types.Stock},
+		{"SPY", mics.ARCX, types.ETF},
+		{"ESZ21", mics.XCME, types.Future},
+		{"SPX", mics.XCBO, types.Index},
+		// An explicit type is not overridden.
+		{"MSFT", mics.XNAS, types.Index},
+	}
+
+	for _, tt := range tests {
+		instr, ok := m.BySymbol(tt.symbol, tt.mic)
+		if !ok || instr.Type() != tt.typ {
+			t.Errorf("BySymbol(%v): expected type %v", tt.symbol, tt.typ)
+		}
+	}
+
+	if _, err := m.Add(MutableInstrument{Symbol: "X", CFI: "ESVUZR"}); err == nil {
+		t.Error("Add(invalid CFI): expected error, got success")
+	}
+}
+
+func TestMasterLoadCSV(t *testing.T) {
+	t.Parallel()
+
+	const data = `symbol,mic,isin,sedol,currency,type,status,holidayCalendar,pricePrecision,minPriceIncrement,margin
+AAPL,XNAS,US0378331005,2046251,USD,stock,active,unitedStates,2,0.01,
+BA.,XLON,GB0002634946,0263494,GBX,stock,,,,0.5,100
+`
+
+	m := NewMaster()
+
+	n, err := m.LoadCSV(strings.NewReader(data))
+	if err != nil || n != 2 {
+		t.Fatalf("LoadCSV(): expected 2, actual %v, %v", n, err)
+	}
+
+	aapl, ok := m.BySEDOL("2046251")
+	if !ok || aapl.Type() != types.Stock || aapl.Status() != status.Active ||
+		aapl.HolidayCalendar() != holidays.UnitedStates || aapl.PricePrecision() != 2 {
+		t.Errorf("BySEDOL(AAPL): unexpected instrument")
+	}
+
+	ba, ok := m.BySymbol("BA.", mics.XLON)
+	if !ok || ba.Currency() != currencies.GBX || ba.Margin() != 100 || ba.Status() != 0 {
+		t.Errorf("BySymbol(BA.): unexpected instrument")
+	}
+
+	bad := []string{
+		"",
+		"symbol,unknown\nX,1\n",
+		"symbol,margin\nX,abc\n",
+		"symbol,\nX,1\n",
+		"symbol,type\nX,nonsense\n",
+		"symbol,mic\nX,XNAS,extra\n",
+		"symbol,isin\nX,GB0002634947\n",
+	}
+
+	for _, s := range bad {
+		if _, err := NewMaster().LoadCSV(strings.NewReader(s)); err == nil {
+			t.Errorf("LoadCSV(%q): expected error, got success", s)
+		}
+	}
+}
@@ -23,6 +23,12 @@ type MutableInstrument struct {
 	// ISIN is an ISO6166 (International Securities Identifying Number) code of the instrument.
 	ISIN symbology.ISIN `json:"isin,omitempty"`
 
+	// CUSIP is a Committee on Uniform Security Identification Procedures code of the instrument.
+	CUSIP symbology.CUSIP `json:"cusip,omitempty"`
+
+	// SEDOL is a Stock Exchange Daily Official List code of the instrument.
+	SEDOL symbology.SEDOL `json:"sedol,omitempty"`
+
 	// CFI is an ISO 10962 (Classification of Financial Instruments) code of the instrument.
 	CFI string `json:"cfi,omitempty"`
 
@@ -85,6 +91,16 @@ func (ii *immutableInstrument) ISIN() symbology.ISIN {
 	return ii.mi.ISIN
 }
 
+// CUSIP is a Committee on Uniform Security Identification Procedures code of the instrument.
+func (ii *immutableInstrument) CUSIP() symbology.CUSIP {
+	return ii.mi.CUSIP
+}
+
+// SEDOL is a Stock Exchange Daily Official List code of the instrument.
+func (ii *immutableInstrument) SEDOL() symbology.SEDOL {
+	return ii.mi.SEDOL
+}
+
 // CFI is an ISO 10962 (Classification of Financial Instruments) code of the instrument.
 func (ii *immutableInstrument) CFI() string {
 	return ii.mi.CFI
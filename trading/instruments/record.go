@@ -0,0 +1,142 @@
+package instruments
+
+//nolint:gofumpt
+import (
+	"sync"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments/status"
+	"mbg/trading/instruments/symbology"
+	"mbg/trading/instruments/types"
+	"mbg/trading/markets/mics"
+	"mbg/trading/time/holidays"
+)
+
+// StatusChange is a versioned change of the status of an instrument registered in a Master.
+type StatusChange struct {
+	// Version is a version of the instrument after the change, starting from 1.
+	Version int `json:"version"`
+
+	// Status is a new status of the instrument.
+	Status status.InstrumentStatus `json:"status"`
+
+	// Time is the time of the change.
+	Time time.Time `json:"time"`
+}
+
+// record is an instrument registered in a Master.
+//
+// All properties but the status are immutable.
+type record struct {
+	owner   *Master
+	mi      MutableInstrument
+	mu      sync.RWMutex
+	history []StatusChange
+}
+
+// newRecord creates a new record from a copy of a mutable instrument.
+// The type of the copy is inferred from the CFI code if it is not set.
+func newRecord(mi *MutableInstrument) *record {
+	r := &record{
+		mi:      *mi,
+		history: []StatusChange{{Version: 1, Status: mi.Status}},
+	}
+
+	if r.mi.Type == 0 && r.mi.CFI != "" {
+		r.mi.Type = symbology.CFI(r.mi.CFI).InstrumentType()
+	}
+
+	return r
+}
+
+// current returns the latest status change.
+// The caller should hold the lock.
+func (r *record) current() StatusChange {
+	return r.history[len(r.history)-1]
+}
+
+// Name is a short name of the instrument.
+func (r *record) Name() string {
+	return r.mi.Name
+}
+
+// Description is a textual description of the instrument.
+func (r *record) Description() string {
+	return r.mi.Description
+}
+
+// Symbol (ticker) is a mnemonic of the instrument.
+func (r *record) Symbol() string {
+	return r.mi.Symbol
+}
+
+// ISIN is an ISO6166 (International Securities Identifying Number) code of the instrument.
+func (r *record) ISIN() symbology.ISIN {
+	return r.mi.ISIN
+}
+
+// CUSIP is a Committee on Uniform Security Identification Procedures code of the instrument.
+func (r *record) CUSIP() symbology.CUSIP {
+	return r.mi.CUSIP
+}
+
+// SEDOL is a Stock Exchange Daily Official List code of the instrument.
+func (r *record) SEDOL() symbology.SEDOL {
+	return r.mi.SEDOL
+}
+
+// CFI is an ISO 10962 (Classification of Financial Instruments) code of the instrument.
+func (r *record) CFI() string {
+	return r.mi.CFI
+}
+
+// MIC is an ISO 10383 Market Identifier Code where the instrument is traded.
+func (r *record) MIC() mics.MIC {
+	return r.mi.MIC
+}
+
+// Currency is an ISO 4217 three-letter currency code which the price of the instrument is denominated.
+func (r *record) Currency() currencies.Currency {
+	return r.mi.Currency
+}
+
+// Type indicates a type of the instrument.
+func (r *record) Type() types.InstrumentType {
+	return r.mi.Type
+}
+
+// Status indicates a state of the instrument.
+func (r *record) Status() status.InstrumentStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.current().Status
+}
+
+// HolidayCalendar specifies a holiday calendar of the instrument.
+func (r *record) HolidayCalendar() holidays.Calendar {
+	return r.mi.HolidayCalendar
+}
+
+// PricePrecision is the number of decimal places in the instruments price.
+func (r *record) PricePrecision() int {
+	return r.mi.PricePrecision
+}
+
+// MinPriceIncrement (tick value) is the minimum price increment of the instrument.
+func (r *record) MinPriceIncrement() float64 {
+	return r.mi.MinPriceIncrement
+}
+
+// PriceFactor is a positive multiplier by which price must be adjusted to determine
+// the true nominal value of a contract:
+//   Nominal Value = Quantity * Price * PriceFactor.
+func (r *record) PriceFactor() float64 {
+	return r.mi.PriceFactor
+}
+
+// Margin is an initial margin of the instrument.
+func (r *record) Margin() float64 {
+	return r.mi.Margin
+}
@@ -0,0 +1,126 @@
+package symbology
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BloombergTicker is a Bloomberg ticker, the security description followed by a market sector (yellow key).
+// See https://www.bloomberg.com/professional/support/api-library/.
+//
+// The security description of equities and preferred shares is the ticker symbol followed by
+// the exchange code, e.g. “AAPL US Equity“ is Apple on a composite US exchange and “VOD LN Equity“
+// is Vodafone on the London Stock Exchange. The descriptions of other instruments vary,
+// e.g. “ESH4 Index“ for a future, “EUR Curncy“ for the euro or “T 2.5 05/15/24 Govt“ for a treasury note.
+//
+// Bloomberg tickers have no check digit. The ticker is validated to consist of the non-empty
+// security description and a known market sector separated by single spaces.
+// The market sector is case-insensitive.
+type BloombergTicker string
+
+// The Bloomberg market sectors (yellow keys).
+const (
+	BloombergGovt   = "Govt"
+	BloombergCorp   = "Corp"
+	BloombergMtge   = "Mtge"
+	BloombergMMkt   = "M-Mkt"
+	BloombergMuni   = "Muni"
+	BloombergPfd    = "Pfd"
+	BloombergEquity = "Equity"
+	BloombergComdty = "Comdty"
+	BloombergIndex  = "Index"
+	BloombergCurncy = "Curncy"
+)
+
+//nolint:gochecknoglobals
+var bloombergSectors = []string{
+	BloombergGovt, BloombergCorp, BloombergMtge, BloombergMMkt, BloombergMuni,
+	BloombergPfd, BloombergEquity, BloombergComdty, BloombergIndex, BloombergCurncy,
+}
+
+const bloombergExchangeTokens = 3
+
+var (
+	errInvalidBloomberg       = errors.New("invalid Bloomberg ticker")
+	errInvalidBloombergSpaces = fmt.Errorf(
+		"security description and market sector should be separated by single spaces: %w", errInvalidBloomberg)
+	errInvalidBloombergSector = fmt.Errorf(
+		"market sector should be one of Govt, Corp, Mtge, M-Mkt, Muni, Pfd, Equity, Comdty, Index, Curncy: %w",
+		errInvalidBloomberg)
+)
+
+// Validate valudates the Bloomberg ticker.
+func (bt BloombergTicker) Validate() error {
+	tokens := strings.Split(string(bt), " ")
+	if len(tokens) < 2 { //nolint:gomnd
+		return errInvalidBloombergSpaces
+	}
+
+	for _, t := range tokens {
+		if t == "" {
+			return errInvalidBloombergSpaces
+		}
+	}
+
+	if bt.MarketSector() == "" {
+		return errInvalidBloombergSector
+	}
+
+	return nil
+}
+
+// MarketSector returns the market sector of the Bloomberg ticker in canonical case, e.g. Equity,
+// or an empty string if the market sector is unknown.
+func (bt BloombergTicker) MarketSector() string {
+	s := string(bt)
+	if i := strings.LastIndexByte(s, ' '); i >= 0 {
+		s = s[i+1:]
+	}
+
+	for _, sector := range bloombergSectors {
+		if strings.EqualFold(s, sector) {
+			return sector
+		}
+	}
+
+	return ""
+}
+
+// Security returns the security description of the Bloomberg ticker, e.g. AAPL US for AAPL US Equity.
+func (bt BloombergTicker) Security() string {
+	s := string(bt)
+	if i := strings.LastIndexByte(s, ' '); i >= 0 {
+		return s[:i]
+	}
+
+	return ""
+}
+
+// Ticker returns the first token of the security description, e.g. AAPL for AAPL US Equity.
+func (bt BloombergTicker) Ticker() string {
+	s := bt.Security()
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		return s[:i]
+	}
+
+	return s
+}
+
+// ExchangeCode returns the Bloomberg exchange code of the equity or the preferred share, e.g. US for AAPL US Equity.
+//
+// Returns an empty string for other market sectors or if the security description has no exchange code.
+func (bt BloombergTicker) ExchangeCode() string {
+	switch bt.MarketSector() {
+	case BloombergEquity, BloombergPfd:
+	default:
+		return ""
+	}
+
+	tokens := strings.Split(string(bt), " ")
+	if len(tokens) != bloombergExchangeTokens {
+		return ""
+	}
+
+	return tokens[1]
+}
@@ -0,0 +1,83 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBloombergTickerValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ticker string
+		err    error
+	}{
+		{"AAPL US Equity", nil},
+		{"VOD LN Equity", nil},
+		{"BRK/B US Equity", nil},
+		{"ESH4 Index", nil},
+		{"SPX Index", nil},
+		{"EUR Curncy", nil},
+		{"CLZ4 Comdty", nil},
+		{"T 2.5 05/15/24 Govt", nil},
+		{"aapl us equity", nil},
+		{"", errInvalidBloombergSpaces},
+		{"AAPL", errInvalidBloombergSpaces},
+		{"AAPL  US Equity", errInvalidBloombergSpaces},
+		{" AAPL Equity", errInvalidBloombergSpaces},
+		{"AAPL US Equity ", errInvalidBloombergSpaces},
+		{"AAPL US Stock", errInvalidBloombergSector},
+	}
+
+	for _, tt := range tests {
+		err := BloombergTicker(tt.ticker).Validate()
+
+		if tt.err == nil && err != nil || tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("BloombergTicker.Validate('%v'): expected %v, actual %v", tt.ticker, tt.err, err)
+		}
+	}
+}
+
+func TestBloombergTickerParts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ticker   string
+		sector   string
+		security string
+		symbol   string
+		exchange string
+	}{
+		{"AAPL US Equity", BloombergEquity, "AAPL US", "AAPL", "US"},
+		{"aapl uw equity", BloombergEquity, "aapl uw", "aapl", "uw"},
+		{"WFC/PL US Pfd", BloombergPfd, "WFC/PL US", "WFC/PL", "US"},
+		{"AAPL Equity", BloombergEquity, "AAPL", "AAPL", ""},
+		{"ESH4 Index", BloombergIndex, "ESH4", "ESH4", ""},
+		{"EUR Curncy", BloombergCurncy, "EUR", "EUR", ""},
+		{"T 2.5 05/15/24 Govt", BloombergGovt, "T 2.5 05/15/24", "T", ""},
+		{"XYZ 1234 M-Mkt", BloombergMMkt, "XYZ 1234", "XYZ", ""},
+		{"AAPL US Stock", "", "AAPL US", "AAPL", ""},
+		{"AAPL", "", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		bt := BloombergTicker(tt.ticker)
+
+		if act := bt.MarketSector(); act != tt.sector {
+			t.Errorf("BloombergTicker.MarketSector('%v'): expected '%v', actual '%v'", tt.ticker, tt.sector, act)
+		}
+
+		if act := bt.Security(); act != tt.security {
+			t.Errorf("BloombergTicker.Security('%v'): expected '%v', actual '%v'", tt.ticker, tt.security, act)
+		}
+
+		if act := bt.Ticker(); act != tt.symbol {
+			t.Errorf("BloombergTicker.Ticker('%v'): expected '%v', actual '%v'", tt.ticker, tt.symbol, act)
+		}
+
+		if act := bt.ExchangeCode(); act != tt.exchange {
+			t.Errorf("BloombergTicker.ExchangeCode('%v'): expected '%v', actual '%v'", tt.ticker, tt.exchange, act)
+		}
+	}
+}
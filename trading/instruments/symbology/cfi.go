@@ -0,0 +1,250 @@
+package symbology
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+
+	"mbg/trading/instruments/types"
+)
+
+// CFI is an ISO 10962 Classification of Financial Instruments code.
+// See https://en.wikipedia.org/wiki/ISO_10962.
+//
+// CFI codes consist of six upper case letters. The first letter denotes a category of the instrument,
+// e.g. E for equities or O for listed options. The second letter denotes a group within the category,
+// e.g. ES for common shares or OC for call options. The remaining four letters denote the attributes
+// specific to the group, e.g. the voting right, the ownership restrictions, the payment status and
+// the form of common shares. The letter X denotes an attribute which is not applicable or undefined.
+//
+// For example, the CFI code of Apple common shares is “ESVUFR“: voting, free, fully paid, registered.
+//
+// The categories and groups follow the ISO 10962:2019 edition. The attributes are decoded
+// for the equities, collective investment vehicles, debt, entitlements, listed options and futures;
+// the attributes of the other groups are validated only to be letters.
+type CFI string
+
+// CFIAttribute is a decoded attribute of a CFI code.
+type CFIAttribute struct {
+	// Name is a name of the attribute, e.g. CFIVotingRight.
+	// It is empty if the attribute is not decoded for the group.
+	Name string
+
+	// Code is the letter of the attribute in the CFI code.
+	Code byte
+
+	// Value is a meaning of the letter, e.g. Voting.
+	// It is empty if the attribute is not decoded for the group.
+	Value string
+}
+
+// The names of the decoded CFI attributes.
+const (
+	CFIVotingRight           = "Voting right"
+	CFIOwnershipRestrictions = "Ownership/transfer/sales restrictions"
+	CFIPaymentStatus         = "Payment status"
+	CFIForm                  = "Form"
+	CFIRedemption            = "Redemption"
+	CFIIncome                = "Income"
+	CFIInstrumentDependency  = "Instrument dependency"
+	CFIRedemptionConversion  = "Redemption/conversion of the underlying assets"
+	CFIClosedOpenEnd         = "Closed/open-end"
+	CFIDistributionPolicy    = "Distribution policy"
+	CFIAssets                = "Assets"
+	CFISecurityType          = "Security type and investor restrictions"
+	CFIInterest              = "Type of interest"
+	CFIGuarantee             = "Guarantee or ranking"
+	CFIReimbursement         = "Redemption/reimbursement"
+	CFIUnderlyingAssets      = "Underlying assets"
+	CFIWarrantType           = "Type"
+	CFICallPut               = "Call/put"
+	CFIExerciseStyle         = "Exercise option style"
+	CFIDelivery              = "Delivery"
+	CFIStandardization       = "Standardized/non-standardized"
+)
+
+const (
+	cfiLength        = 6
+	cfiAttributes    = 4
+	cfiNotApplicable = 'X'
+)
+
+var (
+	errInvalidCFI          = errors.New("invalid CFI")
+	errInvalidCFILength    = fmt.Errorf("length should be 6 symbols: %w", errInvalidCFI)
+	errInvalidCFISymbol    = fmt.Errorf("symbols should be upper case letters A-Z: %w", errInvalidCFI)
+	errInvalidCFICategory  = fmt.Errorf("unknown category (first symbol): %w", errInvalidCFI)
+	errInvalidCFIGroup     = fmt.Errorf("unknown group (second symbol): %w", errInvalidCFI)
+	errInvalidCFIAttribute = fmt.Errorf("unknown attribute: %w", errInvalidCFI)
+)
+
+// Validate validates the category, the group and the decoded attributes of the CFI.
+func (cfi CFI) Validate() error {
+	if len(cfi) != cfiLength {
+		return errInvalidCFILength
+	}
+
+	for i := 0; i < cfiLength; i++ {
+		if cfi[i] < 'A' || cfi[i] > 'Z' {
+			return errInvalidCFISymbol
+		}
+	}
+
+	c, ok := cfiCategories[cfi[0]]
+	if !ok {
+		return errInvalidCFICategory
+	}
+
+	g, ok := c.groups[cfi[1]]
+	if !ok {
+		return errInvalidCFIGroup
+	}
+
+	for i, a := range g.attributes {
+		b := cfi[i+2]
+		if a == nil || b == cfiNotApplicable {
+			continue
+		}
+
+		if _, ok := a.values[b]; !ok {
+			return fmt.Errorf("symbol at position %v should be one of %s for %s: %w",
+				i+2, a.codes(), a.name, errInvalidCFIAttribute)
+		}
+	}
+
+	return nil
+}
+
+// Category returns the category letter of the CFI, e.g. E, or zero if the CFI is empty.
+func (cfi CFI) Category() byte {
+	if len(cfi) < 1 {
+		return 0
+	}
+
+	return cfi[0]
+}
+
+// CategoryName returns the name of the category of the CFI, e.g. Equities,
+// or an empty string if the category is unknown.
+func (cfi CFI) CategoryName() string {
+	if c, ok := cfiCategories[cfi.Category()]; ok {
+		return c.name
+	}
+
+	return ""
+}
+
+// Group returns the group letter of the CFI, e.g. S, or zero if the CFI is shorter than two symbols.
+func (cfi CFI) Group() byte {
+	if len(cfi) < 2 { //nolint:gomnd
+		return 0
+	}
+
+	return cfi[1]
+}
+
+// GroupName returns the name of the group of the CFI, e.g. Common/ordinary shares,
+// or an empty string if the group is unknown.
+func (cfi CFI) GroupName() string {
+	if g := cfi.group(); g != nil {
+		return g.name
+	}
+
+	return ""
+}
+
+// Attributes returns the four decoded attributes of the CFI in order,
+// or nil if the CFI is not valid.
+//
+// An attribute with the letter X is not applicable and has the value Not applicable/undefined.
+func (cfi CFI) Attributes() []CFIAttribute {
+	if cfi.Validate() != nil {
+		return nil
+	}
+
+	g := cfi.group()
+	as := make([]CFIAttribute, cfiAttributes)
+
+	for i, a := range g.attributes {
+		b := cfi[i+2]
+		as[i].Code = b
+
+		if a == nil {
+			continue
+		}
+
+		as[i].Name = a.name
+		if b == cfiNotApplicable {
+			as[i].Value = "Not applicable/undefined"
+		} else {
+			as[i].Value = a.values[b]
+		}
+	}
+
+	return as
+}
+
+// Attribute returns the decoded attribute of the CFI with a given name, e.g. CFIVotingRight.
+// The boolean is false if the CFI is not valid or its group has no such attribute.
+func (cfi CFI) Attribute(name string) (CFIAttribute, bool) {
+	for _, a := range cfi.Attributes() {
+		if a.Name == name {
+			return a, true
+		}
+	}
+
+	return CFIAttribute{}, false
+}
+
+//nolint:cyclop
+// InstrumentType infers the instrument type from the CFI.
+//
+// The shares, the preferred shares, the convertible shares, the limited partnership units and
+// the depository receipts on equities are the Stock; the exchange traded funds are the ETF;
+// the futures are the Future; the listed, non-listed and complex options are the Option;
+// the spot foreign exchange and the referential currencies are the Forex; the referential indices are the Index.
+//
+// Returns the Undefined if the CFI is not valid or does not correspond to any instrument type.
+func (cfi CFI) InstrumentType() types.InstrumentType {
+	if cfi.Validate() != nil {
+		return types.Undefined
+	}
+
+	switch c, g := cfi[0], cfi[1]; c {
+	case 'E':
+		switch g {
+		case 'S', 'P', 'C', 'F', 'L', 'D':
+			return types.Stock
+		}
+	case 'C':
+		if g == 'E' {
+			return types.ETF
+		}
+	case 'F':
+		return types.Future
+	case 'O', 'H':
+		return types.Option
+	case 'I':
+		if g == 'F' {
+			return types.Forex
+		}
+	case 'T':
+		switch g {
+		case 'C':
+			return types.Forex
+		case 'I':
+			return types.Index
+		}
+	}
+
+	return types.Undefined
+}
+
+func (cfi CFI) group() *cfiGroup {
+	c, ok := cfiCategories[cfi.Category()]
+	if !ok {
+		return nil
+	}
+
+	return c.groups[cfi.Group()]
+}
@@ -0,0 +1,20 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"testing"
+)
+
+func BenchmarkValidateCFI(b *testing.B) {
+	cfi := CFI("ESVUFR")
+	for i := 0; i < b.N; i++ {
+		_ = cfi.Validate()
+	}
+}
+
+func BenchmarkAttributesCFI(b *testing.B) {
+	cfi := CFI("OCASPS")
+	for i := 0; i < b.N; i++ {
+		_ = cfi.Attributes()
+	}
+}
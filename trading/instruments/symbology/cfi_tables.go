@@ -0,0 +1,388 @@
+package symbology
+
+import (
+	"sort"
+)
+
+// cfiAttribute is a decoding table of a CFI attribute.
+type cfiAttribute struct {
+	name   string
+	values map[byte]string
+}
+
+// codes returns the known letters of the attribute in alphabetical order.
+func (a *cfiAttribute) codes() string {
+	bs := make([]byte, 0, len(a.values))
+	for b := range a.values {
+		bs = append(bs, b)
+	}
+
+	sort.Slice(bs, func(i, j int) bool { return bs[i] < bs[j] })
+
+	return string(bs)
+}
+
+// cfiGroup is a group of a CFI category with its attributes.
+// A nil attribute is not decoded.
+type cfiGroup struct {
+	name       string
+	attributes [cfiAttributes]*cfiAttribute
+}
+
+// cfiCategory is a CFI category with its groups.
+type cfiCategory struct {
+	name   string
+	groups map[byte]*cfiGroup
+}
+
+var (
+	cfiVotingRight = &cfiAttribute{CFIVotingRight, map[byte]string{
+		'V': "Voting",
+		'N': "Non-voting",
+		'R': "Restricted voting",
+		'E': "Enhanced voting",
+	}}
+
+	cfiOwnershipRestrictions = &cfiAttribute{CFIOwnershipRestrictions, map[byte]string{
+		'T': "Restrictions",
+		'U': "Free",
+	}}
+
+	cfiPaymentStatus = &cfiAttribute{CFIPaymentStatus, map[byte]string{
+		'O': "Nil paid",
+		'P': "Partly paid",
+		'F': "Fully paid",
+	}}
+
+	cfiForm = &cfiAttribute{CFIForm, map[byte]string{
+		'B': "Bearer",
+		'R': "Registered",
+		'N': "Bearer/registered",
+		'M': "Others",
+	}}
+
+	cfiRedemption = &cfiAttribute{CFIRedemption, map[byte]string{
+		'R': "Redeemable",
+		'E': "Extendible",
+		'T': "Redeemable/extendible",
+		'G': "Exchangeable",
+		'A': "Redeemable/exchangeable/extendible",
+		'C': "Redeemable/exchangeable",
+		'N': "Perpetual",
+	}}
+
+	cfiIncome = &cfiAttribute{CFIIncome, map[byte]string{
+		'F': "Fixed rate income",
+		'C': "Cumulative fixed rate income",
+		'P': "Participating income",
+		'Q': "Cumulative participating income",
+		'A': "Adjustable/variable rate income",
+		'N': "Normal rate income",
+		'U': "Auction rate income",
+		'D': "Dividends",
+	}}
+
+	cfiInstrumentDependency = &cfiAttribute{CFIInstrumentDependency, map[byte]string{
+		'S': "Common/ordinary shares",
+		'P': "Preferred/preference shares",
+		'C': "Common/ordinary convertible shares",
+		'F': "Preferred/preference convertible shares",
+		'L': "Limited partnership units",
+		'M': "Others",
+	}}
+
+	cfiRedemptionConversion = &cfiAttribute{CFIRedemptionConversion, map[byte]string{
+		'R': "Redeemable",
+		'N': "Perpetual",
+		'B': "Convertible",
+		'D': "Convertible/redeemable",
+	}}
+
+	cfiClosedOpenEnd = &cfiAttribute{CFIClosedOpenEnd, map[byte]string{
+		'O': "Open-end",
+		'C': "Closed-end",
+		'M': "Others",
+	}}
+
+	cfiDistributionPolicy = &cfiAttribute{CFIDistributionPolicy, map[byte]string{
+		'I': "Income funds",
+		'G': "Accumulation funds",
+		'J': "Mixed funds",
+	}}
+
+	cfiAssets = &cfiAttribute{CFIAssets, map[byte]string{
+		'R': "Real estate",
+		'S': "Securities",
+		'M': "Mixed-general",
+		'C': "Commodities",
+		'D': "Derivatives",
+	}}
+
+	cfiSecurityType = &cfiAttribute{CFISecurityType, map[byte]string{
+		'S': "Shares",
+		'Q': "Shares for qualified investors",
+		'U': "Units",
+		'Y': "Units for qualified investors",
+	}}
+
+	cfiInterest = &cfiAttribute{CFIInterest, map[byte]string{
+		'F': "Fixed rate",
+		'Z': "Zero rate/discounted",
+		'V': "Variable",
+		'C': "Cash payment",
+		'K': "Payment in kind",
+	}}
+
+	cfiGuarantee = &cfiAttribute{CFIGuarantee, map[byte]string{
+		'T': "Government/state guarantee",
+		'G': "Joint guarantee",
+		'S': "Secured",
+		'U': "Unsecured/unguaranteed",
+		'P': "Negative pledge",
+		'N': "Senior",
+		'O': "Senior subordinated",
+		'Q': "Junior",
+		'J': "Junior subordinated",
+		'C': "Supranational",
+	}}
+
+	cfiReimbursement = &cfiAttribute{CFIReimbursement, map[byte]string{
+		'F': "Fixed maturity",
+		'G': "Fixed maturity with call feature",
+		'C': "Fixed maturity with put feature",
+		'D': "Fixed maturity with put and call",
+		'A': "Amortization plan",
+		'B': "Amortization plan with call feature",
+		'T': "Amortization plan with put feature",
+		'L': "Amortization plan with put and call",
+		'P': "Perpetual",
+		'Q': "Perpetual with call feature",
+		'R': "Perpetual with put feature",
+		'E': "Extendible",
+	}}
+
+	cfiSubscriptionAssets = &cfiAttribute{CFIAssets, map[byte]string{
+		'S': "Common/ordinary shares",
+		'P': "Preferred/preference shares",
+		'C': "Common/ordinary convertible shares",
+		'F': "Preferred/preference convertible shares",
+		'B': "Bonds",
+		'I': "Combined instruments",
+		'M': "Others",
+	}}
+
+	cfiWarrantUnderlyingAssets = &cfiAttribute{CFIUnderlyingAssets, map[byte]string{
+		'B': "Baskets",
+		'S': "Stock-equities",
+		'D': "Debt instruments/interest rates",
+		'T': "Commodities",
+		'C': "Currencies",
+		'I': "Indices",
+		'M': "Others",
+	}}
+
+	cfiWarrantType = &cfiAttribute{CFIWarrantType, map[byte]string{
+		'T': "Traditional warrants",
+		'N': "Naked warrants",
+		'C': "Covered warrants",
+	}}
+
+	cfiCallPut = &cfiAttribute{CFICallPut, map[byte]string{
+		'C': "Call",
+		'P': "Put",
+		'B': "Call and put",
+	}}
+
+	cfiExerciseStyle = &cfiAttribute{CFIExerciseStyle, map[byte]string{
+		'E': "European",
+		'A': "American",
+		'B': "Bermudan",
+		'M': "Others",
+	}}
+
+	cfiOptionUnderlyingAssets = &cfiAttribute{CFIUnderlyingAssets, map[byte]string{
+		'B': "Baskets",
+		'S': "Stock-equities",
+		'D': "Debt instruments",
+		'T': "Commodities",
+		'C': "Currencies",
+		'I': "Indices",
+		'O': "Options",
+		'F': "Futures",
+		'W': "Swaps",
+		'N': "Interest rates",
+		'M': "Others",
+	}}
+
+	cfiOptionDelivery = &cfiAttribute{CFIDelivery, map[byte]string{
+		'P': "Physical",
+		'C': "Cash",
+		'N': "Non-deliverable",
+		'E': "Elect at exercise",
+	}}
+
+	cfiStandardization = &cfiAttribute{CFIStandardization, map[byte]string{
+		'S': "Standardized",
+		'N': "Non-standardized",
+	}}
+
+	cfiFinancialUnderlyingAssets = &cfiAttribute{CFIUnderlyingAssets, map[byte]string{
+		'B': "Baskets",
+		'S': "Stock-equities",
+		'D': "Debt instruments",
+		'C': "Currencies",
+		'I': "Indices",
+		'O': "Options",
+		'F': "Futures",
+		'W': "Swaps",
+		'N': "Interest rates",
+		'V': "Stock dividend",
+		'M': "Others",
+	}}
+
+	cfiCommodityUnderlyingAssets = &cfiAttribute{CFIUnderlyingAssets, map[byte]string{
+		'E': "Extraction resources",
+		'A': "Agriculture",
+		'I': "Industrial products",
+		'S': "Services",
+		'N': "Environmental",
+		'P': "Polypropylene products",
+		'H': "Generated resources",
+		'M': "Others",
+	}}
+
+	cfiFutureDelivery = &cfiAttribute{CFIDelivery, map[byte]string{
+		'P': "Physical",
+		'C': "Cash",
+		'N': "Non-deliverable",
+	}}
+)
+
+var (
+	cfiShares = [cfiAttributes]*cfiAttribute{
+		cfiVotingRight, cfiOwnershipRestrictions, cfiPaymentStatus, cfiForm,
+	}
+	cfiPreferredShares = [cfiAttributes]*cfiAttribute{
+		cfiVotingRight, cfiRedemption, cfiIncome, cfiForm,
+	}
+	cfiFunds = [cfiAttributes]*cfiAttribute{
+		cfiClosedOpenEnd, cfiDistributionPolicy, cfiAssets, cfiSecurityType,
+	}
+	cfiBonds = [cfiAttributes]*cfiAttribute{
+		cfiInterest, cfiGuarantee, cfiReimbursement, cfiForm,
+	}
+	cfiOptions = [cfiAttributes]*cfiAttribute{
+		cfiExerciseStyle, cfiOptionUnderlyingAssets, cfiOptionDelivery, cfiStandardization,
+	}
+)
+
+//nolint:lll
+// cfiCategories are the ISO 10962:2019 categories by their letters.
+var cfiCategories = map[byte]*cfiCategory{
+	'E': {"Equities", map[byte]*cfiGroup{
+		'S': {"Common/ordinary shares", cfiShares},
+		'P': {"Preferred/preference shares", cfiPreferredShares},
+		'C': {"Common/ordinary convertible shares", cfiShares},
+		'F': {"Preferred/preference convertible shares", cfiPreferredShares},
+		'L': {"Limited partnership units", cfiShares},
+		'D': {"Depository receipts on equities", [cfiAttributes]*cfiAttribute{cfiInstrumentDependency, cfiRedemptionConversion, cfiIncome, cfiForm}},
+		'Y': {"Structured instruments (participation)", [cfiAttributes]*cfiAttribute{}},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{nil, nil, nil, cfiForm}},
+	}},
+	'C': {"Collective investment vehicles", map[byte]*cfiGroup{
+		'I': {"Standard (vanilla) investment funds/mutual funds", cfiFunds},
+		'H': {"Hedge funds", [cfiAttributes]*cfiAttribute{}},
+		'B': {"Real estate investment trusts", [cfiAttributes]*cfiAttribute{}},
+		'E': {"Exchange traded funds", cfiFunds},
+		'S': {"Pension funds", [cfiAttributes]*cfiAttribute{}},
+		'F': {"Funds of funds", [cfiAttributes]*cfiAttribute{}},
+		'P': {"Private equity funds", [cfiAttributes]*cfiAttribute{}},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'D': {"Debt instruments", map[byte]*cfiGroup{
+		'B': {"Bonds", cfiBonds},
+		'C': {"Convertible bonds", cfiBonds},
+		'W': {"Bonds with warrants attached", cfiBonds},
+		'T': {"Medium-term notes", cfiBonds},
+		'S': {"Structured products (with capital protection)", [cfiAttributes]*cfiAttribute{}},
+		'E': {"Structured products (without capital protection)", [cfiAttributes]*cfiAttribute{}},
+		'G': {"Mortgage-backed securities", [cfiAttributes]*cfiAttribute{}},
+		'A': {"Asset-backed securities", [cfiAttributes]*cfiAttribute{}},
+		'N': {"Municipal bonds", cfiBonds},
+		'D': {"Depository receipts on debt instruments", [cfiAttributes]*cfiAttribute{}},
+		'Y': {"Money market instruments", [cfiAttributes]*cfiAttribute{cfiInterest, cfiGuarantee, nil, cfiForm}},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'R': {"Entitlements (rights)", map[byte]*cfiGroup{
+		'A': {"Allotment (bonus) rights", [cfiAttributes]*cfiAttribute{nil, nil, nil, cfiForm}},
+		'S': {"Subscription rights", [cfiAttributes]*cfiAttribute{cfiSubscriptionAssets, nil, nil, cfiForm}},
+		'P': {"Purchase rights", [cfiAttributes]*cfiAttribute{cfiSubscriptionAssets, nil, nil, cfiForm}},
+		'W': {"Warrants", [cfiAttributes]*cfiAttribute{cfiWarrantUnderlyingAssets, cfiWarrantType, cfiCallPut, cfiExerciseStyle}},
+		'F': {"Mini-future certificates, constant leverage certificates", [cfiAttributes]*cfiAttribute{}},
+		'D': {"Depository receipts on entitlements", [cfiAttributes]*cfiAttribute{}},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'O': {"Listed options", map[byte]*cfiGroup{
+		'C': {"Call options", cfiOptions},
+		'P': {"Put options", cfiOptions},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'F': {"Futures", map[byte]*cfiGroup{
+		'F': {"Financial futures", [cfiAttributes]*cfiAttribute{cfiFinancialUnderlyingAssets, cfiFutureDelivery, cfiStandardization, nil}},
+		'C': {"Commodities futures", [cfiAttributes]*cfiAttribute{cfiCommodityUnderlyingAssets, cfiFutureDelivery, cfiStandardization, nil}},
+	}},
+	'S': {"Swaps", map[byte]*cfiGroup{
+		'R': {"Rates", [cfiAttributes]*cfiAttribute{}},
+		'T': {"Commodities", [cfiAttributes]*cfiAttribute{}},
+		'E': {"Equity", [cfiAttributes]*cfiAttribute{}},
+		'C': {"Credit", [cfiAttributes]*cfiAttribute{}},
+		'F': {"Foreign exchange", [cfiAttributes]*cfiAttribute{}},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'H': {"Non-listed and complex listed options", map[byte]*cfiGroup{
+		'R': {"Rates", [cfiAttributes]*cfiAttribute{}},
+		'T': {"Commodities", [cfiAttributes]*cfiAttribute{}},
+		'E': {"Equity", [cfiAttributes]*cfiAttribute{}},
+		'C': {"Credit", [cfiAttributes]*cfiAttribute{}},
+		'F': {"Foreign exchange", [cfiAttributes]*cfiAttribute{}},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'I': {"Spot", map[byte]*cfiGroup{
+		'F': {"Foreign exchange", [cfiAttributes]*cfiAttribute{}},
+		'T': {"Commodities", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'J': {"Forwards", map[byte]*cfiGroup{
+		'E': {"Equity", [cfiAttributes]*cfiAttribute{}},
+		'F': {"Foreign exchange", [cfiAttributes]*cfiAttribute{}},
+		'C': {"Credit", [cfiAttributes]*cfiAttribute{}},
+		'R': {"Rates", [cfiAttributes]*cfiAttribute{}},
+		'T': {"Commodities", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'K': {"Strategies", map[byte]*cfiGroup{
+		'R': {"Rates", [cfiAttributes]*cfiAttribute{}},
+		'T': {"Commodities", [cfiAttributes]*cfiAttribute{}},
+		'E': {"Equity", [cfiAttributes]*cfiAttribute{}},
+		'C': {"Credit", [cfiAttributes]*cfiAttribute{}},
+		'F': {"Foreign exchange", [cfiAttributes]*cfiAttribute{}},
+		'Y': {"Mixed assets", [cfiAttributes]*cfiAttribute{}},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'L': {"Financing", map[byte]*cfiGroup{
+		'L': {"Loan-lease", [cfiAttributes]*cfiAttribute{}},
+		'R': {"Repurchase agreements", [cfiAttributes]*cfiAttribute{}},
+		'S': {"Securities lending", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'T': {"Referential instruments", map[byte]*cfiGroup{
+		'C': {"Currencies", [cfiAttributes]*cfiAttribute{}},
+		'T': {"Commodities", [cfiAttributes]*cfiAttribute{}},
+		'R': {"Interest rates", [cfiAttributes]*cfiAttribute{}},
+		'I': {"Indices", [cfiAttributes]*cfiAttribute{}},
+		'B': {"Baskets", [cfiAttributes]*cfiAttribute{}},
+		'D': {"Stock dividends", [cfiAttributes]*cfiAttribute{}},
+		'M': {"Others (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+	'M': {"Others (miscellaneous)", map[byte]*cfiGroup{
+		'C': {"Combined instruments", [cfiAttributes]*cfiAttribute{}},
+		'M': {"Other assets (miscellaneous)", [cfiAttributes]*cfiAttribute{}},
+	}},
+}
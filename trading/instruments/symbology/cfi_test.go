@@ -0,0 +1,166 @@
+//nolint:testpackage
+package symbology
+
+//nolint:gofumpt
+import (
+	"errors"
+	"testing"
+
+	"mbg/trading/instruments/types"
+)
+
+func TestCFIValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cfi string
+		err error
+	}{
+		{"ESVUFR", nil},
+		{"ESXXXX", nil},
+		{"EPVRFR", nil},
+		{"EDSNDR", nil},
+		{"CEOGSU", nil},
+		{"DBFTFR", nil},
+		{"DYZUXB", nil},
+		{"RWSTCA", nil},
+		{"OCASPS", nil},
+		{"OPEICS", nil},
+		{"FFICSX", nil},
+		{"FCEPSX", nil},
+		{"SRCCSP", nil},
+		{"IFXXXP", nil},
+		{"TIXXXX", nil},
+		{"MMXXXX", nil},
+		{"", errInvalidCFILength},
+		{"ESVUF", errInvalidCFILength},
+		{"ESVUFRX", errInvalidCFILength},
+		{"esvufr", errInvalidCFISymbol},
+		{"ESVU1R", errInvalidCFISymbol},
+		{"ZSVUFR", errInvalidCFICategory},
+		{"EZVUFR", errInvalidCFIGroup},
+		{"FSICSX", errInvalidCFIGroup},
+		{"ESAUFR", errInvalidCFIAttribute},
+		{"ESVAFR", errInvalidCFIAttribute},
+		{"ESVUAR", errInvalidCFIAttribute},
+		{"ESVUFA", errInvalidCFIAttribute},
+		{"OCZSPS", errInvalidCFIAttribute},
+		{"OCASPZ", errInvalidCFIAttribute},
+		{"FFIESX", errInvalidCFIAttribute},
+	}
+
+	for _, tt := range tests {
+		err := CFI(tt.cfi).Validate()
+
+		switch {
+		case tt.err == nil && err != nil:
+			t.Errorf("'%v': expected success, got error %v", tt.cfi, err)
+		case tt.err != nil && !errors.Is(err, tt.err):
+			t.Errorf("'%v': expected error %v, actual %v", tt.cfi, tt.err, err)
+		case tt.err != nil && !errors.Is(err, errInvalidCFI):
+			t.Errorf("'%v': expected error %v, actual %v", tt.cfi, errInvalidCFI, err)
+		}
+	}
+}
+
+//nolint:funlen
+func TestCFIClassification(t *testing.T) {
+	t.Parallel()
+
+	cfi := CFI("ESVUFR")
+
+	if cfi.Category() != 'E' || cfi.CategoryName() != "Equities" {
+		t.Errorf("Category(): unexpected %c %v", cfi.Category(), cfi.CategoryName())
+	}
+
+	if cfi.Group() != 'S' || cfi.GroupName() != "Common/ordinary shares" {
+		t.Errorf("Group(): unexpected %c %v", cfi.Group(), cfi.GroupName())
+	}
+
+	exp := []CFIAttribute{
+		{CFIVotingRight, 'V', "Voting"},
+		{CFIOwnershipRestrictions, 'U', "Free"},
+		{CFIPaymentStatus, 'F', "Fully paid"},
+		{CFIForm, 'R', "Registered"},
+	}
+
+	act := cfi.Attributes()
+	if len(act) != len(exp) {
+		t.Fatalf("Attributes(): expected %v, actual %v", exp, act)
+	}
+
+	for i := range exp {
+		if act[i] != exp[i] {
+			t.Errorf("Attributes()[%d]: expected %v, actual %v", i, exp[i], act[i])
+		}
+	}
+
+	attributes := []struct {
+		cfi   CFI
+		name  string
+		value string
+		ok    bool
+	}{
+		{"OCASPS", CFIExerciseStyle, "American", true},
+		{"OCASPS", CFIUnderlyingAssets, "Stock-equities", true},
+		{"OCASPS", CFIDelivery, "Physical", true},
+		{"OPEICS", CFIDelivery, "Cash", true},
+		{"FFICSX", CFIStandardization, "Standardized", true},
+		{"EPVRFR", CFIIncome, "Fixed rate income", true},
+		{"ESVUXR", CFIPaymentStatus, "Not applicable/undefined", true},
+		{"RWSTCA", CFICallPut, "Call", true},
+		{"DBFTFR", CFIGuarantee, "Government/state guarantee", true},
+		{"ESVUFR", CFIDelivery, "", false},
+		{"SRCCSP", CFIDelivery, "", false},
+		{"ESAUFR", CFIVotingRight, "", false},
+	}
+
+	for _, tt := range attributes {
+		a, ok := tt.cfi.Attribute(tt.name)
+		if ok != tt.ok || a.Value != tt.value {
+			t.Errorf("'%v' Attribute(%v): expected %v %v, actual %v %v", tt.cfi, tt.name, tt.value, tt.ok, a.Value, ok)
+		}
+	}
+
+	// The attributes of the groups without decoding tables have letters only.
+	if as := CFI("SRCCSP").Attributes(); len(as) != 4 || as[3] != (CFIAttribute{Code: 'P'}) {
+		t.Errorf("Attributes(SRCCSP): unexpected %v", as)
+	}
+
+	if CFI("ZZ").CategoryName() != "" || CFI("EZ").GroupName() != "" || CFI("").Group() != 0 ||
+		CFI("").Category() != 0 || CFI("ESAUFR").Attributes() != nil {
+		t.Error("invalid CFI: expected empty classification")
+	}
+}
+
+func TestCFIInstrumentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cfi CFI
+		typ types.InstrumentType
+	}{
+		{"ESVUFR", types.Stock},
+		{"EPVRFR", types.Stock},
+		{"EDSNDR", types.Stock},
+		{"EYXXXX", types.Undefined},
+		{"CEOGSU", types.ETF},
+		{"CIOGSU", types.Undefined},
+		{"FFICSX", types.Future},
+		{"FCEPSX", types.Future},
+		{"OCASPS", types.Option},
+		{"HEXXXX", types.Option},
+		{"IFXXXP", types.Forex},
+		{"TCXXXX", types.Forex},
+		{"TIXXXX", types.Index},
+		{"DBFTFR", types.Undefined},
+		{"ESAUFR", types.Undefined},
+		{"", types.Undefined},
+	}
+
+	for _, tt := range tests {
+		if act := tt.cfi.InstrumentType(); act != tt.typ {
+			t.Errorf("'%v': expected %v, actual %v", tt.cfi, tt.typ, act)
+		}
+	}
+}
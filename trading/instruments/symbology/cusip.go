@@ -99,6 +99,7 @@ var (
 	errInvalidCUSIPLength8    = fmt.Errorf("length should be at least 8 symbols: %w", errInvalidCUSIP)
 	errInvalidCUSIPLastSymbol = fmt.Errorf("last symbol should be a digit 0-9: %w", errInvalidCUSIP)
 	errInvalidCUSIPCheckDigit = fmt.Errorf("invalid check digit (last symbol): %w", errInvalidCUSIP)
+	errInvalidCUSIPCountry    = fmt.Errorf("ISIN country code should be US or CA: %w", errInvalidCUSIP)
 )
 
 // Validate valudates the CUSIP.
@@ -141,6 +142,25 @@ func (cusip CUSIP) Validate() error {
 	return nil
 }
 
+// ToISIN converts the CUSIP to the ISIN with a given country code, US or CA.
+//
+// The CUSIP is validated, the ISIN check digit is calculated.
+func (cusip CUSIP) ToISIN(country string) (ISIN, error) {
+	if country != "US" && country != "CA" {
+		return "", errInvalidCUSIPCountry
+	}
+
+	if len(cusip) != cusipLength {
+		return "", errInvalidCUSIPLength9
+	}
+
+	if err := cusip.Validate(); err != nil {
+		return "", err
+	}
+
+	return withCheckDigit(ISIN(country + string(cusip)))
+}
+
 // CalculateCheckDigit calculates a check digit of the CUSIP according to the Luhn algorithm.
 func (cusip CUSIP) CalculateCheckDigit() (byte, error) {
 	if len(cusip) < cusipCheckSumIndex {
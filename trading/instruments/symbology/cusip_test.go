@@ -2,6 +2,7 @@
 package symbology
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -4526,3 +4527,29 @@ func TestCalculateCheckDigitCUSIP(t *testing.T) {
 		}
 	}
 }
+
+func TestCUSIPToISIN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		cusip   string
+		country string
+		isin    ISIN
+		err     error
+	}{
+		{"037833100", "US", "US0378331005", nil},
+		{"780087102", "CA", "CA7800871021", nil},
+		{"037833100", "GB", "", errInvalidCUSIPCountry},
+		{"03783310", "US", "", errInvalidCUSIPLength9},
+		{"0378331000", "US", "", errInvalidCUSIPLength9},
+		{"037833101", "US", "", errInvalidCUSIPCheckDigit},
+	}
+
+	for _, tt := range tests {
+		isin, err := CUSIP(tt.cusip).ToISIN(tt.country)
+		if isin != tt.isin || !errors.Is(err, tt.err) {
+			t.Errorf("CUSIP.ToISIN('%v', %v): expected '%v' (%v), actual '%v' (%v)",
+				tt.cusip, tt.country, tt.isin, tt.err, isin, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package symbology
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FIGI is a Financial Instrument Global Identifier, an open standard identifier
+// issued by Bloomberg as the registration authority of the Object Management Group.
+// See https://www.openfigi.com/about/figi and https://en.wikipedia.org/wiki/Financial_Instrument_Global_Identifier.
+//
+// FIGIs consist of twelve upper case consonants and digits, the vowels are never used.
+// The first two symbols denote a certified provider, e.g. BB for Bloomberg; the combinations
+// BS, BM, GG, GB, GH, KY and VG are not allowed to avoid a confusion with ISINs.
+// The third symbol is always G, the next eight symbols are alphanumeric and the last
+// symbol is a check digit.
+//
+// For example, the FIGI of Apple common shares traded on the Nasdaq is “BBG000B9XRY4“.
+//
+// The check digit is calculated with the "Modulus 10 Double Add Double" technique as in CUSIPs.
+// Letters are converted to numbers by adding their ordinal position in the alphabet to 9,
+// such that B = 11 and Z = 35. Every second number starting from the second one is multiplied
+// by two. The digits of the resulting numbers are added up, and the ten's-complement
+// of the last digit of the sum is the check digit.
+type FIGI string
+
+const (
+	figiLength        = 12
+	figiCheckSumIndex = figiLength - 1
+	figiPrefixLength  = 2
+	figiThirdSymbol   = 'G'
+)
+
+var (
+	errInvalidFIGI           = errors.New("invalid FIGI")
+	errInvalidFIGILength12   = fmt.Errorf("length should be 12 symbols: %w", errInvalidFIGI)
+	errInvalidFIGILength11   = fmt.Errorf("length should be at least 11 symbols: %w", errInvalidFIGI)
+	errInvalidFIGIPrefix     = fmt.Errorf("first two symbols should not be BS, BM, GG, GB, GH, KY or VG: %w", errInvalidFIGI)
+	errInvalidFIGIThird      = fmt.Errorf("third symbol should be G: %w", errInvalidFIGI)
+	errInvalidFIGILastSymbol = fmt.Errorf("last symbol should be a digit 0-9: %w", errInvalidFIGI)
+	errInvalidFIGICheckDigit = fmt.Errorf("invalid check digit (last symbol): %w", errInvalidFIGI)
+)
+
+// Validate valudates the prefix, the third symbol and the check digit of the FIGI.
+func (figi FIGI) Validate() error {
+	if len(figi) != figiLength {
+		return errInvalidFIGILength12
+	}
+
+	switch figi[:figiPrefixLength] {
+	case "BS", "BM", "GG", "GB", "GH", "KY", "VG":
+		return errInvalidFIGIPrefix
+	}
+
+	if figi[figiPrefixLength] != figiThirdSymbol {
+		return errInvalidFIGIThird
+	}
+
+	n := figi[figiCheckSumIndex]
+	if n < '0' || n > '9' {
+		return errInvalidFIGILastSymbol
+	}
+
+	n -= '0'
+
+	d, err := figi.CalculateCheckDigit()
+	if err != nil {
+		return err
+	}
+
+	if n != d {
+		return errInvalidFIGICheckDigit
+	}
+
+	return nil
+}
+
+// CalculateCheckDigit calculates a check digit of the FIGI.
+func (figi FIGI) CalculateCheckDigit() (byte, error) {
+	if len(figi) < figiCheckSumIndex {
+		return 0, errInvalidFIGILength11
+	}
+
+	sum := 0
+
+	for i := 0; i < figiCheckSumIndex; i++ {
+		n, err := toOrdinalNumberFIGI(figi[i], i)
+		if err != nil {
+			return 0, err
+		}
+
+		if i%2 == 1 {
+			n *= 2
+		}
+
+		sum += n/ten + n%ten
+	}
+
+	sum = (ten - sum%ten) % ten
+
+	return byte(sum), nil
+}
+
+func toOrdinalNumberFIGI(b byte, i int) (int, error) {
+	switch {
+	case b >= '0' && b <= '9':
+		if i < figiPrefixLength+1 {
+			return 0, fmt.Errorf("symbol at position %v should be a consonant: %w", i, errInvalidFIGI)
+		}
+
+		return int(b - '0'), nil
+	case b >= 'A' && b <= 'Z':
+		switch b {
+		case 'A', 'E', 'I', 'O', 'U':
+			return 0, fmt.Errorf("symbol at position %v should not be a vowel AEIOU: %w", i, errInvalidFIGI)
+		}
+
+		return int(b - 'A' + ten), nil
+	default:
+		return 0, fmt.Errorf(
+			"symbol at position %v should be either a digit 0-9 or a consonant: %w", i, errInvalidFIGI)
+	}
+}
@@ -0,0 +1,20 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"testing"
+)
+
+func BenchmarkValidateFIGI(b *testing.B) {
+	figi := FIGI("BBG000B9XRY4")
+	for i := 0; i < b.N; i++ {
+		_ = figi.Validate()
+	}
+}
+
+func BenchmarkCalculateCheckDigitFIGI(b *testing.B) {
+	figi := FIGI("BBG000B9XRY4")
+	for i := 0; i < b.N; i++ {
+		_, _ = figi.CalculateCheckDigit()
+	}
+}
@@ -0,0 +1,78 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFIGIValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		figi string
+		err  error
+	}{
+		// https://www.openfigi.com.
+		{"BBG000B9XRY4", nil}, // Apple common shares on Nasdaq.
+		{"BBG000BLNNH6", nil},
+		{"BBG000BPH459", nil},
+		{"BBG000BLNQ16", nil},
+		{"BBG000BVPV84", nil},
+		{"BBG001S5N8V8", nil},
+		{"KKG000000M81", nil},
+
+		{"BBG000B9XRY", errInvalidFIGILength12},
+		{"BBG000B9XRY45", errInvalidFIGILength12},
+		{"BSG000B9XRY4", errInvalidFIGIPrefix},
+		{"BMG000B9XRY4", errInvalidFIGIPrefix},
+		{"GGG000B9XRY4", errInvalidFIGIPrefix},
+		{"GBG000B9XRY4", errInvalidFIGIPrefix},
+		{"GHG000B9XRY4", errInvalidFIGIPrefix},
+		{"KYG000B9XRY4", errInvalidFIGIPrefix},
+		{"VGG000B9XRY4", errInvalidFIGIPrefix},
+		{"BBB000B9XRY4", errInvalidFIGIThird},
+		{"BBG000B9XRYX", errInvalidFIGILastSymbol},
+		{"BBG000B9XRY5", errInvalidFIGICheckDigit},
+		{"BBG000A9XRY4", errInvalidFIGI},
+		{"1BG000B9XRY4", errInvalidFIGI},
+		{"BBG000b9XRY4", errInvalidFIGI},
+	}
+
+	for _, tt := range tests {
+		err := FIGI(tt.figi).Validate()
+
+		if tt.err == nil && err != nil || tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("FIGI.Validate('%v'): expected %v, actual %v", tt.figi, tt.err, err)
+		}
+	}
+}
+
+func TestFIGICalculateCheckDigit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		figi  string
+		digit byte
+		valid bool
+	}{
+		{"BBG000B9XRY", 4, true},
+		{"BBG000B9XRY4", 4, true},
+		{"BBG000BLNNH", 6, true},
+		{"KKG000000M8", 1, true},
+		{"BBG000B9XR", 0, false},
+		{"BBG000B9XRE", 0, false},
+		{"BBG000B9XR-", 0, false},
+	}
+
+	for _, tt := range tests {
+		d, err := FIGI(tt.figi).CalculateCheckDigit()
+		if act := err == nil; act != tt.valid {
+			t.Errorf("FIGI.CalculateCheckDigit('%v'): expected valid %v, actual %v (%v)", tt.figi, tt.valid, act, err)
+		}
+
+		if d != tt.digit {
+			t.Errorf("FIGI.CalculateCheckDigit('%v'): expected %v, actual %v", tt.figi, tt.digit, d)
+		}
+	}
+}
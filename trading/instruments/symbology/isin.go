@@ -47,6 +47,7 @@ const (
 	isinLength        = 12
 	isinCheckSumIndex = isinLength - 1
 	isinCountryLength = 2
+	isinSEDOLPrefix   = "00"
 	ten               = 10
 )
 
@@ -57,6 +58,9 @@ var (
 	errInvalidISINLength11    = fmt.Errorf("length should be at least 11 symbols: %w", errInvalidISIN)
 	errInvalidISINLastSymbol  = fmt.Errorf("last symbol should be a digit 0-9: %w", errInvalidISIN)
 	errInvalidISINCheckDigit  = fmt.Errorf("invalid check digit (last symbol): %w", errInvalidISIN)
+	errInvalidISINCUSIP       = fmt.Errorf("country code should be US or CA to embed a CUSIP: %w", errInvalidISIN)
+	errInvalidISINSEDOL       = fmt.Errorf("country code should be GB, IE, GG, JE or IM to embed a SEDOL: %w", errInvalidISIN)
+	errInvalidISINSEDOLPrefix = fmt.Errorf("national identifier should start with 00 to embed a SEDOL: %w", errInvalidISIN)
 )
 
 // Validate valudates the country code and the check digit of the ISIN.
@@ -136,6 +140,66 @@ func (isin ISIN) CalculateCheckDigit() (byte, error) {
 	return byte(sum), nil
 }
 
+// CUSIP extracts the CUSIP embedded in the US or Canadian ISIN.
+//
+// Both the ISIN and the embedded CUSIP are validated.
+func (isin ISIN) CUSIP() (CUSIP, error) {
+	if err := isin.Validate(); err != nil {
+		return "", err
+	}
+
+	switch isin[:isinCountryLength] {
+	case "US", "CA":
+	default:
+		return "", errInvalidISINCUSIP
+	}
+
+	cusip := CUSIP(isin[isinCountryLength:isinCheckSumIndex])
+	if err := cusip.Validate(); err != nil {
+		return "", err
+	}
+
+	return cusip, nil
+}
+
+// SEDOL extracts the SEDOL embedded in the British, Irish, Guernsey, Jersey or Isle of Man ISIN.
+// The national identifier of such ISINs is the SEDOL padded with two leading zeros.
+//
+// Both the ISIN and the embedded SEDOL are validated.
+func (isin ISIN) SEDOL() (SEDOL, error) {
+	if err := isin.Validate(); err != nil {
+		return "", err
+	}
+
+	switch isin[:isinCountryLength] {
+	case "GB", "IE", "GG", "JE", "IM":
+	default:
+		return "", errInvalidISINSEDOL
+	}
+
+	nsin := isin[isinCountryLength:isinCheckSumIndex]
+	if nsin[:len(isinSEDOLPrefix)] != isinSEDOLPrefix {
+		return "", errInvalidISINSEDOLPrefix
+	}
+
+	sedol := SEDOL(nsin[len(isinSEDOLPrefix):])
+	if err := sedol.Validate(); err != nil {
+		return "", err
+	}
+
+	return sedol, nil
+}
+
+// withCheckDigit appends the calculated check digit to an ISIN without it.
+func withCheckDigit(isin ISIN) (ISIN, error) {
+	d, err := isin.CalculateCheckDigit()
+	if err != nil {
+		return "", err
+	}
+
+	return isin + ISIN('0'+d), nil
+}
+
 //gocyclo:ignore
 //nolint:funlen,gocognit,cyclop,maintidx
 // ValidateCountry valudates if two first letters of the ISIN represent a valid country code.
@@ -25,3 +25,10 @@ func BenchmarkValidateCountryISIN(b *testing.B) {
 		_ = isin.ValidateCountry()
 	}
 }
+
+func BenchmarkCUSIPISIN(b *testing.B) {
+	isin := ISIN("US0378331005")
+	for i := 0; i < b.N; i++ {
+		_, _ = isin.CUSIP()
+	}
+}
@@ -4,6 +4,7 @@ package symbology
 // Useful link to verify ISINs: https://www.isindb.com/validate-isin/.
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -895,3 +896,71 @@ func TestValidateCountryISIN(t *testing.T) {
 		}
 	}
 }
+
+func TestCUSIPISIN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		isin  string
+		cusip CUSIP
+		err   error
+	}{
+		{"US0378331005", "037833100", nil}, // Apple.
+		{"US5949181045", "594918104", nil}, // Microsoft.
+		{"CA7800871021", "780087102", nil}, // Royal Bank of Canada.
+		{"US0378331006", "", errInvalidISINCheckDigit},
+		{"GB0002634946", "", errInvalidISINCUSIP},
+		{"US0378331013", "", errInvalidCUSIP},
+	}
+
+	for _, tt := range tests {
+		cusip, err := ISIN(tt.isin).CUSIP()
+		if cusip != tt.cusip || !errors.Is(err, tt.err) {
+			t.Errorf("ISIN.CUSIP('%v'): expected '%v' (%v), actual '%v' (%v)", tt.isin, tt.cusip, tt.err, cusip, err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		isin, err := cusip.ToISIN(tt.isin[:2])
+		if string(isin) != tt.isin || err != nil {
+			t.Errorf("CUSIP.ToISIN('%v'): expected '%v', actual '%v' (%v)", cusip, tt.isin, isin, err)
+		}
+	}
+}
+
+func TestSEDOLISIN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		isin  string
+		sedol SEDOL
+		err   error
+	}{
+		{"GB0002634946", "0263494", nil}, // BAE Systems.
+		{"GB00BH4HKS39", "BH4HKS3", nil}, // Vodafone.
+		{"IE00BYTBXV33", "BYTBXV3", nil}, // Ryanair.
+		{"JE00B4T3BW64", "B4T3BW6", nil}, // Glencore.
+		{"GB0002634947", "", errInvalidISINCheckDigit},
+		{"US0378331005", "", errInvalidISINSEDOL},
+		{"GB1002634944", "", errInvalidISINSEDOLPrefix},
+		{"GB0002634953", "", errInvalidSEDOL},
+	}
+
+	for _, tt := range tests {
+		sedol, err := ISIN(tt.isin).SEDOL()
+		if sedol != tt.sedol || !errors.Is(err, tt.err) {
+			t.Errorf("ISIN.SEDOL('%v'): expected '%v' (%v), actual '%v' (%v)", tt.isin, tt.sedol, tt.err, sedol, err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		isin, err := sedol.ToISIN(tt.isin[:2])
+		if string(isin) != tt.isin || err != nil {
+			t.Errorf("SEDOL.ToISIN('%v'): expected '%v', actual '%v' (%v)", sedol, tt.isin, isin, err)
+		}
+	}
+}
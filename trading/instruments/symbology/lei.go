@@ -0,0 +1,90 @@
+package symbology
+
+import (
+	"errors"
+	"fmt"
+)
+
+// LEI is an ISO 17442 Legal Entity Identifier.
+// See https://www.gleif.org/en/about-lei/iso-17442-the-lei-code-structure
+// and https://en.wikipedia.org/wiki/Legal_Entity_Identifier.
+//
+// LEIs consist of twenty upper case letters and digits. The first four symbols denote
+// the Local Operating Unit which issued the LEI, the next two symbols are reserved (usually 00),
+// the next twelve symbols identify the entity and the last two symbols are check digits.
+//
+// For example, the LEI of Apple Inc. is “HWUPKR0MPOU8FGXBT394“.
+//
+// The check digits are calculated according to ISO 7064 MOD 97-10 as in IBANs.
+// Letters are converted to numbers by adding their ordinal position in the alphabet to 9,
+// such that A = 10 and Z = 35, and the numbers are concatenated into a single large number.
+// The check digits are 98 minus the remainder of the division of the number followed by 00 by 97.
+// A LEI is valid when the number made of all twenty symbols gives the remainder 1.
+type LEI string
+
+const (
+	leiLength        = 20
+	leiCheckSumIndex = leiLength - 2
+	leiModulus       = 97
+	leiComplement    = 98
+	hundred          = 100
+)
+
+var (
+	errInvalidLEI            = errors.New("invalid LEI")
+	errInvalidLEILength20    = fmt.Errorf("length should be 20 symbols: %w", errInvalidLEI)
+	errInvalidLEILength18    = fmt.Errorf("length should be at least 18 symbols: %w", errInvalidLEI)
+	errInvalidLEILastSymbols = fmt.Errorf("last two symbols should be digits 0-9: %w", errInvalidLEI)
+	errInvalidLEICheckDigits = fmt.Errorf("invalid check digits (last two symbols): %w", errInvalidLEI)
+)
+
+// Validate valudates the LEI.
+func (lei LEI) Validate() error {
+	if len(lei) != leiLength {
+		return errInvalidLEILength20
+	}
+
+	for _, b := range []byte(lei[leiCheckSumIndex:]) {
+		if b < '0' || b > '9' {
+			return errInvalidLEILastSymbols
+		}
+	}
+
+	d, err := lei.CalculateCheckDigits()
+	if err != nil {
+		return err
+	}
+
+	if (lei[leiCheckSumIndex]-'0')*ten+lei[leiCheckSumIndex+1]-'0' != d {
+		return errInvalidLEICheckDigits
+	}
+
+	return nil
+}
+
+// CalculateCheckDigits calculates the check digits 2-98 of the LEI according to ISO 7064 MOD 97-10.
+func (lei LEI) CalculateCheckDigits() (byte, error) {
+	if len(lei) < leiCheckSumIndex {
+		return 0, errInvalidLEILength18
+	}
+
+	mod := 0
+
+	for i := 0; i < leiCheckSumIndex; i++ {
+		b := lei[i]
+
+		switch {
+		case b >= '0' && b <= '9':
+			mod = (mod*ten + int(b-'0')) % leiModulus
+		case b >= 'A' && b <= 'Z':
+			mod = (mod*hundred + int(b-'A'+ten)) % leiModulus
+		default:
+			return 0, fmt.Errorf(
+				"symbol at position %v should be either a digit 0-9 or a letter A-Z: %w", i, errInvalidLEI)
+		}
+	}
+
+	mod = mod * hundred % leiModulus
+
+	return byte(leiComplement - mod), nil
+}
@@ -0,0 +1,20 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"testing"
+)
+
+func BenchmarkValidateLEI(b *testing.B) {
+	lei := LEI("HWUPKR0MPOU8FGXBT394")
+	for i := 0; i < b.N; i++ {
+		_ = lei.Validate()
+	}
+}
+
+func BenchmarkCalculateCheckDigitsLEI(b *testing.B) {
+	lei := LEI("HWUPKR0MPOU8FGXBT394")
+	for i := 0; i < b.N; i++ {
+		_, _ = lei.CalculateCheckDigits()
+	}
+}
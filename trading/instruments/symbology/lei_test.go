@@ -0,0 +1,70 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLEIValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		lei string
+		err error
+	}{
+		// https://search.gleif.org.
+		{"HWUPKR0MPOU8FGXBT394", nil}, // Apple Inc.
+		{"529900G3SW56SHYNPR95", nil},
+		{"969500HMVSZ0TCV65D58", nil},
+		{"213800D1EI4B9WTWWD28", nil},
+		{"8156005391EE905D3124", nil},
+		{"7LTWFZYICNSX8D621K86", nil},
+
+		{"HWUPKR0MPOU8FGXBT39", errInvalidLEILength20},
+		{"HWUPKR0MPOU8FGXBT3945", errInvalidLEILength20},
+		{"HWUPKR0MPOU8FGXBT3X4", errInvalidLEILastSymbols},
+		{"HWUPKR0MPOU8FGXBT395", errInvalidLEICheckDigits},
+		{"HWUPKR0MPOU8FGXBT349", errInvalidLEICheckDigits},
+		{"HWUPKR0MPOU8FGXBTX94", errInvalidLEICheckDigits},
+		{"hWUPKR0MPOU8FGXBT394", errInvalidLEI},
+		{"HWUPKR0MPOU8FG-BT394", errInvalidLEI},
+	}
+
+	for _, tt := range tests {
+		err := LEI(tt.lei).Validate()
+
+		if tt.err == nil && err != nil || tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("LEI.Validate('%v'): expected %v, actual %v", tt.lei, tt.err, err)
+		}
+	}
+}
+
+func TestLEICalculateCheckDigits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		lei    string
+		digits byte
+		valid  bool
+	}{
+		{"HWUPKR0MPOU8FGXBT3", 94, true},
+		{"HWUPKR0MPOU8FGXBT394", 94, true},
+		{"529900G3SW56SHYNPR", 95, true},
+		{"7LTWFZYICNSX8D621K", 86, true},
+		{"8156005391EE905D31", 24, true},
+		{"HWUPKR0MPOU8FGXBT", 0, false},
+		{"HWUPKR0MPOU8FGXBT*", 0, false},
+	}
+
+	for _, tt := range tests {
+		d, err := LEI(tt.lei).CalculateCheckDigits()
+		if act := err == nil; act != tt.valid {
+			t.Errorf("LEI.CalculateCheckDigits('%v'): expected valid %v, actual %v (%v)", tt.lei, tt.valid, act, err)
+		}
+
+		if d != tt.digits {
+			t.Errorf("LEI.CalculateCheckDigits('%v'): expected %v, actual %v", tt.lei, tt.digits, d)
+		}
+	}
+}
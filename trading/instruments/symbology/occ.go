@@ -0,0 +1,208 @@
+package symbology
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments/contracts/rights"
+	"mbg/trading/markets/mics"
+)
+
+// OCC is a 21-character option symbol of the Options Clearing Corporation defined by
+// the Options Symbology Initiative (OSI) for the US listed options.
+// See https://en.wikipedia.org/wiki/Option_symbol.
+//
+// OCC symbols consist of four parts:
+// the root symbol of the underlying padded with spaces to six characters,
+// the expiry date in the YYMMDD format, the letter C for a call or P for a put,
+// and the strike price multiplied by 1000 padded with leading zeros to eight digits.
+//
+// For example, “AAPL  211119C00150000“ is the Apple call option expiring on November 19, 2021
+// with the strike price 150, and “SPXW  231215P04512500“ is the weekly S&P 500 index put option
+// expiring on December 15, 2023 with the strike price 4512.5.
+//
+// OCC symbols have no check digit. The root of adjusted options may end with a digit, e.g. AAPL1.
+type OCC string
+
+// OCCOption is a decoded OCC option symbol.
+type OCCOption struct {
+	// Root is the root symbol of the option, e.g. AAPL or SPXW.
+	Root string
+
+	// Expiry is the expiry date at midnight in UTC.
+	Expiry time.Time
+
+	// Right is the right of the option.
+	Right rights.Right
+
+	// Strike is the strike price of the option with at most three decimal places.
+	Strike float64
+}
+
+const (
+	occLength        = 21
+	occRootLength    = 6
+	occSuffixLength  = occLength - occRootLength
+	occDateLength    = 6
+	occStrikeLength  = 8
+	occStrikeFactor  = 1000
+	occMaxStrike     = 99999999
+	occDateLayout    = "060102"
+	occCenturyLayout = "20060102"
+	occCentury       = "20"
+	occCall          = 'C'
+	occPut           = 'P'
+	occDisplayLayout = "Jan 2 2006"
+)
+
+var (
+	errInvalidOCC       = errors.New("invalid OCC option symbol")
+	errInvalidOCCLength = fmt.Errorf("length should be 21 symbols: %w", errInvalidOCC)
+	errInvalidOCCRoot   = fmt.Errorf(
+		"root should have 1 to 6 upper case letters or digits padded with spaces: %w", errInvalidOCC)
+	errInvalidOCCExpiry = fmt.Errorf("expiry should be a date in YYMMDD format: %w", errInvalidOCC)
+	errInvalidOCCRight  = fmt.Errorf("right should be C or P: %w", errInvalidOCC)
+	errInvalidOCCStrike = fmt.Errorf("strike should be 8 digits: %w", errInvalidOCC)
+	errInvalidOCCPrice  = fmt.Errorf(
+		"strike price should be non-negative and less than 100000 with at most 3 decimals: %w", errInvalidOCC)
+	errInvalidOCCYear = fmt.Errorf("expiry year should be from 2000 to 2099: %w", errInvalidOCC)
+)
+
+// Validate valudates the OCC option symbol in the standard 21-character form.
+func (occ OCC) Validate() error {
+	if len(occ) != occLength {
+		return errInvalidOCCLength
+	}
+
+	_, err := occ.Parse()
+
+	return err
+}
+
+// Parse decodes the OCC option symbol.
+//
+// Besides the standard 21-character form, the compact form without the padding spaces
+// is accepted, e.g. AAPL211119C00150000.
+func (occ OCC) Parse() (OCCOption, error) {
+	if len(occ) <= occSuffixLength || len(occ) > occLength {
+		return OCCOption{}, errInvalidOCCLength
+	}
+
+	i := len(occ) - occSuffixLength
+
+	root := strings.TrimRight(string(occ[:i]), " ")
+	if !isOCCRoot(root) {
+		return OCCOption{}, errInvalidOCCRoot
+	}
+
+	// The two-digit years are in the 21st century, as the builder accepts the years 2000 to 2099.
+	expiry, err := time.Parse(occCenturyLayout, occCentury+string(occ[i:i+occDateLength]))
+	if err != nil {
+		return OCCOption{}, errInvalidOCCExpiry
+	}
+
+	i += occDateLength
+
+	var right rights.Right
+
+	switch occ[i] {
+	case occCall:
+		right = rights.Call
+	case occPut:
+		right = rights.Put
+	default:
+		return OCCOption{}, errInvalidOCCRight
+	}
+
+	i++
+
+	s := string(occ[i:])
+	for j := 0; j < occStrikeLength; j++ {
+		if s[j] < '0' || s[j] > '9' {
+			return OCCOption{}, errInvalidOCCStrike
+		}
+	}
+
+	strike, _ := strconv.Atoi(s)
+
+	return OCCOption{Root: root, Expiry: expiry, Right: right, Strike: float64(strike) / occStrikeFactor}, nil
+}
+
+// OCC builds the standard 21-character OCC option symbol.
+func (o OCCOption) OCC() (OCC, error) {
+	if !isOCCRoot(o.Root) {
+		return "", errInvalidOCCRoot
+	}
+
+	if y := o.Expiry.Year(); y < 2000 || y > 2099 {
+		return "", errInvalidOCCYear
+	}
+
+	var r byte
+
+	switch o.Right {
+	case rights.Call:
+		r = occCall
+	case rights.Put:
+		r = occPut
+	default:
+		return "", fmt.Errorf("%v: %w", o.Right, errInvalidOCCRight)
+	}
+
+	k := o.Strike * occStrikeFactor
+
+	n := math.Round(k)
+	if o.Strike < 0 || n > occMaxStrike || math.Abs(k-n) > 1e-6 { //nolint:gomnd
+		return "", fmt.Errorf("%v: %w", o.Strike, errInvalidOCCPrice)
+	}
+
+	return OCC(fmt.Sprintf("%-6s%s%c%08d", o.Root, o.Expiry.Format(occDateLayout), r, int64(n))), nil
+}
+
+// Name returns the display name of the option, e.g. AAPL Nov 19 2021 150 Call.
+func (o OCCOption) Name() string {
+	r := "Call"
+	if o.Right == rights.Put {
+		r = "Put"
+	}
+
+	return fmt.Sprintf("%s %s %s %s", o.Root, o.Expiry.Format(occDisplayLayout),
+		strconv.FormatFloat(o.Strike, 'f', -1, 64), r)
+}
+
+// ExpiryIn returns the expiry date at a given local wall clock time of an exchange,
+// e.g. 15:00 for the close of the last trading day of the US equity options on the XCBO in Chicago.
+func (o OCCOption) ExpiryIn(mic mics.MIC, hour, minute int) time.Time {
+	y, m, d := o.Expiry.Date()
+
+	return time.Date(y, m, d, hour, minute, 0, 0, mic.Location())
+}
+
+// StrikePrice returns the strike price as an amount of money in the currency of the underlying,
+// rounded half to even to the number of decimals of the currency.
+//
+// The third decimal place of a strike is lost in the currencies with two decimals,
+// e.g. the strike 12.375 is 12.38 USD. The Strike field keeps the exact strike price.
+func (o OCCOption) StrikePrice(currency currencies.Currency) currencies.Money {
+	return currencies.NewMoney(o.Strike, currency)
+}
+
+func isOCCRoot(root string) bool {
+	if len(root) < 1 || len(root) > occRootLength {
+		return false
+	}
+
+	for i := 0; i < len(root); i++ {
+		if b := root[i]; (b < 'A' || b > 'Z') && (b < '0' || b > '9') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,20 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"testing"
+)
+
+func BenchmarkParseOCC(b *testing.B) {
+	occ := OCC("AAPL  211119C00150000")
+	for i := 0; i < b.N; i++ {
+		_, _ = occ.Parse()
+	}
+}
+
+func BenchmarkBuildOCC(b *testing.B) {
+	opt, _ := OCC("AAPL  211119C00150000").Parse()
+	for i := 0; i < b.N; i++ {
+		_, _ = opt.OCC()
+	}
+}
@@ -0,0 +1,158 @@
+//nolint:testpackage
+package symbology
+
+//nolint:gofumpt
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments/contracts/rights"
+	"mbg/trading/markets/mics"
+)
+
+func TestOCCParse(t *testing.T) {
+	t.Parallel()
+
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		occ string
+		opt OCCOption
+		err error
+	}{
+		{"AAPL  211119C00150000", OCCOption{"AAPL", date(2021, time.November, 19), rights.Call, 150}, nil},
+		{"SPXW  231215P04512500", OCCOption{"SPXW", date(2023, time.December, 15), rights.Put, 4512.5}, nil},
+		{"AAPL1 220121C00002500", OCCOption{"AAPL1", date(2022, time.January, 21), rights.Call, 2.5}, nil},
+		{"F     240119P00012125", OCCOption{"F", date(2024, time.January, 19), rights.Put, 12.125}, nil},
+		{"GOOGLE240119C00000000", OCCOption{"GOOGLE", date(2024, time.January, 19), rights.Call, 0}, nil},
+		{"AAPL211119C00150000", OCCOption{"AAPL", date(2021, time.November, 19), rights.Call, 150}, nil},
+		{"AAPL  700117C00150000", OCCOption{"AAPL", date(2070, time.January, 17), rights.Call, 150}, nil},
+		{"AAPL  991217P00150000", OCCOption{"AAPL", date(2099, time.December, 17), rights.Put, 150}, nil},
+		{"AAPL  000121C00150000", OCCOption{"AAPL", date(2000, time.January, 21), rights.Call, 150}, nil},
+		{"211119C00150000", OCCOption{}, errInvalidOCCLength},
+		{"AAPLXYZ211119C00150000", OCCOption{}, errInvalidOCCLength},
+		{"aapl  211119C00150000", OCCOption{}, errInvalidOCCRoot},
+		{" AAPL 211119C00150000", OCCOption{}, errInvalidOCCRoot},
+		{"AAPL  211131C00150000", OCCOption{}, errInvalidOCCExpiry},
+		{"AAPL  21111XC00150000", OCCOption{}, errInvalidOCCExpiry},
+		{"AAPL  211119X00150000", OCCOption{}, errInvalidOCCRight},
+		{"AAPL  211119C0015000X", OCCOption{}, errInvalidOCCStrike},
+		{"AAPL  211119C-0150000", OCCOption{}, errInvalidOCCStrike},
+	}
+
+	for _, tt := range tests {
+		opt, err := OCC(tt.occ).Parse()
+		if opt != tt.opt || !errors.Is(err, tt.err) {
+			t.Errorf("OCC.Parse('%v'): expected %v (%v), actual %v (%v)", tt.occ, tt.opt, tt.err, opt, err)
+		}
+	}
+}
+
+func TestOCCValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		occ string
+		err error
+	}{
+		{"AAPL  211119C00150000", nil},
+		{"SPXW  231215P04512500", nil},
+		{"AAPL211119C00150000", errInvalidOCCLength},
+		{"AAPL  211119Q00150000", errInvalidOCCRight},
+	}
+
+	for _, tt := range tests {
+		if err := OCC(tt.occ).Validate(); !errors.Is(err, tt.err) {
+			t.Errorf("OCC.Validate('%v'): expected %v, actual %v", tt.occ, tt.err, err)
+		}
+	}
+}
+
+func TestOCCOptionOCC(t *testing.T) {
+	t.Parallel()
+
+	expiry := time.Date(2021, time.November, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		opt OCCOption
+		occ OCC
+		err error
+	}{
+		{OCCOption{"AAPL", expiry, rights.Call, 150}, "AAPL  211119C00150000", nil},
+		{OCCOption{"SPXW", expiry, rights.Put, 4512.5}, "SPXW  211119P04512500", nil},
+		{OCCOption{"F", expiry, rights.Put, 12.125}, "F     211119P00012125", nil},
+		{OCCOption{"GOOGLE", expiry, rights.Call, 99999.999}, "GOOGLE211119C99999999", nil},
+		{OCCOption{"AAPL", expiry.AddDate(49, 0, 0), rights.Call, 150}, "AAPL  701119C00150000", nil},
+		{OCCOption{"AAPL", expiry.AddDate(78, 0, 0), rights.Call, 150}, "AAPL  991119C00150000", nil},
+		{OCCOption{"", expiry, rights.Call, 150}, "", errInvalidOCCRoot},
+		{OCCOption{"GOOGLEX", expiry, rights.Call, 150}, "", errInvalidOCCRoot},
+		{OCCOption{"AAPL", expiry.AddDate(-30, 0, 0), rights.Call, 150}, "", errInvalidOCCYear},
+		{OCCOption{"AAPL", expiry, rights.Right(0), 150}, "", errInvalidOCCRight},
+		{OCCOption{"AAPL", expiry, rights.Call, -1}, "", errInvalidOCCPrice},
+		{OCCOption{"AAPL", expiry, rights.Call, 100000}, "", errInvalidOCCPrice},
+		{OCCOption{"AAPL", expiry, rights.Call, 150.0001}, "", errInvalidOCCPrice},
+	}
+
+	for _, tt := range tests {
+		occ, err := tt.opt.OCC()
+		if occ != tt.occ || !errors.Is(err, tt.err) {
+			t.Errorf("OCCOption.OCC(%v): expected '%v' (%v), actual '%v' (%v)", tt.opt, tt.occ, tt.err, occ, err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if opt, err := occ.Parse(); opt != tt.opt || err != nil {
+			t.Errorf("OCC.Parse('%v'): expected round trip %v, actual %v (%v)", occ, tt.opt, opt, err)
+		}
+	}
+}
+
+func TestOCCOptionDisplay(t *testing.T) {
+	t.Parallel()
+
+	opt, _ := OCC("SPXW  231215P04512500").Parse()
+
+	if act := opt.Name(); act != "SPXW Dec 15 2023 4512.5 Put" {
+		t.Errorf("Name(): expected 'SPXW Dec 15 2023 4512.5 Put', actual '%v'", act)
+	}
+
+	if act := opt.StrikePrice(currencies.USD); act.String() != "4512.50 USD" {
+		t.Errorf("StrikePrice(USD): expected 4512.50 USD, actual %v", act)
+	}
+
+	tests := []struct {
+		strike   float64
+		currency currencies.Currency
+		exp      string
+	}{
+		{12.375, currencies.USD, "12.38 USD"},
+		{12.125, currencies.USD, "12.12 USD"},
+		{12.375, currencies.KWD, "12.375 KWD"},
+		{12.375, currencies.JPY, "12 JPY"},
+	}
+
+	for _, tt := range tests {
+		o := OCCOption{Strike: tt.strike}
+		if act := o.StrikePrice(tt.currency); act.String() != tt.exp {
+			t.Errorf("StrikePrice(%v) of %v: expected %v, actual %v", tt.currency, tt.strike, tt.exp, act)
+		}
+	}
+
+	exp := time.Date(2023, time.December, 15, 21, 0, 0, 0, time.UTC)
+	if act := opt.ExpiryIn(mics.XCBO, 15, 0); !act.Equal(exp) || act.Location().String() != "America/Chicago" {
+		t.Errorf("ExpiryIn(XCBO, 15:00): expected %v, actual %v", exp, act)
+	}
+
+	opt, _ = OCC("AAPL  210701C00150000").Parse()
+	if act := opt.ExpiryIn(mics.XNYS, 16, 0); !act.Equal(time.Date(2021, time.July, 1, 20, 0, 0, 0, time.UTC)) {
+		t.Errorf("ExpiryIn(XNYS, 16:00): expected 20:00 UTC in summer, actual %v", act)
+	}
+
+	if act := opt.Name(); act != "AAPL Jul 1 2021 150 Call" {
+		t.Errorf("Name(): expected 'AAPL Jul 1 2021 150 Call', actual '%v'", act)
+	}
+}
@@ -0,0 +1,164 @@
+package symbology
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RIC is a Refinitiv (formerly Reuters) Instrument Code.
+// See https://en.wikipedia.org/wiki/Reuters_Instrument_Code.
+//
+// RICs consist of an optional prefix, a root and an optional exchange suffix separated by a dot.
+// The root is usually the ticker symbol of the security, the suffix denotes the exchange,
+// e.g. “IBM.N“ is IBM on the NYSE, “VOD.L“ is Vodafone on the London Stock Exchange
+// and “SIEGn.DE“ is Siemens on Xetra, where the lower case n denotes registered shares.
+//
+// The prefixes are a dot for indices, e.g. “.SPX“ or “.FTSE“, 0# for chains, e.g. “0#.FTSE“
+// for the constituents of the FTSE 100, and a slash for delayed data, e.g. “/IBM.N“.
+// Other RICs have no exchange suffix, e.g. “EUR=“ for the euro spot rate or “ESH4“ for a future.
+//
+// RICs have no check digit. The RIC is validated to have a non-empty root made of letters,
+// digits and the symbols =#:^_-&!/. and, if present, a suffix of one to four letters.
+type RIC string
+
+const (
+	ricMaxLength       = 32
+	ricMaxSuffixLength = 4
+	ricChainPrefix     = "0#"
+	ricIndexPrefix     = "."
+	ricDelayedPrefix   = "/"
+)
+
+var (
+	errInvalidRIC       = errors.New("invalid RIC")
+	errInvalidRICLength = fmt.Errorf("length should be from 1 to 32 symbols: %w", errInvalidRIC)
+	errInvalidRICRoot   = fmt.Errorf("root should not be empty: %w", errInvalidRIC)
+	errInvalidRICSuffix = fmt.Errorf("exchange suffix should be 1 to 4 letters: %w", errInvalidRIC)
+)
+
+// ricParts are the parts of a RIC.
+type ricParts struct {
+	delayed  bool
+	chain    bool
+	index    bool
+	root     string
+	exchange string
+}
+
+// Validate valudates the RIC.
+func (ric RIC) Validate() error {
+	if len(ric) < 1 || len(ric) > ricMaxLength {
+		return errInvalidRICLength
+	}
+
+	for i := 0; i < len(ric); i++ {
+		if !isRICSymbol(ric[i]) {
+			return fmt.Errorf(
+				"symbol at position %v should be either a letter, a digit or one of =#:^_-&!/.: %w", i, errInvalidRIC)
+		}
+	}
+
+	p, suffix := ric.parse()
+	if p.root == "" {
+		return errInvalidRICRoot
+	}
+
+	if suffix && !isRICSuffix(p.exchange) {
+		return errInvalidRICSuffix
+	}
+
+	return nil
+}
+
+// Root returns the root of the RIC without the prefix and the exchange suffix, e.g. IBM for IBM.N.
+func (ric RIC) Root() string {
+	p, _ := ric.parse()
+
+	return p.root
+}
+
+// Exchange returns the exchange suffix of the RIC, e.g. N for IBM.N, or an empty string if there is none.
+func (ric RIC) Exchange() string {
+	p, _ := ric.parse()
+
+	return p.exchange
+}
+
+// IsIndex indicates if the RIC is an index RIC with the dot prefix, e.g. .SPX.
+func (ric RIC) IsIndex() bool {
+	p, _ := ric.parse()
+
+	return p.index
+}
+
+// IsChain indicates if the RIC is a chain RIC with the 0# prefix, e.g. 0#.FTSE.
+func (ric RIC) IsChain() bool {
+	p, _ := ric.parse()
+
+	return p.chain
+}
+
+// IsDelayed indicates if the RIC denotes the delayed data with the slash prefix, e.g. /IBM.N.
+func (ric RIC) IsDelayed() bool {
+	p, _ := ric.parse()
+
+	return p.delayed
+}
+
+// parse splits the RIC into parts, the boolean indicates if the RIC has a dot before the exchange suffix.
+func (ric RIC) parse() (ricParts, bool) {
+	var p ricParts
+
+	s := string(ric)
+
+	if strings.HasPrefix(s, ricDelayedPrefix) {
+		p.delayed = true
+		s = s[len(ricDelayedPrefix):]
+	}
+
+	if strings.HasPrefix(s, ricChainPrefix) {
+		p.chain = true
+		s = s[len(ricChainPrefix):]
+	}
+
+	if strings.HasPrefix(s, ricIndexPrefix) {
+		p.index = true
+		s = s[len(ricIndexPrefix):]
+	}
+
+	i := strings.LastIndex(s, ".")
+	if i < 0 {
+		p.root = s
+
+		return p, false
+	}
+
+	p.root = s[:i]
+	p.exchange = s[i+1:]
+
+	return p, true
+}
+
+func isRICSymbol(b byte) bool {
+	switch {
+	case b >= '0' && b <= '9', b >= 'A' && b <= 'Z', b >= 'a' && b <= 'z':
+		return true
+	}
+
+	return strings.IndexByte("=#:^_-&!/.", b) >= 0
+}
+
+func isRICSuffix(s string) bool {
+	if len(s) < 1 || len(s) > ricMaxSuffixLength {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
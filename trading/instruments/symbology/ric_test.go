@@ -0,0 +1,90 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRICValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ric string
+		err error
+	}{
+		{"IBM.N", nil},
+		{"VOD.L", nil},
+		{"SIEGn.DE", nil},
+		{"AAPL.OQ", nil},
+		{".SPX", nil},
+		{"0#.FTSE", nil},
+		{"/IBM.N", nil},
+		{"EUR=", nil},
+		{"EURJPY=R", nil},
+		{"ESH4", nil},
+		{"0#ES:", nil},
+		{"", errInvalidRICLength},
+		{"ABCDEFGHIJKLMNOPQRSTUVWXYZABCDEFG", errInvalidRICLength},
+		{"IBM N", errInvalidRIC},
+		{".", errInvalidRICRoot},
+		{"/.N", nil},
+		{"0#", errInvalidRICRoot},
+		{"IBM.", errInvalidRICSuffix},
+		{"IBM.n", errInvalidRICSuffix},
+		{"IBM.ABCDE", errInvalidRICSuffix},
+	}
+
+	for _, tt := range tests {
+		err := RIC(tt.ric).Validate()
+
+		if tt.err == nil && err != nil || tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("RIC.Validate('%v'): expected %v, actual %v", tt.ric, tt.err, err)
+		}
+	}
+}
+
+func TestRICParts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ric      string
+		root     string
+		exchange string
+		index    bool
+		chain    bool
+		delayed  bool
+	}{
+		{"IBM.N", "IBM", "N", false, false, false},
+		{"SIEGn.DE", "SIEGn", "DE", false, false, false},
+		{".SPX", "SPX", "", true, false, false},
+		{"0#.FTSE", "FTSE", "", true, true, false},
+		{"0#AAPL.OQ", "AAPL", "OQ", false, true, false},
+		{"/IBM.N", "IBM", "N", false, false, true},
+		{"EUR=", "EUR=", "", false, false, false},
+	}
+
+	for _, tt := range tests {
+		r := RIC(tt.ric)
+
+		if act := r.Root(); act != tt.root {
+			t.Errorf("RIC.Root('%v'): expected '%v', actual '%v'", tt.ric, tt.root, act)
+		}
+
+		if act := r.Exchange(); act != tt.exchange {
+			t.Errorf("RIC.Exchange('%v'): expected '%v', actual '%v'", tt.ric, tt.exchange, act)
+		}
+
+		if act := r.IsIndex(); act != tt.index {
+			t.Errorf("RIC.IsIndex('%v'): expected %v, actual %v", tt.ric, tt.index, act)
+		}
+
+		if act := r.IsChain(); act != tt.chain {
+			t.Errorf("RIC.IsChain('%v'): expected %v, actual %v", tt.ric, tt.chain, act)
+		}
+
+		if act := r.IsDelayed(); act != tt.delayed {
+			t.Errorf("RIC.IsDelayed('%v'): expected %v, actual %v", tt.ric, tt.delayed, act)
+		}
+	}
+}
@@ -53,6 +53,7 @@ const (
 	sedolUserDefined          = 1
 	sedolOldStyle             = 2
 	sedolNewStyle             = 3
+	sedolISINPrefix           = "00"
 )
 
 var (
@@ -61,6 +62,7 @@ var (
 	errInvalidSEDOLLength6    = fmt.Errorf("length should be at least 6 symbols: %w", errInvalidSEDOL)
 	errInvalidSEDOLLastSymbol = fmt.Errorf("last symbol should be a digit 0-9: %w", errInvalidSEDOL)
 	errInvalidSEDOLCheckDigit = fmt.Errorf("invalid check digit (last symbol): %w", errInvalidSEDOL)
+	errInvalidSEDOLCountry    = fmt.Errorf("ISIN country code should be GB, IE, GG, JE or IM: %w", errInvalidSEDOL)
 )
 
 // Validate valudates the SEDOL.
@@ -88,6 +90,28 @@ func (sedol SEDOL) Validate() error {
 	return nil
 }
 
+// ToISIN converts the SEDOL to the ISIN with a given country code, GB, IE, GG, JE or IM,
+// by padding it with two leading zeros.
+//
+// The SEDOL is validated, the ISIN check digit is calculated.
+func (sedol SEDOL) ToISIN(country string) (ISIN, error) {
+	switch country {
+	case "GB", "IE", "GG", "JE", "IM":
+	default:
+		return "", errInvalidSEDOLCountry
+	}
+
+	if len(sedol) != sedolLength {
+		return "", errInvalidSEDOLLength7
+	}
+
+	if err := sedol.Validate(); err != nil {
+		return "", err
+	}
+
+	return withCheckDigit(ISIN(country + sedolISINPrefix + string(sedol)))
+}
+
 //nolint:cyclop
 // CalculateCheckDigit calculates a check digit of the SEDOL.
 func (sedol SEDOL) CalculateCheckDigit() (byte, error) {
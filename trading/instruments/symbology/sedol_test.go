@@ -2,6 +2,7 @@
 package symbology
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -3307,3 +3308,31 @@ func TestSEDOLCalculateCheckDigit(t *testing.T) {
 		}
 	}
 }
+
+func TestSEDOLToISIN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		sedol   string
+		country string
+		isin    ISIN
+		err     error
+	}{
+		{"0263494", "GB", "GB0002634946", nil},
+		{"BH4HKS3", "GB", "GB00BH4HKS39", nil},
+		{"BYTBXV3", "IE", "IE00BYTBXV33", nil},
+		{"B4T3BW6", "JE", "JE00B4T3BW64", nil},
+		{"0263494", "US", "", errInvalidSEDOLCountry},
+		{"026349", "GB", "", errInvalidSEDOLLength7},
+		{"02634940", "GB", "", errInvalidSEDOLLength7},
+		{"0263495", "GB", "", errInvalidSEDOLCheckDigit},
+	}
+
+	for _, tt := range tests {
+		isin, err := SEDOL(tt.sedol).ToISIN(tt.country)
+		if isin != tt.isin || !errors.Is(err, tt.err) {
+			t.Errorf("SEDOL.ToISIN('%v', %v): expected '%v' (%v), actual '%v' (%v)",
+				tt.sedol, tt.country, tt.isin, tt.err, isin, err)
+		}
+	}
+}
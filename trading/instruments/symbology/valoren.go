@@ -0,0 +1,69 @@
+package symbology
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Valoren (Valorennummer, valor number) is a Swiss securities identification number
+// assigned by SIX Financial Information, which serves as the NSIN for securities
+// issued in Switzerland and Liechtenstein.
+// See https://en.wikipedia.org/wiki/Valoren_number.
+//
+// Valor numbers consist of up to nine digits. Unlike other identifiers, the valor number has no check digit.
+//
+// Swiss and Liechtenstein ISINs are made of the country code CH or LI, the valor number
+// padded with leading zeros to nine digits and the ISIN check digit, e.g. the valor number
+// of Nestlé registered shares is “3886335“ and the ISIN is “CH0038863350“.
+type Valoren string
+
+const (
+	valorenMaxLength = 9
+)
+
+var (
+	errInvalidValoren        = errors.New("invalid valor number")
+	errInvalidValorenLength  = fmt.Errorf("length should be from 1 to 9 digits: %w", errInvalidValoren)
+	errInvalidValorenZero    = fmt.Errorf("should not be zero: %w", errInvalidValoren)
+	errInvalidValorenCountry = fmt.Errorf("ISIN country code should be CH or LI: %w", errInvalidValoren)
+)
+
+// Validate valudates the valor number.
+func (valoren Valoren) Validate() error {
+	if len(valoren) < 1 || len(valoren) > valorenMaxLength {
+		return errInvalidValorenLength
+	}
+
+	zero := true
+
+	for i := 0; i < len(valoren); i++ {
+		b := valoren[i]
+		if b < '0' || b > '9' {
+			return fmt.Errorf("symbol at position %v should be a digit 0-9: %w", i, errInvalidValoren)
+		}
+
+		if b != '0' {
+			zero = false
+		}
+	}
+
+	if zero {
+		return errInvalidValorenZero
+	}
+
+	return nil
+}
+
+// ToISIN converts the valor number to the ISIN with a given country code, CH or LI.
+func (valoren Valoren) ToISIN(country string) (ISIN, error) {
+	if country != "CH" && country != "LI" {
+		return "", errInvalidValorenCountry
+	}
+
+	if err := valoren.Validate(); err != nil {
+		return "", err
+	}
+
+	return withCheckDigit(ISIN(country + strings.Repeat("0", valorenMaxLength-len(valoren)) + string(valoren)))
+}
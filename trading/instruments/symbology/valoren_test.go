@@ -0,0 +1,63 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValorenValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		valoren string
+		err     error
+	}{
+		{"3886335", nil},
+		{"24476758", nil},
+		{"1", nil},
+		{"123456789", nil},
+		{"", errInvalidValorenLength},
+		{"1234567890", errInvalidValorenLength},
+		{"000", errInvalidValorenZero},
+		{"38863A5", errInvalidValoren},
+	}
+
+	for _, tt := range tests {
+		err := Valoren(tt.valoren).Validate()
+
+		if tt.err == nil && err != nil || tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("Valoren.Validate('%v'): expected %v, actual %v", tt.valoren, tt.err, err)
+		}
+	}
+}
+
+func TestValorenISIN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		valoren string
+		country string
+		isin    ISIN
+		err     error
+	}{
+		{"3886335", "CH", "CH0038863350", nil},  // Nestlé.
+		{"1200526", "CH", "CH0012005267", nil},  // Novartis.
+		{"24476758", "CH", "CH0244767585", nil}, // UBS.
+		{"3886335", "DE", "", errInvalidValorenCountry},
+		{"", "CH", "", errInvalidValorenLength},
+	}
+
+	for _, tt := range tests {
+		isin, err := Valoren(tt.valoren).ToISIN(tt.country)
+		if isin != tt.isin || !errors.Is(err, tt.err) {
+			t.Errorf("Valoren.ToISIN('%v', %v): expected '%v' (%v), actual '%v' (%v)",
+				tt.valoren, tt.country, tt.isin, tt.err, isin, err)
+		}
+	}
+
+	isin, _ := Valoren("3886335").ToISIN("LI")
+	if err := isin.Validate(); err != nil || isin[:2] != "LI" {
+		t.Errorf("Valoren.ToISIN('3886335', LI): expected valid LI ISIN, actual '%v' (%v)", isin, err)
+	}
+}
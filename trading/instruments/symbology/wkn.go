@@ -0,0 +1,57 @@
+package symbology
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WKN (Wertpapierkennnummer) is a German securities identification number
+// assigned by the WM Datenservice, which serves as the NSIN for securities issued in Germany.
+// See https://en.wikipedia.org/wiki/Wertpapierkennnummer.
+//
+// WKNs consist of six digits or upper case letters, the letters I and O are not used
+// to avoid a confusion with the digits 1 and 0. Unlike other identifiers, the WKN has no check digit.
+//
+// German ISINs are made of the country code DE, three zeros, the WKN and the ISIN check digit,
+// e.g. the WKN of Siemens common shares is “723610“ and the ISIN is “DE0007236101“.
+type WKN string
+
+const (
+	wknLength     = 6
+	wknISINPrefix = "DE000"
+)
+
+var (
+	errInvalidWKN        = errors.New("invalid WKN")
+	errInvalidWKNLength6 = fmt.Errorf("length should be 6 symbols: %w", errInvalidWKN)
+)
+
+// Validate valudates the WKN.
+func (wkn WKN) Validate() error {
+	if len(wkn) != wknLength {
+		return errInvalidWKNLength6
+	}
+
+	for i := 0; i < wknLength; i++ {
+		b := wkn[i]
+		if (b < '0' || b > '9') && (b < 'A' || b > 'Z') {
+			return fmt.Errorf(
+				"symbol at position %v should be either a digit 0-9 or a letter A-Z: %w", i, errInvalidWKN)
+		}
+
+		if b == 'I' || b == 'O' {
+			return fmt.Errorf("symbol at position %v should not be a letter I or O: %w", i, errInvalidWKN)
+		}
+	}
+
+	return nil
+}
+
+// ToISIN converts the WKN to the German ISIN.
+func (wkn WKN) ToISIN() (ISIN, error) {
+	if err := wkn.Validate(); err != nil {
+		return "", err
+	}
+
+	return withCheckDigit(ISIN(wknISINPrefix + string(wkn)))
+}
@@ -0,0 +1,61 @@
+//nolint:testpackage
+package symbology
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWKNValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		wkn string
+		err error
+	}{
+		{"723610", nil},
+		{"840400", nil},
+		{"A1EWWW", nil},
+		{"A0D9PT", nil},
+		{"72361", errInvalidWKNLength6},
+		{"7236100", errInvalidWKNLength6},
+		{"A1EWWI", errInvalidWKN},
+		{"A0D9OT", errInvalidWKN},
+		{"a1ewww", errInvalidWKN},
+		{"7236-0", errInvalidWKN},
+	}
+
+	for _, tt := range tests {
+		err := WKN(tt.wkn).Validate()
+
+		if tt.err == nil && err != nil || tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("WKN.Validate('%v'): expected %v, actual %v", tt.wkn, tt.err, err)
+		}
+	}
+}
+
+func TestWKNISIN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		wkn  string
+		isin ISIN
+	}{
+		{"723610", "DE0007236101"}, // Siemens.
+		{"840400", "DE0008404005"}, // Allianz.
+		{"716460", "DE0007164600"}, // SAP.
+		{"A1EWWW", "DE000A1EWWW0"}, // Adidas.
+		{"72361", ""},
+	}
+
+	for _, tt := range tests {
+		isin, err := WKN(tt.wkn).ToISIN()
+		if isin != tt.isin {
+			t.Errorf("WKN.ToISIN('%v'): expected '%v', actual '%v' (%v)", tt.wkn, tt.isin, isin, err)
+		}
+
+		if isin != "" && isin.Validate() != nil {
+			t.Errorf("WKN.ToISIN('%v'): invalid ISIN '%v'", tt.wkn, isin)
+		}
+	}
+}
@@ -38,6 +38,14 @@ const (
 
 	// Crypto is a crypto currency instrument.
 	Crypto
+
+	// Future is a futures contract, an agreement to buy or sell an underlying asset
+	// at a predetermined price on a specified expiry date.
+	Future
+
+	// Option is an options contract, a right but not an obligation to buy (call) or sell (put)
+	// an underlying asset at a strike price on or before a specified expiry date.
+	Option
 	last
 )
 
@@ -51,6 +59,8 @@ const (
 	etc       = "etc"
 	forex     = "forex"
 	crypto    = "crypto"
+	future    = "future"
+	option    = "option"
 )
 
 var errUnknownInstrumentType = errors.New("unknown instrument type")
@@ -75,6 +85,10 @@ func (t InstrumentType) String() string {
 		return forex
 	case Crypto:
 		return crypto
+	case Future:
+		return future
+	case Option:
+		return option
 	default:
 		return unknown
 	}
@@ -122,6 +136,10 @@ func (t *InstrumentType) UnmarshalJSON(data []byte) error {
 		*t = Forex
 	case crypto:
 		*t = Crypto
+	case future:
+		*t = Future
+	case option:
+		*t = Option
 	case undefined:
 		*t = Undefined
 	default:
@@ -20,6 +20,8 @@ func TestString(t *testing.T) {
 		{ETC, etc},
 		{Forex, forex},
 		{Crypto, crypto},
+		{Future, future},
+		{Option, option},
 		{last, unknown},
 		{InstrumentType(0), unknown},
 		{InstrumentType(9999), unknown},
@@ -51,6 +53,8 @@ func TestIsKnown(t *testing.T) {
 		{ETC, true},
 		{Forex, true},
 		{Crypto, true},
+		{Future, true},
+		{Option, true},
 		{last, false},
 		{InstrumentType(0), false},
 		{InstrumentType(9999), false},
@@ -84,6 +88,8 @@ func TestMarshalJSON(t *testing.T) {
 		{ETC, "\"etc\"", true},
 		{Forex, "\"forex\"", true},
 		{Crypto, "\"crypto\"", true},
+		{Future, "\"future\"", true},
+		{Option, "\"option\"", true},
 		{last, nilstr, false},
 		{InstrumentType(9999), nilstr, false},
 		{InstrumentType(-9999), nilstr, false},
@@ -130,6 +136,8 @@ func TestUnmarshalJSON(t *testing.T) {
 		{ETC, "\"etc\"", true},
 		{Forex, "\"forex\"", true},
 		{Crypto, "\"crypto\"", true},
+		{Future, "\"future\"", true},
+		{Option, "\"option\"", true},
 		{zero, "\"unknown\"", false},
 		{zero, "\"foobar\"", false},
 	}
@@ -22,24 +22,29 @@ import (
 	"unicode"
 )
 
-const dataPublicationDate = "10-May-2021"
+const dataPublicationDate = "27-May-2024"
 
 type market struct {
-	country       string
-	countryInc    string // the name of the country from the countries file
-	code          string // ISO 3166 country code
-	mic           string // market segment MIC
-	micOp         string // operating MIC
-	isOperational bool   // if an operational MIC or a segment MIC
-	name          string // name-institution description
-	acronym       string
-	city          string
-	website       string
-	statusDate    string
-	status        string
-	creationDate  string
-	comments      string
-	tzsec         int // seconds east of UTC
+	countryInc         string // the name of the country from the countries file
+	code               string // ISO 3166 country code
+	mic                string // market segment MIC
+	micOp              string // operating MIC
+	isOperational      bool   // if an operational MIC or a segment MIC
+	name               string // market name-institution description
+	legalEntity        string // legal entity name
+	lei                string // legal entity identifier
+	category           string // market category code
+	acronym            string
+	city               string
+	website            string
+	status             string
+	creationDate       string // YYYYMMDD
+	lastUpdateDate     string // YYYYMMDD
+	lastValidationDate string // YYYYMMDD
+	expiryDate         string // YYYYMMDD
+	comments           string
+	tzsec              int    // seconds east of UTC
+	tzname             string // IANA time zone name
 }
 
 // Contains an operational MIC with its optional segment MICs.
@@ -54,10 +59,36 @@ type tzmic struct {
 	markets []*market
 }
 
+// Contains an IANA time zone name and MICs located in this time zone.
+type tznamemic struct {
+	tzname  string // IANA time zone name
+	markets []*market
+}
+
 //nolint:misspell
 const cxanMic = "CXAN"
 
-var errUnknownTimezoneCity = errors.New("please add time zone for unknown city")
+const utcZone = "UTC"
+
+// Contains a correction of an ISO record misleading for a predefined MIC.
+type override struct {
+	micOp string // operating MIC the segment MIC belongs to
+	name  string // market name-institution description
+}
+
+// The ISO records overridden by the code generation.
+//
+// GLBX has been reassigned to the EBS FX Spot+ platform in Zurich since the 2024 data releases,
+// but it is widely used as the MIC of the CME Globex electronic platform in Chicago.
+var overrides = map[string]override{
+	"GLBX": {micOp: "XCME", name: "CME GLOBEX"},
+}
+
+var (
+	errUnknownTimezoneCity    = errors.New("please add time zone for unknown city")
+	errUnknownTimezoneCountry = errors.New("please add IANA time zone for unknown country")
+	errUnknownOverride        = errors.New("please check the override of unknown MIC")
+)
 
 func main() {
 	// selected country code slice and country code -> country name map
@@ -72,6 +103,11 @@ func main() {
 		die(err)
 	}
 
+	// correct the misleading ISO records
+	if err := overrideMarkets(ms); err != nil {
+		die(err)
+	}
+
 	// countries included from code generation
 	ics := collectIncludedCountries(ms, cm)
 
@@ -89,7 +125,10 @@ func main() {
 	// markets grouped by seconds east of UTC
 	tzmics := arrangeByTimeZone(ams)
 
-	printMicGo("mics.go", ams, ics, ecs, tzmics)
+	// markets grouped by IANA time zone names
+	tznamemics := arrangeByTimeZoneName(ams)
+
+	printMicGo("mics.go", ams, ics, ecs, tzmics, tznamemics)
 	printMicTestGo("mics_test.go", ams, ics, ecs)
 }
 
@@ -150,7 +189,7 @@ func readMarkets(filename string) ([]*market, error) {
 	r := csv.NewReader(f)
 	r.Comma = ','
 	r.Comment = '#'
-	r.FieldsPerRecord = 13
+	r.FieldsPerRecord = 17
 	r.ReuseRecord = false
 	r.TrimLeadingSpace = true
 
@@ -184,73 +223,125 @@ func readMarkets(filename string) ([]*market, error) {
 
 //nolint:gomnd,funlen,goerr113,cyclop
 func parseMarket(record []string, ln int, filename string) (*market, error) {
-	country := strings.Trim(record[0], "\"")
-	if len(country) < 2 {
-		return nil, fmt.Errorf("'%v' line %v: country should have at least 2 characters, got '%v'", filename, ln, country)
-	}
-
-	code := strings.Trim(record[1], "\"")
-	if len(code) != 2 {
-		return nil, fmt.Errorf("'%v' line %v: ISO 3166 country code should have 2 characters, got '%v'", filename, ln, code)
+	field := func(i int) string {
+		return strings.TrimSpace(record[i])
 	}
 
-	mic := strings.Trim(record[2], "\"")
+	mic := field(0)
 	if len(mic) != 4 {
 		return nil, fmt.Errorf("'%v' line %v: MIC should have 4 characters, got '%v'", filename, ln, mic)
 	}
 
-	micOp := strings.Trim(record[3], "\"")
+	micOp := field(1)
 	if len(micOp) != 4 {
 		return nil, fmt.Errorf("'%v' line %v: operational MIC should have 4 characters, got '%v'", filename, ln, micOp)
 	}
 
-	os := strings.Trim(record[4], "\"")
-	if os != "O" && os != "S" {
-		return nil, fmt.Errorf("'%v' line %v: o/s should be either 'O' or 'S', got '%v'", filename, ln, os)
+	os := field(2)
+	if os != "OPRT" && os != "SGMT" {
+		return nil, fmt.Errorf("'%v' line %v: oprt/sgmt should be either 'OPRT' or 'SGMT', got '%v'", filename, ln, os)
 	}
 
-	if os == "O" && mic != micOp {
+	if os == "OPRT" && mic != micOp {
 		return nil, fmt.Errorf(
 			"'%v' line %v: operational MIC should have both MICs '%v', got '%v' and '%v'",
 			filename, ln, micOp, mic, micOp)
 	}
 
-	if os == "S" && mic == micOp {
+	if os == "SGMT" && mic == micOp {
 		return nil, fmt.Errorf(
 			"'%v' line %v: segment MIC should have different O/S MICs, got equal '%v' MICs", filename, ln, mic)
 	}
 
-	name := strings.Trim(record[5], "\"")
-	acronym := strings.Trim(record[6], "\"")
-	city := strings.Trim(record[7], "\"")
-	website := strings.ToLower(strings.Trim(record[8], "\""))
+	lei := field(5)
+	if lei != "" && len(lei) != 20 {
+		return nil, fmt.Errorf("'%v' line %v: LEI should have 20 characters, got '%v'", filename, ln, lei)
+	}
+
+	category := field(6)
+	if len(category) != 4 {
+		return nil, fmt.Errorf("'%v' line %v: market category code should have 4 characters, got '%v'",
+			filename, ln, category)
+	}
 
-	statusDate := strings.Trim(record[9], "\"")
-	if len(statusDate) < 1 {
-		return nil, fmt.Errorf("'%v' line %v: status date should not be empty", filename, ln)
+	code := field(8)
+	if len(code) != 2 {
+		return nil, fmt.Errorf("'%v' line %v: ISO 3166 country code should have 2 characters, got '%v'", filename, ln, code)
 	}
 
-	status := strings.Trim(record[10], "\"")
+	status := field(11)
 	if len(status) < 1 {
 		return nil, fmt.Errorf("'%v' line %v: status should not be empty", filename, ln)
 	}
 
-	creationDate := strings.Trim(record[11], "\"")
-	if len(creationDate) < 1 {
-		return nil, fmt.Errorf("'%v' line %v: creation date should not be empty", filename, ln)
-	}
+	dates := [4]string{}
+	for i := range dates {
+		d := field(12 + i)
+		if d != "" {
+			if _, err := time.Parse("20060102", d); err != nil {
+				return nil, fmt.Errorf("'%v' line %v: date should be in YYYYMMDD format, got '%v'", filename, ln, d)
+			}
+		} else if i == 0 {
+			return nil, fmt.Errorf("'%v' line %v: creation date should not be empty", filename, ln)
+		}
 
-	comments := ""
-	if len(record) > 12 {
-		comments = strings.Trim(record[12], "\"")
+		dates[i] = d
 	}
 
 	return &market{
-		country, "", code, mic, micOp, os == "O", name, acronym, city, website,
-		statusDate, status, creationDate, comments, 0,
+		code:               code,
+		mic:                mic,
+		micOp:              micOp,
+		isOperational:      os == "OPRT",
+		name:               field(3),
+		legalEntity:        field(4),
+		lei:                lei,
+		category:           category,
+		acronym:            field(7),
+		city:               field(9),
+		website:            strings.ToLower(field(10)),
+		status:             status,
+		creationDate:       dates[0],
+		lastUpdateDate:     dates[1],
+		lastValidationDate: dates[2],
+		expiryDate:         dates[3],
+		comments:           field(16),
 	}, nil
 }
 
+// overrideMarkets replaces the ISO records of the overridden segment MICs with the location
+// and the legal entity of their operating MICs.
+func overrideMarkets(ms []*market) error {
+	mm := make(map[string]*market, len(ms))
+	for _, m := range ms {
+		mm[m.mic] = m
+	}
+
+	for mic, o := range overrides {
+		m, ok := mm[mic]
+		if !ok {
+			return fmt.Errorf("'%v': %w", mic, errUnknownOverride)
+		}
+
+		op, ok := mm[o.micOp]
+		if !ok {
+			return fmt.Errorf("'%v': %w", o.micOp, errUnknownOverride)
+		}
+
+		m.micOp = op.mic
+		m.isOperational = false
+		m.name = o.name
+		m.legalEntity = op.legalEntity
+		m.lei = op.lei
+		m.acronym = ""
+		m.code = op.code
+		m.city = op.city
+		m.website = op.website
+	}
+
+	return nil
+}
+
 //nolint:gomnd,funlen
 func enrichMarkets(ms []*market, cm map[string]string) error {
 	// seconds east of UTC
@@ -262,6 +353,8 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"ABU DHABI":                 3600 * 4,       // United Arab Emirates (GMT+4)
 		"ACCRA":                     0,              // Ghana (GMT)
 		"AHMEDABAD":                 3600*5 + 1800,  // Gujarat, India (GMT+5:30)
+		"AICHI":                     3600 * 9,       // Japan (GMT+9)
+		"ALBERTA":                   3600 * -7,      // Canada (GMT-7)
 		"ALGIERS":                   3600,           // Algeria (GMT+1)
 		"ALMA-ATA":                  3600 * 6,       // Kazakhstan (GMT+6)
 		"ALMATY":                    3600 * 6,       // Kazakhstan (GMT+6)
@@ -269,6 +362,7 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"AMSTERDAM":                 3600,           // The Netherlands (GMT+1)
 		"ANKARA":                    3600 * 3,       // Turkey (GMT+3)
 		"ANTANANARIVO":              3600 * 3,       // Madagascar (GMT+3)
+		"ANTWERPEN":                 3600,           // Belgium (GMT+1)
 		"ASTANA":                    3600 * 6,       // Nur-Sultan, Kazakhstan (GMT+6)
 		"ASTI":                      3600,           // Italy (GMT+1)
 		"ASUNCION":                  3600 * -4,      // Paraguay (GMT-4)
@@ -287,6 +381,7 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"BEIJING":                   3600 * 8,       // China (GMT+8)
 		"BEIRUT":                    3600 * 2,       // Lebanon (GMT+2)
 		"BELGRADE":                  3600,           // Serbia (GMT+1)
+		"BERGAMO":                   3600,           // Italy (GMT+1)
 		"BERGEN":                    3600,           // Norway (GMT+1)
 		"BERLIN":                    3600,           // Germany (GMT+1)
 		"BERMUDA":                   3600 * -4,      // Bermuda (GMT-4)
@@ -300,11 +395,14 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"BOGOTA":                    3600 * -5,      // Colombia (GMT-5)
 		"BOLOGNA":                   3600,           // Italy (GMT+1)
 		"BOSTON":                    3600 * -5,      // MA, USA (GMT-5)
+		"BRADFORD":                  0,              // UK (GMT)
 		"BRATISLAVA":                3600,           // Slovakia (GMT+1)
+		"BREMEN":                    3600,           // Germany (GMT+1)
 		"BRIDGETOWN":                3600 * -5,      // Barbados (GMT-4)
 		"BRUSSELS":                  3600,           // Belgium (GMT+1)
 		"BRYANSTON, SANDTON":        3600 * 2,       // Sandton, South Africa (GMT+2)
 		"BUCHAREST":                 3600 * 2,       // Romania (GMT+2)
+		"BUDAORS":                   3600,           // Hungary (GMT+1)
 		"BUDAPEST":                  3600,           // Hungary (GMT+1)
 		"BUENOS AIRES":              3600 * -5,      // Argentina (GMT-3)
 		"CAIRO":                     3600 * 2,       // Egypt (GMT+2)
@@ -323,6 +421,7 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"COPENHAGEN":                3600,           // Denmark (GMT+1)
 		"CORDOBA":                   3600 * -3,      // Argentina (GMT-3)
 		"CORRIENTES":                3600 * -3,      // Argentina (GMT-3)
+		"CURITIBA":                  3600 * -3,      // Brazil (GMT-3)
 		"CYBERCITY, EBENE":          3600 * 4,       // Mauritius (GMT+4)
 		"DALIAN":                    3600 * 8,       // Liaoning, China (GMT+8)
 		"DAMASCUS":                  3600 * 2,       // Syria (GMT+2)
@@ -339,8 +438,10 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"EBENE CITY":                3600 * 4,       // Mauritius (GMT+4)
 		"EDEN ISLAND":               3600 * 4,       // Seychelles (GMT+4)
 		"EDINBURGH":                 0,              // UK (GMT)
+		"EKATERINBURG":              3600 * 5,       // Russia (GMT+5)
 		"EL SALVADOR":               3600 * -6,      // El Salvador (GMT-6)
 		"ESCH-SUR-ALZETTE":          3600,           // Luxembourg (GMT+1)
+		"ESCHBORN":                  3600,           // Germany (GMT+1)
 		"ESPIRITO SANTO":            3600 * -3,      // Brazil (GMT-3)
 		"ESPOO":                     3600 * 2,       // Finland (GMT+2)
 		"FIAC":                      3600,           // France (GMT+1)
@@ -351,6 +452,8 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"FUKUOKA":                   3600 * 9,       // Japan (GMT+9)
 		"GABORONE":                  3600 * 2,       // Botswana (GMT+2)
 		"GANDHINAGAR":               3600*5 + 1800,  // Gujarat, India (GMT+5:30)
+		"GENEVA":                    3600,           // Switzerland (GMT+1)
+		"GENOVA":                    3600,           // Italy (GMT+1)
 		"GEORGETOWN":                3600 * -4,      // Guyana (GMT-4)
 		"GIBRALTAR":                 3600,           // Gibraltar (GMT+1)
 		"GIFT CITY, GANDHINAGAR":    3600*5 + 1800,  // Gujarat, India (GMT+5:30)
@@ -368,12 +471,17 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"HANOI":                     3600 * 7,       // Hoàn Kiếm, Hanoi, Vietnam (GMT+7)
 		"HARARE":                    3600 * 2,       // Zimbabwe (GMT+2)
 		"HELSINKI":                  3600 * 2,       // Finland (GMT+2)
+		"HIROSHIMA":                 3600 * 9,       // Japan (GMT+9)
 		"HO CHI MINH CITY":          3600 * 7,       // Vietnam (GMT+7)
 		"HONG KONG":                 3600 * 7,       // Hong Kong (GMT+8)
 		"HORSENS":                   3600,           // Denmark (GMT+1)
 		"HOVE":                      0,              // UK (GMT)
+		"ILLINOIS":                  3600 * -6,      // IL, USA (GMT-6)
 		"INDORE MADHYA PRADESH":     3600*5 + 1800,  // Madhya Pradesh, India (GMT+5:30)
+		"ISLAMABAD":                 3600 * 5,       // Pakistan (GMT+5)
 		"ISTANBUL":                  3600 * 3,       // Turkey (GMT+3)
+		"IZMIR":                     3600 * 3,       // Turkey (GMT+3)
+		"JAEN":                      3600,           // Spain (GMT+1)
 		"JAKARTA":                   3600 * 7,       // Indonesia (GMT+7)
 		"JERSEY CITY":               3600 * -5,      // NJ, USA (GMT-5)
 		"JOHANNESBURG":              3600 * 2,       // South Africa (GMT+2)
@@ -389,17 +497,23 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"KINGSTON":                  3600 * -5,      // ON, Canada (GMT-5)
 		"KINGSTOWN":                 3600 * -4,      // Saint Vincent and the Grenadines (GMT-4)
 		"KLAGENFURT AM WOERTHERSEE": 3600,           // Austria (GMT+1)
+		"KOBE":                      3600 * 9,       // Japan (GMT+9)
 		"KONGSVINGER":               3600,           // Norway (GMT+1)
+		"KRAKOW":                    3600,           // Poland (GMT+1)
 		"KUALA LUMPUR":              3600 * 8,       // Federal Territory of Kuala Lumpur, Malaysia (GMT+8)
 		"KUWAIT":                    3600 * 3,       // Kuwait (GMT+3)
 		"KYIV":                      3600 * 2,       // Ukraine (GMT+2)
+		"KYOTO":                     3600 * 9,       // Japan (GMT+9)
 		"LA PAZ":                    3600 * -4,      // Bolivia (GMT-4)
 		"LABUAN":                    3600 * 8,       // Labuan Federal Territory, Malaysia (GMT+8)
 		"LAGOS":                     3600,           // Nigeria (GMT+1)
+		"LAHORE":                    3600 * 5,       // Pakistan (GMT+5)
 		"LANE COVE":                 3600 * 10,      // NSW, Australia (GMT+10)
 		"LAO":                       3600 * 7,       // Laos (GMT+7)
 		"LARNACA":                   3600 * 2,       // Cyprus (GMT+2)
 		"LEIPZIG":                   3600,           // Germany (GMT+1)
+		"LENEXA":                    3600 * -6,      // KS, USA (GMT-6)
+		"LEUVEN":                    3600,           // Belgium (GMT+1)
 		"LIMA":                      3600 * -5,      // Peru (GMT-5)
 		"LIMASSOL":                  3600 * 2,       // Cyprus (GMT+2)
 		"LINZ":                      3600,           // Austria (GMT+1)
@@ -407,6 +521,7 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"LISBON":                    0,              // Portugal (GMT)
 		"LJUBLJANA":                 3600,           // Slovenia (GMT+1)
 		"LONDON":                    0,              // UK (GMT)
+		"LOS ANGELES":               3600 * -8,      // CA, USA (GMT-8)
 		"LUANDA":                    3600,           // Angola (GMT+1)
 		"LUSAKA":                    3600 * 2,       // Zambia (GMT+2)
 		"LUXEMBOURG":                3600,           // Luxembourg (GMT+1)
@@ -424,6 +539,7 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"MELBOURNE":                 3600 * 10,      // Melbourne VIC, Australia (GMT+10)
 		"MENDOZA":                   3600 * -3,      // Mendoza Province, Argentina (GMT-3)
 		"MEXICO":                    3600 * -6,      // CDMX, Mexico (GMT-6)
+		"MIAMI":                     3600 * -5,      // FL, USA (GMT-5)
 		"MILAN":                     3600,           // Italy (GMT+1)
 		"MILANO":                    3600,           // Italy (GMT+1)
 		"MINNEAPOLIS":               3600 * -6,      // MN, USA (GMT-6)
@@ -438,6 +554,7 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"MUMBAI":                    3600*5 + 1800,  // Maharashtra, India (GMT+5:30)
 		"MUNICH":                    3600,           // Germany (GMT+1)
 		"MUSCAT":                    3600 * 4,       // Oman (GMT+4)
+		"N/A":                       0,              // Unknown (GMT)
 		"NABLUS":                    3600 * 2,       // Nablus (GMT+2)
 		"NACKA":                     3600,           // Sweden (GMT+1)
 		"NAGOYA":                    3600 * 9,       // Aichi, Japan (GMT+9)
@@ -446,16 +563,21 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"NASAU":                     3600 * -5,      // The Bahamas (GMT-5)
 		"NEW JERSEY":                3600 * -5,      // USA (GMT-5)
 		"NEW YORK":                  3600 * -5,      // NY, USA (GMT-5)
+		"NEW YORK, NY":              3600 * -5,      // NY, USA (GMT-5)
 		"NEWCASTLE":                 0,              // UK (GMT)
 		"NICOSIA":                   3600 * 2,       // Cyprus (GMT+2)
 		"NICOSIA (LEFKOSIA)":        3600 * 2,       // Cyprus (GMT+2)
+		"NIGITA":                    3600 * 9,       // Niigata, Japan (GMT+9)
+		"NIZHNIY NOVGOROD":          3600 * 3,       // Russia (GMT+3)
 		"NORTH BERGEN":              3600 * -5,      // NJ, USA (GMT-5)
+		"NOT APPLICABLE":            0,              // Unknown (GMT)
 		"NOVOSIBIRSK":               3600 * 7,       // Novosibirsk Oblast, Russia (GMT+7)
 		"NYON":                      3600,           // Switzerland (GMT+1)
 		"ODESSA":                    3600 * 2,       // Odessa Oblast, Ukraine (GMT+2)
 		"OLDENBURG":                 3600,           // Germany (GMT+1)
 		"OSAKA":                     3600 * 9,       // Japan (GMT+9)
 		"OSLO":                      3600,           // Norway (GMT+1)
+		"OSTSTEINBEK":               3600,           // Germany (GMT+1)
 		"PADOVA":                    3600,           // Province of Padua, Italy (GMT+1)
 		"PALMA DE MALLORCA":         3600,           // Spain (GMT+1)
 		"PANAMA":                    3600 * -5,      // Panama (GMT-5)
@@ -463,10 +585,14 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"PASIG CITY":                3600 * 8,       // Metro Manila, Philippines (GMT+8)
 		"PHILADELPHIA":              3600 * -5,      // PA, USA (GMT-5)
 		"PHNOM PENH":                3600 * 7,       // Cambodia (GMT+7)
+		"PHOENIX":                   3600 * -7,      // AZ, USA (GMT-7)
+		"PODGORICA":                 3600,           // Montenegro (GMT+1)
+		"POLOKWANE":                 3600 * 2,       // South Africa (GMT+2)
 		"PORT LOUIS":                3600 * 4,       // Mauritius (GMT+4)
 		"PORT MORESBY":              3600 * 10,      // Papua New Guinea (GMT+10)
 		"PORT OF SPAIN":             3600 * -4,      // Trinidad and Tobago (GMT-4)
 		"PORT VILA":                 3600 * 11,      // Vanuatu (GMT+11)
+		"PORTO":                     0,              // Portugal (GMT)
 		"PRAGUE":                    3600,           // Czechia (GMT+1)
 		"PRAIA":                     3600 * -1,      // Cape Verde (GMT-1)
 		"PRINCETON":                 3600 * -5,      // NJ, USA (GMT-5)
@@ -484,6 +610,7 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"ROMA":                      3600,           // Italy (GMT+1)
 		"ROME":                      3600,           // Italy (GMT+1)
 		"ROSARIO":                   3600 * -3,      // Santa Fe Province, Argentina (GMT-3)
+		"ROSTOV":                    3600 * 3,       // Russia (GMT+3)
 		"S-HERTOGENBOSCH":           3600,           // The Netherlands (GMT+1)
 		"SABADELL":                  3600,           // Spain (GMT+1)
 		"SAINT-PETERSBURG":          3600 * 3,       // Russia (GMT+3)
@@ -492,6 +619,7 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"SAN CARLOS":                3600 * -8,      // CA, USA (GMT-8)
 		"SAN FRANCISCO":             3600 * -8,      // CA, USA (GMT-8)
 		"SAN JOSE":                  3600 * -8,      // CA, USA (GMT-8)
+		"SAN PEDRO SULA":            3600 * -6,      // Honduras (GMT-6)
 		"SANTA FE":                  3600 * -7,      // NM, USA (GMT-7)
 		"SANTANDER":                 3600,           // Spain (GMT+1)
 		"SANTIAGO":                  3600 * -4,      // Chile (GMT-4)
@@ -499,9 +627,13 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"SAO PAULO":                 3600 * -3,      // State of São Paulo, Brazil (GMT-3)
 		"SAPPORO":                   3600 * 9,       // Hokkaido, Japan (GMT+9)
 		"SARAJEVO":                  3600,           // Bosnia and Herzegovina (GMT+1)
+		"SCHWERIN":                  3600,           // Germany (GMT+1)
+		"SEA GIRT":                  3600 * -5,      // NJ, USA (GMT-5)
 		"SEOUL":                     3600 * 9,       // South Korea (GMT+9)
 		"SHANGHAI":                  3600 * 8,       // China (GMT+8)
 		"SHENZHEN":                  3600 * 8,       // Guangdong Province, China (GMT+8)
+		"SHIMONOSEKI":               3600 * 9,       // Japan (GMT+9)
+		"SIBIU":                     3600 * 2,       // Romania (GMT+2)
 		"SILKEBORG":                 3600,           // Denmark (GMT+1)
 		"SINGAPORE":                 3600 * 8,       // Singapore (GMT+8)
 		"SKOPJE":                    3600,           // North Macedonia (GMT+1)
@@ -509,10 +641,12 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"SOFIA":                     3600 * 2,       // Bulgaria (GMT+2)
 		"SPLIT":                     3600,           // Croatia (GMT+1)
 		"ST ALBANS":                 0,              // UK (GMT)
+		"ST JOHN":                   3600 * -4,      // Antigua and Barbuda (GMT-4)
 		"ST.  PETER PORT":           0,              // Guernsey (GMT)
 		"STAMFORD":                  3600 * -5,      // CT, USA (GMT-5)
 		"STOCKHOLM":                 3600,           // Sweden (GMT+1)
 		"STUTTGART":                 3600,           // Germany (GMT+1)
+		"SURABAYA":                  3600 * 7,       // Indonesia (GMT+7)
 		"SUVA":                      3600 * 12,      // Fiji (GMT+12)
 		"SYDNEY":                    3600 * 10,      // NSW, Australia (GMT+10)
 		"TAIPEI":                    3600 * 8,       // Taiwan (GMT+8)
@@ -524,11 +658,13 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"TEHRAN":                    3600*3 + 1800,  // Iran (GMT+3:30)
 		"TEL AVIV":                  3600 * 2,       // Yafo, Israel (GMT+2)
 		"THE HAGUE":                 3600,           // The Netjerlands (GMT+1)
+		"THE WOODLANDS":             3600 * -6,      // TX, USA (GMT-6)
 		"TIRANA":                    3600,           // Albania (GMT+1)
 		"TOKYO":                     3600 * 9,       // Japan (GMT+9)
 		"TORINO":                    3600,           // Italy (GMT+1)
 		"TORONTO":                   3600 * -5,      // ON, Canada (GMT-5)
 		"TORSHAVN":                  0,              // Faroe Islands (GMT)
+		"TRIESEN":                   3600,           // Liechtenstein (GMT+1)
 		"TRIPOLI":                   3600 * 2,       // Libya (GMT+2)
 		"TROMSO":                    3600,           // Norway (GMT+1)
 		"TRONDHEIM":                 3600,           // Norway (GMT+1)
@@ -542,19 +678,23 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		"VALLETTA":                  3600,           // Malta (GMT+1)
 		"VALPARAISO":                3600 * -6,      // IN, USA (GMT-6)
 		"VANCOUVER":                 3600 * -8,      // BC, Canada (GMT-8)
+		"VARAZDIN":                  3600,           // Croatia (GMT+1)
 		"VICTORIA":                  3600 * 10,      // Australia (GMT+10)
+		"VICTORIA FALLS":            3600 * 2,       // Zimbabwe (GMT+2)
 		"VIENNA":                    3600,           // Austria (GMT+1)
 		"VILA":                      3600 * 11,      // Vanuatu (GMT+11)
 		"VILNIUS":                   3600 * 2,       // Lithuania (GMT+2)
+		"VLADIVOSTOK":               3600 * 10,      // Russia (GMT+10)
 		"WARSAW":                    3600,           // Poland (GMT+1)
 		"WARSZAWA":                  3600,           // Poland (GMT+1)
-		"WASHINGTON":                3600 * -8,      // USA (GMT-8)
+		"WASHINGTON":                3600 * -5,      // DC, USA (GMT-5)
 		"WASHINGTON/NEW YORK":       3600 * -5,      // NY, USA (GMT-5)
 		"WELLINGTON":                3600 * 12,      // New Zealand (GMT+12)
 		"WILLEMSTAD":                3600 * -4,      // Curaçao (GMT-4)
 		"WILMINGTON":                3600 * -5,      // DE, USA (GMT-5)
 		"WINDHOEK":                  3600 * 2,       // Namibia (GMT+2)
 		"WINNIPEG":                  3600 * -6,      // MB, Canada (GMT-6)
+		"WINTER PARK":               3600 * -5,      // FL, USA (GMT-5)
 		"WROCLAW":                   3600,           // Poland (GMT+1)
 		"WUXI":                      3600 * 8,       // Jiangsu, China (GMT+8)
 		"YEREVAN":                   3600 * 4,       // Armenia (GMT+4)
@@ -577,11 +717,211 @@ func enrichMarkets(ms []*market, cm map[string]string) error {
 		}
 
 		m.tzsec = t
+
+		z, err := ianaTimeZone(m.code, m.city)
+		if err != nil {
+			return err
+		}
+
+		m.tzname = z
 	}
 
 	return nil
 }
 
+//nolint:funlen
+// ianaTimeZone returns an IANA time zone name of a city in a country.
+// The cities are only needed in countries with several time zones.
+func ianaTimeZone(code, city string) (string, error) {
+	cityZones := map[string]string{
+		"AU MELBOURNE":     "Australia/Melbourne",
+		"CA ALBERTA":       "America/Edmonton",
+		"CA CALGARY":       "America/Edmonton",
+		"CA VANCOUVER":     "America/Vancouver",
+		"CA WINNIPEG":      "America/Winnipeg",
+		"RU EKATERINBURG":  "Asia/Yekaterinburg",
+		"RU NOVOSIBIRSK":   "Asia/Novosibirsk",
+		"RU SAMARA":        "Europe/Samara",
+		"RU VLADIVOSTOK":   "Asia/Vladivostok",
+		"US CHICAGO":       "America/Chicago",
+		"US GLENVIEW":      "America/Chicago",
+		"US GUAYNABO":      "America/Puerto_Rico",
+		"US ILLINOIS":      "America/Chicago",
+		"US KANSAS CITY":   "America/Chicago",
+		"US LENEXA":        "America/Chicago",
+		"US LOS ANGELES":   "America/Los_Angeles",
+		"US MINNEAPOLIS":   "America/Chicago",
+		"US MOORPARK":      "America/Los_Angeles",
+		"US PHOENIX":       "America/Phoenix",
+		"US SAN CARLOS":    "America/Los_Angeles",
+		"US SAN FRANCISCO": "America/Los_Angeles",
+		"US THE WOODLANDS": "America/Chicago",
+		"US WASHINGTON":    "America/New_York",
+	}
+
+	if z, ok := cityZones[code+" "+city]; ok {
+		return z, nil
+	}
+
+	countryZones := map[string]string{
+		"AE": "Asia/Dubai",
+		"AG": "America/Antigua",
+		"AL": "Europe/Tirane",
+		"AM": "Asia/Yerevan",
+		"AO": "Africa/Luanda",
+		"AR": "America/Argentina/Buenos_Aires",
+		"AT": "Europe/Vienna",
+		"AU": "Australia/Sydney",
+		"AZ": "Asia/Baku",
+		"BA": "Europe/Sarajevo",
+		"BB": "America/Barbados",
+		"BD": "Asia/Dhaka",
+		"BE": "Europe/Brussels",
+		"BG": "Europe/Sofia",
+		"BH": "Asia/Bahrain",
+		"BM": "Atlantic/Bermuda",
+		"BO": "America/La_Paz",
+		"BR": "America/Sao_Paulo",
+		"BS": "America/Nassau",
+		"BW": "Africa/Gaborone",
+		"BY": "Europe/Minsk",
+		"CA": "America/Toronto",
+		"CH": "Europe/Zurich",
+		"CI": "Africa/Abidjan",
+		"CL": "America/Santiago",
+		"CM": "Africa/Douala",
+		"CN": "Asia/Shanghai",
+		"CO": "America/Bogota",
+		"CR": "America/Costa_Rica",
+		"CV": "Atlantic/Cape_Verde",
+		"CW": "America/Curacao",
+		"CY": "Asia/Nicosia",
+		"CZ": "Europe/Prague",
+		"DE": "Europe/Berlin",
+		"DK": "Europe/Copenhagen",
+		"DO": "America/Santo_Domingo",
+		"DZ": "Africa/Algiers",
+		"EC": "America/Guayaquil",
+		"EE": "Europe/Tallinn",
+		"EG": "Africa/Cairo",
+		"ES": "Europe/Madrid",
+		"FI": "Europe/Helsinki",
+		"FJ": "Pacific/Fiji",
+		"FO": "Atlantic/Faroe",
+		"FR": "Europe/Paris",
+		"GB": "Europe/London",
+		"GE": "Asia/Tbilisi",
+		"GG": "Europe/Guernsey",
+		"GH": "Africa/Accra",
+		"GI": "Europe/Gibraltar",
+		"GR": "Europe/Athens",
+		"GT": "America/Guatemala",
+		"GY": "America/Guyana",
+		"HK": "Asia/Hong_Kong",
+		"HN": "America/Tegucigalpa",
+		"HR": "Europe/Zagreb",
+		"HU": "Europe/Budapest",
+		"ID": "Asia/Jakarta",
+		"IE": "Europe/Dublin",
+		"IL": "Asia/Jerusalem",
+		"IN": "Asia/Kolkata",
+		"IQ": "Asia/Baghdad",
+		"IR": "Asia/Tehran",
+		"IS": "Atlantic/Reykjavik",
+		"IT": "Europe/Rome",
+		"JM": "America/Jamaica",
+		"JO": "Asia/Amman",
+		"JP": "Asia/Tokyo",
+		"KE": "Africa/Nairobi",
+		"KG": "Asia/Bishkek",
+		"KH": "Asia/Phnom_Penh",
+		"KN": "America/St_Kitts",
+		"KR": "Asia/Seoul",
+		"KW": "Asia/Kuwait",
+		"KY": "America/Cayman",
+		"KZ": "Asia/Almaty",
+		"LA": "Asia/Vientiane",
+		"LB": "Asia/Beirut",
+		"LI": "Europe/Vaduz",
+		"LK": "Asia/Colombo",
+		"LT": "Europe/Vilnius",
+		"LU": "Europe/Luxembourg",
+		"LV": "Europe/Riga",
+		"LY": "Africa/Tripoli",
+		"MA": "Africa/Casablanca",
+		"MD": "Europe/Chisinau",
+		"ME": "Europe/Podgorica",
+		"MG": "Indian/Antananarivo",
+		"MK": "Europe/Skopje",
+		"MN": "Asia/Ulaanbaatar",
+		"MT": "Europe/Malta",
+		"MU": "Indian/Mauritius",
+		"MV": "Indian/Maldives",
+		"MW": "Africa/Blantyre",
+		"MX": "America/Mexico_City",
+		"MY": "Asia/Kuala_Lumpur",
+		"MZ": "Africa/Maputo",
+		"NA": "Africa/Windhoek",
+		"NG": "Africa/Lagos",
+		"NI": "America/Managua",
+		"NL": "Europe/Amsterdam",
+		"NO": "Europe/Oslo",
+		"NP": "Asia/Kathmandu",
+		"NZ": "Pacific/Auckland",
+		"OM": "Asia/Muscat",
+		"PA": "America/Panama",
+		"PE": "America/Lima",
+		"PG": "Pacific/Port_Moresby",
+		"PH": "Asia/Manila",
+		"PK": "Asia/Karachi",
+		"PL": "Europe/Warsaw",
+		"PS": "Asia/Hebron",
+		"PT": "Europe/Lisbon",
+		"PY": "America/Asuncion",
+		"QA": "Asia/Qatar",
+		"RO": "Europe/Bucharest",
+		"RS": "Europe/Belgrade",
+		"RU": "Europe/Moscow",
+		"RW": "Africa/Kigali",
+		"SA": "Asia/Riyadh",
+		"SC": "Indian/Mahe",
+		"SD": "Africa/Khartoum",
+		"SE": "Europe/Stockholm",
+		"SG": "Asia/Singapore",
+		"SI": "Europe/Ljubljana",
+		"SK": "Europe/Bratislava",
+		"SV": "America/El_Salvador",
+		"SY": "Asia/Damascus",
+		"SZ": "Africa/Mbabane",
+		"TH": "Asia/Bangkok",
+		"TN": "Africa/Tunis",
+		"TR": "Europe/Istanbul",
+		"TT": "America/Port_of_Spain",
+		"TW": "Asia/Taipei",
+		"TZ": "Africa/Dar_es_Salaam",
+		"UA": "Europe/Kiev",
+		"UG": "Africa/Kampala",
+		"US": "America/New_York",
+		"UY": "America/Montevideo",
+		"UZ": "Asia/Tashkent",
+		"VC": "America/St_Vincent",
+		"VE": "America/Caracas",
+		"VN": "Asia/Ho_Chi_Minh",
+		"VU": "Pacific/Efate",
+		"ZA": "Africa/Johannesburg",
+		"ZM": "Africa/Lusaka",
+		"ZW": "Africa/Harare",
+		"ZZ": "UTC",
+	}
+
+	z, ok := countryZones[code]
+	if !ok {
+		return "", fmt.Errorf("'%v': %w", code, errUnknownTimezoneCountry)
+	}
+
+	return z, nil
+}
+
 func arrangeByCountry(ms []*market, cs []string) []*mic {
 	ams := []*mic{}
 
@@ -643,6 +983,36 @@ func arrangeByTimeZone(ms []*mic) []*tzmic {
 	return tzmics
 }
 
+func arrangeByTimeZoneName(ms []*mic) []*tznamemic {
+	tzm := map[string][]*market{}
+	tzs := []string{}
+
+	add := func(m *market) {
+		if _, ok := tzm[m.tzname]; !ok {
+			tzs = append(tzs, m.tzname)
+		}
+
+		tzm[m.tzname] = append(tzm[m.tzname], m)
+	}
+
+	for _, m := range ms {
+		add(m.oper)
+
+		for _, s := range m.segs {
+			add(s)
+		}
+	}
+
+	sort.Strings(tzs)
+
+	tznamemics := make([]*tznamemic, 0, len(tzs))
+	for _, t := range tzs {
+		tznamemics = append(tznamemics, &tznamemic{t, tzm[t]})
+	}
+
+	return tznamemics
+}
+
 func collectExcludedCountries(ms []*market, icm map[string]string) []string {
 	type void struct{}
 
@@ -776,7 +1146,7 @@ func printMic(w io.Writer, m *market) {
 
 	printf(w, "\n")
 
-	if m.city != "" && m.city != "ZZ" {
+	if m.city != "" && m.city != "ZZ" && m.city != "N/A" && m.city != "NOT APPLICABLE" {
 		s := strings.Title(strings.ToLower(m.city))
 
 		printf(w, "//\n")
@@ -838,7 +1208,7 @@ func printBuffer(b *bytes.Buffer, filename string, formatSource bool) {
 }
 
 //nolint:funlen
-func printMicGo(filename string, ms []*mic, ics []string, ecs []string, tzmics []*tzmic) {
+func printMicGo(filename string, ms []*mic, ics []string, ecs []string, tzmics []*tzmic, tznamemics []*tznamemic) {
 	var b bytes.Buffer
 
 	printf(&b, "// Code generated by 'go generate'; DO NOT EDIT.\n")
@@ -913,6 +1283,25 @@ func printMicGo(filename string, ms []*mic, ics []string, ecs []string, tzmics [
 	printf(&b, "\t}\n")
 	printf(&b, "}\n\n")
 
+	printf(&b, "//nolint:exhaustive,misspell,funlen\n")
+	printf(&b, "// TimeZone returns an IANA time zone name, e.g. America/New_York, for this predefined MIC.\n")
+	printf(&b, "//\n")
+	printf(&b, "// Returns UTC if this MIC is not predefined.\n")
+	printf(&b, "func (m MIC) TimeZone() string {\n")
+	printf(&b, "\tswitch m {\n")
+
+	for _, t := range tznamemics {
+		if t.tzname != utcZone {
+			printf(&b, "\tcase %v:\n", concatenateMics(t.markets))
+			printf(&b, "\t\treturn \"%v\"\n", t.tzname)
+		}
+	}
+
+	printf(&b, "\tdefault:\n")
+	printf(&b, "\t\treturn \"%v\"\n", utcZone)
+	printf(&b, "\t}\n")
+	printf(&b, "}\n\n")
+
 	printf(&b, "//nolint:misspell\n")
 	printf(&b, "// IsPredefined indicates if this MIC is predefined.\n")
 	printf(&b, "func (m MIC) IsPredefined() bool {\n")
@@ -932,11 +1321,41 @@ func printMicGo(filename string, ms []*mic, ics []string, ecs []string, tzmics [
 	printf(&b, "\tdefault:\n")
 	printf(&b, "\t\treturn false\n")
 	printf(&b, "\t}\n")
+	printf(&b, "}\n\n")
+
+	printf(&b, "//nolint:gochecknoglobals,lll,misspell,dupword\n")
+	printf(&b, "// predefined contains the reference data of the predefined MICs,\n")
+	printf(&b, "// each operational MIC followed by its segment MICs.\n")
+	printf(&b, "var predefined = []metadata{\n")
+
+	for _, m := range ms {
+		printMetadata(&b, m.oper)
+
+		for _, s := range m.segs {
+			printMetadata(&b, s)
+		}
+	}
+
 	printf(&b, "}\n")
 
 	printBuffer(&b, filename, true)
 }
 
+func printMetadata(w io.Writer, m *market) {
+	date := func(s string) string {
+		if s == "" {
+			return "0"
+		}
+
+		return strings.TrimLeft(s, "0")
+	}
+
+	printf(w, "\t{%v, %v, %q, %q, %q, %q, %q, %q, %q, %q, %q, %v, %v, %v, %v, %q},\n",
+		safeMic(m.mic), safeMic(m.micOp), m.name, m.legalEntity, m.lei, m.category, m.acronym, m.code,
+		m.city, m.website, m.status, date(m.creationDate), date(m.lastUpdateDate),
+		date(m.lastValidationDate), date(m.expiryDate), m.comments)
+}
+
 //nolint:funlen
 func printMicTestGo(filename string, ms []*mic, ics []string, ecs []string) {
 	var b bytes.Buffer
@@ -1019,6 +1438,37 @@ func printMicTestGo(filename string, ms []*mic, ics []string, ecs []string) {
 	printf(&b, "\t}\n")
 	printf(&b, "}\n\n")
 
+	printf(&b, "//nolint:funlen\n")
+	printf(&b, "func TestTimeZone(t *testing.T) {\n")
+	printf(&b, "\tt.Parallel()\n\n")
+	printf(&b, "\ttests := []struct {\n")
+	printf(&b, "\t\tmic  MIC\n")
+	printf(&b, "\t\tzone string\n")
+	printf(&b, "\t}{\n")
+	printf(&b, "\t\t{MIC(\"FOO\"), \"%v\"}, // unknown\n", utcZone)
+
+	for _, m := range ms {
+		printf(&b, "\t\t{%v, \"%v\"},\n", safeMic(m.oper.mic), m.oper.tzname)
+
+		for _, s := range m.segs {
+			if s.mic == cxanMic {
+				printf(&b, "//nolint:misspell\n")
+			}
+
+			printf(&b, "\t\t{%v, \"%v\"},\n", safeMic(s.mic), s.tzname)
+		}
+	}
+
+	printf(&b, "\t}\n\n")
+	printf(&b, "\tfor _, tt := range tests {\n")
+	printf(&b, "\t\texp := tt.zone\n")
+	printf(&b, "\t\tact := tt.mic.TimeZone()\n\n")
+	printf(&b, "\t\tif exp != act {\n")
+	printf(&b, "\t\t\tt.Errorf(\"%%v.TimeZone(): expected '%%v', actual '%%v'\", tt.mic, exp, act)\n")
+	printf(&b, "\t\t}\n")
+	printf(&b, "\t}\n")
+	printf(&b, "}\n\n")
+
 	printf(&b, "//nolint:funlen\n")
 	printf(&b, "func TestIsPredefined(t *testing.T) {\n")
 	printf(&b, "\tt.Parallel()\n\n")
@@ -0,0 +1,69 @@
+package mics
+
+import (
+	"sync"
+	"time"
+
+	// Embed the IANA time zone database so that the locations
+	// do not depend on the zoneinfo files of the host.
+	_ "time/tzdata"
+)
+
+//nolint:gochecknoglobals
+var locations sync.Map // map[string]*time.Location
+
+// Location returns the IANA time zone location of this predefined MIC,
+// which observes the daylight saving time, unlike the fixed TimeZoneSeconds offset.
+//
+// Returns time.UTC if this MIC is not predefined.
+func (m MIC) Location() *time.Location {
+	name := m.TimeZone()
+	if l, ok := locations.Load(name); ok {
+		return l.(*time.Location) //nolint:forcetypeassert
+	}
+
+	l, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	l2, _ := locations.LoadOrStore(name, l)
+
+	return l2.(*time.Location) //nolint:forcetypeassert
+}
+
+// In returns a given time in the local time of this MIC.
+func (m MIC) In(t time.Time) time.Time {
+	return t.In(m.Location())
+}
+
+// Offset returns the seconds east of UTC in the local time of this MIC at a given time,
+// including the daylight saving time shift.
+func (m MIC) Offset(t time.Time) int {
+	_, offset := t.In(m.Location()).Zone()
+
+	return offset
+}
+
+// Date returns the midnight of the local calendar date of this MIC at a given time,
+// e.g. the cut-off of a daily bar.
+//
+// The result is in the local time of this MIC.
+func (m MIC) Date(t time.Time) time.Time {
+	l := m.Location()
+	y, mo, d := t.In(l).Date()
+
+	return time.Date(y, mo, d, 0, 0, 0, 0, l)
+}
+
+// Clock returns a given local wall clock time of this MIC on the local calendar date of a given time,
+// e.g. a session boundary.
+//
+// The result is in the local time of this MIC. A wall clock time skipped by a daylight saving time
+// transition is normalized by the time package, see time.Date.
+func (m MIC) Clock(t time.Time, hour, minute, second int) time.Time {
+	l := m.Location()
+	y, mo, d := t.In(l).Date()
+
+	return time.Date(y, mo, d, hour, minute, second, 0, l)
+}
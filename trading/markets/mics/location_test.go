@@ -0,0 +1,109 @@
+//nolint:testpackage
+package mics
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:funlen
+func TestLocation(t *testing.T) {
+	t.Parallel()
+
+	if l := XNYS.Location(); l.String() != "America/New_York" {
+		t.Errorf("XNYS.Location(): expected America/New_York, actual %v", l)
+	}
+
+	for _, m := range []MIC{XCME, GLBX} {
+		if l := m.Location(); l.String() != "America/Chicago" {
+			t.Errorf("%v.Location(): expected America/Chicago, actual %v", m, l)
+		}
+	}
+
+	// FINRA is in Washington, DC, not in the Washington state.
+	if l := FINR.Location(); l.String() != "America/New_York" {
+		t.Errorf("FINR.Location(): expected America/New_York, actual %v", l)
+	}
+
+	if l := MIC("FOO").Location(); l != time.UTC {
+		t.Errorf("FOO.Location(): expected UTC, actual %v", l)
+	}
+
+	if XPAR.Location() != XPAR.Location() {
+		t.Error("XPAR.Location(): expected the same cached location")
+	}
+
+	winter := time.Date(2021, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2021, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		mic    MIC
+		t      time.Time
+		offset int
+	}{
+		{XNYS, winter, -5 * 3600},
+		{XNYS, summer, -4 * 3600},
+		{XCME, winter, -6 * 3600},
+		{XCME, summer, -5 * 3600},
+		{GLBX, winter, -6 * 3600},
+		{GLBX, summer, -5 * 3600},
+		{XLON, winter, 0},
+		{XLON, summer, 3600},
+		{XETR, winter, 3600},
+		{XETR, summer, 2 * 3600},
+		{XASX, winter, 11 * 3600},
+		{XASX, summer, 10 * 3600},
+		{XTKS, winter, 9 * 3600},
+		{XTKS, summer, 9 * 3600},
+		{XNSE, summer, 5*3600 + 1800},
+		{FINR, winter, -5 * 3600},
+		{FINR, summer, -4 * 3600},
+		{MIC("FOO"), summer, 0},
+	}
+
+	for _, tt := range tests {
+		if act := tt.mic.Offset(tt.t); act != tt.offset {
+			t.Errorf("%v.Offset(%v): expected %v, actual %v", tt.mic, tt.t, tt.offset, act)
+		}
+
+		if _, act := tt.mic.In(tt.t).Zone(); act != tt.offset {
+			t.Errorf("%v.In(%v): expected offset %v, actual %v", tt.mic, tt.t, tt.offset, act)
+		}
+	}
+}
+
+func TestLocationDST(t *testing.T) {
+	t.Parallel()
+
+	// The US daylight saving time started on 2021-03-14, Europe on 2021-03-28.
+	// 14:30 UTC is the XNYS open on 2021-03-12 and one hour after the open on 2021-03-15.
+	before := time.Date(2021, 3, 12, 14, 30, 0, 0, time.UTC)
+	after := time.Date(2021, 3, 15, 14, 30, 0, 0, time.UTC)
+
+	if h := XNYS.In(before).Hour(); h != 9 {
+		t.Errorf("XNYS.In(%v): expected hour 9, actual %v", before, h)
+	}
+
+	if h := XNYS.In(after).Hour(); h != 10 {
+		t.Errorf("XNYS.In(%v): expected hour 10, actual %v", after, h)
+	}
+
+	open := XNYS.Clock(after, 9, 30, 0)
+	if exp := time.Date(2021, 3, 15, 13, 30, 0, 0, time.UTC); !open.Equal(exp) {
+		t.Errorf("XNYS.Clock(%v): expected %v, actual %v", after, exp, open)
+	}
+
+	// 02:00 in New York on 2021-03-15 is still 2021-03-14 local.
+	late := time.Date(2021, 3, 15, 2, 0, 0, 0, time.UTC)
+
+	d := XNYS.Date(late)
+	if exp := time.Date(2021, 3, 14, 5, 0, 0, 0, time.UTC); !d.Equal(exp) || d.Location() != XNYS.Location() {
+		t.Errorf("XNYS.Date(%v): expected %v, actual %v", late, exp, d)
+	}
+
+	// The day of the transition is 23 hours long.
+	d = XNYS.Date(time.Date(2021, 3, 14, 12, 0, 0, 0, time.UTC))
+	if next := XNYS.Date(d.Add(25 * time.Hour)); next.Sub(d) != 23*time.Hour {
+		t.Errorf("XNYS.Date(): expected 23 hour day, actual %v", next.Sub(d))
+	}
+}
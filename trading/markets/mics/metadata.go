@@ -0,0 +1,355 @@
+package mics
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Category is an ISO 10383 market category code of a MIC,
+// which specifies the type of the market.
+type Category string
+
+const (
+	// ApprovedPublicationArrangement is a person authorised to provide the service of publishing trade reports.
+	ApprovedPublicationArrangement Category = "APPA"
+
+	// AlternativeTradingSystem is a trading system regulated as a broker-dealer, not as an exchange.
+	AlternativeTradingSystem Category = "ATSS"
+
+	// CryptoAssetServicesProvider is a provider of the crypto-asset services.
+	CryptoAssetServicesProvider Category = "CASP"
+
+	// DesignatedContractMarket is a board of trade designated by the CFTC.
+	DesignatedContractMarket Category = "DCMS"
+
+	// InterDealerQuotationSystem is a system which disseminates quotations of broker-dealers.
+	InterDealerQuotationSystem Category = "IDQS"
+
+	// MultilateralTradingFacility is a multilateral system bringing together multiple third-party
+	// buying and selling interests in financial instruments.
+	MultilateralTradingFacility Category = "MLTF"
+
+	// NotSpecified is a market category which is not specified.
+	NotSpecified Category = "NSPD"
+
+	// OrganisedTradingFacility is a multilateral system, not a regulated market or an MTF,
+	// in which buying and selling interests in non-equity instruments interact.
+	OrganisedTradingFacility Category = "OTFS"
+
+	// OtherCategory is any other market category.
+	OtherCategory Category = "OTHR"
+
+	// RegulatedMarket is a multilateral system operated and/or managed by a market operator
+	// and authorised as a regulated market.
+	RegulatedMarket Category = "RMKT"
+
+	// RecognisedMarketOperator is a market operator recognised by a regulator.
+	RecognisedMarketOperator Category = "RMOS"
+
+	// SwapExecutionFacility is a trading system for swaps registered with the CFTC.
+	SwapExecutionFacility Category = "SEFS"
+
+	// SystematicInternaliser is an investment firm which deals on own account by executing
+	// client orders outside a regulated market, an MTF or an OTF.
+	SystematicInternaliser Category = "SINT"
+
+	// TradeReportingFacility is a facility for reporting the off-exchange trades.
+	TradeReportingFacility Category = "TRFS"
+)
+
+// Status is an ISO 10383 status of a MIC.
+type Status string
+
+const (
+	// StatusActive denotes an active MIC.
+	StatusActive Status = "ACTIVE"
+
+	// StatusUpdated denotes an active MIC updated in the latest data release.
+	StatusUpdated Status = "UPDATED"
+
+	// StatusExpired denotes a MIC which is no longer in use.
+	StatusExpired Status = "EXPIRED"
+)
+
+// metadata is the ISO 10383 reference data of a predefined MIC.
+// The dates are in the YYYYMMDD format, zero means no date.
+type metadata struct {
+	mic         MIC
+	operational MIC
+	name        string
+	legalEntity string
+	lei         string
+	category    Category
+	acronym     string
+	country     string
+	city        string
+	website     string
+	status      Status
+	created     int
+	updated     int
+	validated   int
+	expires     int
+	comments    string
+}
+
+//nolint:gochecknoglobals
+var (
+	predefinedOnce  sync.Once
+	predefinedIndex map[MIC]int
+)
+
+// lookup returns the reference data of this MIC or nil if it is not predefined.
+func (m MIC) lookup() *metadata {
+	predefinedOnce.Do(func() {
+		predefinedIndex = make(map[MIC]int, len(predefined))
+		for i := range predefined {
+			predefinedIndex[predefined[i].mic] = i
+		}
+	})
+
+	if i, ok := predefinedIndex[m]; ok {
+		return &predefined[i]
+	}
+
+	return nil
+}
+
+// Name returns the name of the market or the institution of this predefined MIC.
+//
+// Returns an empty string if this MIC is not predefined.
+func (m MIC) Name() string {
+	if d := m.lookup(); d != nil {
+		return d.name
+	}
+
+	return ""
+}
+
+// LegalEntity returns the legal entity name of the market operator of this predefined MIC.
+//
+// Returns an empty string if this MIC is not predefined or has no legal entity.
+func (m MIC) LegalEntity() string {
+	if d := m.lookup(); d != nil {
+		return d.legalEntity
+	}
+
+	return ""
+}
+
+// LEI returns the ISO 17442 Legal Entity Identifier of the market operator of this predefined MIC.
+//
+// Returns an empty string if this MIC is not predefined or has no LEI.
+func (m MIC) LEI() string {
+	if d := m.lookup(); d != nil {
+		return d.lei
+	}
+
+	return ""
+}
+
+// Category returns the market category code of this predefined MIC.
+//
+// Returns an empty string if this MIC is not predefined.
+func (m MIC) Category() Category {
+	if d := m.lookup(); d != nil {
+		return d.category
+	}
+
+	return ""
+}
+
+// Acronym returns the known acronym of the market of this predefined MIC.
+//
+// Returns an empty string if this MIC is not predefined or has no acronym.
+func (m MIC) Acronym() string {
+	if d := m.lookup(); d != nil {
+		return d.acronym
+	}
+
+	return ""
+}
+
+// Country returns the ISO 3166 alpha-2 country code of this predefined MIC.
+// The code ZZ denotes the markets not bound to a country.
+//
+// Returns an empty string if this MIC is not predefined.
+func (m MIC) Country() string {
+	if d := m.lookup(); d != nil {
+		return d.country
+	}
+
+	return ""
+}
+
+// City returns the city of this predefined MIC in upper case.
+//
+// Returns an empty string if this MIC is not predefined.
+func (m MIC) City() string {
+	if d := m.lookup(); d != nil {
+		return d.city
+	}
+
+	return ""
+}
+
+// Website returns the website of the market of this predefined MIC in lower case.
+//
+// Returns an empty string if this MIC is not predefined or has no website.
+func (m MIC) Website() string {
+	if d := m.lookup(); d != nil {
+		return d.website
+	}
+
+	return ""
+}
+
+// Status returns the status of this predefined MIC.
+//
+// Returns an empty string if this MIC is not predefined.
+func (m MIC) Status() Status {
+	if d := m.lookup(); d != nil {
+		return d.status
+	}
+
+	return ""
+}
+
+// IsActive indicates if this MIC is predefined and is not expired.
+func (m MIC) IsActive() bool {
+	if d := m.lookup(); d != nil {
+		return d.status != StatusExpired
+	}
+
+	return false
+}
+
+// IsOperational indicates if this MIC is a predefined operational MIC, not a segment MIC.
+func (m MIC) IsOperational() bool {
+	if d := m.lookup(); d != nil {
+		return d.operational == m
+	}
+
+	return false
+}
+
+// CreationDate returns the date when this predefined MIC was created.
+//
+// Returns the zero time if this MIC is not predefined.
+func (m MIC) CreationDate() time.Time {
+	if d := m.lookup(); d != nil {
+		return date(d.created)
+	}
+
+	return time.Time{}
+}
+
+// LastUpdateDate returns the date when this predefined MIC was last updated.
+//
+// Returns the zero time if this MIC is not predefined or has no update date.
+func (m MIC) LastUpdateDate() time.Time {
+	if d := m.lookup(); d != nil {
+		return date(d.updated)
+	}
+
+	return time.Time{}
+}
+
+// LastValidationDate returns the date when this predefined MIC was last validated.
+//
+// Returns the zero time if this MIC is not predefined or has no validation date.
+func (m MIC) LastValidationDate() time.Time {
+	if d := m.lookup(); d != nil {
+		return date(d.validated)
+	}
+
+	return time.Time{}
+}
+
+// ExpiryDate returns the date when this predefined MIC expired or expires.
+//
+// Returns the zero time if this MIC is not predefined or has no expiry date.
+func (m MIC) ExpiryDate() time.Time {
+	if d := m.lookup(); d != nil {
+		return date(d.expires)
+	}
+
+	return time.Time{}
+}
+
+// Comments returns the comments on this predefined MIC.
+//
+// Returns an empty string if this MIC is not predefined or has no comments.
+func (m MIC) Comments() string {
+	if d := m.lookup(); d != nil {
+		return d.comments
+	}
+
+	return ""
+}
+
+// Segments returns the predefined segment MICs of this operational MIC.
+//
+// Returns nil if this MIC is not a predefined operational MIC or has no segments.
+func (m MIC) Segments() []MIC {
+	return filter(func(d *metadata) bool { return d.operational == m && d.mic != m })
+}
+
+// All returns all predefined MICs, each operational MIC followed by its segment MICs.
+func All() []MIC {
+	return filter(func(*metadata) bool { return true })
+}
+
+// ByCountry returns the predefined MICs with a given ISO 3166 alpha-2 country code.
+func ByCountry(country string) []MIC {
+	return filter(func(d *metadata) bool { return d.country == country })
+}
+
+// ByCategory returns the predefined MICs with a given market category.
+func ByCategory(c Category) []MIC {
+	return filter(func(d *metadata) bool { return d.category == c })
+}
+
+// ByAcronym returns the predefined MICs with a given acronym, the case is ignored.
+func ByAcronym(acronym string) []MIC {
+	if acronym == "" {
+		return nil
+	}
+
+	return filter(func(d *metadata) bool { return strings.EqualFold(d.acronym, acronym) })
+}
+
+// Active returns the active MICs from a given slice, e.g. from the result of a query.
+func Active(ms []MIC) []MIC {
+	var active []MIC
+
+	for _, m := range ms {
+		if m.IsActive() {
+			active = append(active, m)
+		}
+	}
+
+	return active
+}
+
+func filter(f func(*metadata) bool) []MIC {
+	var ms []MIC
+
+	for i := range predefined {
+		if f(&predefined[i]) {
+			ms = append(ms, predefined[i].mic)
+		}
+	}
+
+	return ms
+}
+
+// date converts a YYYYMMDD date to the midnight UTC, zero is the zero time.
+func date(yyyymmdd int) time.Time {
+	if yyyymmdd == 0 {
+		return time.Time{}
+	}
+
+	//nolint:gomnd
+	return time.Date(yyyymmdd/10000, time.Month(yyyymmdd/100%100), yyyymmdd%100, 0, 0, 0, 0, time.UTC)
+}
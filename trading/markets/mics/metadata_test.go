@@ -0,0 +1,225 @@
+//nolint:testpackage
+package mics
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:funlen
+func TestMetadata(t *testing.T) {
+	t.Parallel()
+
+	m := XPAR
+	if act := m.Name(); act != "EURONEXT - EURONEXT PARIS" {
+		t.Errorf("Name(): expected EURONEXT - EURONEXT PARIS, actual %v", act)
+	}
+
+	if act := m.LegalEntity(); act != "EURONEXT PARIS SA" {
+		t.Errorf("LegalEntity(): expected EURONEXT PARIS SA, actual %v", act)
+	}
+
+	if act := m.LEI(); act != "969500HMVSZ0TCV65D58" {
+		t.Errorf("LEI(): expected 969500HMVSZ0TCV65D58, actual %v", act)
+	}
+
+	if act := m.Category(); act != RegulatedMarket {
+		t.Errorf("Category(): expected %v, actual %v", RegulatedMarket, act)
+	}
+
+	if act := m.Country(); act != "FR" {
+		t.Errorf("Country(): expected FR, actual %v", act)
+	}
+
+	if act := m.City(); act != "PARIS" {
+		t.Errorf("City(): expected PARIS, actual %v", act)
+	}
+
+	if act := m.Website(); act != "www.euronext.com" {
+		t.Errorf("Website(): expected www.euronext.com, actual %v", act)
+	}
+
+	if act := m.Status(); act != StatusActive {
+		t.Errorf("Status(): expected %v, actual %v", StatusActive, act)
+	}
+
+	if !m.IsActive() {
+		t.Error("IsActive(): expected true, actual false")
+	}
+
+	if !m.IsOperational() {
+		t.Error("IsOperational(): expected true, actual false")
+	}
+
+	if act := m.CreationDate(); !act.Equal(time.Date(2005, 6, 27, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("CreationDate(): expected 2005-06-27, actual %v", act)
+	}
+
+	if act := m.LastUpdateDate(); !act.Equal(time.Date(2023, 10, 23, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("LastUpdateDate(): expected 2023-10-23, actual %v", act)
+	}
+
+	if act := m.LastValidationDate(); !act.IsZero() {
+		t.Errorf("LastValidationDate(): expected zero, actual %v", act)
+	}
+
+	if act := m.ExpiryDate(); !act.IsZero() {
+		t.Errorf("ExpiryDate(): expected zero, actual %v", act)
+	}
+
+	if act := XNYS.Acronym(); act != "NYSE" {
+		t.Errorf("XNYS.Acronym(): expected NYSE, actual %v", act)
+	}
+
+	if act := XLOM.Comments(); act != "DEACTIVATED JAN 2017 UNTIL MARKET LAUNCH. REACTIVATED AUGUST 2017." {
+		t.Errorf("XLOM.Comments(): unexpected %v", act)
+	}
+
+	if XNGS.IsOperational() {
+		t.Error("XNGS.IsOperational(): expected false, actual true")
+	}
+
+	// GLBX is kept as a segment of XCME, overriding the ISO record of the EBS FX Spot+ platform.
+	if m := GLBX; m.OperationalMIC() != XCME || m.Name() != "CME GLOBEX" || m.Country() != "US" ||
+		m.City() != "CHICAGO" || m.IsOperational() {
+		t.Errorf("GLBX: unexpected %v, %v, %v, %v, operational %v",
+			m.OperationalMIC(), m.Name(), m.Country(), m.City(), m.IsOperational())
+	}
+
+	if !contains(XCME.Segments(), GLBX) {
+		t.Errorf("XCME.Segments(): expected to contain GLBX, actual %v", XCME.Segments())
+	}
+
+	m = XA1X
+	if act := m.Status(); act != StatusExpired {
+		t.Errorf("XA1X.Status(): expected %v, actual %v", StatusExpired, act)
+	}
+
+	if m.IsActive() {
+		t.Error("XA1X.IsActive(): expected false, actual true")
+	}
+
+	if act := m.ExpiryDate(); !act.Equal(time.Date(2011, 1, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("XA1X.ExpiryDate(): expected 2011-01-24, actual %v", act)
+	}
+}
+
+func TestMetadataUnknown(t *testing.T) {
+	t.Parallel()
+
+	m := MIC("FOO")
+	if m.Name() != "" || m.LegalEntity() != "" || m.LEI() != "" || m.Category() != "" ||
+		m.Acronym() != "" || m.Country() != "" || m.City() != "" || m.Website() != "" ||
+		m.Status() != "" || m.Comments() != "" {
+		t.Error("expected empty strings for unknown MIC")
+	}
+
+	if m.IsActive() || m.IsOperational() {
+		t.Error("expected false for unknown MIC")
+	}
+
+	if !m.CreationDate().IsZero() || !m.LastUpdateDate().IsZero() ||
+		!m.LastValidationDate().IsZero() || !m.ExpiryDate().IsZero() {
+		t.Error("expected zero dates for unknown MIC")
+	}
+
+	if s := m.Segments(); s != nil {
+		t.Errorf("Segments(): expected nil, actual %v", s)
+	}
+}
+
+func TestSegments(t *testing.T) {
+	t.Parallel()
+
+	s := XNAS.Segments()
+	for _, w := range []MIC{XNGS, XNMS, XNCM} {
+		if !contains(s, w) {
+			t.Errorf("XNAS.Segments(): expected to contain %v, actual %v", w, s)
+		}
+	}
+
+	for _, m := range s {
+		if m == XNAS {
+			t.Error("XNAS.Segments(): expected not to contain XNAS")
+		}
+
+		if act := m.OperationalMIC(); act != XNAS {
+			t.Errorf("%v.OperationalMIC(): expected XNAS, actual %v", m, act)
+		}
+	}
+
+	if s := XNGS.Segments(); s != nil {
+		t.Errorf("XNGS.Segments(): expected nil, actual %v", s)
+	}
+}
+
+func TestQueries(t *testing.T) {
+	t.Parallel()
+
+	all := All()
+	if len(all) != len(predefined) {
+		t.Errorf("All(): expected %v MICs, actual %v", len(predefined), len(all))
+	}
+
+	for _, m := range all {
+		if !m.IsPredefined() {
+			t.Errorf("All(): %v is not predefined", m)
+		}
+	}
+
+	us := ByCountry("US")
+	if !contains(us, XNYS) || !contains(us, XNAS) || contains(us, XPAR) {
+		t.Errorf("ByCountry(US): unexpected %v", us)
+	}
+
+	for _, m := range us {
+		if m.Country() != "US" {
+			t.Errorf("ByCountry(US): %v has country %v", m, m.Country())
+		}
+	}
+
+	if act := ByCountry("QQ"); act != nil {
+		t.Errorf("ByCountry(QQ): expected nil, actual %v", act)
+	}
+
+	rm := ByCategory(RegulatedMarket)
+	if !contains(rm, XPAR) || contains(rm, XNYS) {
+		t.Errorf("ByCategory(RMKT): unexpected %v", rm)
+	}
+
+	for _, m := range ByCategory(MultilateralTradingFacility) {
+		if m.Category() != MultilateralTradingFacility {
+			t.Errorf("ByCategory(MLTF): %v has category %v", m, m.Category())
+		}
+	}
+
+	lse := ByAcronym("lse")
+	if !contains(lse, XLON) || !contains(lse, XLAH) {
+		t.Errorf("ByAcronym(lse): unexpected %v", lse)
+	}
+
+	if act := ByAcronym(""); act != nil {
+		t.Errorf("ByAcronym(): expected nil, actual %v", act)
+	}
+
+	active := Active([]MIC{XA1X, XPAR, MIC("FOO"), XNYS})
+	if len(active) != 2 || active[0] != XPAR || active[1] != XNYS {
+		t.Errorf("Active(): expected [XPAR XNYS], actual %v", active)
+	}
+
+	for _, m := range Active(ByCountry("AR")) {
+		if m.Status() == StatusExpired {
+			t.Errorf("Active(ByCountry(AR)): %v is expired", m)
+		}
+	}
+}
+
+func contains(ms []MIC, m MIC) bool {
+	for _, x := range ms {
+		if x == m {
+			return true
+		}
+	}
+
+	return false
+}
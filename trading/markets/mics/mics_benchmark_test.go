@@ -23,3 +23,30 @@ func BenchmarkIsPredefined(b *testing.B) {
 		_ = instance.IsPredefined()
 	}
 }
+
+func BenchmarkLocation(b *testing.B) {
+	instance := XNYS
+	for i := 0; i < b.N; i++ {
+		_ = instance.Location()
+	}
+}
+
+func BenchmarkName(b *testing.B) {
+	instance := XNYS
+	for i := 0; i < b.N; i++ {
+		_ = instance.Name()
+	}
+}
+
+func BenchmarkByCountry(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = ByCountry("US")
+	}
+}
+
+func BenchmarkRegistryLookup(b *testing.B) {
+	r := NewRegistry()
+	for i := 0; i < b.N; i++ {
+		_, _ = r.Lookup(XNYS)
+	}
+}
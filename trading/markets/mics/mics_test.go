@@ -1,12 +1,12 @@
 // Code generated by 'go generate'; DO NOT EDIT.
-// 2021-06-11 17:48:23.9393503 +0200 CEST m=+0.086978101
+// 2026-10-19 16:52:14.706898768 +0000 UTC m=+0.275833279
 
 // Data source: https://www.iso20022.org/sites/default/files/ISO10383_MIC/ISO10383_MIC.csv
-// Data source publication date: 10-May-2021
+// Data source publication date: 27-May-2024
 
 //nolint:lll
 // Included: MICs for countries with ISO 3166 alpha-2 codes
-// AE, AL, AM, AO, AR, AT, AU, AZ, BA, BB, BD, BE, BG, BH, BM, BO, BR, BS, BW, BY, CA, CH, CI, CL, CM, CN, CO, CR, CV, CW, CY, CZ, DE, DK, DO, DZ, EC, EE, EG, ES, FI, FJ, FO, FR, GB, GE, GG, GH, GI, GR, GT, GY, HK, HN, HR, HU, ID, IE, IL, IN, IQ, IR, IS, IT, JM, JO, JP, KE, KG, KH, KN, KR, KW, KY, KZ, LA, LB, LI, LK, LT, LU, LV, LY, MA, MD, ME, MG, MK, MN, MT, MU, MV, MW, MX, MY, MZ, NA, NG, NI, NL, NO, NP, NZ, OM, PA, PE, PG, PH, PK, PL, PS, PT, PY, QA, RO, RS, RU, RW, SA, SC, SD, SE, SG, SI, SK, SV, SY, SZ, TH, TN, TR, TT, TW, TZ, UA, UG, US, UY, UZ, VC, VE, VN, VU, ZA, ZM, ZW, ZZ
+// AE, AG, AL, AM, AO, AR, AT, AU, AZ, BA, BB, BD, BE, BG, BH, BM, BO, BR, BS, BW, BY, CA, CH, CI, CL, CM, CN, CO, CR, CV, CW, CY, CZ, DE, DK, DO, DZ, EC, EE, EG, ES, FI, FJ, FO, FR, GB, GE, GG, GH, GI, GR, GT, GY, HK, HN, HR, HU, ID, IE, IL, IN, IQ, IR, IS, IT, JM, JO, JP, KE, KG, KH, KN, KR, KW, KY, KZ, LA, LB, LI, LK, LT, LU, LV, LY, MA, MD, ME, MG, MK, MN, MT, MU, MV, MW, MX, MY, MZ, NA, NG, NI, NL, NO, NP, NZ, OM, PA, PE, PG, PH, PK, PL, PS, PT, PY, QA, RO, RS, RU, RW, SA, SC, SD, SE, SG, SI, SK, SV, SY, SZ, TH, TN, TR, TT, TW, TZ, UA, UG, US, UY, UZ, VC, VE, VN, VU, ZA, ZM, ZW, ZZ
 // Excluded: MICs for countries with ISO 3166 alpha-2 codes
 // none
 
@@ -28,882 +28,1098 @@ func TestOperationalMIC(t *testing.T) {
 		{XTIR, XTIR},
 		{XALG, XALG},
 		{XBDV, XBDV},
+		{XFTX, XFTX},
+		{XA1X, XA1X},
 		{BACE, BACE},
 		{BCFS, BCFS},
 		{XMVL, BCFS},
-		{XCNF, XCNF},
 		{ROFX, ROFX},
-		{XMAB, XMAB},
-		{XBUE, XBUE},
-		{XMEV, XBUE},
 		{XBCC, XBCC},
 		{MVCX, XBCC},
 		{XBCM, XBCM},
 		{XBCX, XBCM},
+		{XBUE, XBUE},
+		{XMEV, XBUE},
+		{XCNF, XCNF},
+		{XMAB, XMAB},
 		{XMTB, XMTB},
 		{XROS, XROS},
 		{XROX, XROS},
 		{XTUC, XROS},
 		{XARM, XARM},
+		{XLQC, XLQC},
+		{XFEX, XFEX},
+		{BLXA, BLXA},
+		{APXL, APXL},
+		{CGMA, CGMA},
+		{CSAU, CSAU},
+		{CFAU, CSAU},
+		{NECD, NECD},
 		{AWBX, AWBX},
 		{AWEX, AWEX},
-		{APXL, APXL},
+		{BLEV, BLEV},
 		{CHIA, CHIA},
-		{CXAF, CHIA},
-		{CXAP, CHIA},
-		{CXAW, CHIA},
 		{CXAR, CHIA},
 		{CXAC, CHIA},
+		{CXAP, CHIA},
+		{CXAM, CHIA},
 		{CXAQ, CHIA},
 		{CXAV, CHIA},
-		{CXAM, CHIA},
+		{CXAF, CHIA},
 		//nolint:misspell
 		{CXAN, CHIA},
+		{CXAW, CHIA},
+		{CXAB, CHIA},
+		{CXAI, CHIA},
+		{CLAU, CLAU},
 		{MAQX, MAQX},
 		{MACB, MAQX},
-		{CSAU, CSAU},
-		{CFAU, CSAU},
+		{MEAU, MEAU},
 		{MSAL, MSAL},
-		{XYIE, XYIE},
-		{CLAU, CLAU},
-		{XNEC, XNEC},
+		{NSXB, NSXB},
+		{SIGA, SIGA},
+		{SIMV, SIMV},
+		{XAOM, XAOM},
 		{XASX, XASX},
+		{NZFX, XASX},
 		{ASXC, XASX},
-		{ASXP, XASX},
 		{ASXT, XASX},
+		{ASXB, XASX},
 		{ASXV, XASX},
+		{ASXP, XASX},
 		{XSFE, XASX},
-		{ASXB, XASX},
-		{SIMV, SIMV},
-		{XFEX, XFEX},
-		{SIGA, SIGA},
-		{NSXB, NSXB},
-		{CGMA, CGMA},
-		{BLXA, BLXA},
-		{BLEV, BLEV},
-		{XLQC, XLQC},
+		{XNEC, XNEC},
+		{XYIE, XYIE},
+		{GFAU, GFAU},
+		{BGCA, BGCA},
+		{POTL, POTL},
+		{LLAT, LLAT},
+		{UCBA, UCBA},
+		{SLHB, SLHB},
 		{BKSK, BKSK},
+		{APAW, APAW},
+		{OBKL, OBKL},
+		{RBIV, RBIV},
+		{RLBO, RLBO},
 		{XRCB, XRCB},
 		{EGSI, EGSI},
-		{RBIV, RBIV},
-		{APAW, APAW},
+		{XOTB, XOTB},
 		{XWBO, XWBO},
+		{WBGF, XWBO},
+		{XCEG, XWBO},
 		{EXAA, XWBO},
-		{WBDM, XWBO},
 		{WBAH, XWBO},
+		{WBDM, XWBO},
 		{XVIE, XWBO},
-		{RLBO, RLBO},
-		{UCBA, UCBA},
-		{OBKL, OBKL},
-		{SLHB, SLHB},
-		{LLAT, LLAT},
+		{FPWB, FPWB},
+		{RVSA, RVSA},
 		{BSEX, BSEX},
 		{XIBE, XIBE},
 		{XBAA, XBAA},
 		{BFEX, BFEX},
 		{XBAH, XBAH},
-		{XDHA, XDHA},
 		{XCHG, XCHG},
+		{XDHA, XDHA},
 		{XBAB, XBAB},
-		{BAJM, XBAB},
 		{XBIS, XBAB},
+		{BAJM, XBAB},
 		{BCSE, BCSE},
-		{BLPX, BLPX},
+		{SMBB, SMBB},
 		{KBCB, KBCB},
+		{BELB, BELB},
+		{BELF, BELB},
+		{BNPF, BNPF},
 		{BKBR, BKBR},
 		{BKBF, BKBR},
+		{BEAM, BEAM},
+		{MTSD, BEAM},
+		{MTSF, BEAM},
+		{BMTS, BEAM},
+		{BLPX, BLPX},
+		{FRRF, FRRF},
+		{XANT, XANT},
+		{XBFO, XBFO},
 		{XBRU, XBRU},
+		{TNLK, XBRU},
+		{ENXB, XBRU},
 		{ALXB, XBRU},
 		{MLXB, XBRU},
-		{TNLB, XBRU},
 		{VPXB, XBRU},
+		{TNLB, XBRU},
 		{XBRD, XBRU},
-		{TNLK, XBRU},
-		{BEAM, BEAM},
-		{BMTS, BEAM},
-		{MTSD, BEAM},
-		{MTSF, BEAM},
-		{SMBB, SMBB},
-		{BELB, BELB},
-		{BELF, BELB},
-		{BNPF, BNPF},
-		{XBDA, XBDA},
+		{DBRU, XBRU},
 		{X24EX, X24EX},
 		{GTXE, GTXE},
+		{XBDA, XBDA},
 		{XBOL, XBOL},
 		{XBLB, XBLB},
 		{BLBF, XBLB},
 		{XSSE, XSSE},
 		{XBOT, XBOT},
-		{BOTV, XBOT},
 		{BOTE, XBOT},
+		{BOTV, XBOT},
+		{XBMF, XBMF},
+		{XBSP, XBSP},
 		{BCMM, BCMM},
 		{BOVM, BOVM},
 		{BRIX, BRIX},
 		{BVMF, BVMF},
-		{CETI, CETI},
+		{CETI, BVMF},
 		{SELC, SELC},
+		{XBBF, XBBF},
+		{XBVP, XBVP},
+		{XRIO, XRIO},
+		{XSOM, XSOM},
+		{UCBG, UCBG},
+		{BEBG, BEBG},
+		{T212, T212},
+		{BDSK, BDSK},
+		{BGHX, BGHX},
+		{IBEX, IBEX},
+		{MBUL, MBUL},
+		{GMBG, MBUL},
 		{XBUL, XBUL},
-		{ABUL, XBUL},
 		{ZBUL, XBUL},
-		{GBUL, XBUL},
 		{JBUL, XBUL},
+		{GBUL, XBUL},
 		{PBUL, XBUL},
 		{IBUL, XBUL},
-		{IBEX, IBEX},
-		{MBUL, MBUL},
-		{BDSK, BDSK},
-		{T212, T212},
-		{BEBG, BEBG},
-		{BGHX, BGHX},
+		{ABUL, XBUL},
+		{LBUL, XBUL},
+		{EUFN, EUFN},
 		{XCSX, XCSX},
 		{XDSX, XDSX},
+		{XCNQ, XCNQ},
+		{PURE, XCNQ},
+		{CSE2, XCNQ},
+		{EQCA, EQCA},
+		{NEOE, NEOE},
+		{NEOD, NEOE},
+		{NEON, NEOE},
+		{NEOC, NEOE},
+		{BNSX, BNSX},
+		{TMXS, TMXS},
+		{ATSA, ATSA},
+		{CAND, CAND},
 		{CANX, CANX},
 		{CHIC, CHIC},
 		{XCX2, CHIC},
+		{COTC, COTC},
 		{IFCA, IFCA},
+		{XWCE, IFCA},
+		{IVZX, IVZX},
 		{LICA, LICA},
 		{MATN, MATN},
-		{XTSX, XTSX},
-		{XTNX, XTSX},
-		{VDRK, XTSX},
-		{NEOE, NEOE},
-		{NEOC, NEOE},
-		{NEON, NEOE},
-		{NEOD, NEOE},
-		{XCNQ, XCNQ},
-		{PURE, XCNQ},
-		{CAND, CAND},
+		{NGXC, NGXC},
 		{OMGA, OMGA},
 		{LYNX, OMGA},
+		{XALB, XALB},
 		{XATS, XATS},
+		{XATX, XATS},
+		{ADRK, XATS},
 		{XBBK, XBBK},
-		{XTSE, XTSE},
-		{XDRK, XTSE},
-		{NGXC, NGXC},
-		{XICX, XICX},
-		{BNSX, BNSX},
-		{COTC, COTC},
-		{IVZX, IVZX},
 		{XCXD, XCXD},
+		{XICX, XICX},
 		{XMOC, XMOC},
 		{XMOD, XMOD},
-		{EQCA, EQCA},
+		{XMOO, XMOO},
+		{XTFE, XTFE},
+		{XTOE, XTOE},
+		{XTSE, XTSE},
+		{XDRK, XTSE},
+		{XTSX, XTSX},
+		{VDRK, XTSX},
+		{XTNX, XTSX},
+		{XVSE, XVSE},
 		{XBVC, XBVC},
 		{XCAY, XCAY},
 		{BOVA, BOVA},
 		{XBCL, XBCL},
 		{XSGO, XSGO},
+		{GSXC, GSXC},
 		{CCFX, CCFX},
 		{CSSX, CSSX},
-		{XDCE, XDCE},
+		{SGEX, SGEX},
 		{XCFE, XCFE},
 		{CFBC, XCFE},
-		{SGEX, SGEX},
+		{XDCE, XDCE},
 		{XSGE, XSGE},
 		{XINE, XSGE},
 		{XSHE, XSHE},
 		{XSEC, XSHE},
 		{XSHG, XSHG},
 		{XSSC, XSHG},
-		{GSXC, GSXC},
+		{XSME, XSME},
 		{XZCE, XZCE},
+		{BJSE, BJSE},
+		{NEEQ, NEEQ},
 		{XBOG, XBOG},
 		{XBNV, XBNV},
+		{XOTP, XOTP},
 		{XCRO, XCRO},
 		{XTRZ, XTRZ},
+		{XVAR, XVAR},
 		{XZAG, XZAG},
+		{XZAM, XZAG},
 		{ZAPA, XZAG},
 		{XZAP, XZAG},
-		{XOTP, XOTP},
 		{DCSX, DCSX},
-		{XCYS, XCYS},
-		{XCYO, XCYS},
-		{XECM, XCYS},
-		{BCSC, BCSC},
-		{ATHL, ATHL},
-		{MKAP, MKAP},
+		{TMCY, TMCY},
 		{ATLN, ATLN},
+		{ATHL, ATHL},
+		{BCSC, BCSC},
 		{CFIF, CFIF},
-		{SIBC, SIBC},
-		{RENC, RENC},
 		{GPBC, GPBC},
-		{TMCY, TMCY},
+		{RENC, RENC},
+		{SIBC, SIBC},
+		{MKAP, MKAP},
+		{XCYS, XCYS},
+		{XCYO, XCYS},
+		{XECM, XCYS},
+		{XMME, XCYS},
+		{ETOR, ETOR},
+		{CSAS, CSAS},
+		{KOME, KOME},
+		{RFBK, RFBK},
+		{UBCZ, UBCZ},
+		{CSOB, CSOB},
+		{CELP, CSOB},
+		{CESI, CSOB},
 		{FTFS, FTFS},
 		{FTFM, FTFS},
+		{WOOD, WOOD},
+		{XPRA, XPRA},
+		{XPRM, XPRA},
+		{STRT, XPRA},
+		{SPAD, XPRA},
 		{XPXE, XPXE},
 		{XRMZ, XRMZ},
 		{XRMO, XRMZ},
-		{XPRA, XPRA},
-		{XPRM, XPRA},
-		{WOOD, WOOD},
-		{RFBK, RFBK},
-		{CSOB, CSOB},
-		{CELP, CSOB},
-		{CESI, CSOB},
-		{KOME, KOME},
-		{CSAS, CSAS},
-		{UBCZ, UBCZ},
+		{PATF, PATF},
+		{ATAD, ATAD},
+		{GXGR, GXGR},
+		{GXGM, GXGR},
+		{GXGF, GXGR},
+		{JYSI, JYSI},
+		{ABSI, ABSI},
+		{ALSI, ALSI},
+		{SBSI, SBSI},
+		{LASP, LASP},
+		{SKSI, SKSI},
+		{NYSI, NYSI},
+		{JBSI, JBSI},
+		{SNSI, SNSI},
+		{DAMP, DAMP},
+		{DASI, DASI},
 		{DKTC, DKTC},
+		{NPGA, NPGA},
+		{SXSI, SXSI},
 		{XCSE, XCSE},
-		{DCSE, XCSE},
 		{DSME, XCSE},
-		{MCSE, XCSE},
+		{DCSE, XCSE},
 		{MNDK, XCSE},
 		{FNDK, XCSE},
 		{DNDK, XCSE},
-		{NYSI, NYSI},
-		{DASI, DASI},
-		{JBSI, JBSI},
-		{ABSI, ABSI},
-		{SBSI, SBSI},
-		{NPGA, NPGA},
-		{SKSI, SKSI},
-		{SXSI, SXSI},
-		{LASP, LASP},
-		{SNSI, SNSI},
-		{JYSI, JYSI},
-		{ALSI, ALSI},
+		{MCSE, XCSE},
+		{XFND, XCSE},
+		{XTRA, XTRA},
 		{XBVR, XBVR},
+		{XCVD, XCVD},
 		{XGUA, XGUA},
 		{XQUI, XQUI},
-		{XCAI, XCAI},
 		{NILX, NILX},
+		{XCAI, XCAI},
 		{XSVA, XSVA},
+		{LMNR, LMNR},
+		{SWEE, SWEE},
+		{XTAR, XTAR},
 		{XTAL, XTAL},
+		{XTAA, XTAL},
 		{FNEE, XTAL},
-		{SWEE, SWEE},
-		{LMNR, LMNR},
 		{VMFX, VMFX},
 		{XSPS, XSPS},
-		{OPCO, OPCO},
+		{AXSI, AXSI},
 		{XNOR, XNOR},
+		{OPCO, OPCO},
 		{FGEX, FGEX},
+		{XFOM, XFOM},
 		{XHEL, XHEL},
-		{MNFI, XHEL},
-		{DHEL, XHEL},
-		{DNFI, XHEL},
 		{FSME, XHEL},
+		{MNFI, XHEL},
 		{FNFI, XHEL},
+		{DNFI, XHEL},
+		{DHEL, XHEL},
 		{MHEL, XHEL},
-		{GMTF, GMTF},
-		{COAL, COAL},
-		{EPEX, EPEX},
-		{FMTS, FMTS},
+		{ESLO, ESLO},
+		{ENSL, ESLO},
+		{BRED, BRED},
+		{HBFR, HBFR},
+		{RBCC, RBCC},
+		{SGMU, SGMU},
+		{SGMV, SGMU},
+		{SGMW, SGMU},
+		{WFSE, WFSE},
+		{MKTF, MKTF},
+		{AQEU, AQEU},
+		{AQED, AQEU},
+		{AQEA, AQEU},
 		{GIPB, GIPB},
 		{GSPX, GIPB},
-		{XBLN, XBLN},
-		{AACA, AACA},
-		{TPEU, TPEU},
-		{TPER, TPEU},
-		{CMCI, CMCI},
-		{NATX, NATX},
-		{HPCX, HPCX},
-		{HPCO, HPCX},
-		{HPCV, HPCX},
-		{HPCS, HPCX},
-		{TDON, TDON},
-		{NOWX, NOWX},
-		{AQEU, AQEU},
-		{AQEA, AQEU},
-		{AQED, AQEU},
-		{XAFR, XAFR},
-		{TSAF, TSAF},
 		{ELXE, ELXE},
 		{TRXE, TRXE},
-		{RBCC, RBCC},
+		{XTXE, XTXE},
+		{GMES, GMES},
+		{GMEO, GMES},
+		{MHBP, MHBP},
+		{TPIC, TPIC},
+		{TPEE, TPIC},
+		{TPIR, TPIC},
+		{TPIO, TPIC},
+		{LNEQ, TPIC},
+		{LNFI, TPIC},
+		{MLEX, MLEX},
+		{MLER, MLEX},
+		{MLES, MLEX},
+		{NOWX, NOWX},
+		{MSAX, MSAX},
+		{MSCX, MSAX},
+		{MSNT, MSAX},
+		{ICOT, ICOT},
+		{ICOR, ICOT},
+		{TLCM, ICOT},
+		{TPEU, TPEU},
+		{TPER, TPEU},
+		{TPFR, TPFR},
+		{TEPG, TPFR},
+		{TEPX, TPFR},
+		{TEPR, TPFR},
+		{TEPM, TPFR},
+		{TEPI, TPFR},
+		{TEPF, TPFR},
+		{CMCI, CMCI},
+		{MUBP, MUBP},
+		{SMBP, SMBP},
+		{EDRF, EDRF},
+		{EXSE, EXSE},
+		{SGOE, SGOE},
 		{EXSY, EXSY},
 		{EXYY, EXSY},
-		{EXSB, EXSY},
-		{EXSD, EXSY},
 		{EXSF, EXSY},
 		{EXSP, EXSY},
+		{EXSD, EXSY},
+		{EXSB, EXSY},
 		{EXSH, EXSY},
-		{MHBP, MHBP},
-		{GMES, GMES},
-		{GMEO, GMES},
-		{EDRF, EDRF},
 		{BPSX, BPSX},
+		{HPCX, HPCX},
+		{HPCS, HPCX},
+		{HPCO, HPCX},
+		{HPCV, HPCX},
+		{MSSA, HPCX},
 		{ODDO, ODDO},
-		{SMBP, SMBP},
-		{MUBP, MUBP},
+		{ODOC, ODOC},
+		{AACA, AACA},
+		{BNPA, BNPA},
+		{BNPS, BNPS},
 		{XSGA, XSGA},
+		{KOTF, KOTF},
+		{TDON, TDON},
+		{TSAF, TSAF},
 		{AURB, AURB},
 		{GFPO, AURB},
 		{AURO, AURB},
-		{ODOC, ODOC},
-		{BNPS, BNPS},
-		{SGOE, SGOE},
-		{XPOW, XPOW},
-		{HBFR, HBFR},
-		{XTXE, XTXE},
-		{BNPA, BNPA},
-		{TPIC, TPIC},
-		{TPIR, TPIC},
-		{TPIO, TPIC},
-		{TPEE, TPIC},
+		{NATX, NATX},
+		{COAL, COAL},
+		{EPEX, EPEX},
+		{FMTS, FMTS},
+		{GMTF, GMTF},
 		{LCHC, LCHC},
+		{XAFR, XAFR},
+		{XBLN, XBLN},
+		{XFMN, XFMN},
 		{XPAR, XPAR},
+		{XBLK, XPAR},
+		{XETF, XPAR},
 		{XAPA, XPAR},
-		{MTCH, XPAR},
-		{XMAT, XPAR},
 		{XMLI, XPAR},
 		{ALXP, XPAR},
-		{XBLK, XPAR},
-		{XETF, XPAR},
-		{XMON, XPAR},
 		{XSPM, XPAR},
-		{KOTF, KOTF},
-		{MSAX, MSAX},
-		{MSCX, MSAX},
-		{MSNT, MSAX},
-		{MKTF, MKTF},
-		{SGMU, SGMU},
-		{SGMV, SGMU},
-		{SGMW, SGMU},
-		{ICOT, ICOT},
-		{TLCM, ICOT},
-		{ICOR, ICOT},
-		{MLEX, MLEX},
-		{MLER, MLEX},
-		{MLES, MLEX},
-		{EXSE, EXSE},
-		{MAQE, MAQE},
-		{WFSE, WFSE},
-		{BRED, BRED},
-		{ESLO, ESLO},
-		{ENSL, ESLO},
+		{MTCH, XPAR},
+		{XMAT, XPAR},
+		{XMON, XPAR},
+		{DPAR, XPAR},
+		{XPOW, XPOW},
+		{NABP, NABP},
 		{XGSE, XGSE},
-		{CATS, CATS},
-		{X360T, X360T},
-		{DBOX, DBOX},
-		{AUTO, DBOX},
-		{NORD, NORD},
-		{FICX, FICX},
-		{XBER, XBER},
-		{BERA, XBER},
-		{BERB, XBER},
-		{BERC, XBER},
-		{EQTA, XBER},
-		{EQTB, XBER},
-		{EQTC, XBER},
-		{EQTD, XBER},
-		{XEQT, XBER},
-		{ZOBX, XBER},
-		{NLBX, NLBX},
-		{MHBD, MHBD},
+		{SEBA, SEBA},
+		{SSWM, SSWM},
+		{OLBB, OLBB},
+		{SPEX, SPEX},
+		{CSDA, CSDA},
+		{CDSI, CSDA},
+		{SMBD, SMBD},
+		{UBSD, UBSD},
+		{UBSL, UBSL},
+		{UBSE, UBSL},
+		{UBSI, UBSL},
+		{SMFF, SMFF},
+		{SCAG, SCAG},
+		{NCME, NCME},
+		{GSBE, GSBE},
+		{GSEI, GSBE},
+		{MHEU, MHEU},
 		{LBCW, LBCW},
-		{XECB, XECB},
-		{XSTU, XSTU},
-		{EUWX, XSTU},
-		{XSTF, XSTU},
-		{STUC, XSTU},
-		{STUD, XSTU},
-		{STUA, XSTU},
-		{STUB, XSTU},
-		{XDEX, XSTU},
+		{JEFE, JEFE},
+		{JESI, JEFE},
+		{RBCG, RBCG},
+		{UCDE, UCDE},
 		{MSEU, MSEU},
 		{MESI, MSEU},
-		{SMFF, SMFF},
-		{SCAG, SCAG},
-		{XXSC, XXSC},
+		{DEKA, DEKA},
+		{LIGA, LIGA},
+		{MHBD, MHBD},
+		{IKBS, IKBS},
 		{CGMD, CGMD},
+		{CGEE, CGMD},
 		{CGEC, CGMD},
 		{CGET, CGMD},
-		{CGEE, CGMD},
-		{XMUN, XMUN},
-		{MUNC, XMUN},
-		{MUNA, XMUN},
-		{MUNB, XMUN},
-		{MUND, XMUN},
-		{NCME, NCME},
-		{XECC, XECC},
-		{EUWA, EUWA},
-		{XEEE, XEEE},
-		{XEEO, XEEE},
-		{XEER, XEEE},
-		{XPSF, XEEE},
-		{XPOT, XEEE},
-		{ECAG, ECAG},
-		{ECGS, ECAG},
-		{XEUP, XEUP},
-		{XERE, XEUP},
-		{XEUM, XEUP},
-		{XEHQ, XEUP},
-		{XERT, XEUP},
-		{XEUR, XEUR},
-		{XETR, XETR},
-		{XETA, XETR},
-		{XETB, XETR},
-		{XETW, XETR},
-		{XETV, XETR},
-		{XETU, XETR},
-		{XETS, XETR},
-		{XFRA, XFRA},
-		{FRAU, XFRA},
-		{FRAA, XFRA},
-		{FRAB, XFRA},
-		{FRAV, XFRA},
-		{FRAW, XFRA},
-		{FRAS, XFRA},
-		{XHAM, XHAM},
-		{HAMM, XHAM},
-		{HAMA, XHAM},
-		{HAMB, XHAM},
-		{HAMN, XHAM},
-		{HAML, XHAM},
-		{XHAN, XHAN},
-		{HANA, XHAN},
-		{HANB, XHAN},
+		{JPEU, JPEU},
 		{HELA, HELA},
-		{XSCO, XSCO},
-		{XSC2, XSCO},
-		{XSC1, XSCO},
-		{XSC3, XSCO},
+		{NESI, NESI},
+		{LBBW, LBBW},
+		{LBWL, LBBW},
+		{LBWS, LBBW},
 		{BLBB, BLBB},
+		{BLFX, BLBB},
 		{BLEQ, BLBB},
-		{BSFX, BLBB},
 		{BLIQ, BLBB},
+		{BSFX, BLBB},
 		{BDEA, BLBB},
 		{BLBS, BLBB},
-		{BLFX, BLBB},
+		{TPDE, TPDE},
+		{TSFG, TPDE},
+		{TSFF, TPDE},
+		{EUWA, EUWA},
+		{SSBI, SSBI},
+		{SSBM, SSBI},
 		{DBAG, DBAG},
-		{DBES, DBAG},
-		{DBLN, DBAG},
 		{DBMO, DBAG},
+		{DBLN, DBAG},
+		{DBES, DBAG},
 		{VWDX, VWDX},
-		{VTLS, VWDX},
-		{VTPS, VWDX},
 		{VWDA, VWDX},
-		{SSBI, SSBI},
-		{SSBM, SSBI},
-		{XDUS, XDUS},
-		{XQTX, XDUS},
-		{DUSA, XDUS},
-		{DUSB, XDUS},
-		{DUSC, XDUS},
-		{DUSD, XDUS},
-		{NESI, NESI},
-		{UCDE, UCDE},
-		{DZBK, DZBK},
-		{SMBD, SMBD},
-		{IKBS, IKBS},
-		{DAPA, DAPA},
-		{JEFE, JEFE},
-		{JESI, JEFE},
-		{BAAD, BAAD},
-		{SPEX, SPEX},
-		{HSBT, HSBT},
+		{VTPS, VWDX},
+		{VTLS, VWDX},
+		{NORD, NORD},
+		{BINV, BINV},
+		{NLBX, NLBX},
 		{TGAT, TGAT},
-		{TGSI, TGAT},
 		{XGAT, TGAT},
+		{TGSI, TGAT},
 		{XGRM, TGAT},
+		{VONT, VONT},
 		{CBKA, CBKA},
-		{CBKE, CBKA},
+		{CBKS, CBKA},
+		{CBKC, CBKA},
 		{CBKD, CBKA},
 		{CBKF, CBKA},
+		{CBKE, CBKA},
 		{CBKG, CBKA},
-		{CBKC, CBKA},
-		{CBKS, CBKA},
-		{LSSI, LSSI},
+		{DZBK, DZBK},
 		{BGSI, BGSI},
-		{BGFI, BGSI},
 		{BGFX, BGSI},
-		{CSDA, CSDA},
-		{CDSI, CSDA},
-		{UBSL, UBSL},
-		{UBSI, UBSL},
-		{BINV, BINV},
-		{LBBW, LBBW},
-		{LBWS, LBBW},
-		{LBWL, LBBW},
-		{JPEU, JPEU},
-		{OLBB, OLBB},
-		{LIGA, LIGA},
-		{GSBE, GSBE},
-		{GSEI, GSBE},
-		{VONT, VONT},
-		{DEKA, DEKA},
+		{BGFI, BGSI},
+		{LSSI, LSSI},
+		{BAAD, BAAD},
+		{HSBT, HSBT},
+		{DAPA, DAPA},
+		{XEEE, XEEE},
+		{XPSF, XEEE},
+		{XPOT, XEEE},
+		{XEER, XEEE},
+		{XEEO, XEEE},
+		{FICX, FICX},
+		{XMUN, XMUN},
+		{MUND, XMUN},
+		{MUNC, XMUN},
+		{MUNB, XMUN},
+		{MUNA, XMUN},
+		{PLUS, XMUN},
+		{XHCE, XHCE},
+		{XRTR, XRTR},
+		{X360T, X360T},
+		{CATS, CATS},
+		{DBOX, DBOX},
+		{AUTO, DBOX},
+		{ECAG, ECAG},
+		{ECGS, ECAG},
+		{EFTP, EFTP},
+		{GMEX, GMEX},
+		{XBER, XBER},
+		{BERA, XBER},
+		{BERB, XBER},
+		{BERC, XBER},
+		{ZOBX, XBER},
+		{EQTA, XBER},
+		{EQTB, XBER},
+		{EQTC, XBER},
+		{EQTD, XBER},
+		{XEQT, XBER},
+		{XBRE, XBRE},
+		{XDTB, XDTB},
+		{XDUS, XDUS},
+		{DUSA, XDUS},
+		{DUSB, XDUS},
+		{DUSC, XDUS},
+		{DUSD, XDUS},
+		{XQTX, XDUS},
+		{XDWZ, XDWZ},
+		{XECB, XECB},
+		{XECC, XECC},
+		{XETI, XETI},
+		{XETD, XETI},
+		{XETC, XETI},
+		{XETR, XETR},
+		{XEUB, XETR},
+		{XETV, XETR},
+		{XETW, XETR},
+		{XETU, XETR},
+		{XETS, XETR},
+		{XETB, XETR},
+		{XETA, XETR},
+		{XETE, XETR},
+		{XETX, XETR},
+		{XEMA, XETR},
+		{XEMI, XETR},
+		{XEMB, XETR},
+		{XEUP, XEUP},
+		{XEHQ, XEUP},
+		{XERT, XEUP},
+		{XERE, XEUP},
+		{XEUM, XEUP},
+		{XEUR, XEUR},
+		{XFRA, XFRA},
+		{FRAV, XFRA},
+		{FRAW, XFRA},
+		{FRAU, XFRA},
+		{FRAS, XFRA},
+		{XDBC, XFRA},
+		{XDBV, XFRA},
+		{FRAA, XFRA},
+		{FRAD, XFRA},
+		{FRAB, XFRA},
+		{XDBX, XFRA},
+		{XNEW, XFRA},
+		{XHAM, XHAM},
+		{HAMA, XHAM},
+		{HAMB, XHAM},
+		{HAMM, XHAM},
+		{HAML, XHAM},
+		{HAMN, XHAM},
+		{XHAN, XHAN},
+		{HANB, XHAN},
+		{HANA, XHAN},
 		{XINV, XINV},
-		{RBCG, RBCG},
-		{MHEU, MHEU},
-		{SEBA, SEBA},
+		{XSCO, XSCO},
+		{XSC2, XSCO},
+		{XSC3, XSCO},
+		{XSC1, XSCO},
+		{XSTU, XSTU},
+		{STUF, XSTU},
+		{XSTP, XSTU},
+		{STUE, XSTU},
+		{XDEX, XSTU},
+		{XSTF, XSTU},
+		{STUC, XSTU},
+		{STUD, XSTU},
+		{STUB, XSTU},
+		{STUA, XSTU},
+		{EUWX, XSTU},
+		{XXSC, XXSC},
+		{DBDX, DBDX},
+		{XIGG, XIGG},
+		{EBLX, XIGG},
+		{ERFQ, XIGG},
+		{ENTW, XIGG},
+		{TRBX, TRBX},
+		{BOCF, BOCF},
+		{X360X, X360X},
+		{SCLB, SCLB},
+		{X21XX, X21XX},
 		{XGHA, XGHA},
 		{GSXL, GSXL},
-		{HDAT, HDAT},
-		{ASEX, ASEX},
-		{HOTC, ASEX},
-		{XADE, ASEX},
-		{XATH, ASEX},
-		{ENAX, ASEX},
-		{XIPO, ASEX},
 		{PBGR, PBGR},
 		{ABFI, ABFI},
-		{AAPA, AAPA},
+		{ERBX, ERBX},
 		{HEMO, HEMO},
 		{HESP, HEMO},
 		{HEDE, HEMO},
-		{ERBX, ERBX},
+		{HGSP, HEMO},
+		{AAPA, AAPA},
+		{ASEX, ASEX},
+		{HOTC, ASEX},
+		{XADE, ASEX},
+		{ENAX, ASEX},
+		{XATH, ASEX},
+		{XIPO, ASEX},
+		{EUAX, ASEX},
+		{HDAT, HDAT},
 		{XGTG, XGTG},
 		{XCIE, XCIE},
 		{GSCI, GSCI},
+		{XHON, XHON},
 		{XBCV, XBCV},
-		{HSXA, HSXA},
-		{CGMH, CGMH},
-		{DBHK, DBHK},
-		{EOTC, EOTC},
-		{JPMI, JPMI},
-		{XCGS, XCGS},
-		{XHKF, XHKF},
-		{CLHK, CLHK},
-		{BASE, BASE},
+		{OSDS, OSDS},
 		{BAIP, BAIP},
-		{BAEP, BAIP},
+		{BACR, BAIP},
 		{BASP, BAIP},
-		{UBSX, UBSX},
-		{TOCP, TOCP},
+		{BAEP, BAIP},
+		{BASE, BASE},
 		{TFSD, TFSD},
 		{TRAS, TRAS},
 		{GSAL, GSAL},
-		{SIGH, GSAL},
-		{GSXH, GSAL},
 		{GSPL, GSAL},
-		{MAQH, MAQH},
+		{GSXH, GSAL},
+		{SIGH, GSAL},
+		{GSXM, GSAL},
 		{TWHK, TWHK},
-		{XPST, XPST},
-		{XIHK, XIHK},
+		{CGMH, CGMH},
 		{CSHK, CSHK},
 		{CFHK, CSHK},
+		{CLHK, CLHK},
+		{DBHK, DBHK},
+		{EOTC, EOTC},
+		{HKME, HKME},
+		{HSXA, HSXA},
+		{JPMI, JPMI},
+		{MAQH, MAQH},
+		{MEHK, MEHK},
+		{TOCP, TOCP},
+		{UBSX, UBSX},
+		{XCGS, XCGS},
+		{XHKF, XHKF},
 		{XHKG, XHKG},
 		{SHSC, XHKG},
 		{SZSC, XHKG},
 		{XGEM, XHKG},
-		{HUPX, HUPX},
-		{XGAS, XGAS},
-		{KELR, KELR},
-		{ERST, ERST},
-		{XBUD, XBUD},
-		{BETA, XBUD},
-		{XBND, XBUD},
-		{XTND, XBUD},
+		{XIHK, XIHK},
+		{XPST, XPST},
+		{GSXN, GSXN},
 		{EBHU, EBHU},
-		{CONC, CONC},
 		{UCHU, UCHU},
+		{CONC, CONC},
 		{KHHU, KHHU},
-		{HUDX, HUDX},
+		{CIBH, CIBH},
 		{OTPB, OTPB},
 		{RBHU, RBHU},
+		{ERST, ERST},
+		{KELR, KELR},
+		{HUDX, HUDX},
+		{HUPX, HUPX},
 		{KCCP, KCCP},
-		{CIBH, CIBH},
+		{QMTF, QMTF},
+		{XQLX, QMTF},
+		{XBCE, XBCE},
+		{XBUD, XBUD},
+		{BETA, XBUD},
+		{XBND, XBUD},
+		{XTND, XBUD},
+		{XGAS, XGAS},
 		{XICE, XICE},
-		{DICE, XICE},
 		{FNIS, XICE},
+		{MICE, XICE},
 		{DNIS, XICE},
+		{ISEC, XICE},
+		{DICE, XICE},
 		{MNIS, XICE},
-		{MICE, XICE},
-		{ICXL, ICXL},
-		{ACEX, ACEX},
-		{IINX, IINX},
-		{ISEX, ISEX},
 		{MCXX, MCXX},
-		{XUSE, XUSE},
-		{XDES, XDES},
-		{XBAN, XBAN},
+		{IINX, IINX},
+		{ACEX, ACEX},
 		{CDSL, CDSL},
-		{FXSW, CDSL},
-		{FXCL, CDSL},
 		{ASTR, CDSL},
+		{FXCL, CDSL},
+		{FXSW, CDSL},
+		{ICXL, ICXL},
+		{ISEX, ISEX},
+		{NBOT, NBOT},
+		{NMCE, NMCE},
 		{OTCX, OTCX},
 		{PXIL, PXIL},
-		{XNSE, XNSE},
-		{INSE, XNSE},
-		{XNCD, XNCD},
-		{XCAL, XCAL},
+		{XBAN, XBAN},
 		{XBOM, XBOM},
 		{BSME, XBOM},
+		{XCAL, XCAL},
+		{XDES, XDES},
 		{XIMC, XIMC},
-		{NMCE, NMCE},
-		{NBOT, NBOT},
 		{XMDS, XMDS},
+		{XNCD, XNCD},
+		{XNSE, XNSE},
+		{INSE, XNSE},
+		{XUSE, XUSE},
+		{RITS, RITS},
+		{XSUR, XSUR},
+		{XJKT, XJKT},
 		{ICDX, ICDX},
-		{XJNB, XJNB},
 		{XBBJ, XBBJ},
 		{XIDX, XIDX},
+		{XJNB, XJNB},
+		{IFBX, IFBX},
 		{IMEX, IMEX},
 		{XTEH, XTEH},
-		{IFBX, IFBX},
 		{XIQS, XIQS},
-		{XCDE, XCDE},
-		{BBIE, BBIE},
-		{BBIS, BBIE},
-		{CSGI, CSGI},
+		{SIDX, SIDX},
+		{AILT, AILT},
+		{MAQE, MAQE},
+		{CFIL, CFIL},
+		{BMLI, BMLI},
+		{BMLS, BMLI},
+		{BMLX, BMLX},
+		{BMSI, BMLX},
 		{ITGL, ITGL},
 		{XPOS, ITGL},
-		{XPAC, ITGL},
 		{XRFQ, ITGL},
-		{RMTF, RMTF},
-		{FXRQ, RMTF},
-		{FXFM, RMTF},
-		{FXRS, RMTF},
-		{CFIL, CFIL},
-		{BKDM, BKDM},
+		{XPAC, ITGL},
+		{CEPL, CEPL},
 		{TDGF, TDGF},
-		{AREX, AREX},
+		{BKDM, BKDM},
+		{CSGI, CSGI},
+		{EUCC, EUCC},
 		{MCID, MCID},
 		{MSEL, MSEL},
+		{HREU, HREU},
 		{EQIE, EQIE},
 		{EQSE, EQIE},
-		{LEUE, LEUE},
-		{LEUF, LEUE},
+		{RMTF, RMTF},
+		{FXRS, RMTF},
+		{FXRQ, RMTF},
+		{FXFM, RMTF},
+		{ICUR, ICUR},
+		{ICXR, ICUR},
 		{IFXC, IFXC},
-		{IFXR, IFXC},
 		{IFXA, IFXC},
-		{BMLI, BMLI},
-		{BMLS, BMLI},
+		{IFXR, IFXC},
+		{DAVY, DAVY},
+		{BBIE, BBIE},
+		{BBIS, BBIE},
+		{LEUE, LEUE},
+		{LEUF, LEUE},
 		{SISI, SISI},
-		{CEPL, CEPL},
+		{SEMX, SEMX},
 		{XDUB, XDUB},
-		{XMSM, XDUB},
-		{XEYE, XDUB},
 		{EDBT, XDUB},
 		{EDGL, XDUB},
-		{XATL, XDUB},
+		{XMSM, XDUB},
 		{XESM, XDUB},
+		{XEYE, XDUB},
+		{XATL, XDUB},
+		{XASM, XDUB},
+		{XIEX, XDUB},
+		{DDUB, XDUB},
 		{VFIL, VFIL},
-		{VFXO, VFIL},
 		{VFSI, VFIL},
-		{EUCC, EUCC},
+		{VFXO, VFIL},
+		{AREX, AREX},
+		{XFNX, XFNX},
+		{XCDE, XCDE},
 		{XEBI, XEBI},
-		{ICUR, ICUR},
-		{ICXR, ICUR},
-		{DAVY, DAVY},
-		{HREU, HREU},
-		{SEMX, SEMX},
-		{SIDX, SIDX},
-		{AILT, AILT},
+		{IBSI, IBSI},
+		{GBSI, GBSI},
+		{TMEU, TMEU},
 		{XTAE, XTAE},
-		{HMTF, HMTF},
-		{HMOD, HMTF},
-		{HRFQ, HMTF},
-		{HCER, HMTF},
-		{EMID, EMID},
-		{EMDR, EMID},
-		{EMIR, EMID},
-		{EMIB, EMID},
-		{ISBA, ISBA},
-		{DDTX, DDTX},
-		{BREA, BREA},
+		{UBIM, UBIM},
 		{IMMH, IMMH},
 		{BNLD, BNLD},
-		{XGME, XGME},
+		{BREA, BREA},
+		{BPAS, BPAS},
+		{CREM, CREM},
 		{UCIT, UCIT},
+		{MUBM, MUBM},
+		{ISBA, ISBA},
+		{ISBV, ISBV},
+		{BEIS, BEIS},
 		{IBIS, IBIS},
 		{IBEQ, IBIS},
-		{ISBV, ISBV},
+		{AKIS, AKIS},
+		{DDTX, DDTX},
+		{UBIS, UBIS},
+		{FBSI, FBSI},
 		{MTSO, MTSO},
-		{MTSC, MTSO},
-		{SSOB, MTSO},
-		{EBMX, MTSO},
 		{MCAD, MTSO},
-		{BEIS, BEIS},
-		{MUBM, MUBM},
-		{CREM, CREM},
-		{UBIS, UBIS},
+		{EBMX, MTSO},
+		{MTSM, MTSO},
+		{BOND, MTSO},
+		{SSOB, MTSO},
+		{MTSC, MTSO},
+		{MSWP, MTSO},
+		{ITSM, ITSM},
+		{MTAX, MTAX},
+		{XTLX, XTLX},
 		{CGIT, CGIT},
-		{CGCM, CGIT},
-		{CGND, CGIT},
+		{CGQD, CGIT},
 		{CGDB, CGIT},
-		{CGEB, CGIT},
 		{CGTR, CGIT},
-		{CGQD, CGIT},
-		{CGGD, CGIT},
+		{CGND, CGIT},
+		{CGEB, CGIT},
 		{CGQT, CGIT},
-		{FBSI, FBSI},
+		{CGCM, CGIT},
+		{CGGD, CGIT},
+		{EMID, EMID},
+		{EMIB, EMID},
+		{EMIR, EMID},
+		{EMDR, EMID},
+		{HMTF, HMTF},
+		{HCER, HMTF},
+		{HMOD, HMTF},
+		{HRFQ, HMTF},
+		{TLAB, TLAB},
+		{XGME, XGME},
+		{XMIF, XMIF},
 		{XMIL, XMIL},
-		{XDMI, XMIL},
-		{XMOT, XMIL},
 		{ETLX, XMIL},
+		{SEDX, XMIL},
+		{ETFP, XMIL},
+		{XMOT, XMIL},
+		{MOTX, XMIL},
+		{EXGM, XMIL},
 		{MTAH, XMIL},
 		{ATFX, XMIL},
-		{XAIM, XMIL},
-		{MOTX, XMIL},
-		{ETFP, XMIL},
 		{MIVX, XMIL},
+		{XAIM, XMIL},
+		{XDMI, XMIL},
+		{MACX, XMIL},
 		{MTAA, XMIL},
-		{SEDX, XMIL},
-		{AKIS, AKIS},
+		{BGEM, XMIL},
+		{DMIL, XMIL},
+		{XABJ, XABJ},
 		{XBRV, XBRV},
 		{XJAM, XJAM},
+		{MUDX, MUDX},
+		{BNPX, BNPX},
+		{SBIJ, SBIJ},
+		{SBIU, SBIJ},
+		{XSBI, SBIJ},
+		{SBIV, SBIJ},
+		{BAJD, BAJD},
+		{TWJP, TWJP},
+		{TWJT, TWJP},
+		{BASX, BASX},
+		{UTSL, UTSL},
+		{XIJP, XIJP},
+		{JASR, XIJP},
 		{DRCT, DRCT},
-		{CHIJ, CHIJ},
-		{CHIV, CHIJ},
-		{KAIX, CHIJ},
-		{CHIS, CHIJ},
 		{CITX, CITX},
 		{CITD, CITX},
 		{CSJP, CSJP},
 		{CFJP, CSJP},
-		{MSMS, MSMS},
-		{XKAC, XKAC},
 		{NMRJ, NMRJ},
+		{NMSX, NMRJ},
 		{NXJP, NMRJ},
-		{NXSE, NMRJ},
 		{NXVW, NMRJ},
-		{ICHK, NMRJ},
-		{ICKR, NMRJ},
 		{ICSH, NMRJ},
 		{ICSZ, NMRJ},
+		{ICKR, NMRJ},
+		{ICHK, NMRJ},
+		{NXSE, NMRJ},
 		{ICTW, NMRJ},
-		{NMSX, NMRJ},
+		{NXFO, NMRJ},
+		{NXBX, NMRJ},
+		{JATA, JATA},
+		{XHER, XHER},
+		{BETP, BETP},
+		{CHIJ, CHIJ},
+		{KAIX, CHIJ},
+		{CHIS, CHIJ},
+		{CHIV, CHIJ},
 		{CLJP, CLJP},
-		{XTFF, XTFF},
-		{XNGO, XNGO},
-		{XNKS, XNKS},
-		{BASX, BASX},
-		{XTKT, XTKT},
+		{CMET, CMET},
+		{KABU, KABU},
+		{VKAB, KABU},
+		{LXJP, LXJP},
 		{MAQJ, MAQJ},
-		{SBIJ, SBIJ},
-		{SBIV, SBIJ},
-		{SBIU, SBIJ},
-		{XSBI, SBIJ},
+		{MIZX, MIZX},
+		{MSMS, MSMS},
 		{SIGJ, SIGJ},
-		{BETP, BETP},
+		{XCCE, XCCE},
+		{XFFE, XFFE},
 		{XFKA, XFKA},
-		{TWJP, TWJP},
-		{TWJT, TWJP},
-		{LXJP, LXJP},
-		{XSAP, XSAP},
-		{MIZX, MIZX},
-		{CMET, CMET},
-		{UTSL, UTSL},
-		{BAJD, BAJD},
+		{XHIR, XHIR},
 		{XJPX, XJPX},
-		{XTAM, XJPX},
 		{XTK1, XJPX},
-		{XTK2, XJPX},
-		{XTK3, XJPX},
-		{XTKS, XJPX},
 		{XJAS, XJPX},
+		{XTKS, XJPX},
 		{XOSE, XJPX},
+		{XTAM, XJPX},
+		{XTK3, XJPX},
+		{XTK2, XJPX},
 		{XOSJ, XJPX},
-		{MUDX, MUDX},
-		{BNPX, BNPX},
+		{XKAC, XKAC},
+		{XKGT, XKGT},
+		{XKKT, XKKT},
+		{XKST, XKST},
+		{XKYO, XKYO},
+		{XNGO, XNGO},
+		{XNII, XNII},
+		{XNKS, XNKS},
+		{XNST, XNST},
+		{XOSM, XOSM},
+		{XOST, XOST},
+		{XSAP, XSAP},
+		{XTFF, XTFF},
+		{XTKA, XTKA},
+		{XTKO, XTKO},
+		{XTKT, XTKT},
+		{XYKT, XYKT},
+		{ODXE, ODXE},
+		{ODST, ODXE},
+		{SMBC, SMBC},
+		{CILH, CILH},
+		{TICT, TICT},
+		{BGCJ, BGCJ},
+		{JEFA, JEFA},
 		{XAMM, XAMM},
 		{AMNL, XAMM},
-		{ETSC, ETSC},
 		{CCEX, CCEX},
 		{AIXK, AIXK},
+		{ETSC, ETSC},
 		{XKAZ, XKAZ},
+		{ITSL, ITSL},
 		{XNAI, XNAI},
-		{XKFB, XKFB},
 		{GSXK, GSXK},
+		{KOCN, KOCN},
+		{XKFB, XKFB},
+		{XKOR, XKOR},
 		{XKRX, XKRX},
+		{XKON, XKRX},
 		{XKFE, XKRX},
 		{XKOS, XKRX},
-		{XKON, XKRX},
 		{XKCM, XKRX},
 		{XKEM, XKRX},
 		{XKUW, XKUW},
 		{XKSE, XKSE},
 		{XLAO, XLAO},
+		{SWLV, SWLV},
 		{XRIS, XRIS},
 		{FNLV, XRIS},
-		{SWLV, SWLV},
 		{XBEY, XBEY},
 		{XLSM, XLSM},
+		{XNOM, XNOM},
+		{XLLB, XLLB},
 		{XVPB, XVPB},
 		{XLGT, XLGT},
-		{XLLB, XLLB},
-		{XNOM, XNOM},
-		{BAPX, BAPX},
-		{SWLT, SWLT},
+		{ARTX, ARTX},
 		{SEBL, SEBL},
+		{SWLT, SWLT},
+		{BAPX, BAPX},
 		{GETB, GETB},
+		{NASB, NASB},
 		{XLIT, XLIT},
+		{XVIA, XLIT},
 		{FNLT, XLIT},
-		{CCLX, CCLX},
+		{BGLU, BGLU},
+		{BILU, BILU},
+		{BLUX, BLUX},
 		{DBLX, DBLX},
 		{DHLX, DHLX},
-		{XVES, XVES},
-		{BLUX, BLUX},
-		{BILU, BILU},
 		{KBLL, KBLL},
-		{KBLT, KBLL},
-		{KBLS, KBLL},
 		{KBLC, KBLL},
+		{KBLS, KBLL},
+		{KBLT, KBLL},
+		{MIBL, MIBL},
+		{BCEE, BCEE},
 		{RBCB, RBCB},
 		{RBSI, RBCB},
+		{XVES, XVES},
+		{CCLX, CCLX},
 		{XLUX, XLUX},
 		{EMTF, XLUX},
-		{BCEE, BCEE},
-		{BGLU, BGLU},
+		{BDPL, BDPL},
 		{XMAE, XMAE},
 		{XMDG, XMDG},
 		{XMSW, XMSW},
-		{XLFX, XLFX},
+		{XLOF, XLOF},
 		{XKLS, XKLS},
 		{MESQ, XKLS},
+		{XLFX, XLFX},
 		{XRBM, XRBM},
 		{MALX, MALX},
-		{COMG, COMG},
+		{EWSM, EWSM},
 		{XMAL, XMAL},
 		{IFSM, XMAL},
 		{PROS, XMAL},
-		{GBOT, GBOT},
-		{XMAU, XMAU},
+		{COMG, COMG},
 		{AFEX, AFEX},
+		{GBOT, GBOT},
 		{XAFX, XAFX},
+		{XMAU, XMAU},
+		{BIVA, BIVA},
 		{CGMX, CGMX},
-		{XMEX, XMEX},
 		{XEMD, XEMD},
-		{BIVA, BIVA},
+		{XMEX, XMEX},
 		{XMOL, XMOL},
 		{XULA, XULA},
 		{XMNX, XMNX},
 		{XCAS, XCAS},
 		{XBVM, XBVM},
+		{XMAP, XMAP},
 		{XNAM, XNAM},
 		{XNEP, XNEP},
-		{NZFX, NZFX},
+		{XAUK, XAUK},
+		{XNEE, XNEE},
 		{XNZE, XNZE},
+		{NZXC, XNZE},
+		{NZXM, XNZE},
 		{XMAN, XMAN},
-		{XNSA, XNSA},
 		{NASX, NASX},
-		{FISH, FISH},
-		{FSHX, FSHX},
-		{SPTR, SPTR},
+		{XNSA, XNSA},
+		{NORX, NORX},
+		{STEE, NORX},
+		{BULK, NORX},
+		{ELUK, NORX},
+		{ELNO, NORX},
+		{ELSE, NORX},
+		{ELEU, NORX},
+		{FREI, NORX},
 		{XOSL, XOSL},
 		{XOAS, XOSL},
-		{XOSC, XOSL},
+		{MERK, XOSL},
 		{XOBD, XOSL},
-		{XOAM, XOSL},
+		{XOAA, XOSL},
 		{XOSA, XOSL},
+		{BURG, XOSL},
 		{MERD, XOSL},
-		{MERK, XOSL},
+		{XOAM, XOSL},
+		{BURM, XOSL},
+		{XOSC, XOSL},
+		{XOAD, XOSL},
 		{XOSD, XOSL},
-		{XOAA, XOSL},
 		{NIBR, XOSL},
-		{XOAD, XOSL},
+		{DOSL, XOSL},
+		{SPTR, SPTR},
+		{ICAS, ICAS},
+		{OAPA, OAPA},
 		{SB1M, SB1M},
+		{XABG, XABG},
+		{CNSI, CNSI},
+		{XDNB, XDNB},
+		{NEXO, NEXO},
+		{FISH, FISH},
+		{FSHX, FSHX},
 		{NOPS, NOPS},
-		{NORX, NORX},
-		{ELUK, NORX},
-		{FREI, NORX},
-		{ELEU, NORX},
-		{ELSE, NORX},
-		{ELNO, NORX},
+		{NOSC, NOSC},
 		{NOTC, NOTC},
-		{XDNB, XDNB},
 		{OSLC, OSLC},
-		{OAPA, OAPA},
-		{CNSI, CNSI},
 		{XIMA, XIMA},
-		{NOSC, NOSC},
-		{NEXO, NEXO},
-		{XABG, XABG},
+		{INFT, INFT},
+		{FNDS, INFT},
+		{IFFX, INFT},
+		{QUNT, INFT},
+		{HWHE, HWHE},
 		{XMUS, XMUS},
-		{NCEL, NCEL},
+		{MSXB, XMUS},
+		{MSXO, XMUS},
+		{XISL, XISL},
 		{XKAR, XKAR},
+		{XLAH, XLAH},
+		{NCEL, NCEL},
 		{XPAE, XPAE},
 		{XPTY, XPTY},
 		{XPOM, XPOM},
@@ -912,1168 +1128,1558 @@ func TestOperationalMIC(t *testing.T) {
 		{CLPH, CLPH},
 		{PDEX, PDEX},
 		{XPHS, XPHS},
+		{CAPL, CAPL},
+		{PARK, PARK},
+		{BNPP, BNPP},
 		{PKOP, PKOP},
-		{PTPG, PTPG},
-		{MBPL, MBPL},
 		{INGW, INGW},
-		{HBPL, HBPL},
-		{BPKO, BPKO},
+		{MBPL, MBPL},
 		{SIAB, SIAB},
-		{PARK, PARK},
+		{BHWA, BHWA},
+		{BPKO, BPKO},
+		{IENG, IENG},
+		{HBPL, HBPL},
+		{WBKP, WBKP},
+		{PTPG, PTPG},
+		{MTSP, MTSP},
+		{KDPW, KDPW},
 		{XWAR, XWAR},
-		{WDER, XWAR},
-		{WETP, XWAR},
+		{XGLO, XWAR},
 		{WIND, XWAR},
-		{WMTF, XWAR},
-		{WBCL, XWAR},
-		{WBON, XWAR},
-		{BOSP, XWAR},
-		{RPWC, XWAR},
-		{TBSP, XWAR},
+		{WOPO, XWAR},
+		{PLPD, XWAR},
 		{XNCO, XWAR},
 		{WIPO, XWAR},
-		{PLPD, XWAR},
+		{CETO, XWAR},
+		{RPWC, XWAR},
+		{TBSP, XWAR},
+		{TBSA, XWAR},
+		{BOSP, XWAR},
+		{WETP, XWAR},
 		{PLPO, XWAR},
 		{PLPS, XWAR},
-		{TBSA, XWAR},
-		{XGLO, XWAR},
-		{WBKP, WBKP},
-		{BNPP, BNPP},
-		{CAPL, CAPL},
-		{BHWA, BHWA},
-		{OMIC, OMIC},
-		{OPEX, OPEX},
+		{WBLC, XWAR},
+		{WBCL, XWAR},
+		{WBON, XWAR},
+		{WMTF, XWAR},
+		{WDER, XWAR},
+		{WCDE, XWAR},
+		{POEE, XWAR},
+		{WGAS, XWAR},
+		{PLPX, XWAR},
+		{NSSA, NSSA},
+		{MSDM, MSDM},
+		{XBRY, XBRY},
+		{MBCP, MBCP},
 		{OMIP, OMIP},
 		{XLIS, XLIS},
-		{ALXL, XLIS},
 		{ENXL, XLIS},
+		{ALXL, XLIS},
 		{MFOX, XLIS},
 		{WQXL, XLIS},
+		{DLIS, XLIS},
 		{BFPT, BFPT},
+		{MDIP, MDIP},
+		{OMIC, OMIC},
+		{OPEX, OPEX},
+		{PMTS, PMTS},
 		{DSMD, DSMD},
 		{TRPX, TRPX},
-		{SECE, SECE},
 		{SECC, SECC},
 		{SECD, SECD},
+		{SECE, SECE},
 		{SEDC, SEDC},
+		{BTRL, BTRL},
+		{OTPR, OTPR},
 		{BRDE, BRDE},
 		{BRDS, BRDE},
 		{BRDL, BRDE},
+		{BMFX, BMFX},
+		{SBMF, BMFX},
+		{BMFM, BMFX},
+		{BMFA, BMFX},
 		{XBRM, XBRM},
+		{EMCE, XBRM},
+		{BRMF, XBRM},
 		{XBSE, XBSE},
 		{XCAN, XBSE},
 		{XRAS, XBSE},
 		{XBSD, XBSE},
 		{XRPM, XRPM},
-		{OTPR, OTPR},
-		{BTRL, BTRL},
+		{RRSI, RRSI},
+		{SPBE, SPBE},
+		{RUSX, RUSX},
+		{XMOS, XMOS},
+		{XPET, XPET},
 		{MISX, MISX},
 		{RTSX, MISX},
-		{RPDX, RPDX},
+		{XSIC, XSIC},
+		{XMIC, XMIC},
+		{URCE, URCE},
+		{XROV, XROV},
+		{IXSP, IXSP},
 		{NAMX, NAMX},
+		{NNCS, NNCS},
+		{RPDX, RPDX},
 		{SPIM, SPIM},
-		{RUSX, RUSX},
+		{XAPI, XAPI},
+		{XPIC, XPIC},
 		{XRUS, XRUS},
 		{XSAM, XSAM},
-		{XMOS, XMOS},
 		{XSIB, XSIB},
-		{XPET, XPET},
-		{XPIC, XPIC},
-		{SPBE, SPBE},
+		{XVLA, XVLA},
 		{ROTC, ROTC},
 		{RSEX, RSEX},
 		{XECS, XECS},
 		{SVXI, SVXI},
 		{XSAU, XSAU},
 		{XBEL, XBEL},
-		{CLTD, CLTD},
-		{JADX, JADX},
+		{BBLX, BBLX},
+		{OSSG, OSSG},
+		{CNOD, CNOD},
+		{EQOS, EQOS},
+		{EQOC, EQOS},
+		{EQOD, EQOS},
+		{ENMS, ENMS},
+		{BTBS, BTBS},
+		{SYFX, SYFX},
+		{TSIG, TSIG},
 		{APEX, APEX},
 		{APCL, APEX},
+		{TWSG, TWSG},
+		{MASG, MASG},
+		{MALM, MASG},
+		{RFQS, MASG},
+		{CLTD, CLTD},
+		{CHIE, CHIE},
 		{ECAL, ECAL},
+		{EEAL, EEAL},
+		{IFSG, IFSG},
+		{JADX, JADX},
+		{RTSP, RTSP},
+		{FXSM, RTSP},
 		{SMEX, SMEX},
 		{TFSA, TFSA},
-		{SYFX, SYFX},
-		{TSIG, TSIG},
-		{BTBS, BTBS},
 		{XSES, XSES},
-		{XSIM, XSES},
+		{XSCA, XSES},
 		{XSBT, XSES},
+		{XSCL, XSES},
+		{XSIM, XSES},
 		{XSCE, XSES},
-		{XSCA, XSES},
-		{IFSG, IFSG},
-		{MASG, MASG},
-		{MALM, MASG},
-		{EEAL, EEAL},
-		{RTSP, RTSP},
-		{TWSG, TWSG},
-		{ENMS, ENMS},
-		{EQOS, EQOS},
-		{EQOC, EQOS},
-		{EQOD, EQOS},
+		{TERM, TERM},
+		{XABX, XABX},
+		{TRDC, TRDC},
+		{XAND, XAND},
+		{XANM, XANM},
+		{LMAS, LMAS},
+		{GFSG, GFSG},
+		{BGSG, BGSG},
+		{ICPS, ICPS},
+		{XANS, XANS},
+		{CBSK, CBSK},
+		{XRMS, XRMS},
 		{SPXE, SPXE},
 		{XBRA, XBRA},
 		{EBRA, XBRA},
-		{CBSK, CBSK},
+		{VUBA, VUBA},
+		{SKBB, SKBB},
+		{XLJS, XLJS},
 		{XLJU, XLJU},
 		{XLJM, XLJU},
-		{SKBB, SKBB},
 		{XSOP, XSOP},
+		{RMMS, RMMS},
+		{RMMX, RMMS},
+		{X4AXE, X4AXE},
+		{ZARX, ZARX},
+		{A2XX, A2XX},
 		{XJSE, XJSE},
+		{JSER, XJSE},
+		{ZFXM, XJSE},
+		{JSEB, XJSE},
 		{XBES, XJSE},
+		{ALTX, XJSE},
 		{XSAF, XJSE},
 		{XSFA, XJSE},
 		{YLDX, XJSE},
-		{ALTX, XJSE},
-		{JSEB, XJSE},
-		{JSER, XJSE},
-		{ZFXM, XJSE},
-		{A2XX, A2XX},
-		{X4AXE, X4AXE},
-		{ZARX, ZARX},
-		{RMMS, RMMS},
-		{RMMX, RMMS},
-		{MIBG, MIBG},
-		{MDRV, MIBG},
-		{SANT, SANT},
-		{XDPA, XDPA},
-		{CABK, CABK},
-		{IBGH, IBGH},
-		{OMEL, OMEL},
-		{PAVE, PAVE},
-		{XNAF, XNAF},
+		{EESX, EESX},
+		{CBMS, CBMS},
+		{POSE, POSE},
+		{TEUR, TEUR},
+		{CECA, CECA},
+		{BSAB, BSAB},
+		{DOWM, DOWM},
+		{DOWE, DOWM},
+		{IBER, IBER},
+		{ALLT, ALLT},
+		{CSMD, CSMD},
+		{SIMD, CSMD},
+		{AGBP, AGBP},
+		{ABAN, ABAN},
 		{BCMA, BCMA},
+		{CABK, CABK},
+		{SANT, SANT},
 		{CIMD, CIMD},
-		{CIMB, CIMD},
+		{CIMA, CIMD},
 		{CIMV, CIMD},
+		{CIMB, CIMD},
 		{CIME, CIMD},
-		{CIMA, CIMD},
 		{BBVA, BBVA},
 		{CAPI, CAPI},
 		{CMAP, CAPI},
-		{ABAN, ABAN},
+		{TPES, TPES},
+		{TOMF, TPES},
+		{TOMG, TPES},
+		{IBGH, IBGH},
 		{BMEX, BMEX},
 		{MABX, BMEX},
+		{GROW, BMEX},
+		{XMFX, BMEX},
+		{XMCE, BMEX},
+		{BMEA, BMEX},
 		{SEND, BMEX},
-		{XBAR, BMEX},
-		{XBIL, BMEX},
 		{XDRF, BMEX},
-		{XLAT, BMEX},
+		{MARF, BMEX},
+		{BMCL, BMEX},
+		{MERF, BMEX},
+		{XBIL, BMEX},
 		{XMAD, BMEX},
-		{XMRV, BMEX},
+		{SBIL, BMEX},
+		{SBAR, BMEX},
 		{XVAL, BMEX},
+		{XBAR, BMEX},
+		{XLAT, BMEX},
+		{XMEF, BMEX},
 		{XMPW, BMEX},
-		{XMFX, BMEX},
-		{SBAR, BMEX},
-		{SBIL, BMEX},
-		{MERF, BMEX},
-		{BMCL, BMEX},
-		{BMEA, BMEX},
-		{MARF, BMEX},
-		{GROW, BMEX},
-		{ALLT, ALLT},
-		{CSMD, CSMD},
-		{SIMD, CSMD},
-		{AGBP, AGBP},
-		{DOWM, DOWM},
-		{DOWE, DOWM},
-		{TPES, TPES},
-		{TOMG, TPES},
-		{IBER, IBER},
-		{CECA, CECA},
-		{BSAB, BSAB},
-		{TEUR, TEUR},
+		{XMRV, BMEX},
+		{SCLE, BMEX},
+		{MIBG, MIBG},
+		{MDRV, MIBG},
+		{OMEL, OMEL},
+		{PAVE, PAVE},
+		{XBAV, XBAV},
+		{XDPA, XDPA},
+		{XFCM, XFCM},
+		{XNAF, XNAF},
+		{XSRM, XSRM},
 		{XCOL, XCOL},
 		{XKHA, XKHA},
 		{XSWA, XSWA},
+		{PEPW, PEPW},
+		{PEPQ, PEPW},
+		{PEPY, PEPW},
+		{PEPH, PEPW},
+		{PEPM, PEPW},
+		{XSAT, XSAT},
+		{SPDK, XSAT},
+		{SPNO, XSAT},
+		{SPFI, XSAT},
+		{SPEU, XSAT},
+		{GFKS, GFKS},
+		{SEBX, SEBX},
+		{SEBS, SEBS},
+		{ENSX, SEBS},
+		{XABC, XABC},
 		{SVEX, SVEX},
 		{SVES, SVEX},
-		{XABC, XABC},
-		{XNGM, XNGM},
-		{NMTF, XNGM},
-		{NSME, XNGM},
-		{CRYX, CRYX},
 		{CASI, CASI},
-		{CRYD, CRYD},
-		{XSAT, XSAT},
-		{SEBS, SEBS},
-		{GFKS, GFKS},
 		{SWBI, SWBI},
+		{NAPA, NAPA},
+		{CRYD, CRYD},
+		{CRYX, CRYX},
+		{XNGM, XNGM},
+		{XNDX, XNGM},
+		{XNMR, XNGM},
+		{NSME, XNGM},
+		{NMTF, XNGM},
+		{XOME, XOME},
 		{XSTO, XSTO},
-		{ESTO, XSTO},
-		{FIED, XSTO},
-		{DSTO, XSTO},
-		{DOSE, XSTO},
-		{DNSE, XSTO},
-		{EBON, XSTO},
-		{NOFI, XSTO},
-		{MSTO, XSTO},
+		{NOCO, XSTO},
 		{SSME, XSTO},
-		{CSTO, XSTO},
-		{MNSE, XSTO},
-		{SEED, XSTO},
+		{GBWB, XSTO},
 		{MOSE, XSTO},
+		{DOSE, XSTO},
+		{ESTO, XSTO},
+		{ONSE, XSTO},
 		{DKED, XSTO},
+		{DKFI, XSTO},
 		{NOED, XSTO},
-		{XOPV, XSTO},
-		{FNSE, XSTO},
+		{SEED, XSTO},
 		{PNED, XSTO},
-		{EUWB, XSTO},
 		{USWB, XSTO},
-		{GBWB, XSTO},
-		{DKFI, XSTO},
-		{ONSE, XSTO},
-		{NAPA, NAPA},
-		{PEPW, PEPW},
-		{PEPM, PEPW},
-		{PEPQ, PEPW},
-		{PEPH, PEPW},
-		{PEPY, PEPW},
-		{OTXB, OTXB},
-		{CCMS, CCMS},
-		{CSAG, CSAG},
-		{CSOT, CSAG},
-		{XBRN, XBRN},
-		{S3FM, S3FM},
-		{CSZH, CSZH},
-		{UBSG, UBSG},
-		{UBSF, UBSG},
-		{UBSC, UBSG},
-		{STOX, STOX},
-		{XSCU, STOX},
-		{XSTV, STOX},
-		{XSTX, STOX},
-		{DOTS, DOTS},
-		{EBSS, EBSS},
-		{EBSC, EBSS},
-		{XSWX, XSWX},
-		{XBTR, XSWX},
+		{NOFI, XSTO},
+		{FIED, XSTO},
+		{EBON, XSTO},
+		{EUWB, XSTO},
+		{XOPV, XSTO},
+		{NASN, XSTO},
+		{DNSE, XSTO},
+		{FNSE, XSTO},
+		{CSTO, XSTO},
+		{MNSE, XSTO},
+		{MSTO, XSTO},
+		{DSTO, XSTO},
+		{USOB, XSTO},
+		{SEWB, XSTO},
+		{DKWB, XSTO},
+		{NOWB, XSTO},
+		{SEOB, XSTO},
+		{DKOB, XSTO},
+		{EUOB, XSTO},
+		{GBOB, XSTO},
+		{NOOB, XSTO},
+		{NSPO, NSPO},
+		{XSWX, XSWX},
+		{XSDX, XSWX},
+		{XDLP, XSWX},
 		{XSWM, XSWX},
+		{XSLS, XSWX},
 		{XSEB, XSWX},
-		{XQMH, XSWX},
-		{XSDX, XSWX},
+		{XBTR, XSWX},
+		{XVTX, XSWX},
 		{XQOD, XSWX},
-		{XDLP, XSWX},
+		{XQMH, XSWX},
 		{CBOE, CBOE},
-		{VLEX, VLEX},
+		{XICB, XICB},
+		{EUCH, EUCH},
+		{EUSP, EUCH},
+		{EURM, EUCH},
+		{EUSC, EUCH},
+		{CSAG, CSAG},
+		{CSOT, CSAG},
+		{CCMS, CCMS},
+		{CSZH, CSZH},
+		{OTXB, OTXB},
 		{ROSR, ROSR},
-		{XROT, ROSR},
 		{XREP, ROSR},
+		{XROT, ROSR},
+		{EBSS, EBSS},
+		{EBSC, EBSS},
+		{VLEX, VLEX},
+		{AIXE, AIXE},
+		{DOTS, DOTS},
+		{S3FM, S3FM},
+		{STOX, STOX},
+		{XSTV, STOX},
+		{XSCU, STOX},
+		{XSTX, STOX},
+		{UBSG, UBSG},
+		{UBSC, UBSG},
+		{UBSF, UBSG},
+		{UBST, UBST},
+		{XBRN, XBRN},
+		{EQWB, XBRN},
+		{XSWO, XSWO},
 		{ZKBX, ZKBX},
 		{KMUX, ZKBX},
+		{RULE, RULE},
+		{BXDA, BXDA},
+		{TDXS, TDXS},
 		{XDSE, XDSE},
+		{GSXT, GSXT},
+		{CFTW, CFTW},
 		{ROCO, ROCO},
+		{XIME, XIME},
+		{XTAD, XTAD},
 		{XTAF, XTAF},
 		{XTAI, XTAI},
-		{CFTW, CFTW},
-		{GSXT, GSXT},
 		{XDAR, XDAR},
-		{BEEX, BEEX},
 		{AFET, AFET},
+		{BEEX, BEEX},
+		{TFEX, TFEX},
 		{XBKK, XBKK},
-		{XBKF, XBKK},
 		{XMAI, XBKK},
-		{TFEX, TFEX},
-		{CLMX, CLMX},
+		{XBKF, XBKK},
+		{RR4G, RR4G},
+		{TRNL, TRNL},
+		{ABNC, ABNC},
+		{JNSI, JNSI},
 		{MANL, MANL},
 		{MXNL, MANL},
+		{RFQN, MANL},
+		{CAVD, CAVD},
+		{JLEU, JLEU},
+		{MHBE, MHBE},
+		{BTAM, BTAM},
+		{BTQE, BTAM},
+		{CABV, CABV},
+		{TOWR, TOWR},
 		{FLWX, FLWX},
 		{FLTR, FLWX},
 		{FLTB, FLWX},
-		{MHBE, MHBE},
-		{JLEU, JLEU},
-		{NDEX, NDEX},
-		{IMCO, NDEX},
-		{IMEQ, NDEX},
-		{NDXS, NDEX},
-		{INGB, INGB},
-		{INGE, INGB},
-		{INGF, INGB},
-		{ETPA, ETPA},
-		{XAMS, XAMS},
-		{TNLA, XAMS},
-		{XEUC, XAMS},
-		{XEUE, XAMS},
-		{XEUI, XAMS},
-		{HCHC, HCHC},
-		{COMM, COMM},
+		{IPNL, IPNL},
+		{ISWP, ISWP},
+		{ISWO, ISWP},
+		{ISWN, ISWP},
+		{ISWT, ISWP},
 		{EBSN, EBSN},
+		{NEXY, EBSN},
 		{RESF, EBSN},
 		{EBSF, EBSN},
 		{IEBS, EBSN},
+		{EBSI, EBSN},
+		{RESE, EBSN},
+		{EBSD, EBSN},
 		{ECEU, ECEU},
-		{BTAM, BTAM},
-		{BTQE, BTAM},
-		{CAVD, CAVD},
-		{ABNA, ABNA},
-		{TWEU, TWEU},
-		{TWEA, TWEU},
-		{TWEM, TWEU},
-		{TWEO, TWEU},
-		{NLPX, NLPX},
-		{RABO, RABO},
-		{XEMS, XEMS},
-		{CABV, CABV},
-		{TOWR, TOWR},
-		{NPEX, NPEX},
-		{BAPE, BAPE},
-		{BTFE, BTFE},
-		{AFSA, AFSA},
-		{AFSL, AFSA},
-		{AFSX, AFSA},
-		{AFSI, AFSA},
-		{AFSO, AFSA},
-		{NWNV, NWNV},
+		{OHVO, OHVO},
+		{MUSN, MUSN},
+		{NIBC, NIBC},
+		{CCRM, CCRM},
+		{CEDX, CCRM},
+		{BARU, CCRM},
+		{BEUO, CCRM},
+		{BEUT, CCRM},
 		{CCXE, CCXE},
-		{CEUX, CCXE},
-		{CEUD, CCXE},
 		{BEUP, CCXE},
+		{BEUF, CCXE},
+		{CEUD, CCXE},
+		{BEUD, CCXE},
+		{CEUX, CCXE},
 		{CEUO, CCXE},
-		{LISZ, CCXE},
+		{BEUE, CCXE},
+		{CEUE, CCXE},
 		{CAPA, CCXE},
+		{LISZ, CCXE},
+		{NWNV, NWNV},
+		{ABNA, ABNA},
 		{TQEX, TQEX},
-		{TQEA, TQEX},
-		{TQEM, TQEX},
 		{TQEB, TQEX},
-		{MUBE, MUBE},
-		{CCRM, CCRM},
-		{BEUO, CCRM},
-		{BEUT, CCRM},
-		{BARU, CCRM},
-		{CEDX, CCRM},
+		{TQEM, TQEX},
+		{TQEA, TQEX},
 		{IMCT, IMCT},
-		{NIBC, NIBC},
+		{MUBE, MUBE},
+		{BAPE, BAPE},
+		{BTFE, BTFE},
+		{COMM, COMM},
+		{NPEX, NPEX},
+		{AFSA, AFSA},
+		{AFSO, AFSA},
+		{AFSX, AFSA},
+		{AFSL, AFSA},
+		{AFSI, AFSA},
+		{ETPA, ETPA},
+		{RABO, RABO},
+		{INGB, INGB},
+		{INGE, INGB},
+		{INGF, INGB},
 		{CPTX, CPTX},
-		{MUSN, MUSN},
-		{TRNL, TRNL},
-		{AFSE, AFSE},
-		{ISWP, ISWP},
-		{ISWN, ISWP},
-		{ISWO, ISWP},
-		{ISWT, ISWP},
+		{TOMX, TOMX},
+		{TOMD, TOMX},
+		{NDEX, NDEX},
+		{IMCO, NDEX},
+		{IMEQ, NDEX},
+		{NDXS, NDEX},
 		{XNXC, XNXC},
 		{XNXD, XNXC},
-		{OHVO, OHVO},
-		{JNSI, JNSI},
-		{ABNC, ABNC},
+		{AFSE, AFSE},
+		{CLMX, CLMX},
+		{ECXE, ECXE},
+		{HCHC, HCHC},
+		{NLPX, NLPX},
+		{TWEU, TWEU},
+		{TWEM, TWEU},
+		{TWEA, TWEU},
+		{TWEO, TWEU},
+		{XACE, XACE},
+		{XAEX, XAEX},
+		{XAMS, XAMS},
+		{ALXA, XAMS},
+		{XEUC, XAMS},
+		{XEUI, XAMS},
+		{TNLA, XAMS},
+		{XHFT, XAMS},
+		{XEUE, XAMS},
+		{DAMS, XAMS},
+		{XEMS, XEMS},
+		{XFTA, XFTA},
+		{STXS, STXS},
+		{CBAE, CBAE},
+		{LEBV, LEBV},
+		{GMGE, GMGE},
+		{D2XG, D2XG},
+		{D2XC, D2XG},
 		{XTRN, XTRN},
 		{XTUN, XTUN},
+		{BTUN, XTUN},
+		{TMEX, TMEX},
+		{EWRM, TMEX},
 		{EXTR, EXTR},
-		{XEID, EXTR},
 		{XEDA, EXTR},
+		{XEID, EXTR},
+		{XIAB, XIAB},
 		{XIST, XIST},
-		{XEQY, XIST},
-		{XFNO, XIST},
 		{XPMS, XIST},
+		{XFNO, XIST},
 		{XDSM, XIST},
-		{TMEX, TMEX},
-		{EWRM, TMEX},
+		{XEQY, XIST},
+		{XTUR, XTUR},
 		{ULTX, ULTX},
 		{XUGA, XUGA},
+		{UICE, UICE},
+		{XNDU, XNDU},
 		{EESE, EESE},
-		{XKHR, XKHR},
-		{XKIE, XKIE},
-		{XDFB, XDFB},
-		{XKIS, XKIS},
 		{PFTS, PFTS},
 		{PFTQ, PFTS},
-		{UKEX, UKEX},
 		{SEPE, SEPE},
-		{XUAX, XUAX},
-		{UICE, UICE},
+		{UKEX, UKEX},
+		{XDFB, XDFB},
+		{XKHR, XKHR},
+		{XKIE, XKIE},
+		{XKIS, XKIS},
 		{XODE, XODE},
-		{XUKR, XUKR},
-		{XNDU, XNDU},
 		{XPRI, XPRI},
-		{DGCX, DGCX},
+		{XUAX, XUAX},
+		{XUKR, XUKR},
+		{MIDC, MIDC},
+		{DEXE, DEXE},
+		{GLOM, GLOM},
+		{IFAD, IFAD},
+		{GMGD, GMGD},
 		{DIFX, DIFX},
+		{DGCX, DGCX},
 		{DUMX, DUMX},
-		{XDFM, XDFM},
-		{GMGD, GMGD},
-		{XADS, XADS},
-		{GLOM, GLOM},
-		{DEXE, DEXE},
 		{KRME, KRME},
 		{MATX, MATX},
-		{IFAD, IFAD},
-		{ABXX, ABXX},
-		{MIDC, MIDC},
-		{BALT, BALT},
-		{BLTX, BALT},
-		{BGCI, BGCI},
-		{BGCO, BGCI},
-		{BGCB, BGCI},
-		{BOAT, BOAT},
-		{BOSC, BOSC},
-		{GFIC, GFIC},
-		{GFIF, GFIC},
-		{GFIN, GFIC},
-		{GFIR, GFIC},
-		{GFSO, GFIC},
-		{GFSM, GFIC},
-		{BTEE, BTEE},
-		{BTQG, BTEE},
-		{EBSM, BTEE},
-		{BCXE, BCXE},
-		{BATE, BCXE},
-		{CHIX, BCXE},
-		{BATF, BCXE},
-		{CHIO, BCXE},
-		{BATP, BCXE},
-		{BATD, BCXE},
-		{BOTC, BCXE},
-		{CHID, BCXE},
-		{LISX, BCXE},
-		{EXEU, EXEU},
-		{EXMP, EXEU},
-		{EXOR, EXEU},
-		{EXVP, EXEU},
-		{EXBO, EXEU},
-		{EXCP, EXEU},
-		{EXSI, EXEU},
-		{EXDC, EXEU},
-		{EXLP, EXEU},
-		{CCO2, CCO2},
-		{CHEV, CHEV},
-		{BLNK, CHEV},
-		{NDCM, NDCM},
-		{FAIR, FAIR},
-		{GEMX, GEMX},
-		{IFEU, IFEU},
-		{CXRT, IFEU},
-		{IFLO, IFEU},
-		{IFLL, IFEU},
-		{CXOT, IFEU},
-		{IFUT, IFEU},
-		{IFLX, IFEU},
-		{IFEN, IFEU},
-		{ISWA, ISWA},
-		{ISWR, ISWA},
-		{ISWB, ISWA},
-		{ISWC, ISWA},
-		{KLEU, KLEU},
-		{LIQU, LIQU},
-		{LIQH, LIQU},
-		{LIQF, LIQU},
-		{LMAX, LMAX},
-		{LMAD, LMAX},
-		{LMAE, LMAX},
-		{LMAF, LMAX},
-		{LMAO, LMAX},
-		{LPPM, LPPM},
-		{MAEL, MAEL},
-		{MXLM, MAEL},
-		{MFGL, MFGL},
-		{XLDN, XLDN},
-		{TNLL, XLDN},
-		{XSMP, XLDN},
-		{ENSY, XLDN},
-		{MAQI, MAQI},
-		{VAGL, VAGL},
-		{GMEG, GMEG},
-		{XLDX, GMEG},
-		{XGDX, GMEG},
-		{XGSX, GMEG},
-		{XGCX, GMEG},
-		{BRNX, BRNX},
-		{TPSL, TPSL},
-		{TSMR, TPSL},
-		{CSIN, CSIN},
-		{CSSI, CSIN},
-		{XSWB, XSWB},
-		{QWIX, QWIX},
-		{RBSX, RBSX},
-		{MCUR, MCUR},
-		{MCXR, MCUR},
-		{MCXS, MCUR},
-		{UKPX, UKPX},
-		{ENCL, ENCL},
+		{XADS, XADS},
+		{XDFM, XDFM},
+		{VMEX, VMEX},
+		{ABXX, VMEX},
+		{ACXL, ACXL},
+		{ACXC, ACXC},
+		{RFIM, RFIM},
+		{XGGI, XGGI},
+		{DRSP, DRSP},
+		{ZODM, ZODM},
+		{TRUK, TRUK},
+		{UGEN, UGEN},
+		{IOTF, IOTF},
+		{ILCM, IOTF},
+		{IUOB, IOTF},
+		{IOFB, IOTF},
+		{IOFI, IOTF},
+		{IOFX, IOTF},
+		{IOGB, IOTF},
+		{IOCD, IOTF},
+		{IOED, IOTF},
+		{IOGI, IOTF},
+		{IOIR, IOTF},
+		{IOMM, IOTF},
+		{XUBS, XUBS},
+		{XUMP, XUBS},
+		{DBIX, DBIX},
+		{DBDC, DBIX},
+		{DBSE, DBIX},
+		{DBCX, DBIX},
+		{DBCR, DBIX},
+		{UNGB, UNGB},
+		{CFIC, CFIC},
 		{TRAX, TRAX},
-		{CCEU, CCEU},
-		{RBCE, RBCE},
-		{EXOT, EXOT},
-		{GRIF, GRIF},
-		{GRIO, GRIF},
-		{CSLB, CSLB},
-		{GSIL, GSIL},
-		{GSSI, GSIL},
-		{XGCL, XGCL},
-		{XCOR, XCOR},
-		{GRSE, GRSE},
-		{VEGA, VEGA},
-		{ISSI, ISSI},
-		{LOYD, LOYD},
-		{SPRZ, SPRZ},
-		{MYTR, MYTR},
-		{N2EX, N2EX},
-		{CLVE, CLVE},
-		{CIBC, CIBC},
-		{CGMG, CGMG},
-		{AUTX, AUTX},
-		{AUTB, AUTX},
-		{AUTP, AUTX},
-		{AFDL, AFDL},
-		{VCMO, VCMO},
-		{PIEU, PIEU},
-		{PIRM, PIRM},
+		{SIFX, SIFX},
+		{ARAX, ARAX},
+		{RABL, RABL},
+		{NTUK, NTUK},
+		{EVOL, EVOL},
+		{BSPL, BSPL},
+		{BNPL, BNPL},
+		{MSBI, MSBI},
+		{MBSI, MSBI},
+		{AQSE, AQSE},
+		{AQST, AQSE},
+		{AQSL, AQSE},
+		{AQSN, AQSE},
+		{AQSG, AQSE},
+		{AQSF, AQSE},
+		{AQSD, AQSE},
+		{LELE, LELE},
+		{WFLB, WFLB},
+		{NBFL, NBFL},
+		{NEXX, NEXX},
+		{NEXT, NEXX},
+		{NEXN, NEXX},
+		{NEXF, NEXX},
+		{NEXG, NEXX},
+		{NEXL, NEXX},
+		{NEXD, NEXX},
+		{MAXD, MAXD},
+		{SKYX, SKYX},
+		{XLCH, XLCH},
+		{CLCH, XLCH},
+		{BUYN, XLCH},
+		{DAUK, DAUK},
+		{BSLB, BSLB},
+		{RBCM, RBCM},
+		{VFGB, VFGB},
+		{VFUK, VFUK},
+		{VIUK, VIUK},
+		{XPOL, VIUK},
+		{XPAL, VIUK},
+		{BBSX, BBSX},
+		{JLSI, JLSI},
+		{SCOT, SCOT},
+		{XSGB, XSGB},
+		{VAGM, VAGM},
+		{BMCM, BMCM},
+		{STFL, STFL},
 		{XALT, XALT},
+		{ARCH, ARCH},
+		{ARDA, ARCH},
+		{SNUK, SNUK},
+		{CGMG, CGMG},
+		{FNUK, FNUK},
+		{CBNL, CBNL},
+		{BGUK, BGUK},
+		{BGFU, BGUK},
+		{CLVE, CLVE},
+		{CEPU, CEPU},
 		{RTSL, RTSL},
-		{TRAL, RTSL},
 		{TRFW, RTSL},
-		{BCSL, BCSL},
-		{BCSI, BCSL},
-		{TRDE, TRDE},
-		{TCDS, TRDE},
-		{TRDX, TRDE},
-		{ELIX, TRDE},
-		{PFXD, TRDE},
-		{PARX, TRDE},
-		{XLBM, XLBM},
-		{SNUK, SNUK},
-		{TWGP, TWGP},
-		{PPEX, PPEX},
-		{BMLB, BMLB},
-		{NEXS, NEXS},
-		{REST, NEXS},
-		{EBSO, NEXS},
-		{TRQX, TRQX},
-		{TRQM, TRQX},
-		{TRQB, TRQX},
-		{TRQA, TRQX},
-		{TRQC, TRQX},
-		{SPEC, SPEC},
-		{BMTF, BMTF},
-		{SQUA, SQUA},
-		{ICEO, ICEO},
-		{IECL, ICEO},
-		{BSLB, BSLB},
+		{TRAL, RTSL},
+		{ECNL, RTSL},
 		{UBSB, UBSB},
 		{UBSY, UBSB},
-		{AMPX, AMPX},
-		{VIUK, VIUK},
-		{XPOL, VIUK},
-		{XPAL, VIUK},
-		{VFGB, VFGB},
-		{XIEL, XIEL},
-		{BLOX, XIEL},
-		{BRFQ, XIEL},
-		{BNTW, XIEL},
-		{IOTC, XIEL},
-		{SWAP, SWAP},
-		{NOSI, NOSI},
+		{SSIL, SSIL},
+		{BETX, BETX},
+		{BTLX, BTLX},
+		{AQXE, AQXE},
+		{EIXE, AQXE},
+		{AQXA, AQXE},
+		{AQXD, AQXE},
 		{ICPM, ICPM},
-		{IMGI, ICPM},
-		{IMET, ICPM},
-		{UKOR, ICPM},
 		{UKRE, ICPM},
-		{IMGB, ICPM},
+		{UKOR, ICPM},
 		{IMSB, ICPM},
+		{IMGI, ICPM},
 		{IMRD, ICPM},
-		{IMED, ICPM},
+		{IMET, ICPM},
+		{IMGB, ICPM},
 		{IMMM, ICPM},
-		{IMCE, ICPM},
 		{IMFD, ICPM},
-		{CEPU, CEPU},
-		{CCML, CCML},
-		{FNUK, FNUK},
-		{NXEU, NXEU},
-		{LCUR, LCUR},
-		{IBAL, IBAL},
-		{SMBE, SMBE},
-		{TREU, TREU},
-		{TREO, TREU},
-		{TREA, TREU},
-		{ATLB, ATLB},
-		{CIBP, CIBP},
-		{BGUK, BGUK},
-		{BGFU, BGUK},
-		{ECSL, ECSL},
+		{IMCE, ICPM},
+		{IMED, ICPM},
+		{IMCM, ICPM},
+		{IMCD, ICPM},
+		{NCML, NCML},
 		{XPUK, XPUK},
-		{NWMS, NWMS},
-		{NOFF, NOFF},
-		{VTBC, VTBC},
-		{TCML, TCML},
-		{TDBL, TDBL},
-		{SPDX, SPDX},
-		{INVE, INVE},
-		{BETX, BETX},
-		{BTLX, BTLX},
-		{SSIL, SSIL},
-		{SECF, SECF},
-		{SGMX, SGMX},
-		{SGMZ, SGMX},
-		{SGMY, SGMX},
-		{SHAR, SHAR},
-		{MFXC, MFXC},
-		{MFXA, MFXC},
-		{MFXR, MFXC},
-		{OCSI, OCSI},
+		{STSI, STSI},
+		{MLIB, MLIB},
+		{MHBL, MHBL},
 		{EQLD, EQLD},
 		{EQSL, EQLD},
-		{LOUI, LOUI},
-		{CSEC, CSEC},
-		{AQXE, AQXE},
-		{AQXA, AQXE},
-		{EIXE, AQXE},
-		{IPSX, IPSX},
-		{IPXW, IPSX},
-		{IPXP, IPSX},
-		{IFLS, IFLS},
-		{FISU, FISU},
-		{SFCL, SFCL},
-		{XMTS, XMTS},
-		{BVUK, XMTS},
-		{UKGD, XMTS},
-		{BCRM, BCRM},
-		{BART, BCRM},
-		{BARO, BCRM},
-		{BARK, BCRM},
-		{BOFS, BOFS},
-		{LBCM, LBCM},
-		{TPEO, TPEO},
-		{TEMM, TPEO},
-		{TEFX, TPEO},
-		{TEGB, TPEO},
-		{TERE, TPEO},
-		{TECO, TPEO},
-		{TEIR, TPEO},
-		{TEGI, TPEO},
-		{PVMF, PVMF},
-		{JPSI, JPSI},
+		{BRGA, BRGA},
+		{SPDX, SPDX},
+		{ECSL, ECSL},
+		{TPIS, TPIS},
+		{TPMF, TPIS},
+		{TPSY, TPIS},
+		{TPMG, TPIS},
+		{TPLF, TPIS},
+		{CIBC, CIBC},
+		{CIBP, CIBP},
+		{ISSI, ISSI},
+		{WSIL, WSIL},
+		{MAKX, MAKX},
+		{WSIN, WSIN},
+		{WELN, WELN},
+		{BMLB, BMLB},
+		{CBAL, CBAL},
+		{MUSE, MUSE},
+		{NABE, NABE},
+		{NABU, NABE},
+		{NABL, NABL},
+		{NABA, NABL},
+		{METZ, METZ},
+		{MUBL, MUBL},
+		{SMBG, SMBG},
+		{SMBE, SMBE},
+		{MAQI, MAQI},
+		{MAQU, MAQU},
+		{NURO, NURO},
+		{XNLX, NURO},
+		{NURD, NURO},
+		{NOME, NURO},
+		{HRSI, HRSI},
+		{R5FX, R5FX},
+		{OCSI, OCSI},
+		{LBCM, LBCM},
+		{SBEX, SBEX},
+		{VTBC, VTBC},
+		{IPSX, IPSX},
+		{IPXW, IPSX},
+		{IPXP, IPSX},
+		{ATLB, ATLB},
+		{DAIW, DAIW},
+		{IMTF, IMTF},
+		{STAL, STAL},
+		{FXOP, FXOP},
+		{TRDE, TRDE},
+		{PFXD, TRDE},
+		{TFSG, TRDE},
+		{TCDS, TRDE},
+		{NAVE, TRDE},
+		{EMCH, TRDE},
+		{VOLA, TRDE},
+		{PARX, TRDE},
+		{ELIX, TRDE},
+		{TRDX, TRDE},
+		{TFSS, TRDE},
+		{DBVX, TRDE},
+		{TFSC, TRDE},
+		{OILX, TRDE},
+		{TCME, TRDE},
+		{TFSE, TRDE},
+		{IGDL, IGDL},
+		{ISWE, IGDL},
+		{ISWV, IGDL},
+		{JSSI, JSSI},
+		{TWGP, TWGP},
+		{GMGL, GMGL},
+		{SEDR, SEDR},
+		{ANTS, ANTS},
+		{SGMX, SGMX},
+		{SGMZ, SGMX},
+		{SGMY, SGMX},
+		{UBIN, UBIN},
+		{CSLB, CSLB},
+		{JISI, JISI},
+		{JEFS, JISI},
+		{GFIC, GFIC},
+		{GFSM, GFIC},
+		{GFSO, GFIC},
+		{GFIF, GFIC},
+		{GFIN, GFIC},
+		{GFIR, GFIC},
+		{XGFI, GFIC},
+		{GFIM, GFIC},
+		{MLXN, MLXN},
+		{MLAX, MLXN},
+		{MLVE, MLXN},
+		{MLEU, MLXN},
+		{JPCB, JPCB},
+		{TDBL, TDBL},
+		{ICEO, ICEO},
+		{IECE, ICEO},
+		{ISWA, ISWA},
+		{ISWR, ISWA},
+		{ISWC, ISWA},
+		{ISWB, ISWA},
+		{NWMS, NWMS},
+		{RBCE, RBCE},
+		{KBLM, KBLM},
+		{XIEL, XIEL},
+		{BRFQ, XIEL},
+		{BNTW, XIEL},
+		{BLOX, XIEL},
+		{IOTC, XIEL},
+		{BANA, BANA},
+		{BASI, BANA},
+		{BOAL, BANA},
+		{CSEC, CSEC},
+		{MLIX, MLIX},
+		{MLSI, MLIX},
+		{MLRQ, MLIX},
+		{SQUA, SQUA},
 		{SSBT, SSBT},
 		{SSFX, SSBT},
+		{ARIA, ARIA},
+		{DVFX, DVFX},
+		{LOUI, LOUI},
+		{CCEU, CCEU},
+		{XTXM, XTXM},
+		{VAGL, VAGL},
+		{BCSL, BCSL},
+		{BCSI, BCSL},
+		{GFIB, GFIB},
+		{GFBM, GFIB},
+		{GFBO, GFIB},
+		{SFCL, SFCL},
+		{SUNB, SUNB},
+		{SUNM, SUNB},
+		{SUNO, SUNB},
+		{TPSL, TPSL},
+		{TSUK, TPSL},
+		{TSMC, TPSL},
+		{TSMG, TPSL},
+		{TSMI, TPSL},
+		{TSMB, TPSL},
+		{TSMR, TPSL},
 		{TPSO, TPSO},
+		{TUOB, TPSO},
+		{TEEG, TPSO},
 		{TSCB, TPSO},
+		{TSRE, TPSO},
+		{TSCD, TPSO},
 		{TSGB, TPSO},
 		{TSFI, TPSO},
-		{TUOB, TPSO},
 		{TSED, TPSO},
 		{TSGI, TPSO},
-		{TSCD, TPSO},
 		{TSMM, TPSO},
-		{TSRE, TPSO},
-		{EMBX, EMBX},
-		{STFL, STFL},
-		{CRYP, CRYP},
-		{HRSI, HRSI},
-		{UBIN, UBIN},
-		{JPCB, JPCB},
-		{XTXM, XTXM},
-		{SUNB, SUNB},
-		{SUNO, SUNB},
-		{GFIB, GFIB},
-		{GFBM, GFIB},
-		{GFBO, GFIB},
-		{METZ, METZ},
-		{GSIB, GSIB},
-		{BISI, GSIB},
-		{MUSE, MUSE},
-		{NABL, NABL},
-		{NABA, NABL},
-		{MUFP, MUFP},
-		{BANA, BANA},
-		{BOAL, BANA},
-		{BASI, BANA},
-		{DVFX, DVFX},
-		{XLON, XLON},
-		{XLOD, XLON},
-		{XLOM, XLON},
-		{AIMX, XLON},
-		{MUBL, MUBL},
-		{CSEU, CSEU},
-		{CSCF, CSEU},
-		{SICS, CSEU},
-		{CSBX, CSEU},
-		{XLCH, XLCH},
-		{CLCH, XLCH},
-		{BUYN, XLCH},
-		{MUTI, MUTI},
-		{ANZL, ANZL},
-		{ANLP, ANZL},
-		{SKYX, SKYX},
-		{CMEE, CMEE},
-		{CMEC, CMEE},
-		{PEEL, PEEL},
-		{XRSP, PEEL},
-		{XPHX, PEEL},
-		{WINS, WINS},
-		{WINX, WINS},
-		{CBNL, CBNL},
-		{IMTF, IMTF},
-		{DAIW, DAIW},
-		{KBLM, KBLM},
+		{TSIR, TPSO},
+		{TSFX, TPSO},
+		{CGML, CGML},
+		{CGME, CGML},
+		{CGMC, CGML},
+		{CGMU, CGML},
+		{CGMT, CGML},
+		{MAQL, MAQL},
 		{TPEL, TPEL},
-		{TEMI, TPEL},
-		{TEMB, TPEL},
+		{TEMG, TPEL},
+		{TEMC, TPEL},
 		{TEFD, TPEL},
+		{TEMF, TPEL},
+		{TEMI, TPEL},
 		{TIRD, TPEL},
+		{TEMB, TPEL},
 		{TEMR, TPEL},
-		{TEMF, TPEL},
-		{CGML, CGML},
-		{CGMU, CGML},
-		{CGMC, CGML},
-		{CGMT, CGML},
-		{XUBS, XUBS},
-		{XUMP, XUBS},
-		{RBCT, RBCT},
-		{RTSI, RBCT},
-		{MHIP, MHIP},
-		{X3579, X3579},
-		{NCML, NCML},
-		{MHBL, MHBL},
-		{JISI, JISI},
-		{JEFS, JISI},
+		{TPEO, TPEO},
+		{TEOF, TPEO},
+		{TECO, TPEO},
+		{IECL, TPEO},
+		{TERE, TPEO},
+		{TEFX, TPEO},
+		{TEMM, TPEO},
+		{TEGI, TPEO},
+		{TEIR, TPEO},
+		{TEGB, TPEO},
+		{PVMF, TPEO},
+		{LOYD, LOYD},
+		{BOFS, BOFS},
 		{BPLC, BPLC},
 		{BBSI, BPLC},
-		{ARAX, ARAX},
-		{ARCH, ARCH},
-		{NEXX, NEXX},
-		{NEXT, NEXX},
-		{NEXN, NEXX},
-		{NEXF, NEXX},
-		{NEXG, NEXX},
-		{NEXD, NEXX},
-		{NEXL, NEXX},
-		{CMMT, CMMT},
-		{MAQL, MAQL},
-		{BMCM, BMCM},
-		{MAQU, MAQU},
-		{MAXD, MAXD},
-		{OTCE, OTCE},
-		{CBAL, CBAL},
-		{JSSI, JSSI},
-		{VAGM, VAGM},
-		{XSGB, XSGB},
-		{NABE, NABE},
-		{NABU, NABE},
-		{IGDL, IGDL},
-		{ISWV, IGDL},
-		{ISWE, IGDL},
-		{ARIA, ARIA},
-		{MSIP, MSIP},
-		{MSSI, MSIP},
-		{TPIE, TPIE},
-		{NBFL, NBFL},
-		{WSIL, WSIL},
-		{FRTE, FRTE},
-		{SBEX, SBEX},
-		{SMBG, SMBG},
-		{BAPA, BAPA},
-		{LMEC, LMEC},
-		{RBCM, RBCM},
-		{ANTS, ANTS},
-		{GMGL, GMGL},
-		{FXOP, FXOP},
-		{R5FX, R5FX},
-		{BNPL, BNPL},
-		{STAN, STAN},
+		{BKLN, BKLN},
+		{BKLF, BKLN},
+		{FISU, FISU},
+		{PPEX, PPEX},
+		{INVE, INVE},
+		{IFLS, IFLS},
+		{EXOT, EXOT},
+		{HSXE, HSXE},
+		{ANZL, ANZL},
+		{ANLP, ANZL},
 		{ECHO, ECHO},
-		{XLME, XLME},
-		{TRSI, TRSI},
-		{FXGB, FXGB},
-		{EVOL, EVOL},
-		{BRGA, BRGA},
 		{HSBC, HSBC},
-		{LOTC, LOTC},
-		{PLDX, LOTC},
-		{IOTF, IOTF},
-		{IOIR, IOTF},
-		{IOMM, IOTF},
-		{IOFX, IOTF},
-		{IUOB, IOTF},
-		{IOCD, IOTF},
-		{IOGI, IOTF},
-		{IOED, IOTF},
-		{IOGB, IOTF},
-		{IOFI, IOTF},
-		{ILCM, IOTF},
-		{SSEX, SSEX},
-		{MLIX, MLIX},
-		{MLSI, MLIX},
-		{MLRQ, MLIX},
-		{MAKX, MAKX},
-		{WSIN, WSIN},
-		{WELN, WELN},
-		{SCOT, SCOT},
-		{BBSX, BBSX},
-		{BKLN, BKLN},
-		{BKLF, BKLN},
+		{STAN, STAN},
+		{VCMO, VCMO},
+		{CSIN, CSIN},
+		{CSSI, CSIN},
 		{DOWG, DOWG},
-		{WFLB, WFLB},
-		{LELE, LELE},
-		{MSBI, MSBI},
-		{MBSI, MSBI},
-		{BSPL, BSPL},
-		{SIFX, SIFX},
-		{CFIC, CFIC},
-		{RABL, RABL},
-		{NTUK, NTUK},
-		{UNGB, UNGB},
+		{AMPX, AMPX},
+		{FXGB, FXGB},
+		{TRSI, TRSI},
+		{BTEE, BTEE},
+		{BTQG, BTEE},
+		{EBSX, BTEE},
+		{EBSM, BTEE},
+		{RBCT, RBCT},
+		{RTSI, RBCT},
+		{GSIB, GSIB},
+		{BISI, GSIB},
+		{MUFP, MUFP},
+		{CCML, CCML},
+		{X3579, X3579},
+		{MHIP, MHIP},
+		{WINS, WINS},
+		{WINX, WINS},
+		{TCML, TCML},
+		{FRTE, FRTE},
+		{MUTI, MUTI},
+		{NDCM, NDCM},
+		{SPEC, SPEC},
+		{BCRM, BCRM},
+		{BART, BCRM},
+		{BARO, BCRM},
+		{BARK, BCRM},
+		{MFXC, MFXC},
+		{MFXR, MFXC},
+		{MFXA, MFXC},
+		{NOSI, NOSI},
+		{AUTX, AUTX},
+		{AUTB, AUTX},
+		{AUTP, AUTX},
+		{NEXS, NEXS},
+		{EBSO, NEXS},
+		{REST, NEXS},
+		{BALT, BALT},
+		{BLTX, BALT},
+		{PEEL, PEEL},
+		{XRSP, PEEL},
+		{XPHX, PEEL},
+		{PHSI, PEEL},
+		{CAZE, CAZE},
+		{JPSI, JPSI},
+		{CSEU, CSEU},
+		{SICS, CSEU},
+		{CSBX, CSEU},
+		{CSCF, CSEU},
+		{CSFB, CSEU},
+		{GRIF, GRIF},
+		{GRIO, GRIF},
+		{LMEC, LMEC},
+		{KMTS, KMTS},
+		{ICEU, ICEU},
+		{FXMT, FXMT},
+		{QMTS, QMTS},
+		{XEDX, XEDX},
+		{CRDL, CRDL},
+		{BAIK, BAIK},
+		{UMTS, UMTS},
+		{XPLU, XPLU},
+		{AFDL, AFDL},
+		{BAPA, BAPA},
+		{BCXE, BCXE},
+		{BATE, BCXE},
+		{BATF, BCXE},
+		{BATD, BCXE},
+		{CHIY, BCXE},
+		{CHIO, BCXE},
+		{BATP, BCXE},
+		{CHID, BCXE},
+		{BOTC, BCXE},
+		{LISX, BCXE},
+		{CHIX, BCXE},
+		{BGCI, BGCI},
+		{BGCM, BGCI},
+		{BGCO, BGCI},
+		{BGCB, BGCI},
+		{BMTF, BMTF},
+		{BOAT, BOAT},
+		{BOSC, BOSC},
+		{BRNX, BRNX},
+		{CCO2, CCO2},
+		{CHEV, CHEV},
+		{BLNK, CHEV},
+		{CMEE, CMEE},
+		{CMED, CMEE},
+		{CMEC, CMEE},
+		{CMMT, CMMT},
+		{CRYP, CRYP},
+		{EMBX, EMBX},
+		{ENCL, ENCL},
+		{EXEU, EXEU},
+		{EXSI, EXEU},
+		{EXOR, EXEU},
+		{EXVP, EXEU},
+		{EXMP, EXEU},
+		{EXLP, EXEU},
+		{EXCP, EXEU},
+		{EXBO, EXEU},
+		{EXDC, EXEU},
+		{FAIR, FAIR},
+		{GEMX, GEMX},
+		{GFIA, GFIA},
+		{GMEG, GMEG},
+		{XGDX, GMEG},
+		{XLDX, GMEG},
+		{XGCX, GMEG},
+		{XGSX, GMEG},
+		{GRSE, GRSE},
+		{GSIL, GSIL},
+		{GSBX, GSIL},
+		{GSSI, GSIL},
+		{IBAL, IBAL},
+		{ICAP, ICAP},
+		{ICEN, ICAP},
+		{ISDX, ICAP},
+		{WCLK, ICAP},
+		{ICAH, ICAP},
+		{ICSE, ICAP},
+		{ICTQ, ICAP},
+		{PLSX, ICAP},
+		{IFEU, IFEU},
+		{CXOT, IFEU},
+		{CXRT, IFEU},
+		{IFLX, IFEU},
+		{IFLL, IFEU},
+		{IFEN, IFEU},
+		{IFLO, IFEU},
+		{IFUT, IFEU},
+		{KLEU, KLEU},
+		{LCUR, LCUR},
+		{LIQU, LIQU},
+		{LIQH, LIQU},
+		{LIQF, LIQU},
+		{LMAX, LMAX},
+		{LMAO, LMAX},
+		{LMAE, LMAX},
+		{LMAF, LMAX},
+		{LMAD, LMAX},
+		{LOTC, LOTC},
+		{PLDX, LOTC},
+		{LPPM, LPPM},
+		{MAEL, MAEL},
+		{MXLM, MAEL},
+		{RFQU, MAEL},
+		{MCUR, MCUR},
+		{MCXR, MCUR},
+		{MCXS, MCUR},
+		{MFGL, MFGL},
+		{MSIP, MSIP},
+		{MSSI, MSIP},
+		{MYTR, MYTR},
+		{N2EX, N2EX},
+		{NOFF, NOFF},
+		{NXEU, NXEU},
+		{NYMX, NYMX},
+		{OFEX, OFEX},
+		{OTCE, OTCE},
+		{PIEU, PIEU},
+		{PIRM, PIRM},
+		{QWIX, QWIX},
+		{RBSX, RBSX},
+		{SECF, SECF},
+		{SHAR, SHAR},
+		{SLXT, SLXT},
+		{SPRZ, SPRZ},
+		{SSEX, SSEX},
+		{SWAP, SWAP},
+		{TFSV, TFSV},
+		{TPIE, TPIE},
+		{TPIM, TPIM},
+		{TREU, TREU},
+		{TREA, TREU},
+		{TREO, TREU},
+		{TRQX, TRQX},
+		{TRQS, TRQX},
+		{TRQC, TRQX},
+		{TRQM, TRQX},
+		{TRQB, TRQX},
+		{TRQA, TRQX},
+		{TRQD, TRQX},
+		{UKPX, UKPX},
+		{VEGA, VEGA},
+		{XCOR, XCOR},
+		{XGCL, XGCL},
+		{XIPE, XIPE},
+		{XJWY, XJWY},
+		{XLBM, XLBM},
+		{XLCE, XLCE},
+		{XLDN, XLDN},
+		{TNLL, XLDN},
+		{XSMP, XLDN},
+		{ENSY, XLDN},
+		{XLIF, XLDN},
+		{XLME, XLME},
+		{XLON, XLON},
+		{XLOD, XLON},
+		{AIMX, XLON},
+		{XLOM, XLON},
+		{XLTO, XLTO},
+		{XMLX, XMLX},
+		{XMTS, XMTS},
+		{MCZK, XMTS},
+		{HUNG, XMTS},
+		{EMTS, XMTS},
+		{MTSA, XMTS},
+		{GMTS, XMTS},
+		{MTSG, XMTS},
+		{IMTS, XMTS},
+		{RMTS, XMTS},
+		{AMTS, XMTS},
+		{PORT, XMTS},
+		{SLKK, XMTS},
+		{VMTS, XMTS},
+		{SMTS, XMTS},
+		{UKGD, XMTS},
+		{MTSS, XMTS},
+		{MTSW, XMTS},
+		{PRME, XMTS},
+		{CMTS, XMTS},
+		{TMTS, XMTS},
+		{LMTS, XMTS},
+		{EACM, XMTS},
+		{BVUK, XMTS},
+		{NMTS, XMTS},
+		{USWP, XMTS},
+		{XSWB, XSWB},
+		{XTFN, XTFN},
+		{XTUP, XTUP},
+		{TPCD, XTUP},
+		{TBLA, XTUP},
+		{TPFD, XTUP},
+		{TPSP, XTUP},
+		{TPSD, XTUP},
+		{TPRE, XTUP},
+		{TPEQ, XTUP},
+		{XTPE, XTUP},
+		{TBEN, XTUP},
+		{UKCA, UKCA},
+		{XEBS, XEBS},
+		{GFOX, GFOX},
+		{BBVX, BBVX},
+		{HPSX, HPSX},
+		{HPSO, HPSX},
+		{CMCM, CMCM},
+		{TPID, TPID},
+		{TPDA, TPID},
+		{INGU, INGU},
+		{SISU, SISU},
+		{OTXT, OTXT},
+		{TMUK, TMUK},
+		{ALGO, ALGO},
+		{GSLO, GSLO},
+		{G360, G360},
+		{AFTS, AFTS},
+		{ACCX, ACCX},
+		{JPJX, JPJX},
+		{SFMP, SFMP},
+		{PUND, PUND},
+		{XOCH, XOCH},
+		{OTCM, OTCM},
+		{EXPM, OTCM},
+		{CAVE, OTCM},
+		{OTCB, OTCM},
+		{PINL, OTCM},
+		{PINI, OTCM},
+		{PINX, OTCM},
+		{OTCQ, OTCM},
+		{PSGM, OTCM},
+		{PINC, OTCM},
+		{FREX, FREX},
+		{IDXM, IDXM},
+		{OCEA, OCEA},
+		{GFAM, GFAM},
+		{FNIX, GFAM},
+		{LATG, GFAM},
+		{UBEC, UBEC},
+		{AMLG, AMLG},
+		{KLSH, KLSH},
+		{LEVL, LEVL},
+		{EBXV, LEVL},
+		{STRM, STRM},
+		{BNPC, BNPC},
+		{CGXS, CGXS},
+		{EQUS, EQUS},
+		{XTXD, XTXD},
+		{BSTX, BSTX},
+		{CCMX, CCMX},
+		{XCBO, XCBO},
+		{CONE, XCBO},
+		{CTWO, XCBO},
+		{C2OX, XCBO},
+		{EDGA, XCBO},
+		{EDGD, XCBO},
+		{EDGO, XCBO},
+		{EDGX, XCBO},
+		{BATS, XCBO},
+		{BATY, XCBO},
+		{BYXD, XCBO},
+		{BZXD, XCBO},
+		{EDDP, XCBO},
+		{BATO, XCBO},
+		{CBSX, XCBO},
+		{XCBF, XCBO},
+		{XCBD, XCBO},
+		{CLST, CLST},
+		{FAST, FAST},
+		{MEMX, MEMX},
+		{MEMD, MEMX},
+		{MEMM, MEMX},
+		{MXOP, MEMX},
+		{PIPR, PIPR},
+		{PJCX, PIPR},
+		{TRAI, TRAI},
+		{COHR, COHR},
+		{STFU, STFU},
+		{STFX, STFX},
+		{ATDF, ATDF},
+		{LTSE, LTSE},
+		{CALH, CALH},
+		{THRE, THRE},
+		{FXPS, THRE},
+		{FXNM, THRE},
+		{WEED, WEED},
+		{XWEE, WEED},
+		{BKKT, BKKT},
+		{XPUS, XPUS},
+		{JLQD, JLQD},
+		{JLEQ, JLQD},
+		{LAMP, LAMP},
+		{RCMA, RCMA},
+		{ERIS, ERIS},
+		{YKNA, YKNA},
+		{DASH, DASH},
+		{TRCX, TRCX},
+		{CODA, CODA},
+		{PDQX, PDQX},
+		{PDQD, PDQX},
+		{SAGE, SAGE},
+		{JPMS, JPMS},
+		{ARKX, ARKX},
+		{PUMA, PUMA},
+		{PUMX, PUMA},
+		{FTUS, FTUS},
+		{CPGX, CPGX},
+		{NLAX, CPGX},
+		{DBAB, DBAB},
+		{OTCN, OTCN},
+		{SCXO, SCXO},
+		{ZERO, SCXO},
+		{SCXS, SCXO},
+		{SCXM, SCXO},
+		{SCXA, SCXO},
+		{SCXF, SCXO},
+		{SGAS, SGAS},
+		{SGA2, SGAS},
+		{SMFE, SMFE},
+		{BLUE, BLUE},
+		{DWFI, DWFI},
+		{XTRD, XTRD},
+		{VUSA, VUSA},
+		{VALX, VUSA},
+		{VCRS, VUSA},
+		{VFMI, VUSA},
+		{WELX, WELX},
+		{WELS, WELS},
+		{HRTF, HRTF},
+		{FICO, FICO},
+		{XFCI, FICO},
+		{XFDA, FICO},
+		{HRTX, HRTX},
+		{INCR, INCR},
+		{ASPI, INCR},
+		{ASMT, INCR},
+		{ASPN, INCR},
+		{NTRL, INCR},
+		{TSBX, TSBX},
+		{MAGM, MAGM},
+		{UBSA, UBSA},
+		{XPIN, UBSA},
+		{UBSP, UBSA},
+		{UBSS, UBSS},
+		{PULX, PULX},
+		{BAMX, BAMX},
+		{XBOX, XBOX},
+		{GLMX, GLMX},
+		{CURX, CURX},
+		{BTNL, BTNL},
+		{SUNT, SUNT},
+		{ADVT, ADVT},
+		{IMCS, IMCS},
+		{THEM, THEM},
+		{LEDG, LEDG},
+		{FUSD, LEDG},
+		{MTUS, MTUS},
+		{BVUS, MTUS},
+		{MTSB, MTUS},
+		{GOTC, GOTC},
+		{VERT, VERT},
+		{MTXX, MTXX},
+		{MKAA, MTXX},
+		{MTXA, MTXX},
+		{MTXS, MTXX},
+		{MTXC, MTXX},
+		{MTXM, MTXX},
+		{VIRT, VIRT},
+		{CGMI, CGMI},
+		{ONEC, CGMI},
+		{CORE, CGMI},
+		{CICX, CGMI},
+		{CBLC, CGMI},
+		{CIOI, CGMI},
+		{LQFI, CGMI},
+		{LQED, LQED},
+		{TSAD, TSAD},
+		{JPBX, JPBX},
+		{JSEF, JSEF},
+		{EDGE, EDGE},
+		{IEXG, IEXG},
+		{IEXD, IEXG},
+		{IEXC, IEXG},
+		{ICUS, ICUS},
+		{XBMK, XBMK},
+		{XEUS, XEUS},
+		{XNYF, XNYF},
+		{BAMP, BAMP},
+		{AATS, AATS},
+		{AQUA, AQUA},
 		{BAML, BAML},
-		{MLVX, BAML},
 		{MLCO, BAML},
+		{MLVX, BAML},
 		{BARX, BARX},
-		{BARD, BARX},
-		{BCDX, BARX},
 		{BBOK, BARX},
+		{BCDX, BARX},
 		{BARL, BARX},
+		{BARD, BARX},
+		{BBSF, BBSF},
+		{BBSN, BBSF},
 		{BGCF, BGCF},
-		{FNCS, BGCF},
+		{FNFX, BGCF},
 		{BGCD, BGCF},
+		{FNCS, BGCF},
+		{FNFT, BGCF},
+		{FNXB, BGCF},
+		{BHSF, BHSF},
 		{BIDS, BIDS},
+		{BPOL, BPOL},
 		{BLTD, BLTD},
-		{BPOL, BLTD},
 		{BNYC, BNYC},
 		{VTEX, BNYC},
 		{NYFX, BNYC},
 		{BTEC, BTEC},
-		{ICSU, BTEC},
 		{BTEQ, BTEC},
-		{GLLC, GLLC},
-		{GOVX, GOVX},
-		{GREE, GREE},
-		{GTCO, GTCO},
-		{HEGX, HEGX},
-		{HSFX, HSFX},
-		{ICEL, ICEL},
-		{AATS, AATS},
-		{AQUA, AQUA},
+		{ICSU, BTEC},
+		{CCFE, CCFE},
 		{CDED, CDED},
-		{CGMI, CGMI},
-		{CBLC, CGMI},
-		{LQFI, CGMI},
-		{CIOI, CGMI},
-		{CORE, CGMI},
-		{ONEC, CGMI},
+		{CDEL, CDEL},
+		{CMSF, CMSF},
 		{CRED, CRED},
-		{CAES, CRED},
+		{CSLP, CRED},
 		{CSCL, CRED},
 		{CSVW, CRED},
+		{CAES, CRED},
 		{DBSX, DBSX},
 		{DEAL, DEAL},
-		{ERIS, ERIS},
-		{FAST, FAST},
+		{EGMT, EGMT},
+		{FINR, FINR},
+		{FINN, FINR},
+		{XADF, FINR},
+		{FINC, FINR},
+		{FINO, FINR},
+		{FINY, FINR},
+		{OOTC, FINR},
+		{FSEF, FSEF},
 		{FXAL, FXAL},
 		{FXCM, FXCM},
+		{G1XX, G1XX},
+		{GLLC, GLLC},
+		{GLPS, GLPS},
+		{GLPX, GLPX},
+		{GOVX, GOVX},
+		{GREE, GREE},
+		{GSCO, GSCO},
+		{SGMT, GSCO},
+		{GSEF, GSEF},
+		{GTCO, GTCO},
+		{GTSX, GTSX},
+		{GTXS, GTXS},
+		{HEGX, HEGX},
+		{HPPO, HPPO},
+		{HSFX, HSFX},
+		{ICEL, ICEL},
 		{IFUS, IFUS},
-		{IEPA, IFUS},
+		{TMCC, IFUS},
+		{VABD, IFUS},
+		{IMCC, IFUS},
 		{IMFX, IFUS},
-		{IMAG, IFUS},
-		{IMBD, IFUS},
+		{IFED, IFUS},
+		{IEPA, IFUS},
+		{IMCG, IFUS},
+		{IMIR, IFUS},
 		{IMCR, IFUS},
 		{IMEN, IFUS},
-		{IMIR, IFUS},
 		{ICES, IFUS},
-		{IMCG, IFUS},
-		{VABD, IFUS},
-		{TMCC, IFUS},
-		{IFED, IFUS},
-		{IMCC, IFUS},
+		{IMAG, IFUS},
+		{IMBD, IFUS},
+		{ISDA, ISDA},
 		{ITGI, ITGI},
+		{JEFX, JEFX},
+		{JNST, JNST},
 		{JPMX, JPMX},
+		{JSES, JSES},
+		{JSJX, JSJX},
 		{KNIG, KNIG},
-		{KNCM, KNIG},
+		{KNMX, KNIG},
+		{ACKF, KNIG},
 		{KNEM, KNIG},
 		{KNLI, KNIG},
-		{ACKF, KNIG},
-		{LEVL, LEVL},
-		{EBXV, LEVL},
-		{XNYM, XNYM},
-		{XNYL, XNYM},
-		{XCEC, XNYM},
-		{XNYE, XNYM},
-		{XCUR, XCUR},
-		{XCBO, XCBO},
-		{BATO, XCBO},
-		{BATS, XCBO},
-		{BATY, XCBO},
-		{EDDP, XCBO},
-		{EDGA, XCBO},
-		{EDGD, XCBO},
-		{EDGX, XCBO},
-		{BZXD, XCBO},
-		{BYXD, XCBO},
-		{C2OX, XCBO},
-		{CBSX, XCBO},
-		{EDGO, XCBO},
-		{XCBF, XCBO},
-		{XCBT, XCBT},
-		{FCBT, XCBT},
-		{XKBT, XCBT},
-		{SMFE, SMFE},
-		{XAQS, XAQS},
-		{LQED, LQED},
-		{RICX, RICX},
-		{RICD, RICX},
-		{XCFF, XCFF},
-		{XNAS, XNAS},
-		{XNCM, XNAS},
-		{XNDQ, XNAS},
-		{XNGS, XNAS},
-		{XNIM, XNAS},
-		{XNMS, XNAS},
-		{XPBT, XNAS},
-		{XPHL, XNAS},
-		{XBOS, XNAS},
-		{XPHO, XNAS},
-		{XPOR, XNAS},
-		{PSXD, XNAS},
-		{XBXO, XNAS},
-		{XNFI, XNAS},
-		{MELO, XNAS},
-		{XPSX, XNAS},
-		{NASD, XNAS},
-		{XBRT, XNAS},
-		{BOSD, XNAS},
-		{TRCK, TRCK},
-		{FICO, FICO},
-		{XFDA, FICO},
-		{XFCI, FICO},
-		{HPPO, HPPO},
-		{GLPS, GLPS},
-		{G1XX, G1XX},
-		{GLMX, GLMX},
-		{CODA, CODA},
-		{VERT, VERT},
+		{KNCM, KNIG},
+		{LASF, LASF},
+		{LAVA, LAVA},
+		{LAFX, LAVA},
+		{LAFL, LAVA},
+		{LAFD, LAVA},
 		{LIUS, LIUS},
 		{LIUH, LIUS},
 		{LIFI, LIUS},
-		{TSEF, TSEF},
+		{LTAA, LTAA},
+		{LMNX, LTAA},
+		{MIHI, MIHI},
+		{EPRD, MIHI},
+		{EMLD, MIHI},
+		{EPRL, MIHI},
+		{MPRL, MIHI},
+		{XMIO, MIHI},
+		{SPHR, MIHI},
 		{MSCO, MSCO},
-		{MSPL, MSCO},
-		{MSRP, MSCO},
-		{MSTX, MSCO},
-		{TERA, TERA},
-		{BAMX, BAMX},
-		{XOTC, XOTC},
-		{NBLX, NBLX},
-		{XISX, XISX},
-		{GMNI, XISX},
-		{XISE, XISX},
-		{XISA, XISX},
-		{MCRY, XISX},
-		{SOHO, SOHO},
-		{UBSA, UBSA},
-		{UBSP, UBSA},
-		{LASF, LASF},
-		{ADVT, ADVT},
-		{WSAG, WSAG},
-		{WABR, WSAG},
-		{VNDM, WSAG},
-		{SSTX, SSTX},
-		{NYPC, NYPC},
-		{OPRA, OPRA},
-		{OTCM, OTCM},
-		{PINC, OTCM},
-		{PINI, OTCM},
-		{PINL, OTCM},
-		{OTCB, OTCM},
-		{OTCQ, OTCM},
-		{PINX, OTCM},
-		{PSGM, OTCM},
-		{CAVE, OTCM},
-		{EXPM, OTCM},
-		{TMID, TMID},
-		{TRUX, TRUX},
-		{TRU1, TRUX},
-		{TRU2, TRUX},
-		{PIPE, PIPE},
-		{PRSE, PRSE},
-		{WELS, WELS},
-		{THRE, THRE},
-		{FXPS, THRE},
-		{TRWB, TRWB},
-		{BNDD, TRWB},
-		{TWSF, TRWB},
-		{DWSF, TRWB},
-		{ARKX, ARKX},
-		{GSEF, GSEF},
-		{JSES, JSES},
-		{XCME, XCME},
-		{XIOM, XCME},
-		{NYMS, XCME},
-		{CMES, XCME},
-		{CBTS, XCME},
-		{CECS, XCME},
-		{FCME, XCME},
-		{GLBX, XCME},
-		{XIMM, XCME},
-		{MTXX, MTXX},
-		{MTXM, MTXX},
-		{MTXS, MTXX},
-		{MTXC, MTXX},
-		{MTXA, MTXX},
-		{MKAA, MTXX},
-		{THEM, THEM},
-		{VUSA, VUSA},
-		{VFMI, VUSA},
-		{VCRS, VUSA},
-		{VALX, VUSA},
-		{FINR, FINR},
-		{FINC, FINR},
-		{OOTC, FINR},
-		{FINN, FINR},
-		{FINO, FINR},
-		{FINY, FINR},
-		{XADF, FINR},
-		{TFSU, TFSU},
-		{SGAS, SGAS},
-		{SGA2, SGAS},
-		{DBAB, DBAB},
-		{TRAI, TRAI},
-		{FTUS, FTUS},
-		{JPMS, JPMS},
-		{PUMA, PUMA},
-		{PUMX, PUMA},
-		{NXUS, NXUS},
-		{XBOX, XBOX},
-		{BBSF, BBSF},
+		{MSRP, MSCO},
+		{MSPL, MSCO},
+		{MSTC, MSCO},
+		{MSTX, MSCO},
+		{MSLP, MSCO},
+		{MSLC, MSCO},
+		{NBLX, NBLX},
 		{NFSC, NFSC},
-		{NFSA, NFSC},
 		{NFSD, NFSC},
 		{XSTM, NFSC},
-		{XGMX, XGMX},
+		{NFSA, NFSC},
+		{NMRA, NMRA},
 		{NODX, NODX},
-		{JLQD, JLQD},
-		{JSJX, JSJX},
+		{NXUS, NXUS},
+		{NYPC, NYPC},
+		{OLLC, OLLC},
+		{OPRA, OPRA},
+		{PIPE, PIPE},
+		{PRSE, PRSE},
+		{RICX, RICX},
+		{RICD, RICX},
 		{SGMA, SGMA},
 		{SHAW, SHAW},
 		{SHAD, SHAW},
-		{GTXS, GTXS},
-		{EGMT, EGMT},
-		{FSEF, FSEF},
-		{XMER, XMER},
-		{BTNL, BTNL},
-		{DWFI, DWFI},
+		{SIGX, SIGX},
+		{SOHO, SOHO},
+		{SSTX, SSTX},
+		{TERA, TERA},
+		{TFSU, TFSU},
+		{TMID, TMID},
+		{TPSE, TPSE},
+		{TPSV, TPSE},
+		{TPSB, TPSE},
+		{TRCK, TRCK},
+		{TRUX, TRUX},
+		{TRU2, TRUX},
+		{TRU1, TRUX},
+		{TRWB, TRWB},
+		{BNDD, TRWB},
+		{TWSF, TRWB},
+		{DWSF, TRWB},
+		{TRFX, TRWB},
+		{TSEF, TSEF},
+		{VFCM, VFCM},
+		{WSAG, WSAG},
+		{VNDM, WSAG},
+		{WABR, WSAG},
+		{XAQS, XAQS},
+		{XARC, XARC},
+		{XAZX, XAZX},
+		{XBTF, XBTF},
+		{XCBT, XCBT},
+		{FCBT, XCBT},
+		{XKBT, XCBT},
+		{XCCX, XCCX},
+		{XCFF, XCFF},
+		{XCME, XCME},
+		{FCME, XCME},
+		{XIMM, XCME},
+		{GLBX, XCME},
+		{XIOM, XCME},
+		{CMES, XCME},
+		{CBTS, XCME},
+		{NYMS, XCME},
+		{CECS, XCME},
+		{XCRC, XCRC},
+		{XCSC, XCSC},
+		{XCUR, XCUR},
 		{XELX, XELX},
-		{XMGE, XMGE},
-		{HRTF, HRTF},
-		{NMRA, NMRA},
-		{BLUE, BLUE},
+		{XGMX, XGMX},
 		{XINS, XINS},
+		{BLKX, XINS},
 		{INCA, XINS},
-		{MOCX, XINS},
+		{IIDX, XINS},
+		{IBLX, XINS},
+		{RCBX, XINS},
 		{ICRO, XINS},
-		{BLKX, XINS},
 		{ICBX, XINS},
-		{SCXO, SCXO},
-		{SCXS, SCXO},
-		{SCXA, SCXO},
-		{SCXM, SCXO},
-		{SCXF, SCXO},
-		{ZERO, SCXO},
-		{JPBX, JPBX},
-		{BKKT, BKKT},
-		{IEXG, IEXG},
-		{IEXD, IEXG},
-		{MAGM, MAGM},
-		{GSCO, GSCO},
-		{SGMT, GSCO},
-		{LTAA, LTAA},
-		{LMNX, LTAA},
-		{BHSF, BHSF},
-		{TSAD, TSAD},
-		{IMCS, IMCS},
-		{XOCH, XOCH},
-		{MEMX, MEMX},
-		{MEMD, MEMX},
-		{XPUS, XPUS},
-		{SAGE, SAGE},
-		{CMSF, CMSF},
-		{UBSS, UBSS},
-		{GOTC, GOTC},
-		{GTSX, GTSX},
+		{MOCX, XINS},
+		{XISX, XISX},
+		{XISE, XISX},
+		{GMNI, XISX},
+		{XTPZ, XISX},
+		{MCRY, XISX},
+		{XISA, XISX},
+		{XMAC, XMAC},
+		{XMER, XMER},
+		{XMGE, XMGE},
+		{XMID, XMID},
+		{XNAS, XNAS},
+		{XNFI, XNAS},
+		{ESPD, XNAS},
+		{MELO, XNAS},
+		{NASD, XNAS},
+		{XNMS, XNAS},
+		{XNDQ, XNAS},
+		{XNGS, XNAS},
+		{XNCM, XNAS},
+		{XNIM, XNAS},
+		{XBOS, XNAS},
+		{BOSD, XNAS},
+		{XBXO, XNAS},
+		{XPOR, XNAS},
+		{XPSX, XNAS},
+		{XBRT, XNAS},
+		{PSXD, XNAS},
+		{XPBT, XNAS},
+		{XPHO, XNAS},
+		{XPHL, XNAS},
+		{XNQL, XNQL},
+		{XNYC, XNYC},
+		{XNYM, XNYM},
+		{XCEC, XNYM},
+		{XNYE, XNYM},
+		{XNYL, XNYM},
 		{XNYS, XNYS},
-		{ALDP, XNYS},
-		{AMXO, XNYS},
-		{ARCD, XNYS},
-		{ARCO, XNYS},
+		{CISD, XNYS},
 		{XCHI, XNYS},
-		{NYSD, XNYS},
 		{XCIS, XNYS},
+		{ALDP, XNYS},
+		{ARCX, XNYS},
 		{XASE, XNYS},
 		{XNLI, XNYS},
-		{ARCX, XNYS},
-		{CISD, XNYS},
-		{GLPX, GLPX},
-		{MIHI, MIHI},
-		{XMIO, MIHI},
-		{EPRL, MIHI},
-		{EMLD, MIHI},
-		{MPRL, MIHI},
-		{EPRD, MIHI},
-		{PIPR, PIPR},
-		{PJCX, PIPR},
-		{TPSE, TPSE},
-		{TPSV, TPSE},
-		{VIRT, VIRT},
-		{XTRD, XTRD},
-		{TSBX, TSBX},
-		{ISDA, ISDA},
-		{DASH, DASH},
-		{VFCM, VFCM},
-		{OLLC, OLLC},
-		{COHR, COHR},
-		{STFX, STFX},
-		{JEFX, JEFX},
-		{OTCN, OTCN},
-		{JNST, JNST},
-		{CURX, CURX},
-		{IATS, IATS},
-		{SUNT, SUNT},
-		{INCR, INCR},
-		{ASPI, INCR},
-		{ASMT, INCR},
-		{ASPN, INCR},
-		{HRTX, HRTX},
-		{YKNA, YKNA},
-		{RCMA, RCMA},
-		{LTSE, LTSE},
-		{FREX, FREX},
-		{CCMX, CCMX},
-		{TRCX, TRCX},
-		{LEDG, LEDG},
+		{NYSD, XNYS},
+		{AMXO, XNYS},
+		{ARCD, XNYS},
+		{ARCO, XNYS},
+		{XOTC, XOTC},
+		{XPSE, XPSE},
 		{XSEF, XSEF},
-		{CALH, CALH},
-		{CPGX, CPGX},
-		{NLAX, CPGX},
-		{LAMP, LAMP},
-		{MTUS, MTUS},
-		{BVUS, MTUS},
-		{MTSB, MTUS},
-		{CLST, CLST},
-		{EQUS, EQUS},
-		{BSTX, BSTX},
-		{XTXD, XTXD},
-		{STRM, STRM},
-		{BNPC, BNPC},
-		{KLSH, KLSH},
-		{CGXS, CGXS},
-		{AMLG, AMLG},
-		{UBEC, UBEC},
-		{GFAM, GFAM},
-		{FNIX, GFAM},
-		{LATG, GFAM},
-		{BVUR, BVUR},
+		{ASEF, ASEF},
+		{OTCI, OTCI},
+		{SPTX, SPTX},
+		{CAST, CAST},
+		{USEF, USEF},
+		{SFOX, SFOX},
+		{RAJA, RAJA},
+		{LPSF, LPSF},
+		{SPAX, SPAX},
+		{LESI, LESI},
+		{INTL, INTL},
+		{U360, U360},
+		{EDXM, EDXM},
+		{IBKR, IBKR},
+		{IATS, IBKR},
+		{IEOS, IBKR},
+		{RTXF, RTXF},
+		{XIMX, XIMX},
+		{CFIM, CFIM},
+		{BNDS, BNDS},
+		{NPMS, NPMS},
+		{OCTL, OCTL},
+		{BNPH, BNPH},
+		{DWIN, DWIN},
+		{OCTC, OCTC},
+		{IBCO, IBCO},
+		{IEXA, IEXA},
+		{RBCS, RBCS},
+		{LAKE, RBCS},
 		{UFEX, UFEX},
+		{BVUR, BVUR},
 		{XMNT, XMNT},
 		{XCET, XCET},
 		{XCUE, XCUE},
-		{XUNI, XUNI},
 		{XKCE, XKCE},
 		{XSTE, XSTE},
-		{GXMA, GXMA},
+		{XUNI, XUNI},
 		{POTC, POTC},
+		{GXMA, GXMA},
 		{BVCA, BVCA},
+		{XCAR, BVCA},
 		{HSTC, HSTC},
-		{XHNX, HSTC},
 		{XHNF, HSTC},
+		{XHNX, HSTC},
 		{XSTC, XSTC},
 		{XLUS, XLUS},
 		{XZIM, XZIM},
-		{XXXX, XXXX},
-		{XOFF, XOFF},
+		{VFEX, VFEX},
 		{BILT, BILT},
+		{XOFF, XOFF},
+		{XXXX, XXXX},
 	}
 
 	for _, tt := range tests {
@@ -2099,882 +2705,1098 @@ func TestTimeZoneSeconds(t *testing.T) {
 		{XTIR, 3600},
 		{XALG, 3600},
 		{XBDV, 3600},
+		{XFTX, -14400},
+		{XA1X, -18000},
 		{BACE, -18000},
 		{BCFS, -25200},
 		{XMVL, -25200},
-		{XCNF, -10800},
 		{ROFX, -10800},
-		{XMAB, -18000},
-		{XBUE, -18000},
-		{XMEV, -18000},
 		{XBCC, -10800},
 		{MVCX, -10800},
 		{XBCM, -10800},
 		{XBCX, -10800},
+		{XBUE, -18000},
+		{XMEV, -18000},
+		{XCNF, -10800},
+		{XMAB, -18000},
 		{XMTB, -18000},
 		{XROS, -10800},
 		{XROX, -10800},
 		{XTUC, -10800},
 		{XARM, 14400},
+		{XLQC, 36000},
+		{XFEX, 36000},
+		{BLXA, 36000},
+		{APXL, 36000},
+		{CGMA, 36000},
+		{CSAU, 36000},
+		{CFAU, 36000},
+		{NECD, 0},
 		{AWBX, 36000},
 		{AWEX, 36000},
-		{APXL, 36000},
+		{BLEV, 36000},
 		{CHIA, 36000},
-		{CXAF, 36000},
-		{CXAP, 36000},
-		{CXAW, 36000},
 		{CXAR, 36000},
 		{CXAC, 36000},
+		{CXAP, 36000},
+		{CXAM, 36000},
 		{CXAQ, 36000},
 		{CXAV, 36000},
-		{CXAM, 36000},
+		{CXAF, 36000},
 		//nolint:misspell
 		{CXAN, 36000},
+		{CXAW, 36000},
+		{CXAB, 36000},
+		{CXAI, 36000},
+		{CLAU, 36000},
 		{MAQX, 36000},
 		{MACB, 36000},
-		{CSAU, 36000},
-		{CFAU, 36000},
+		{MEAU, 36000},
 		{MSAL, 36000},
-		{XYIE, 36000},
-		{CLAU, 36000},
-		{XNEC, 0},
+		{NSXB, 36000},
+		{SIGA, 36000},
+		{SIMV, 36000},
+		{XAOM, 36000},
 		{XASX, 36000},
+		{NZFX, 36000},
 		{ASXC, 36000},
-		{ASXP, 36000},
 		{ASXT, 36000},
-		{ASXV, 36000},
-		{XSFE, 36000},
 		{ASXB, 36000},
-		{SIMV, 36000},
-		{XFEX, 36000},
-		{SIGA, 36000},
-		{NSXB, 36000},
-		{CGMA, 36000},
-		{BLXA, 36000},
-		{BLEV, 36000},
-		{XLQC, 36000},
+		{ASXV, 36000},
+		{ASXP, 36000},
+		{XSFE, 36000},
+		{XNEC, 0},
+		{XYIE, 36000},
+		{GFAU, 36000},
+		{BGCA, 36000},
+		{POTL, 36000},
+		{LLAT, 3600},
+		{UCBA, 3600},
+		{SLHB, 3600},
 		{BKSK, 3600},
+		{APAW, 3600},
+		{OBKL, 3600},
+		{RBIV, 3600},
+		{RLBO, 3600},
 		{XRCB, 3600},
 		{EGSI, 3600},
-		{RBIV, 3600},
-		{APAW, 3600},
+		{XOTB, 3600},
 		{XWBO, 3600},
+		{WBGF, 3600},
+		{XCEG, 3600},
 		{EXAA, 3600},
-		{WBDM, 3600},
 		{WBAH, 3600},
+		{WBDM, 3600},
 		{XVIE, 3600},
-		{RLBO, 3600},
-		{UCBA, 3600},
-		{OBKL, 3600},
-		{SLHB, 3600},
-		{LLAT, 3600},
+		{FPWB, 3600},
+		{RVSA, 3600},
 		{BSEX, 14400},
 		{XIBE, 14400},
 		{XBAA, -18000},
 		{BFEX, 10800},
 		{XBAH, 10800},
-		{XDHA, 21600},
 		{XCHG, 21600},
+		{XDHA, 21600},
 		{XBAB, -18000},
-		{BAJM, -18000},
 		{XBIS, -18000},
+		{BAJM, -18000},
 		{BCSE, 10800},
-		{BLPX, 3600},
+		{SMBB, 3600},
 		{KBCB, 3600},
+		{BELB, 3600},
+		{BELF, 3600},
+		{BNPF, 3600},
 		{BKBR, 3600},
 		{BKBF, 3600},
+		{BEAM, 3600},
+		{MTSD, 3600},
+		{MTSF, 3600},
+		{BMTS, 3600},
+		{BLPX, 3600},
+		{FRRF, 3600},
+		{XANT, 3600},
+		{XBFO, 3600},
 		{XBRU, 3600},
+		{TNLK, 3600},
+		{ENXB, 3600},
 		{ALXB, 3600},
 		{MLXB, 3600},
-		{TNLB, 3600},
 		{VPXB, 3600},
+		{TNLB, 3600},
 		{XBRD, 3600},
-		{TNLK, 3600},
-		{BEAM, 3600},
-		{BMTS, 3600},
-		{MTSD, 3600},
-		{MTSF, 3600},
-		{SMBB, 3600},
-		{BELB, 3600},
-		{BELF, 3600},
-		{BNPF, 3600},
-		{XBDA, -18000},
+		{DBRU, 3600},
 		{X24EX, -14400},
 		{GTXE, -18000},
+		{XBDA, -18000},
 		{XBOL, -14400},
 		{XBLB, 3600},
 		{BLBF, 3600},
 		{XSSE, 3600},
 		{XBOT, 7200},
-		{BOTV, 7200},
 		{BOTE, 7200},
+		{BOTV, 7200},
+		{XBMF, -10800},
+		{XBSP, -10800},
 		{BCMM, -10800},
 		{BOVM, -10800},
 		{BRIX, -10800},
 		{BVMF, -10800},
 		{CETI, -10800},
 		{SELC, -10800},
+		{XBBF, -10800},
+		{XBVP, -10800},
+		{XRIO, -10800},
+		{XSOM, -10800},
+		{UCBG, 7200},
+		{BEBG, 7200},
+		{T212, 7200},
+		{BDSK, 7200},
+		{BGHX, 7200},
+		{IBEX, 7200},
+		{MBUL, 7200},
+		{GMBG, 7200},
 		{XBUL, 7200},
-		{ABUL, 7200},
 		{ZBUL, 7200},
-		{GBUL, 7200},
 		{JBUL, 7200},
+		{GBUL, 7200},
 		{PBUL, 7200},
 		{IBUL, 7200},
-		{IBEX, 7200},
-		{MBUL, 7200},
-		{BDSK, 7200},
-		{T212, 7200},
-		{BEBG, 7200},
-		{BGHX, 7200},
+		{ABUL, 7200},
+		{LBUL, 7200},
+		{EUFN, 7200},
 		{XCSX, 25200},
 		{XDSX, 3600},
+		{XCNQ, -18000},
+		{PURE, -18000},
+		{CSE2, -18000},
+		{EQCA, -18000},
+		{NEOE, -18000},
+		{NEOD, -18000},
+		{NEON, -18000},
+		{NEOC, -18000},
+		{BNSX, -18000},
+		{TMXS, -18000},
+		{ATSA, -18000},
+		{CAND, -18000},
 		{CANX, -18000},
 		{CHIC, -18000},
 		{XCX2, -18000},
+		{COTC, -18000},
 		{IFCA, -21600},
+		{XWCE, -21600},
+		{IVZX, -18000},
 		{LICA, -18000},
 		{MATN, -18000},
-		{XTSX, -18000},
-		{XTNX, -28800},
-		{VDRK, -18000},
-		{NEOE, -18000},
-		{NEOC, -18000},
-		{NEON, -18000},
-		{NEOD, -18000},
-		{XCNQ, -18000},
-		{PURE, -18000},
-		{CAND, -18000},
+		{NGXC, -25200},
 		{OMGA, -18000},
 		{LYNX, -18000},
+		{XALB, -25200},
 		{XATS, -18000},
+		{XATX, -18000},
+		{ADRK, -18000},
 		{XBBK, -18000},
-		{XTSE, -18000},
-		{XDRK, -18000},
-		{NGXC, -25200},
-		{XICX, -18000},
-		{BNSX, -18000},
-		{COTC, -18000},
-		{IVZX, -18000},
 		{XCXD, -18000},
+		{XICX, -18000},
 		{XMOC, -18000},
 		{XMOD, -18000},
-		{EQCA, -18000},
+		{XMOO, -18000},
+		{XTFE, -18000},
+		{XTOE, -18000},
+		{XTSE, -18000},
+		{XDRK, -18000},
+		{XTSX, -18000},
+		{VDRK, -18000},
+		{XTNX, -28800},
+		{XVSE, -28800},
 		{XBVC, -3600},
 		{XCAY, -14400},
 		{BOVA, -21600},
 		{XBCL, -14400},
 		{XSGO, -14400},
+		{GSXC, 28800},
 		{CCFX, 28800},
 		{CSSX, 28800},
-		{XDCE, 28800},
+		{SGEX, 28800},
 		{XCFE, 28800},
 		{CFBC, 28800},
-		{SGEX, 28800},
+		{XDCE, 28800},
 		{XSGE, 28800},
 		{XINE, 28800},
 		{XSHE, 28800},
 		{XSEC, 28800},
 		{XSHG, 28800},
 		{XSSC, 28800},
-		{GSXC, 28800},
+		{XSME, 28800},
 		{XZCE, 28800},
+		{BJSE, 28800},
+		{NEEQ, 28800},
 		{XBOG, -18000},
 		{XBNV, -28800},
+		{XOTP, 3600},
 		{XCRO, 3600},
 		{XTRZ, 3600},
+		{XVAR, 3600},
 		{XZAG, 3600},
+		{XZAM, 3600},
 		{ZAPA, 3600},
 		{XZAP, 3600},
-		{XOTP, 3600},
 		{DCSX, -14400},
-		{XCYS, 7200},
-		{XCYO, 7200},
-		{XECM, 7200},
-		{BCSC, 7200},
-		{ATHL, 7200},
-		{MKAP, 7200},
+		{TMCY, 7200},
 		{ATLN, 7200},
+		{ATHL, 7200},
+		{BCSC, 7200},
 		{CFIF, 7200},
-		{SIBC, 7200},
-		{RENC, 7200},
 		{GPBC, 7200},
-		{TMCY, 7200},
+		{RENC, 7200},
+		{SIBC, 7200},
+		{MKAP, 7200},
+		{XCYS, 7200},
+		{XCYO, 7200},
+		{XECM, 7200},
+		{XMME, 7200},
+		{ETOR, 7200},
+		{CSAS, 3600},
+		{KOME, 3600},
+		{RFBK, 3600},
+		{UBCZ, 3600},
+		{CSOB, 3600},
+		{CELP, 3600},
+		{CESI, 3600},
 		{FTFS, 3600},
 		{FTFM, 3600},
+		{WOOD, 3600},
+		{XPRA, 3600},
+		{XPRM, 3600},
+		{STRT, 3600},
+		{SPAD, 3600},
 		{XPXE, 3600},
 		{XRMZ, 3600},
 		{XRMO, 3600},
-		{XPRA, 3600},
-		{XPRM, 3600},
-		{WOOD, 3600},
-		{RFBK, 3600},
-		{CSOB, 3600},
-		{CELP, 3600},
-		{CESI, 3600},
-		{KOME, 3600},
-		{CSAS, 3600},
-		{UBCZ, 3600},
+		{PATF, 3600},
+		{ATAD, 3600},
+		{GXGR, 3600},
+		{GXGM, 3600},
+		{GXGF, 3600},
+		{JYSI, 3600},
+		{ABSI, 3600},
+		{ALSI, 3600},
+		{SBSI, 3600},
+		{LASP, 3600},
+		{SKSI, 3600},
+		{NYSI, 3600},
+		{JBSI, 3600},
+		{SNSI, 3600},
+		{DAMP, 3600},
+		{DASI, 3600},
 		{DKTC, 3600},
+		{NPGA, 3600},
+		{SXSI, 3600},
 		{XCSE, 3600},
-		{DCSE, 3600},
 		{DSME, 3600},
-		{MCSE, 3600},
+		{DCSE, 3600},
 		{MNDK, 3600},
 		{FNDK, 3600},
 		{DNDK, 3600},
-		{NYSI, 3600},
-		{DASI, 3600},
-		{JBSI, 3600},
-		{ABSI, 3600},
-		{SBSI, 3600},
-		{NPGA, 3600},
-		{SKSI, 3600},
-		{SXSI, 3600},
-		{LASP, 3600},
-		{SNSI, 3600},
-		{JYSI, 3600},
-		{ALSI, 3600},
+		{MCSE, 3600},
+		{XFND, 3600},
+		{XTRA, 3600},
 		{XBVR, -14400},
+		{XCVD, -14400},
 		{XGUA, -18000},
 		{XQUI, -18000},
-		{XCAI, 7200},
 		{NILX, 7200},
+		{XCAI, 7200},
 		{XSVA, -21600},
+		{LMNR, 7200},
+		{SWEE, 7200},
+		{XTAR, 7200},
 		{XTAL, 7200},
+		{XTAA, 7200},
 		{FNEE, 7200},
-		{SWEE, 7200},
-		{LMNR, 7200},
 		{VMFX, 0},
 		{XSPS, 43200},
-		{OPCO, 7200},
+		{AXSI, 7200},
 		{XNOR, 7200},
+		{OPCO, 7200},
 		{FGEX, 7200},
+		{XFOM, 7200},
 		{XHEL, 7200},
-		{MNFI, 7200},
-		{DHEL, 7200},
-		{DNFI, 7200},
 		{FSME, 7200},
+		{MNFI, 7200},
 		{FNFI, 7200},
+		{DNFI, 7200},
+		{DHEL, 7200},
 		{MHEL, 7200},
-		{GMTF, 3600},
-		{COAL, 3600},
-		{EPEX, 3600},
-		{FMTS, 3600},
+		{ESLO, 3600},
+		{ENSL, 3600},
+		{BRED, 3600},
+		{HBFR, 3600},
+		{RBCC, 3600},
+		{SGMU, 3600},
+		{SGMV, 3600},
+		{SGMW, 3600},
+		{WFSE, 3600},
+		{MKTF, 3600},
+		{AQEU, 3600},
+		{AQED, 3600},
+		{AQEA, 3600},
 		{GIPB, 3600},
 		{GSPX, 3600},
-		{XBLN, 3600},
-		{AACA, 3600},
+		{ELXE, 3600},
+		{TRXE, 3600},
+		{XTXE, 3600},
+		{GMES, 3600},
+		{GMEO, 3600},
+		{MHBP, 3600},
+		{TPIC, 3600},
+		{TPEE, 3600},
+		{TPIR, 3600},
+		{TPIO, 3600},
+		{LNEQ, 3600},
+		{LNFI, 3600},
+		{MLEX, 3600},
+		{MLER, 3600},
+		{MLES, 3600},
+		{NOWX, 3600},
+		{MSAX, 3600},
+		{MSCX, 3600},
+		{MSNT, 3600},
+		{ICOT, 3600},
+		{ICOR, 3600},
+		{TLCM, 3600},
 		{TPEU, 3600},
 		{TPER, 3600},
+		{TPFR, 3600},
+		{TEPG, 3600},
+		{TEPX, 3600},
+		{TEPR, 3600},
+		{TEPM, 3600},
+		{TEPI, 3600},
+		{TEPF, 3600},
 		{CMCI, 3600},
-		{NATX, 3600},
-		{HPCX, 3600},
-		{HPCO, 3600},
-		{HPCV, 3600},
-		{HPCS, 3600},
-		{TDON, 3600},
-		{NOWX, 3600},
-		{AQEU, 3600},
-		{AQEA, 3600},
-		{AQED, 3600},
-		{XAFR, 3600},
-		{TSAF, 3600},
-		{ELXE, 3600},
-		{TRXE, 3600},
-		{RBCC, 3600},
+		{MUBP, 3600},
+		{SMBP, 3600},
+		{EDRF, 3600},
+		{EXSE, 3600},
+		{SGOE, 3600},
 		{EXSY, 3600},
 		{EXYY, 3600},
-		{EXSB, 3600},
-		{EXSD, 3600},
 		{EXSF, 3600},
 		{EXSP, 3600},
+		{EXSD, 3600},
+		{EXSB, 3600},
 		{EXSH, 3600},
-		{MHBP, 3600},
-		{GMES, 3600},
-		{GMEO, 3600},
-		{EDRF, 3600},
 		{BPSX, 3600},
+		{HPCX, 3600},
+		{HPCS, 3600},
+		{HPCO, 3600},
+		{HPCV, 3600},
+		{MSSA, 3600},
 		{ODDO, 3600},
-		{SMBP, 3600},
-		{MUBP, 3600},
+		{ODOC, 3600},
+		{AACA, 3600},
+		{BNPA, 3600},
+		{BNPS, 3600},
 		{XSGA, 3600},
+		{KOTF, 3600},
+		{TDON, 3600},
+		{TSAF, 3600},
 		{AURB, 3600},
 		{GFPO, 3600},
 		{AURO, 3600},
-		{ODOC, 3600},
-		{BNPS, 3600},
-		{SGOE, 3600},
-		{XPOW, 3600},
-		{HBFR, 3600},
-		{XTXE, 3600},
-		{BNPA, 3600},
-		{TPIC, 3600},
-		{TPIR, 3600},
-		{TPIO, 3600},
-		{TPEE, 3600},
+		{NATX, 3600},
+		{COAL, 3600},
+		{EPEX, 3600},
+		{FMTS, 3600},
+		{GMTF, 3600},
 		{LCHC, 3600},
+		{XAFR, 3600},
+		{XBLN, 3600},
+		{XFMN, 3600},
 		{XPAR, 3600},
+		{XBLK, 3600},
+		{XETF, 3600},
 		{XAPA, 3600},
-		{MTCH, 3600},
-		{XMAT, 3600},
 		{XMLI, 3600},
 		{ALXP, 3600},
-		{XBLK, 3600},
-		{XETF, 3600},
-		{XMON, 3600},
 		{XSPM, 3600},
-		{KOTF, 3600},
-		{MSAX, 3600},
-		{MSCX, 3600},
-		{MSNT, 3600},
-		{MKTF, 3600},
-		{SGMU, 3600},
-		{SGMV, 3600},
-		{SGMW, 3600},
-		{ICOT, 3600},
-		{TLCM, 3600},
-		{ICOR, 3600},
-		{MLEX, 3600},
-		{MLER, 3600},
-		{MLES, 3600},
-		{EXSE, 3600},
-		{MAQE, 3600},
-		{WFSE, 3600},
-		{BRED, 3600},
-		{ESLO, 3600},
-		{ENSL, 3600},
+		{MTCH, 3600},
+		{XMAT, 3600},
+		{XMON, 3600},
+		{DPAR, 3600},
+		{XPOW, 3600},
+		{NABP, 3600},
 		{XGSE, 14400},
-		{CATS, 3600},
-		{X360T, 3600},
-		{DBOX, 3600},
-		{AUTO, 3600},
-		{NORD, 3600},
-		{FICX, 3600},
-		{XBER, 3600},
-		{BERA, 3600},
-		{BERB, 3600},
-		{BERC, 3600},
-		{EQTA, 3600},
-		{EQTB, 3600},
-		{EQTC, 3600},
-		{EQTD, 3600},
-		{XEQT, 3600},
-		{ZOBX, 3600},
-		{NLBX, 3600},
-		{MHBD, 3600},
+		{SEBA, 3600},
+		{SSWM, 3600},
+		{OLBB, 3600},
+		{SPEX, 3600},
+		{CSDA, 3600},
+		{CDSI, 3600},
+		{SMBD, 3600},
+		{UBSD, 3600},
+		{UBSL, 3600},
+		{UBSE, 0},
+		{UBSI, 3600},
+		{SMFF, 3600},
+		{SCAG, 3600},
+		{NCME, 3600},
+		{GSBE, 3600},
+		{GSEI, 3600},
+		{MHEU, 3600},
 		{LBCW, 3600},
-		{XECB, 3600},
-		{XSTU, 3600},
-		{EUWX, 3600},
-		{XSTF, 3600},
-		{STUC, 3600},
-		{STUD, 3600},
-		{STUA, 3600},
-		{STUB, 3600},
-		{XDEX, 3600},
+		{JEFE, 3600},
+		{JESI, 3600},
+		{RBCG, 3600},
+		{UCDE, 3600},
 		{MSEU, 3600},
 		{MESI, 3600},
-		{SMFF, 3600},
-		{SCAG, 3600},
-		{XXSC, 3600},
+		{DEKA, 3600},
+		{LIGA, 3600},
+		{MHBD, 3600},
+		{IKBS, 3600},
 		{CGMD, 3600},
+		{CGEE, 3600},
 		{CGEC, 3600},
 		{CGET, 3600},
-		{CGEE, 3600},
-		{XMUN, 3600},
-		{MUNC, 3600},
-		{MUNA, 3600},
-		{MUNB, 3600},
-		{MUND, 3600},
-		{NCME, 3600},
-		{XECC, 3600},
-		{EUWA, 3600},
-		{XEEE, 3600},
-		{XEEO, 3600},
-		{XEER, 3600},
-		{XPSF, 3600},
-		{XPOT, 3600},
-		{ECAG, 3600},
-		{ECGS, 3600},
-		{XEUP, 3600},
-		{XERE, 3600},
-		{XEUM, 3600},
-		{XEHQ, 3600},
-		{XERT, 3600},
-		{XEUR, 3600},
-		{XETR, 3600},
-		{XETA, 3600},
-		{XETB, 3600},
-		{XETW, 3600},
-		{XETV, 3600},
-		{XETU, 3600},
-		{XETS, 3600},
-		{XFRA, 3600},
-		{FRAU, 3600},
-		{FRAA, 3600},
-		{FRAB, 3600},
-		{FRAV, 3600},
-		{FRAW, 3600},
-		{FRAS, 3600},
-		{XHAM, 3600},
-		{HAMM, 3600},
-		{HAMA, 3600},
-		{HAMB, 3600},
-		{HAMN, 3600},
-		{HAML, 3600},
-		{XHAN, 3600},
-		{HANA, 3600},
-		{HANB, 3600},
+		{JPEU, 3600},
 		{HELA, 3600},
-		{XSCO, 3600},
-		{XSC2, 3600},
-		{XSC1, 3600},
-		{XSC3, 3600},
+		{NESI, 3600},
+		{LBBW, 3600},
+		{LBWL, 3600},
+		{LBWS, 3600},
 		{BLBB, 3600},
+		{BLFX, 3600},
 		{BLEQ, 3600},
-		{BSFX, 3600},
 		{BLIQ, 3600},
+		{BSFX, 3600},
 		{BDEA, 3600},
 		{BLBS, 3600},
-		{BLFX, 3600},
+		{TPDE, 3600},
+		{TSFG, 3600},
+		{TSFF, 3600},
+		{EUWA, 3600},
+		{SSBI, 3600},
+		{SSBM, 3600},
 		{DBAG, 3600},
-		{DBES, 3600},
-		{DBLN, 3600},
 		{DBMO, 3600},
+		{DBLN, 3600},
+		{DBES, 3600},
 		{VWDX, 3600},
-		{VTLS, 3600},
-		{VTPS, 3600},
 		{VWDA, 3600},
-		{SSBI, 3600},
-		{SSBM, 3600},
-		{XDUS, 3600},
-		{XQTX, 3600},
-		{DUSA, 3600},
-		{DUSB, 3600},
-		{DUSC, 3600},
-		{DUSD, 3600},
-		{NESI, 3600},
-		{UCDE, 3600},
-		{DZBK, 3600},
-		{SMBD, 3600},
-		{IKBS, 3600},
-		{DAPA, 3600},
-		{JEFE, 3600},
-		{JESI, 3600},
-		{BAAD, 3600},
-		{SPEX, 3600},
-		{HSBT, 3600},
+		{VTPS, 3600},
+		{VTLS, 3600},
+		{NORD, 3600},
+		{BINV, 3600},
+		{NLBX, 3600},
 		{TGAT, 3600},
-		{TGSI, 3600},
 		{XGAT, 3600},
+		{TGSI, 3600},
 		{XGRM, 3600},
+		{VONT, 3600},
 		{CBKA, 3600},
-		{CBKE, 3600},
+		{CBKS, 3600},
+		{CBKC, 3600},
 		{CBKD, 3600},
 		{CBKF, 3600},
+		{CBKE, 3600},
 		{CBKG, 3600},
-		{CBKC, 3600},
-		{CBKS, 3600},
-		{LSSI, 3600},
+		{DZBK, 3600},
 		{BGSI, 3600},
-		{BGFI, 3600},
 		{BGFX, 3600},
-		{CSDA, 3600},
-		{CDSI, 3600},
-		{UBSL, 3600},
-		{UBSI, 3600},
-		{BINV, 3600},
-		{LBBW, 3600},
-		{LBWS, 3600},
-		{LBWL, 3600},
-		{JPEU, 3600},
-		{OLBB, 3600},
-		{LIGA, 3600},
-		{GSBE, 3600},
-		{GSEI, 3600},
-		{VONT, 3600},
-		{DEKA, 3600},
+		{BGFI, 3600},
+		{LSSI, 3600},
+		{BAAD, 3600},
+		{HSBT, 3600},
+		{DAPA, 3600},
+		{XEEE, 3600},
+		{XPSF, 3600},
+		{XPOT, 3600},
+		{XEER, 3600},
+		{XEEO, 3600},
+		{FICX, 3600},
+		{XMUN, 3600},
+		{MUND, 3600},
+		{MUNC, 3600},
+		{MUNB, 3600},
+		{MUNA, 3600},
+		{PLUS, 3600},
+		{XHCE, 3600},
+		{XRTR, 3600},
+		{X360T, 3600},
+		{CATS, 3600},
+		{DBOX, 3600},
+		{AUTO, 3600},
+		{ECAG, 3600},
+		{ECGS, 3600},
+		{EFTP, 3600},
+		{GMEX, 3600},
+		{XBER, 3600},
+		{BERA, 3600},
+		{BERB, 3600},
+		{BERC, 3600},
+		{ZOBX, 3600},
+		{EQTA, 3600},
+		{EQTB, 3600},
+		{EQTC, 3600},
+		{EQTD, 3600},
+		{XEQT, 3600},
+		{XBRE, 3600},
+		{XDTB, 3600},
+		{XDUS, 3600},
+		{DUSA, 3600},
+		{DUSB, 3600},
+		{DUSC, 3600},
+		{DUSD, 3600},
+		{XQTX, 3600},
+		{XDWZ, 3600},
+		{XECB, 3600},
+		{XECC, 3600},
+		{XETI, 3600},
+		{XETD, 3600},
+		{XETC, 3600},
+		{XETR, 3600},
+		{XEUB, 3600},
+		{XETV, 3600},
+		{XETW, 3600},
+		{XETU, 3600},
+		{XETS, 3600},
+		{XETB, 3600},
+		{XETA, 3600},
+		{XETE, 3600},
+		{XETX, 3600},
+		{XEMA, 3600},
+		{XEMI, 3600},
+		{XEMB, 3600},
+		{XEUP, 3600},
+		{XEHQ, 3600},
+		{XERT, 3600},
+		{XERE, 3600},
+		{XEUM, 3600},
+		{XEUR, 3600},
+		{XFRA, 3600},
+		{FRAV, 3600},
+		{FRAW, 3600},
+		{FRAU, 3600},
+		{FRAS, 3600},
+		{XDBC, 3600},
+		{XDBV, 3600},
+		{FRAA, 3600},
+		{FRAD, 3600},
+		{FRAB, 3600},
+		{XDBX, 3600},
+		{XNEW, 3600},
+		{XHAM, 3600},
+		{HAMA, 3600},
+		{HAMB, 3600},
+		{HAMM, 3600},
+		{HAML, 3600},
+		{HAMN, 3600},
+		{XHAN, 3600},
+		{HANB, 3600},
+		{HANA, 3600},
 		{XINV, 3600},
-		{RBCG, 3600},
-		{MHEU, 3600},
-		{SEBA, 3600},
+		{XSCO, 3600},
+		{XSC2, 3600},
+		{XSC3, 3600},
+		{XSC1, 3600},
+		{XSTU, 3600},
+		{STUF, 3600},
+		{XSTP, 3600},
+		{STUE, 3600},
+		{XDEX, 3600},
+		{XSTF, 3600},
+		{STUC, 3600},
+		{STUD, 3600},
+		{STUB, 3600},
+		{STUA, 3600},
+		{EUWX, 3600},
+		{XXSC, 3600},
+		{DBDX, 3600},
+		{XIGG, 3600},
+		{EBLX, 3600},
+		{ERFQ, 3600},
+		{ENTW, 3600},
+		{TRBX, 3600},
+		{BOCF, 3600},
+		{X360X, 3600},
+		{SCLB, 3600},
+		{X21XX, 3600},
 		{XGHA, 0},
 		{GSXL, 3600},
-		{HDAT, 7200},
-		{ASEX, 7200},
-		{HOTC, 7200},
-		{XADE, 7200},
-		{XATH, 7200},
-		{ENAX, 7200},
-		{XIPO, 7200},
 		{PBGR, 7200},
 		{ABFI, 7200},
-		{AAPA, 7200},
+		{ERBX, 7200},
 		{HEMO, 7200},
 		{HESP, 7200},
 		{HEDE, 7200},
-		{ERBX, 7200},
+		{HGSP, 7200},
+		{AAPA, 7200},
+		{ASEX, 7200},
+		{HOTC, 7200},
+		{XADE, 7200},
+		{ENAX, 7200},
+		{XATH, 7200},
+		{XIPO, 7200},
+		{EUAX, 7200},
+		{HDAT, 7200},
 		{XGTG, -21600},
 		{XCIE, 0},
 		{GSCI, -14400},
+		{XHON, -21600},
 		{XBCV, -21600},
-		{HSXA, 25200},
-		{CGMH, 25200},
-		{DBHK, 25200},
-		{EOTC, 25200},
-		{JPMI, 25200},
-		{XCGS, 25200},
-		{XHKF, 25200},
-		{CLHK, 25200},
-		{BASE, 25200},
+		{OSDS, 25200},
 		{BAIP, 25200},
-		{BAEP, 25200},
+		{BACR, 25200},
 		{BASP, 25200},
-		{UBSX, 25200},
-		{TOCP, 25200},
+		{BAEP, 25200},
+		{BASE, 25200},
 		{TFSD, 25200},
 		{TRAS, 25200},
 		{GSAL, 25200},
-		{SIGH, 25200},
-		{GSXH, 25200},
 		{GSPL, 25200},
-		{MAQH, 25200},
+		{GSXH, 25200},
+		{SIGH, 25200},
+		{GSXM, 25200},
 		{TWHK, 25200},
-		{XPST, 25200},
-		{XIHK, 25200},
+		{CGMH, 25200},
 		{CSHK, 25200},
 		{CFHK, 25200},
+		{CLHK, 25200},
+		{DBHK, 25200},
+		{EOTC, 25200},
+		{HKME, 25200},
+		{HSXA, 25200},
+		{JPMI, 25200},
+		{MAQH, 25200},
+		{MEHK, 25200},
+		{TOCP, 25200},
+		{UBSX, 25200},
+		{XCGS, 25200},
+		{XHKF, 25200},
 		{XHKG, 25200},
 		{SHSC, 25200},
 		{SZSC, 25200},
 		{XGEM, 25200},
-		{HUPX, 3600},
-		{XGAS, 3600},
-		{KELR, 3600},
-		{ERST, 3600},
-		{XBUD, 3600},
-		{BETA, 3600},
-		{XBND, 3600},
-		{XTND, 3600},
+		{XIHK, 25200},
+		{XPST, 25200},
+		{GSXN, 25200},
 		{EBHU, 3600},
-		{CONC, 3600},
 		{UCHU, 3600},
+		{CONC, 3600},
 		{KHHU, 3600},
-		{HUDX, 3600},
+		{CIBH, 3600},
 		{OTPB, 3600},
 		{RBHU, 3600},
+		{ERST, 3600},
+		{KELR, 3600},
+		{HUDX, 3600},
+		{HUPX, 3600},
 		{KCCP, 3600},
-		{CIBH, 3600},
+		{QMTF, 3600},
+		{XQLX, 3600},
+		{XBCE, 3600},
+		{XBUD, 3600},
+		{BETA, 3600},
+		{XBND, 3600},
+		{XTND, 3600},
+		{XGAS, 3600},
 		{XICE, 0},
-		{DICE, 0},
 		{FNIS, 0},
+		{MICE, 0},
 		{DNIS, 0},
+		{ISEC, 0},
+		{DICE, 0},
 		{MNIS, 0},
-		{MICE, 0},
-		{ICXL, 19800},
-		{ACEX, 19800},
-		{IINX, 19800},
-		{ISEX, 19800},
 		{MCXX, 19800},
-		{XUSE, 19800},
-		{XDES, 19800},
-		{XBAN, 19800},
+		{IINX, 19800},
+		{ACEX, 19800},
 		{CDSL, 19800},
-		{FXSW, 19800},
-		{FXCL, 19800},
 		{ASTR, 19800},
+		{FXCL, 19800},
+		{FXSW, 19800},
+		{ICXL, 19800},
+		{ISEX, 19800},
+		{NBOT, 19800},
+		{NMCE, 19800},
 		{OTCX, 19800},
 		{PXIL, 19800},
-		{XNSE, 19800},
-		{INSE, 19800},
-		{XNCD, 19800},
-		{XCAL, 19800},
+		{XBAN, 19800},
 		{XBOM, 19800},
 		{BSME, 19800},
+		{XCAL, 19800},
+		{XDES, 19800},
 		{XIMC, 19800},
-		{NMCE, 19800},
-		{NBOT, 19800},
 		{XMDS, 19800},
+		{XNCD, 19800},
+		{XNSE, 19800},
+		{INSE, 19800},
+		{XUSE, 19800},
+		{RITS, 19800},
+		{XSUR, 25200},
+		{XJKT, 25200},
 		{ICDX, 25200},
-		{XJNB, 25200},
 		{XBBJ, 25200},
 		{XIDX, 25200},
+		{XJNB, 25200},
+		{IFBX, 12600},
 		{IMEX, 12600},
 		{XTEH, 12600},
-		{IFBX, 12600},
 		{XIQS, 10800},
-		{XCDE, 0},
-		{BBIE, 0},
-		{BBIS, 0},
-		{CSGI, 0},
+		{SIDX, 0},
+		{AILT, 0},
+		{MAQE, 0},
+		{CFIL, 0},
+		{BMLI, 0},
+		{BMLS, 0},
+		{BMLX, 0},
+		{BMSI, 0},
 		{ITGL, 0},
 		{XPOS, 0},
-		{XPAC, 0},
 		{XRFQ, 0},
-		{RMTF, 0},
-		{FXRQ, 0},
-		{FXFM, 0},
-		{FXRS, 0},
-		{CFIL, 0},
-		{BKDM, 0},
+		{XPAC, 0},
+		{CEPL, 0},
 		{TDGF, 0},
-		{AREX, 0},
+		{BKDM, 0},
+		{CSGI, 0},
+		{EUCC, 0},
 		{MCID, 0},
 		{MSEL, 0},
+		{HREU, 0},
 		{EQIE, 0},
 		{EQSE, 0},
-		{LEUE, 0},
-		{LEUF, 0},
+		{RMTF, 0},
+		{FXRS, 0},
+		{FXRQ, 0},
+		{FXFM, 0},
+		{ICUR, 0},
+		{ICXR, 0},
 		{IFXC, 0},
-		{IFXR, 0},
 		{IFXA, 0},
-		{BMLI, 0},
-		{BMLS, 0},
+		{IFXR, 0},
+		{DAVY, 0},
+		{BBIE, 0},
+		{BBIS, 0},
+		{LEUE, 0},
+		{LEUF, 0},
 		{SISI, 0},
-		{CEPL, 0},
+		{SEMX, 0},
 		{XDUB, 0},
-		{XMSM, 0},
-		{XEYE, 0},
 		{EDBT, 0},
 		{EDGL, 0},
-		{XATL, 0},
+		{XMSM, 0},
 		{XESM, 0},
+		{XEYE, 0},
+		{XATL, 0},
+		{XASM, 0},
+		{XIEX, 0},
+		{DDUB, 0},
 		{VFIL, 0},
-		{VFXO, 0},
 		{VFSI, 0},
-		{EUCC, 0},
+		{VFXO, 0},
+		{AREX, 0},
+		{XFNX, 0},
+		{XCDE, 0},
 		{XEBI, 0},
-		{ICUR, 0},
-		{ICXR, 0},
-		{DAVY, 0},
-		{HREU, 0},
-		{SEMX, 0},
-		{SIDX, 0},
-		{AILT, 0},
+		{IBSI, 0},
+		{GBSI, 0},
+		{TMEU, 0},
 		{XTAE, 7200},
-		{HMTF, 3600},
-		{HMOD, 3600},
-		{HRFQ, 3600},
-		{HCER, 3600},
-		{EMID, 3600},
-		{EMDR, 3600},
-		{EMIR, 3600},
-		{EMIB, 3600},
-		{ISBA, 3600},
-		{DDTX, 3600},
-		{BREA, 3600},
+		{UBIM, 3600},
 		{IMMH, 3600},
 		{BNLD, 3600},
-		{XGME, 3600},
+		{BREA, 3600},
+		{BPAS, 3600},
+		{CREM, 3600},
 		{UCIT, 3600},
+		{MUBM, 3600},
+		{ISBA, 3600},
+		{ISBV, 3600},
+		{BEIS, 3600},
 		{IBIS, 3600},
 		{IBEQ, 3600},
-		{ISBV, 3600},
+		{AKIS, 3600},
+		{DDTX, 3600},
+		{UBIS, 3600},
+		{FBSI, 3600},
 		{MTSO, 3600},
-		{MTSC, 3600},
-		{SSOB, 3600},
-		{EBMX, 3600},
 		{MCAD, 3600},
-		{BEIS, 3600},
-		{MUBM, 3600},
-		{CREM, 3600},
-		{UBIS, 3600},
+		{EBMX, 3600},
+		{MTSM, 3600},
+		{BOND, 3600},
+		{SSOB, 3600},
+		{MTSC, 3600},
+		{MSWP, 3600},
+		{ITSM, 3600},
+		{MTAX, 3600},
+		{XTLX, 3600},
 		{CGIT, 3600},
-		{CGCM, 3600},
-		{CGND, 3600},
+		{CGQD, 3600},
 		{CGDB, 3600},
-		{CGEB, 3600},
 		{CGTR, 3600},
-		{CGQD, 3600},
-		{CGGD, 3600},
+		{CGND, 3600},
+		{CGEB, 3600},
 		{CGQT, 3600},
-		{FBSI, 3600},
+		{CGCM, 3600},
+		{CGGD, 3600},
+		{EMID, 3600},
+		{EMIB, 3600},
+		{EMIR, 3600},
+		{EMDR, 3600},
+		{HMTF, 3600},
+		{HCER, 3600},
+		{HMOD, 3600},
+		{HRFQ, 3600},
+		{TLAB, 3600},
+		{XGME, 3600},
+		{XMIF, 3600},
 		{XMIL, 3600},
-		{XDMI, 3600},
-		{XMOT, 3600},
 		{ETLX, 3600},
+		{SEDX, 3600},
+		{ETFP, 3600},
+		{XMOT, 3600},
+		{MOTX, 3600},
+		{EXGM, 3600},
 		{MTAH, 3600},
 		{ATFX, 3600},
-		{XAIM, 3600},
-		{MOTX, 3600},
-		{ETFP, 3600},
 		{MIVX, 3600},
+		{XAIM, 3600},
+		{XDMI, 3600},
+		{MACX, 3600},
 		{MTAA, 3600},
-		{SEDX, 3600},
-		{AKIS, 3600},
+		{BGEM, 3600},
+		{DMIL, 3600},
+		{XABJ, 0},
 		{XBRV, 0},
 		{XJAM, -18000},
+		{MUDX, 32400},
+		{BNPX, 32400},
+		{SBIJ, 32400},
+		{SBIU, 32400},
+		{XSBI, 32400},
+		{SBIV, 32400},
+		{BAJD, 32400},
+		{TWJP, 32400},
+		{TWJT, 32400},
+		{BASX, 32400},
+		{UTSL, 32400},
+		{XIJP, 32400},
+		{JASR, 32400},
 		{DRCT, 32400},
-		{CHIJ, 32400},
-		{CHIV, 32400},
-		{KAIX, 32400},
-		{CHIS, 32400},
 		{CITX, 32400},
 		{CITD, 32400},
 		{CSJP, 32400},
 		{CFJP, 32400},
-		{MSMS, 32400},
-		{XKAC, 32400},
 		{NMRJ, 32400},
+		{NMSX, 32400},
 		{NXJP, 32400},
-		{NXSE, 32400},
 		{NXVW, 32400},
-		{ICHK, 32400},
-		{ICKR, 32400},
 		{ICSH, 32400},
 		{ICSZ, 32400},
+		{ICKR, 32400},
+		{ICHK, 32400},
+		{NXSE, 32400},
 		{ICTW, 32400},
-		{NMSX, 32400},
+		{NXFO, 32400},
+		{NXBX, 32400},
+		{JATA, 32400},
+		{XHER, 32400},
+		{BETP, 32400},
+		{CHIJ, 32400},
+		{KAIX, 32400},
+		{CHIS, 32400},
+		{CHIV, 32400},
 		{CLJP, 32400},
-		{XTFF, 32400},
-		{XNGO, 32400},
-		{XNKS, 32400},
-		{BASX, 32400},
-		{XTKT, 32400},
+		{CMET, 32400},
+		{KABU, 32400},
+		{VKAB, 32400},
+		{LXJP, 32400},
 		{MAQJ, 32400},
-		{SBIJ, 32400},
-		{SBIV, 32400},
-		{SBIU, 32400},
-		{XSBI, 32400},
+		{MIZX, 32400},
+		{MSMS, 32400},
 		{SIGJ, 32400},
-		{BETP, 32400},
+		{XCCE, 32400},
+		{XFFE, 32400},
 		{XFKA, 32400},
-		{TWJP, 32400},
-		{TWJT, 32400},
-		{LXJP, 32400},
-		{XSAP, 32400},
-		{MIZX, 32400},
-		{CMET, 32400},
-		{UTSL, 32400},
-		{BAJD, 32400},
+		{XHIR, 32400},
 		{XJPX, 32400},
-		{XTAM, 32400},
 		{XTK1, 32400},
-		{XTK2, 32400},
-		{XTK3, 32400},
-		{XTKS, 32400},
 		{XJAS, 32400},
+		{XTKS, 32400},
 		{XOSE, 32400},
+		{XTAM, 32400},
+		{XTK3, 32400},
+		{XTK2, 32400},
 		{XOSJ, 32400},
-		{MUDX, 32400},
-		{BNPX, 32400},
+		{XKAC, 32400},
+		{XKGT, 32400},
+		{XKKT, 32400},
+		{XKST, 32400},
+		{XKYO, 32400},
+		{XNGO, 32400},
+		{XNII, 32400},
+		{XNKS, 32400},
+		{XNST, 32400},
+		{XOSM, 32400},
+		{XOST, 32400},
+		{XSAP, 32400},
+		{XTFF, 32400},
+		{XTKA, 32400},
+		{XTKO, 32400},
+		{XTKT, 32400},
+		{XYKT, 32400},
+		{ODXE, 32400},
+		{ODST, 32400},
+		{SMBC, 32400},
+		{CILH, 32400},
+		{TICT, 32400},
+		{BGCJ, 32400},
+		{JEFA, 32400},
 		{XAMM, 7200},
 		{AMNL, 7200},
-		{ETSC, 21600},
 		{CCEX, 21600},
 		{AIXK, 21600},
+		{ETSC, 21600},
 		{XKAZ, 21600},
+		{ITSL, 21600},
 		{XNAI, 10800},
-		{XKFB, 32400},
 		{GSXK, 32400},
+		{KOCN, 32400},
+		{XKFB, 32400},
+		{XKOR, 32400},
 		{XKRX, 32400},
+		{XKON, 32400},
 		{XKFE, 32400},
 		{XKOS, 32400},
-		{XKON, 32400},
 		{XKCM, 32400},
 		{XKEM, 32400},
 		{XKUW, 10800},
 		{XKSE, 21600},
 		{XLAO, 25200},
+		{SWLV, 7200},
 		{XRIS, 7200},
 		{FNLV, 7200},
-		{SWLV, 7200},
 		{XBEY, 7200},
 		{XLSM, 7200},
+		{XNOM, 3600},
+		{XLLB, 3600},
 		{XVPB, 3600},
 		{XLGT, 3600},
-		{XLLB, 3600},
-		{XNOM, 3600},
-		{BAPX, 7200},
-		{SWLT, 7200},
+		{ARTX, 3600},
 		{SEBL, 7200},
+		{SWLT, 7200},
+		{BAPX, 7200},
 		{GETB, 7200},
+		{NASB, 7200},
 		{XLIT, 7200},
+		{XVIA, 7200},
 		{FNLT, 7200},
-		{CCLX, 3600},
+		{BGLU, 3600},
+		{BILU, 3600},
+		{BLUX, 3600},
 		{DBLX, 3600},
 		{DHLX, 3600},
-		{XVES, 3600},
-		{BLUX, 3600},
-		{BILU, 3600},
 		{KBLL, 3600},
-		{KBLT, 3600},
-		{KBLS, 3600},
 		{KBLC, 3600},
+		{KBLS, 3600},
+		{KBLT, 3600},
+		{MIBL, 3600},
+		{BCEE, 3600},
 		{RBCB, 3600},
 		{RBSI, 3600},
+		{XVES, 3600},
+		{CCLX, 3600},
 		{XLUX, 3600},
 		{EMTF, 3600},
-		{BCEE, 3600},
-		{BGLU, 3600},
+		{BDPL, 3600},
 		{XMAE, 3600},
 		{XMDG, 10800},
 		{XMSW, 7200},
-		{XLFX, 28800},
+		{XLOF, 28800},
 		{XKLS, 28800},
 		{MESQ, 28800},
+		{XLFX, 28800},
 		{XRBM, 28800},
 		{MALX, 18000},
-		{COMG, 3600},
+		{EWSM, 3600},
 		{XMAL, 3600},
 		{IFSM, 3600},
 		{PROS, 3600},
-		{GBOT, 14400},
-		{XMAU, 14400},
+		{COMG, 3600},
 		{AFEX, 14400},
+		{GBOT, 14400},
 		{XAFX, 14400},
+		{XMAU, 14400},
+		{BIVA, -21600},
 		{CGMX, -21600},
-		{XMEX, -21600},
 		{XEMD, -21600},
-		{BIVA, -21600},
+		{XMEX, -21600},
 		{XMOL, 7200},
 		{XULA, 28800},
 		{XMNX, 3600},
 		{XCAS, 0},
 		{XBVM, 7200},
+		{XMAP, 7200},
 		{XNAM, 7200},
 		{XNEP, 20700},
-		{NZFX, 43200},
+		{XAUK, 43200},
+		{XNEE, 43200},
 		{XNZE, 43200},
+		{NZXC, 43200},
+		{NZXM, 43200},
 		{XMAN, -21600},
-		{XNSA, 3600},
 		{NASX, 3600},
-		{FISH, 3600},
-		{FSHX, 3600},
-		{SPTR, 3600},
+		{XNSA, 3600},
+		{NORX, 3600},
+		{STEE, 3600},
+		{BULK, 3600},
+		{ELUK, 3600},
+		{ELNO, 3600},
+		{ELSE, 3600},
+		{ELEU, 3600},
+		{FREI, 3600},
 		{XOSL, 3600},
 		{XOAS, 3600},
-		{XOSC, 3600},
+		{MERK, 3600},
 		{XOBD, 3600},
-		{XOAM, 3600},
+		{XOAA, 3600},
 		{XOSA, 3600},
+		{BURG, 3600},
 		{MERD, 3600},
-		{MERK, 3600},
+		{XOAM, 3600},
+		{BURM, 3600},
+		{XOSC, 3600},
+		{XOAD, 3600},
 		{XOSD, 3600},
-		{XOAA, 3600},
 		{NIBR, 3600},
-		{XOAD, 3600},
+		{DOSL, 3600},
+		{SPTR, 3600},
+		{ICAS, 3600},
+		{OAPA, 3600},
 		{SB1M, 3600},
+		{XABG, 3600},
+		{CNSI, 3600},
+		{XDNB, 3600},
+		{NEXO, 3600},
+		{FISH, 3600},
+		{FSHX, 3600},
 		{NOPS, 3600},
-		{NORX, 3600},
-		{ELUK, 3600},
-		{FREI, 3600},
-		{ELEU, 3600},
-		{ELSE, 3600},
-		{ELNO, 3600},
+		{NOSC, 3600},
 		{NOTC, 3600},
-		{XDNB, 3600},
 		{OSLC, 3600},
-		{OAPA, 3600},
-		{CNSI, 3600},
 		{XIMA, 3600},
-		{NOSC, 3600},
-		{NEXO, 3600},
-		{XABG, 3600},
+		{INFT, 3600},
+		{FNDS, 3600},
+		{IFFX, 3600},
+		{QUNT, 3600},
+		{HWHE, 3600},
 		{XMUS, 14400},
-		{NCEL, 18000},
+		{MSXB, 14400},
+		{MSXO, 14400},
+		{XISL, 18000},
 		{XKAR, 18000},
+		{XLAH, 18000},
+		{NCEL, 18000},
 		{XPAE, 7200},
 		{XPTY, -18000},
 		{XPOM, 36000},
@@ -2983,1168 +3805,1558 @@ func TestTimeZoneSeconds(t *testing.T) {
 		{CLPH, 28800},
 		{PDEX, 28800},
 		{XPHS, 28800},
+		{CAPL, 3600},
+		{PARK, 3600},
+		{BNPP, 3600},
 		{PKOP, 3600},
-		{PTPG, 3600},
-		{MBPL, 3600},
 		{INGW, 3600},
-		{HBPL, 3600},
-		{BPKO, 3600},
+		{MBPL, 3600},
 		{SIAB, 3600},
-		{PARK, 3600},
+		{BHWA, 3600},
+		{BPKO, 3600},
+		{IENG, 3600},
+		{HBPL, 3600},
+		{WBKP, 3600},
+		{PTPG, 3600},
+		{MTSP, 3600},
+		{KDPW, 3600},
 		{XWAR, 3600},
-		{WDER, 3600},
-		{WETP, 3600},
+		{XGLO, 3600},
 		{WIND, 3600},
-		{WMTF, 3600},
-		{WBCL, 3600},
-		{WBON, 3600},
-		{BOSP, 3600},
-		{RPWC, 3600},
-		{TBSP, 3600},
+		{WOPO, 3600},
+		{PLPD, 3600},
 		{XNCO, 3600},
 		{WIPO, 3600},
-		{PLPD, 3600},
+		{CETO, 3600},
+		{RPWC, 3600},
+		{TBSP, 3600},
+		{TBSA, 3600},
+		{BOSP, 3600},
+		{WETP, 3600},
 		{PLPO, 3600},
 		{PLPS, 3600},
-		{TBSA, 3600},
-		{XGLO, 3600},
-		{WBKP, 3600},
-		{BNPP, 3600},
-		{CAPL, 3600},
-		{BHWA, 3600},
-		{OMIC, 0},
-		{OPEX, 0},
+		{WBLC, 3600},
+		{WBCL, 3600},
+		{WBON, 3600},
+		{WMTF, 3600},
+		{WDER, 3600},
+		{WCDE, 3600},
+		{POEE, 3600},
+		{WGAS, 3600},
+		{PLPX, 3600},
+		{NSSA, 3600},
+		{MSDM, 3600},
+		{XBRY, 3600},
+		{MBCP, 0},
 		{OMIP, 0},
 		{XLIS, 0},
-		{ALXL, 0},
 		{ENXL, 0},
+		{ALXL, 0},
 		{MFOX, 0},
 		{WQXL, 0},
+		{DLIS, 0},
 		{BFPT, 0},
+		{MDIP, 0},
+		{OMIC, 0},
+		{OPEX, 0},
+		{PMTS, 0},
 		{DSMD, 10800},
 		{TRPX, 14400},
-		{SECE, 36000},
 		{SECC, 36000},
 		{SECD, 36000},
+		{SECE, 36000},
 		{SEDC, 36000},
+		{BTRL, 7200},
+		{OTPR, 7200},
 		{BRDE, 7200},
 		{BRDS, 7200},
 		{BRDL, 7200},
+		{BMFX, 7200},
+		{SBMF, 7200},
+		{BMFM, 7200},
+		{BMFA, 7200},
 		{XBRM, 7200},
+		{EMCE, 7200},
+		{BRMF, 7200},
 		{XBSE, 7200},
 		{XCAN, 7200},
 		{XRAS, 7200},
 		{XBSD, 7200},
 		{XRPM, 7200},
-		{OTPR, 7200},
-		{BTRL, 7200},
+		{RRSI, 7200},
+		{SPBE, 10800},
+		{RUSX, 10800},
+		{XMOS, 10800},
+		{XPET, 10800},
 		{MISX, 10800},
 		{RTSX, 10800},
-		{RPDX, 10800},
+		{XSIC, 25200},
+		{XMIC, 10800},
+		{URCE, 18000},
+		{XROV, 10800},
+		{IXSP, 10800},
 		{NAMX, 10800},
+		{NNCS, 10800},
+		{RPDX, 10800},
 		{SPIM, 10800},
-		{RUSX, 10800},
+		{XAPI, 36000},
+		{XPIC, 10800},
 		{XRUS, 10800},
 		{XSAM, 14400},
-		{XMOS, 10800},
 		{XSIB, 25200},
-		{XPET, 10800},
-		{XPIC, 10800},
-		{SPBE, 10800},
+		{XVLA, 36000},
 		{ROTC, 7200},
 		{RSEX, 7200},
 		{XECS, -14400},
 		{SVXI, -14400},
 		{XSAU, 10800},
 		{XBEL, 3600},
-		{CLTD, 28800},
-		{JADX, 28800},
+		{BBLX, 28800},
+		{OSSG, 28800},
+		{CNOD, 28800},
+		{EQOS, 28800},
+		{EQOC, 28800},
+		{EQOD, 28800},
+		{ENMS, 28800},
+		{BTBS, 28800},
+		{SYFX, 28800},
+		{TSIG, 28800},
 		{APEX, 28800},
 		{APCL, 28800},
+		{TWSG, 28800},
+		{MASG, 28800},
+		{MALM, 28800},
+		{RFQS, 28800},
+		{CLTD, 28800},
+		{CHIE, 28800},
 		{ECAL, 28800},
+		{EEAL, 28800},
+		{IFSG, 28800},
+		{JADX, 28800},
+		{RTSP, 28800},
+		{FXSM, 28800},
 		{SMEX, 28800},
 		{TFSA, 28800},
-		{SYFX, 28800},
-		{TSIG, 28800},
-		{BTBS, 28800},
 		{XSES, 28800},
-		{XSIM, 28800},
+		{XSCA, 28800},
 		{XSBT, 28800},
+		{XSCL, 28800},
+		{XSIM, 28800},
 		{XSCE, 28800},
-		{XSCA, 28800},
-		{IFSG, 28800},
-		{MASG, 28800},
-		{MALM, 28800},
-		{EEAL, 28800},
-		{RTSP, 28800},
-		{TWSG, 28800},
-		{ENMS, 28800},
-		{EQOS, 28800},
-		{EQOC, 28800},
-		{EQOD, 28800},
+		{TERM, 28800},
+		{XABX, 28800},
+		{TRDC, 28800},
+		{XAND, 28800},
+		{XANM, 28800},
+		{LMAS, 28800},
+		{GFSG, 28800},
+		{BGSG, 28800},
+		{ICPS, 28800},
+		{XANS, 28800},
+		{CBSK, 3600},
+		{XRMS, 3600},
 		{SPXE, 3600},
 		{XBRA, 3600},
 		{EBRA, 3600},
-		{CBSK, 3600},
+		{VUBA, 3600},
+		{SKBB, 3600},
+		{XLJS, 3600},
 		{XLJU, 3600},
 		{XLJM, 3600},
-		{SKBB, 3600},
 		{XSOP, 3600},
+		{RMMS, 7200},
+		{RMMX, 7200},
+		{X4AXE, 7200},
+		{ZARX, 7200},
+		{A2XX, 7200},
 		{XJSE, 7200},
+		{JSER, 7200},
+		{ZFXM, 7200},
+		{JSEB, 7200},
 		{XBES, 7200},
+		{ALTX, 7200},
 		{XSAF, 7200},
 		{XSFA, 7200},
 		{YLDX, 7200},
-		{ALTX, 7200},
-		{JSEB, 7200},
-		{JSER, 7200},
-		{ZFXM, 7200},
-		{A2XX, 7200},
-		{X4AXE, 7200},
-		{ZARX, 7200},
-		{RMMS, 7200},
-		{RMMX, 7200},
-		{MIBG, 3600},
-		{MDRV, 3600},
-		{SANT, 3600},
-		{XDPA, 3600},
-		{CABK, 3600},
-		{IBGH, 3600},
-		{OMEL, 3600},
-		{PAVE, 3600},
-		{XNAF, 3600},
+		{EESX, 7200},
+		{CBMS, 7200},
+		{POSE, 3600},
+		{TEUR, 3600},
+		{CECA, 3600},
+		{BSAB, 3600},
+		{DOWM, 3600},
+		{DOWE, 3600},
+		{IBER, 3600},
+		{ALLT, 3600},
+		{CSMD, 3600},
+		{SIMD, 3600},
+		{AGBP, 3600},
+		{ABAN, 3600},
 		{BCMA, 3600},
+		{CABK, 3600},
+		{SANT, 3600},
 		{CIMD, 3600},
-		{CIMB, 3600},
+		{CIMA, 3600},
 		{CIMV, 3600},
+		{CIMB, 3600},
 		{CIME, 3600},
-		{CIMA, 3600},
 		{BBVA, 3600},
 		{CAPI, 3600},
 		{CMAP, 3600},
-		{ABAN, 3600},
+		{TPES, 3600},
+		{TOMF, 3600},
+		{TOMG, 3600},
+		{IBGH, 3600},
 		{BMEX, 3600},
 		{MABX, 3600},
+		{GROW, 3600},
+		{XMFX, 3600},
+		{XMCE, 3600},
+		{BMEA, 3600},
 		{SEND, 3600},
-		{XBAR, 3600},
-		{XBIL, 3600},
 		{XDRF, 3600},
-		{XLAT, 3600},
+		{MARF, 3600},
+		{BMCL, 3600},
+		{MERF, 3600},
+		{XBIL, 3600},
 		{XMAD, 3600},
-		{XMRV, 3600},
+		{SBIL, 3600},
+		{SBAR, 3600},
 		{XVAL, 3600},
+		{XBAR, 3600},
+		{XLAT, 3600},
+		{XMEF, 3600},
 		{XMPW, 3600},
-		{XMFX, 3600},
-		{SBAR, 3600},
-		{SBIL, 3600},
-		{MERF, 3600},
-		{BMCL, 3600},
-		{BMEA, 3600},
-		{MARF, 3600},
-		{GROW, 3600},
-		{ALLT, 3600},
-		{CSMD, 3600},
-		{SIMD, 3600},
-		{AGBP, 3600},
-		{DOWM, 3600},
-		{DOWE, 3600},
-		{TPES, 3600},
-		{TOMG, 3600},
-		{IBER, 3600},
-		{CECA, 3600},
-		{BSAB, 3600},
-		{TEUR, 3600},
+		{XMRV, 3600},
+		{SCLE, 3600},
+		{MIBG, 3600},
+		{MDRV, 3600},
+		{OMEL, 3600},
+		{PAVE, 3600},
+		{XBAV, 3600},
+		{XDPA, 3600},
+		{XFCM, 3600},
+		{XNAF, 3600},
+		{XSRM, 3600},
 		{XCOL, 19800},
 		{XKHA, 7200},
 		{XSWA, 7200},
+		{PEPW, 3600},
+		{PEPQ, 3600},
+		{PEPY, 3600},
+		{PEPH, 3600},
+		{PEPM, 3600},
+		{XSAT, 3600},
+		{SPDK, 3600},
+		{SPNO, 3600},
+		{SPFI, 3600},
+		{SPEU, 3600},
+		{GFKS, 3600},
+		{SEBX, 3600},
+		{SEBS, 3600},
+		{ENSX, 3600},
+		{XABC, 3600},
 		{SVEX, 3600},
 		{SVES, 3600},
-		{XABC, 3600},
-		{XNGM, 3600},
-		{NMTF, 3600},
-		{NSME, 3600},
-		{CRYX, 3600},
 		{CASI, 3600},
-		{CRYD, 3600},
-		{XSAT, 3600},
-		{SEBS, 3600},
-		{GFKS, 3600},
 		{SWBI, 3600},
+		{NAPA, 3600},
+		{CRYD, 3600},
+		{CRYX, 3600},
+		{XNGM, 3600},
+		{XNDX, 3600},
+		{XNMR, 3600},
+		{NSME, 3600},
+		{NMTF, 3600},
+		{XOME, 3600},
 		{XSTO, 3600},
-		{ESTO, 3600},
-		{FIED, 3600},
-		{DSTO, 3600},
-		{DOSE, 3600},
-		{DNSE, 3600},
-		{EBON, 3600},
-		{NOFI, 3600},
-		{MSTO, 3600},
+		{NOCO, 3600},
 		{SSME, 3600},
-		{CSTO, 3600},
-		{MNSE, 3600},
-		{SEED, 3600},
+		{GBWB, 3600},
 		{MOSE, 3600},
+		{DOSE, 3600},
+		{ESTO, 3600},
+		{ONSE, 3600},
 		{DKED, 3600},
+		{DKFI, 3600},
 		{NOED, 3600},
-		{XOPV, 3600},
-		{FNSE, 3600},
+		{SEED, 3600},
 		{PNED, 3600},
-		{EUWB, 3600},
 		{USWB, 3600},
-		{GBWB, 3600},
-		{DKFI, 3600},
-		{ONSE, 3600},
-		{NAPA, 3600},
-		{PEPW, 3600},
-		{PEPM, 3600},
-		{PEPQ, 3600},
-		{PEPH, 3600},
-		{PEPY, 3600},
-		{OTXB, 3600},
-		{CCMS, 3600},
-		{CSAG, 3600},
-		{CSOT, 3600},
-		{XBRN, 3600},
-		{S3FM, 3600},
-		{CSZH, 3600},
-		{UBSG, 3600},
-		{UBSF, 3600},
-		{UBSC, 3600},
-		{STOX, 3600},
-		{XSCU, 3600},
-		{XSTV, 3600},
-		{XSTX, 3600},
-		{DOTS, 3600},
-		{EBSS, 3600},
-		{EBSC, 3600},
+		{NOFI, 3600},
+		{FIED, 3600},
+		{EBON, 3600},
+		{EUWB, 3600},
+		{XOPV, 3600},
+		{NASN, 3600},
+		{DNSE, 3600},
+		{FNSE, 3600},
+		{CSTO, 3600},
+		{MNSE, 3600},
+		{MSTO, 3600},
+		{DSTO, 3600},
+		{USOB, 3600},
+		{SEWB, 3600},
+		{DKWB, 3600},
+		{NOWB, 3600},
+		{SEOB, 3600},
+		{DKOB, 3600},
+		{EUOB, 3600},
+		{GBOB, 3600},
+		{NOOB, 3600},
+		{NSPO, 3600},
 		{XSWX, 3600},
-		{XBTR, 3600},
+		{XSDX, 3600},
+		{XDLP, 3600},
 		{XSWM, 3600},
+		{XSLS, 3600},
 		{XSEB, 3600},
-		{XQMH, 3600},
-		{XSDX, 3600},
+		{XBTR, 3600},
+		{XVTX, 3600},
 		{XQOD, 3600},
-		{XDLP, 3600},
+		{XQMH, 3600},
 		{CBOE, 3600},
-		{VLEX, 3600},
+		{XICB, 3600},
+		{EUCH, 3600},
+		{EUSP, 3600},
+		{EURM, 3600},
+		{EUSC, 3600},
+		{CSAG, 3600},
+		{CSOT, 3600},
+		{CCMS, 3600},
+		{CSZH, 3600},
+		{OTXB, 3600},
 		{ROSR, 3600},
-		{XROT, 3600},
 		{XREP, 3600},
+		{XROT, 3600},
+		{EBSS, 3600},
+		{EBSC, 3600},
+		{VLEX, 3600},
+		{AIXE, 3600},
+		{DOTS, 3600},
+		{S3FM, 3600},
+		{STOX, 3600},
+		{XSTV, 3600},
+		{XSCU, 3600},
+		{XSTX, 3600},
+		{UBSG, 3600},
+		{UBSC, 3600},
+		{UBSF, 3600},
+		{UBST, 3600},
+		{XBRN, 3600},
+		{EQWB, 3600},
+		{XSWO, 3600},
 		{ZKBX, 3600},
 		{KMUX, 3600},
+		{RULE, 3600},
+		{BXDA, 3600},
+		{TDXS, 3600},
 		{XDSE, 7200},
+		{GSXT, 28800},
+		{CFTW, 28800},
 		{ROCO, 28800},
+		{XIME, 28800},
+		{XTAD, 28800},
 		{XTAF, 28800},
 		{XTAI, 28800},
-		{CFTW, 28800},
-		{GSXT, 28800},
 		{XDAR, 10800},
-		{BEEX, 25200},
 		{AFET, 25200},
-		{XBKK, 25200},
-		{XBKF, 25200},
-		{XMAI, 25200},
+		{BEEX, 25200},
 		{TFEX, 25200},
-		{CLMX, 3600},
+		{XBKK, 25200},
+		{XMAI, 25200},
+		{XBKF, 25200},
+		{RR4G, 3600},
+		{TRNL, 3600},
+		{ABNC, 3600},
+		{JNSI, 3600},
 		{MANL, 3600},
 		{MXNL, 3600},
+		{RFQN, 3600},
+		{CAVD, 3600},
+		{JLEU, 3600},
+		{MHBE, 3600},
+		{BTAM, 3600},
+		{BTQE, 3600},
+		{CABV, 3600},
+		{TOWR, 3600},
 		{FLWX, 3600},
 		{FLTR, 3600},
 		{FLTB, 3600},
-		{MHBE, 3600},
-		{JLEU, 3600},
-		{NDEX, 3600},
-		{IMCO, 3600},
-		{IMEQ, 3600},
-		{NDXS, 3600},
-		{INGB, 3600},
-		{INGE, 3600},
-		{INGF, 3600},
-		{ETPA, 3600},
-		{XAMS, 3600},
-		{TNLA, 3600},
-		{XEUC, 3600},
-		{XEUE, 3600},
-		{XEUI, 3600},
-		{HCHC, 3600},
-		{COMM, 3600},
+		{IPNL, 3600},
+		{ISWP, 3600},
+		{ISWO, 3600},
+		{ISWN, 3600},
+		{ISWT, 3600},
 		{EBSN, 3600},
+		{NEXY, 3600},
 		{RESF, 3600},
 		{EBSF, 3600},
 		{IEBS, 3600},
+		{EBSI, 3600},
+		{RESE, 3600},
+		{EBSD, 3600},
 		{ECEU, 3600},
-		{BTAM, 3600},
-		{BTQE, 3600},
-		{CAVD, 3600},
-		{ABNA, 3600},
-		{TWEU, 3600},
-		{TWEA, 3600},
-		{TWEM, 3600},
-		{TWEO, 3600},
-		{NLPX, 3600},
-		{RABO, 3600},
-		{XEMS, 3600},
-		{CABV, 3600},
-		{TOWR, 3600},
-		{NPEX, 3600},
-		{BAPE, 3600},
-		{BTFE, 3600},
-		{AFSA, 3600},
-		{AFSL, 3600},
-		{AFSX, 3600},
-		{AFSI, 3600},
-		{AFSO, 3600},
-		{NWNV, 3600},
+		{OHVO, 3600},
+		{MUSN, 3600},
+		{NIBC, 3600},
+		{CCRM, 3600},
+		{CEDX, 3600},
+		{BARU, 3600},
+		{BEUO, 3600},
+		{BEUT, 3600},
 		{CCXE, 3600},
-		{CEUX, 3600},
-		{CEUD, 3600},
 		{BEUP, 3600},
+		{BEUF, 3600},
+		{CEUD, 3600},
+		{BEUD, 3600},
+		{CEUX, 3600},
 		{CEUO, 3600},
-		{LISZ, 3600},
+		{BEUE, 3600},
+		{CEUE, 3600},
 		{CAPA, 3600},
+		{LISZ, 3600},
+		{NWNV, 3600},
+		{ABNA, 3600},
 		{TQEX, 3600},
-		{TQEA, 3600},
-		{TQEM, 3600},
 		{TQEB, 3600},
-		{MUBE, 3600},
-		{CCRM, 3600},
-		{BEUO, 3600},
-		{BEUT, 3600},
-		{BARU, 3600},
-		{CEDX, 3600},
+		{TQEM, 3600},
+		{TQEA, 3600},
 		{IMCT, 3600},
-		{NIBC, 3600},
+		{MUBE, 3600},
+		{BAPE, 3600},
+		{BTFE, 3600},
+		{COMM, 3600},
+		{NPEX, 3600},
+		{AFSA, 3600},
+		{AFSO, 3600},
+		{AFSX, 3600},
+		{AFSL, 3600},
+		{AFSI, 3600},
+		{ETPA, 3600},
+		{RABO, 3600},
+		{INGB, 3600},
+		{INGE, 3600},
+		{INGF, 3600},
 		{CPTX, 3600},
-		{MUSN, 3600},
-		{TRNL, 3600},
-		{AFSE, 3600},
-		{ISWP, 3600},
-		{ISWN, 3600},
-		{ISWO, 3600},
-		{ISWT, 3600},
+		{TOMX, 3600},
+		{TOMD, 3600},
+		{NDEX, 3600},
+		{IMCO, 3600},
+		{IMEQ, 3600},
+		{NDXS, 3600},
 		{XNXC, 3600},
 		{XNXD, 3600},
-		{OHVO, 3600},
-		{JNSI, 3600},
-		{ABNC, 3600},
+		{AFSE, 3600},
+		{CLMX, 3600},
+		{ECXE, 3600},
+		{HCHC, 3600},
+		{NLPX, 3600},
+		{TWEU, 3600},
+		{TWEM, 3600},
+		{TWEA, 3600},
+		{TWEO, 3600},
+		{XACE, 3600},
+		{XAEX, 3600},
+		{XAMS, 3600},
+		{ALXA, 3600},
+		{XEUC, 3600},
+		{XEUI, 3600},
+		{TNLA, 3600},
+		{XHFT, 3600},
+		{XEUE, 3600},
+		{DAMS, 3600},
+		{XEMS, 3600},
+		{XFTA, 3600},
+		{STXS, 3600},
+		{CBAE, 3600},
+		{LEBV, 3600},
+		{GMGE, 3600},
+		{D2XG, 3600},
+		{D2XC, 3600},
 		{XTRN, -14400},
 		{XTUN, 3600},
+		{BTUN, 3600},
+		{TMEX, 10800},
+		{EWRM, 10800},
 		{EXTR, 10800},
-		{XEID, 10800},
 		{XEDA, 10800},
+		{XEID, 10800},
+		{XIAB, 10800},
 		{XIST, 10800},
-		{XEQY, 10800},
-		{XFNO, 10800},
 		{XPMS, 10800},
+		{XFNO, 10800},
 		{XDSM, 10800},
-		{TMEX, 10800},
-		{EWRM, 10800},
+		{XEQY, 10800},
+		{XTUR, 10800},
 		{ULTX, 10800},
 		{XUGA, 10800},
+		{UICE, 7200},
+		{XNDU, 7200},
 		{EESE, 7200},
-		{XKHR, 7200},
-		{XKIE, 7200},
-		{XDFB, 7200},
-		{XKIS, 7200},
 		{PFTS, 7200},
 		{PFTQ, 7200},
-		{UKEX, 7200},
 		{SEPE, 7200},
-		{XUAX, 7200},
-		{UICE, 7200},
+		{UKEX, 7200},
+		{XDFB, 7200},
+		{XKHR, 7200},
+		{XKIE, 7200},
+		{XKIS, 7200},
 		{XODE, 7200},
-		{XUKR, 7200},
-		{XNDU, 7200},
 		{XPRI, 7200},
-		{DGCX, 14400},
-		{DIFX, 14400},
-		{DUMX, 14400},
-		{XDFM, 14400},
-		{GMGD, 14400},
-		{XADS, 14400},
-		{GLOM, 14400},
+		{XUAX, 7200},
+		{XUKR, 7200},
+		{MIDC, 14400},
 		{DEXE, 14400},
-		{KRME, 14400},
-		{MATX, 14400},
+		{GLOM, 14400},
 		{IFAD, 14400},
-		{ABXX, 14400},
-		{MIDC, 14400},
-		{BALT, 0},
-		{BLTX, 0},
-		{BGCI, 0},
-		{BGCO, 0},
-		{BGCB, 0},
-		{BOAT, 0},
-		{BOSC, 0},
-		{GFIC, 0},
-		{GFIF, 0},
-		{GFIN, 0},
-		{GFIR, 0},
-		{GFSO, 0},
-		{GFSM, 0},
-		{BTEE, 0},
-		{BTQG, 0},
-		{EBSM, 0},
-		{BCXE, 0},
-		{BATE, 0},
-		{CHIX, 0},
-		{BATF, 0},
-		{CHIO, 0},
-		{BATP, 0},
-		{BATD, 0},
-		{BOTC, 0},
-		{CHID, 0},
-		{LISX, 0},
-		{EXEU, 0},
-		{EXMP, 0},
-		{EXOR, 0},
-		{EXVP, 0},
-		{EXBO, 0},
-		{EXCP, 0},
-		{EXSI, 0},
-		{EXDC, 0},
-		{EXLP, 0},
-		{CCO2, 0},
-		{CHEV, 0},
-		{BLNK, 0},
-		{NDCM, 0},
-		{FAIR, 0},
-		{GEMX, 0},
-		{IFEU, 0},
-		{CXRT, 0},
-		{IFLO, 0},
-		{IFLL, 0},
-		{CXOT, 0},
-		{IFUT, 0},
-		{IFLX, 0},
-		{IFEN, 0},
-		{ISWA, 0},
-		{ISWR, 0},
-		{ISWB, 0},
-		{ISWC, 0},
-		{KLEU, 0},
-		{LIQU, 0},
-		{LIQH, 0},
-		{LIQF, 0},
-		{LMAX, 0},
-		{LMAD, 0},
-		{LMAE, 0},
-		{LMAF, 0},
-		{LMAO, 0},
-		{LPPM, 0},
-		{MAEL, 0},
-		{MXLM, 0},
-		{MFGL, 0},
-		{XLDN, 0},
-		{TNLL, 0},
-		{XSMP, 0},
-		{ENSY, 0},
-		{MAQI, 0},
-		{VAGL, 0},
-		{GMEG, 0},
-		{XLDX, 0},
-		{XGDX, 0},
-		{XGSX, 0},
-		{XGCX, 0},
-		{BRNX, 0},
-		{TPSL, 0},
-		{TSMR, 0},
-		{CSIN, 0},
-		{CSSI, 0},
-		{XSWB, 0},
-		{QWIX, 0},
-		{RBSX, 0},
-		{MCUR, 0},
-		{MCXR, 0},
-		{MCXS, 0},
-		{UKPX, 0},
-		{ENCL, 0},
+		{GMGD, 14400},
+		{DIFX, 14400},
+		{DGCX, 14400},
+		{DUMX, 14400},
+		{KRME, 14400},
+		{MATX, 14400},
+		{XADS, 14400},
+		{XDFM, 14400},
+		{VMEX, 14400},
+		{ABXX, 14400},
+		{ACXL, 14400},
+		{ACXC, 14400},
+		{RFIM, 14400},
+		{XGGI, 14400},
+		{DRSP, 0},
+		{ZODM, 0},
+		{TRUK, 0},
+		{UGEN, 0},
+		{IOTF, 0},
+		{ILCM, 0},
+		{IUOB, 0},
+		{IOFB, 0},
+		{IOFI, 0},
+		{IOFX, 0},
+		{IOGB, 0},
+		{IOCD, 0},
+		{IOED, 0},
+		{IOGI, 0},
+		{IOIR, 0},
+		{IOMM, 0},
+		{XUBS, 0},
+		{XUMP, 0},
+		{DBIX, 0},
+		{DBDC, 0},
+		{DBSE, 0},
+		{DBCX, 0},
+		{DBCR, 0},
+		{UNGB, 0},
+		{CFIC, 0},
 		{TRAX, 0},
-		{CCEU, 0},
-		{RBCE, 0},
-		{EXOT, 0},
-		{GRIF, 0},
-		{GRIO, 0},
-		{CSLB, 0},
-		{GSIL, 0},
-		{GSSI, 0},
-		{XGCL, 0},
-		{XCOR, 0},
-		{GRSE, 0},
-		{VEGA, 0},
-		{ISSI, 0},
-		{LOYD, 0},
-		{SPRZ, 0},
-		{MYTR, 0},
-		{N2EX, 0},
-		{CLVE, 0},
-		{CIBC, 0},
-		{CGMG, 0},
-		{AUTX, 0},
-		{AUTB, 0},
-		{AUTP, 0},
-		{AFDL, 0},
-		{VCMO, 0},
-		{PIEU, 0},
-		{PIRM, 0},
+		{SIFX, 0},
+		{ARAX, 0},
+		{RABL, 0},
+		{NTUK, 0},
+		{EVOL, 0},
+		{BSPL, 0},
+		{BNPL, 0},
+		{MSBI, 0},
+		{MBSI, 0},
+		{AQSE, 0},
+		{AQST, 0},
+		{AQSL, 0},
+		{AQSN, 0},
+		{AQSG, 0},
+		{AQSF, 0},
+		{AQSD, 0},
+		{LELE, 0},
+		{WFLB, 0},
+		{NBFL, 0},
+		{NEXX, 0},
+		{NEXT, 0},
+		{NEXN, 0},
+		{NEXF, 0},
+		{NEXG, 0},
+		{NEXL, 0},
+		{NEXD, 0},
+		{MAXD, 0},
+		{SKYX, 0},
+		{XLCH, 0},
+		{CLCH, 0},
+		{BUYN, 0},
+		{DAUK, 0},
+		{BSLB, 0},
+		{RBCM, 0},
+		{VFGB, 0},
+		{VFUK, 0},
+		{VIUK, 0},
+		{XPOL, 0},
+		{XPAL, 0},
+		{BBSX, 0},
+		{JLSI, 0},
+		{SCOT, 0},
+		{XSGB, 0},
+		{VAGM, 0},
+		{BMCM, 0},
+		{STFL, 0},
 		{XALT, 0},
+		{ARCH, 0},
+		{ARDA, 0},
+		{SNUK, 0},
+		{CGMG, 0},
+		{FNUK, 0},
+		{CBNL, 0},
+		{BGUK, 0},
+		{BGFU, 0},
+		{CLVE, 0},
+		{CEPU, 0},
 		{RTSL, 0},
-		{TRAL, 0},
 		{TRFW, 0},
-		{BCSL, 0},
-		{BCSI, 0},
-		{TRDE, 0},
-		{TCDS, 0},
-		{TRDX, 0},
-		{ELIX, 0},
-		{PFXD, 0},
-		{PARX, 0},
-		{XLBM, 0},
-		{SNUK, 0},
-		{TWGP, 0},
-		{PPEX, 0},
-		{BMLB, 0},
-		{NEXS, 0},
-		{REST, 0},
-		{EBSO, 0},
-		{TRQX, 0},
-		{TRQM, 0},
-		{TRQB, 0},
-		{TRQA, 0},
-		{TRQC, 0},
-		{SPEC, 0},
-		{BMTF, 0},
-		{SQUA, 0},
-		{ICEO, 0},
-		{IECL, 0},
-		{BSLB, 0},
+		{TRAL, 0},
+		{ECNL, 0},
 		{UBSB, 0},
 		{UBSY, 0},
-		{AMPX, 0},
-		{VIUK, 0},
-		{XPOL, 0},
-		{XPAL, 0},
-		{VFGB, 0},
-		{XIEL, 0},
-		{BLOX, 0},
-		{BRFQ, 0},
-		{BNTW, 0},
-		{IOTC, 0},
-		{SWAP, 0},
-		{NOSI, 0},
+		{SSIL, 0},
+		{BETX, 0},
+		{BTLX, 0},
+		{AQXE, 0},
+		{EIXE, 0},
+		{AQXA, 0},
+		{AQXD, 0},
 		{ICPM, 0},
-		{IMGI, 0},
-		{IMET, 0},
-		{UKOR, 0},
 		{UKRE, 0},
-		{IMGB, 0},
+		{UKOR, 0},
 		{IMSB, 0},
+		{IMGI, 0},
 		{IMRD, 0},
-		{IMED, 0},
+		{IMET, 0},
+		{IMGB, 0},
 		{IMMM, 0},
-		{IMCE, 0},
 		{IMFD, 0},
-		{CEPU, 0},
-		{CCML, 0},
-		{FNUK, 0},
-		{NXEU, 0},
-		{LCUR, 0},
-		{IBAL, 0},
-		{SMBE, 0},
-		{TREU, 0},
-		{TREO, 0},
-		{TREA, 0},
-		{ATLB, 0},
-		{CIBP, 0},
-		{BGUK, 0},
-		{BGFU, 0},
-		{ECSL, 0},
+		{IMCE, 0},
+		{IMED, 0},
+		{IMCM, 0},
+		{IMCD, 0},
+		{NCML, 0},
 		{XPUK, 0},
-		{NWMS, 0},
-		{NOFF, 0},
-		{VTBC, 0},
-		{TCML, 0},
-		{TDBL, 0},
+		{STSI, 0},
+		{MLIB, 0},
+		{MHBL, 0},
+		{EQLD, 0},
+		{EQSL, 0},
+		{BRGA, 0},
 		{SPDX, 0},
-		{INVE, 0},
-		{BETX, 0},
-		{BTLX, 0},
-		{SSIL, 0},
-		{SECF, 0},
+		{ECSL, 0},
+		{TPIS, 0},
+		{TPMF, 0},
+		{TPSY, 0},
+		{TPMG, 0},
+		{TPLF, 0},
+		{CIBC, 0},
+		{CIBP, 0},
+		{ISSI, 0},
+		{WSIL, 0},
+		{MAKX, 0},
+		{WSIN, 0},
+		{WELN, 0},
+		{BMLB, 0},
+		{CBAL, 0},
+		{MUSE, 0},
+		{NABE, 0},
+		{NABU, 0},
+		{NABL, 0},
+		{NABA, 0},
+		{METZ, 0},
+		{MUBL, 0},
+		{SMBG, 0},
+		{SMBE, 0},
+		{MAQI, 0},
+		{MAQU, 0},
+		{NURO, 0},
+		{XNLX, 0},
+		{NURD, 0},
+		{NOME, 0},
+		{HRSI, 0},
+		{R5FX, 0},
+		{OCSI, 0},
+		{LBCM, 0},
+		{SBEX, 0},
+		{VTBC, 0},
+		{IPSX, 0},
+		{IPXW, 0},
+		{IPXP, 0},
+		{ATLB, 0},
+		{DAIW, 0},
+		{IMTF, 0},
+		{STAL, 0},
+		{FXOP, 0},
+		{TRDE, 0},
+		{PFXD, 0},
+		{TFSG, 0},
+		{TCDS, 0},
+		{NAVE, 0},
+		{EMCH, 0},
+		{VOLA, 0},
+		{PARX, 0},
+		{ELIX, 0},
+		{TRDX, 0},
+		{TFSS, 0},
+		{DBVX, 0},
+		{TFSC, 0},
+		{OILX, 0},
+		{TCME, 0},
+		{TFSE, 0},
+		{IGDL, 0},
+		{ISWE, 0},
+		{ISWV, 0},
+		{JSSI, 0},
+		{TWGP, 0},
+		{GMGL, 0},
+		{SEDR, 0},
+		{ANTS, 0},
 		{SGMX, 0},
 		{SGMZ, 0},
 		{SGMY, 0},
-		{SHAR, 0},
-		{MFXC, 0},
-		{MFXA, 0},
-		{MFXR, 0},
-		{OCSI, 0},
-		{EQLD, 0},
-		{EQSL, 0},
-		{LOUI, 0},
+		{UBIN, 0},
+		{CSLB, 0},
+		{JISI, 0},
+		{JEFS, 0},
+		{GFIC, 0},
+		{GFSM, 0},
+		{GFSO, 0},
+		{GFIF, 0},
+		{GFIN, 0},
+		{GFIR, 0},
+		{XGFI, 0},
+		{GFIM, 0},
+		{MLXN, 0},
+		{MLAX, 0},
+		{MLVE, 0},
+		{MLEU, 0},
+		{JPCB, 0},
+		{TDBL, 0},
+		{ICEO, 0},
+		{IECE, 0},
+		{ISWA, 0},
+		{ISWR, 0},
+		{ISWC, 0},
+		{ISWB, 0},
+		{NWMS, 0},
+		{RBCE, 0},
+		{KBLM, 0},
+		{XIEL, 0},
+		{BRFQ, 0},
+		{BNTW, 0},
+		{BLOX, 0},
+		{IOTC, 0},
+		{BANA, 0},
+		{BASI, 0},
+		{BOAL, 0},
 		{CSEC, 0},
-		{AQXE, 0},
-		{AQXA, 0},
-		{EIXE, 0},
-		{IPSX, 0},
-		{IPXW, 0},
-		{IPXP, 0},
-		{IFLS, 0},
-		{FISU, 0},
-		{SFCL, 0},
-		{XMTS, 0},
-		{BVUK, 0},
-		{UKGD, 0},
-		{BCRM, 0},
-		{BART, 0},
-		{BARO, 0},
-		{BARK, 0},
-		{BOFS, 0},
-		{LBCM, 0},
-		{TPEO, 0},
-		{TEMM, 0},
-		{TEFX, 0},
-		{TEGB, 0},
-		{TERE, 0},
-		{TECO, 0},
-		{TEIR, 0},
-		{TEGI, 0},
-		{PVMF, 0},
-		{JPSI, 0},
+		{MLIX, 0},
+		{MLSI, 0},
+		{MLRQ, 0},
+		{SQUA, 0},
 		{SSBT, 0},
 		{SSFX, 0},
+		{ARIA, 0},
+		{DVFX, 0},
+		{LOUI, 0},
+		{CCEU, 0},
+		{XTXM, 0},
+		{VAGL, 0},
+		{BCSL, 0},
+		{BCSI, 0},
+		{GFIB, 0},
+		{GFBM, 0},
+		{GFBO, 0},
+		{SFCL, 0},
+		{SUNB, 0},
+		{SUNM, 0},
+		{SUNO, 0},
+		{TPSL, 0},
+		{TSUK, 0},
+		{TSMC, 0},
+		{TSMG, 0},
+		{TSMI, 0},
+		{TSMB, 0},
+		{TSMR, 0},
 		{TPSO, 0},
+		{TUOB, 0},
+		{TEEG, 0},
 		{TSCB, 0},
+		{TSRE, 0},
+		{TSCD, 0},
 		{TSGB, 0},
 		{TSFI, 0},
-		{TUOB, 0},
 		{TSED, 0},
 		{TSGI, 0},
-		{TSCD, 0},
 		{TSMM, 0},
-		{TSRE, 0},
-		{EMBX, 0},
-		{STFL, 0},
-		{CRYP, 0},
-		{HRSI, 0},
-		{UBIN, 0},
-		{JPCB, 0},
-		{XTXM, 0},
-		{SUNB, 0},
-		{SUNO, 0},
-		{GFIB, 0},
-		{GFBM, 0},
-		{GFBO, 0},
-		{METZ, 0},
-		{GSIB, 0},
-		{BISI, 0},
-		{MUSE, 0},
-		{NABL, 0},
-		{NABA, 0},
-		{MUFP, 0},
-		{BANA, 0},
-		{BOAL, 0},
-		{BASI, 0},
-		{DVFX, 0},
-		{XLON, 0},
-		{XLOD, 0},
-		{XLOM, 0},
-		{AIMX, 0},
-		{MUBL, 0},
-		{CSEU, 0},
-		{CSCF, 0},
-		{SICS, 0},
-		{CSBX, 0},
-		{XLCH, 0},
-		{CLCH, 0},
-		{BUYN, 0},
-		{MUTI, 0},
-		{ANZL, 0},
-		{ANLP, 0},
-		{SKYX, 0},
-		{CMEE, 0},
-		{CMEC, 0},
-		{PEEL, 0},
-		{XRSP, 0},
-		{XPHX, 0},
-		{WINS, 0},
-		{WINX, 0},
-		{CBNL, 0},
-		{IMTF, 0},
-		{DAIW, 0},
-		{KBLM, 0},
+		{TSIR, 0},
+		{TSFX, 0},
+		{CGML, 0},
+		{CGME, 0},
+		{CGMC, 0},
+		{CGMU, 0},
+		{CGMT, 0},
+		{MAQL, 0},
 		{TPEL, 0},
-		{TEMI, 0},
-		{TEMB, 0},
+		{TEMG, 0},
+		{TEMC, 0},
 		{TEFD, 0},
+		{TEMF, 0},
+		{TEMI, 0},
 		{TIRD, 0},
+		{TEMB, 0},
 		{TEMR, 0},
-		{TEMF, 0},
-		{CGML, 0},
-		{CGMU, 0},
-		{CGMC, 0},
-		{CGMT, 0},
-		{XUBS, 0},
-		{XUMP, 0},
+		{TPEO, 0},
+		{TEOF, 0},
+		{TECO, 0},
+		{IECL, 0},
+		{TERE, 0},
+		{TEFX, 0},
+		{TEMM, 0},
+		{TEGI, 0},
+		{TEIR, 0},
+		{TEGB, 0},
+		{PVMF, 0},
+		{LOYD, 0},
+		{BOFS, 0},
+		{BPLC, 0},
+		{BBSI, 0},
+		{BKLN, 0},
+		{BKLF, 0},
+		{FISU, 0},
+		{PPEX, 0},
+		{INVE, 0},
+		{IFLS, 0},
+		{EXOT, 0},
+		{HSXE, 0},
+		{ANZL, 0},
+		{ANLP, 0},
+		{ECHO, 0},
+		{HSBC, 0},
+		{STAN, 0},
+		{VCMO, 0},
+		{CSIN, 0},
+		{CSSI, 0},
+		{DOWG, 0},
+		{AMPX, 0},
+		{FXGB, 0},
+		{TRSI, 0},
+		{BTEE, 0},
+		{BTQG, 0},
+		{EBSX, 0},
+		{EBSM, 0},
 		{RBCT, 0},
 		{RTSI, 0},
-		{MHIP, 0},
+		{GSIB, 0},
+		{BISI, 0},
+		{MUFP, 0},
+		{CCML, 0},
 		{X3579, 0},
-		{NCML, 0},
-		{MHBL, 0},
-		{JISI, 0},
-		{JEFS, 0},
-		{BPLC, 0},
-		{BBSI, 0},
-		{ARAX, 0},
-		{ARCH, 0},
-		{NEXX, 0},
-		{NEXT, 0},
-		{NEXN, 0},
-		{NEXF, 0},
-		{NEXG, 0},
-		{NEXD, 0},
-		{NEXL, 0},
-		{CMMT, 0},
-		{MAQL, 0},
-		{BMCM, 0},
-		{MAQU, 0},
-		{MAXD, 0},
-		{OTCE, 0},
-		{CBAL, 0},
-		{JSSI, 0},
-		{VAGM, 0},
-		{XSGB, 0},
-		{NABE, 0},
-		{NABU, 0},
-		{IGDL, 0},
-		{ISWV, 0},
-		{ISWE, 0},
-		{ARIA, 0},
+		{MHIP, 0},
+		{WINS, 0},
+		{WINX, 0},
+		{TCML, 0},
+		{FRTE, 0},
+		{MUTI, 0},
+		{NDCM, 0},
+		{SPEC, 0},
+		{BCRM, 0},
+		{BART, 0},
+		{BARO, 0},
+		{BARK, 0},
+		{MFXC, 0},
+		{MFXR, 0},
+		{MFXA, 0},
+		{NOSI, 0},
+		{AUTX, 0},
+		{AUTB, 0},
+		{AUTP, 0},
+		{NEXS, 0},
+		{EBSO, 0},
+		{REST, 0},
+		{BALT, 0},
+		{BLTX, 0},
+		{PEEL, 0},
+		{XRSP, 0},
+		{XPHX, 0},
+		{PHSI, 0},
+		{CAZE, 0},
+		{JPSI, 0},
+		{CSEU, 0},
+		{SICS, 0},
+		{CSBX, 0},
+		{CSCF, 0},
+		{CSFB, 0},
+		{GRIF, 0},
+		{GRIO, 0},
+		{LMEC, 0},
+		{KMTS, 0},
+		{ICEU, 0},
+		{FXMT, 0},
+		{QMTS, 0},
+		{XEDX, 0},
+		{CRDL, 0},
+		{BAIK, 0},
+		{UMTS, 0},
+		{XPLU, 0},
+		{AFDL, 0},
+		{BAPA, 0},
+		{BCXE, 0},
+		{BATE, 0},
+		{BATF, 0},
+		{BATD, 0},
+		{CHIY, 0},
+		{CHIO, 0},
+		{BATP, 0},
+		{CHID, 0},
+		{BOTC, 0},
+		{LISX, 0},
+		{CHIX, 0},
+		{BGCI, 0},
+		{BGCM, 0},
+		{BGCO, 0},
+		{BGCB, 0},
+		{BMTF, 0},
+		{BOAT, 0},
+		{BOSC, 0},
+		{BRNX, 0},
+		{CCO2, 0},
+		{CHEV, 0},
+		{BLNK, 0},
+		{CMEE, 0},
+		{CMED, 0},
+		{CMEC, 0},
+		{CMMT, 0},
+		{CRYP, 0},
+		{EMBX, 0},
+		{ENCL, 0},
+		{EXEU, 0},
+		{EXSI, 0},
+		{EXOR, 0},
+		{EXVP, 0},
+		{EXMP, 0},
+		{EXLP, 0},
+		{EXCP, 0},
+		{EXBO, 0},
+		{EXDC, 0},
+		{FAIR, 0},
+		{GEMX, 0},
+		{GFIA, 0},
+		{GMEG, 0},
+		{XGDX, 0},
+		{XLDX, 0},
+		{XGCX, 0},
+		{XGSX, 0},
+		{GRSE, 0},
+		{GSIL, 0},
+		{GSBX, 0},
+		{GSSI, 0},
+		{IBAL, 0},
+		{ICAP, 0},
+		{ICEN, 0},
+		{ISDX, 0},
+		{WCLK, 0},
+		{ICAH, 0},
+		{ICSE, 0},
+		{ICTQ, 0},
+		{PLSX, 0},
+		{IFEU, 0},
+		{CXOT, 0},
+		{CXRT, 0},
+		{IFLX, 0},
+		{IFLL, 0},
+		{IFEN, 0},
+		{IFLO, 0},
+		{IFUT, 0},
+		{KLEU, 0},
+		{LCUR, 0},
+		{LIQU, 0},
+		{LIQH, 0},
+		{LIQF, 0},
+		{LMAX, 0},
+		{LMAO, 0},
+		{LMAE, 0},
+		{LMAF, 0},
+		{LMAD, 0},
+		{LOTC, 0},
+		{PLDX, 0},
+		{LPPM, 0},
+		{MAEL, 0},
+		{MXLM, 0},
+		{RFQU, 0},
+		{MCUR, 0},
+		{MCXR, 0},
+		{MCXS, 0},
+		{MFGL, 0},
 		{MSIP, 0},
 		{MSSI, 0},
+		{MYTR, 0},
+		{N2EX, 0},
+		{NOFF, 0},
+		{NXEU, 0},
+		{NYMX, 0},
+		{OFEX, 0},
+		{OTCE, 0},
+		{PIEU, 0},
+		{PIRM, 0},
+		{QWIX, 0},
+		{RBSX, 0},
+		{SECF, 0},
+		{SHAR, 0},
+		{SLXT, 0},
+		{SPRZ, 0},
+		{SSEX, 0},
+		{SWAP, 0},
+		{TFSV, 0},
 		{TPIE, 0},
-		{NBFL, 0},
-		{WSIL, 0},
-		{FRTE, 0},
-		{SBEX, 0},
-		{SMBG, 0},
-		{BAPA, 0},
-		{LMEC, 0},
-		{RBCM, 0},
-		{ANTS, 0},
-		{GMGL, 0},
-		{FXOP, 0},
-		{R5FX, 0},
-		{BNPL, 0},
-		{STAN, 0},
-		{ECHO, 0},
+		{TPIM, 0},
+		{TREU, 0},
+		{TREA, 0},
+		{TREO, 0},
+		{TRQX, 0},
+		{TRQS, 0},
+		{TRQC, 0},
+		{TRQM, 0},
+		{TRQB, 0},
+		{TRQA, 0},
+		{TRQD, 0},
+		{UKPX, 0},
+		{VEGA, 0},
+		{XCOR, 0},
+		{XGCL, 0},
+		{XIPE, 0},
+		{XJWY, 0},
+		{XLBM, 0},
+		{XLCE, 0},
+		{XLDN, 0},
+		{TNLL, 0},
+		{XSMP, 0},
+		{ENSY, 0},
+		{XLIF, 0},
 		{XLME, 0},
-		{TRSI, 0},
-		{FXGB, 0},
-		{EVOL, 0},
-		{BRGA, 0},
-		{HSBC, 0},
-		{LOTC, 0},
-		{PLDX, 0},
-		{IOTF, 0},
-		{IOIR, 0},
-		{IOMM, 0},
-		{IOFX, 0},
-		{IUOB, 0},
-		{IOCD, 0},
-		{IOGI, 0},
-		{IOED, 0},
-		{IOGB, 0},
-		{IOFI, 0},
-		{ILCM, 0},
-		{SSEX, 0},
-		{MLIX, 0},
-		{MLSI, 0},
-		{MLRQ, 0},
-		{MAKX, 0},
-		{WSIN, 0},
-		{WELN, 0},
-		{SCOT, 0},
-		{BBSX, 0},
-		{BKLN, 0},
-		{BKLF, 0},
-		{DOWG, 0},
-		{WFLB, 0},
-		{LELE, 0},
-		{MSBI, 0},
-		{MBSI, 0},
-		{BSPL, 0},
-		{SIFX, 0},
-		{CFIC, 0},
-		{RABL, 0},
-		{NTUK, 0},
-		{UNGB, 0},
+		{XLON, 0},
+		{XLOD, 0},
+		{AIMX, 0},
+		{XLOM, 0},
+		{XLTO, 0},
+		{XMLX, 0},
+		{XMTS, 0},
+		{MCZK, 0},
+		{HUNG, 0},
+		{EMTS, 0},
+		{MTSA, 0},
+		{GMTS, 0},
+		{MTSG, 0},
+		{IMTS, 0},
+		{RMTS, 0},
+		{AMTS, 0},
+		{PORT, 0},
+		{SLKK, 0},
+		{VMTS, 0},
+		{SMTS, 0},
+		{UKGD, 0},
+		{MTSS, 0},
+		{MTSW, 0},
+		{PRME, 0},
+		{CMTS, 0},
+		{TMTS, 0},
+		{LMTS, 0},
+		{EACM, 0},
+		{BVUK, 0},
+		{NMTS, 0},
+		{USWP, 0},
+		{XSWB, 0},
+		{XTFN, 0},
+		{XTUP, 0},
+		{TPCD, 0},
+		{TBLA, 0},
+		{TPFD, 0},
+		{TPSP, 0},
+		{TPSD, 0},
+		{TPRE, 0},
+		{TPEQ, 0},
+		{XTPE, 0},
+		{TBEN, 0},
+		{UKCA, 0},
+		{XEBS, 0},
+		{GFOX, 0},
+		{BBVX, 0},
+		{HPSX, 0},
+		{HPSO, 0},
+		{CMCM, 0},
+		{TPID, 0},
+		{TPDA, 0},
+		{INGU, 0},
+		{SISU, 0},
+		{OTXT, 0},
+		{TMUK, 0},
+		{ALGO, 0},
+		{GSLO, 0},
+		{G360, 0},
+		{AFTS, 0},
+		{ACCX, 0},
+		{JPJX, 0},
+		{SFMP, 0},
+		{PUND, -18000},
+		{XOCH, -21600},
+		{OTCM, -18000},
+		{EXPM, -18000},
+		{CAVE, -18000},
+		{OTCB, -18000},
+		{PINL, -18000},
+		{PINI, -18000},
+		{PINX, -18000},
+		{OTCQ, -18000},
+		{PSGM, -18000},
+		{PINC, -18000},
+		{FREX, -21600},
+		{IDXM, -18000},
+		{OCEA, -18000},
+		{GFAM, -18000},
+		{FNIX, -18000},
+		{LATG, -18000},
+		{UBEC, -18000},
+		{AMLG, -28800},
+		{KLSH, -18000},
+		{LEVL, -18000},
+		{EBXV, -18000},
+		{STRM, -18000},
+		{BNPC, -18000},
+		{CGXS, -18000},
+		{EQUS, -18000},
+		{XTXD, -18000},
+		{BSTX, -18000},
+		{CCMX, -18000},
+		{XCBO, -21600},
+		{CONE, -21600},
+		{CTWO, -21600},
+		{C2OX, -21600},
+		{EDGA, -21600},
+		{EDGD, -21600},
+		{EDGO, -21600},
+		{EDGX, -21600},
+		{BATS, -21600},
+		{BATY, -21600},
+		{BYXD, -21600},
+		{BZXD, -21600},
+		{EDDP, -21600},
+		{BATO, -21600},
+		{CBSX, -21600},
+		{XCBF, -21600},
+		{XCBD, -21600},
+		{CLST, -18000},
+		{FAST, -18000},
+		{MEMX, -18000},
+		{MEMD, -18000},
+		{MEMM, -18000},
+		{MXOP, -18000},
+		{PIPR, 0},
+		{PJCX, 0},
+		{TRAI, -18000},
+		{COHR, -21600},
+		{STFU, -18000},
+		{STFX, -18000},
+		{ATDF, -18000},
+		{LTSE, -28800},
+		{CALH, -18000},
+		{THRE, -18000},
+		{FXPS, -18000},
+		{FXNM, -18000},
+		{WEED, 0},
+		{XWEE, 0},
+		{BKKT, -18000},
+		{XPUS, -18000},
+		{JLQD, -21600},
+		{JLEQ, -21600},
+		{LAMP, -18000},
+		{RCMA, -18000},
+		{ERIS, -21600},
+		{YKNA, -21600},
+		{DASH, -21600},
+		{TRCX, -18000},
+		{CODA, -21600},
+		{PDQX, -21600},
+		{PDQD, -21600},
+		{SAGE, -28800},
+		{JPMS, -18000},
+		{ARKX, -18000},
+		{PUMA, -18000},
+		{PUMX, -18000},
+		{FTUS, -18000},
+		{CPGX, -18000},
+		{NLAX, -18000},
+		{DBAB, -18000},
+		{OTCN, -18000},
+		{SCXO, -21600},
+		{ZERO, -21600},
+		{SCXS, -21600},
+		{SCXM, -21600},
+		{SCXA, -21600},
+		{SCXF, -21600},
+		{SGAS, -18000},
+		{SGA2, -18000},
+		{SMFE, -21600},
+		{BLUE, -18000},
+		{DWFI, -18000},
+		{XTRD, -18000},
+		{VUSA, -18000},
+		{VALX, -18000},
+		{VCRS, -18000},
+		{VFMI, -18000},
+		{WELX, -18000},
+		{WELS, -18000},
+		{HRTF, -18000},
+		{FICO, -28800},
+		{XFCI, -28800},
+		{XFDA, -28800},
+		{HRTX, -18000},
+		{INCR, -18000},
+		{ASPI, -18000},
+		{ASMT, -18000},
+		{ASPN, -18000},
+		{NTRL, -18000},
+		{TSBX, -18000},
+		{MAGM, -18000},
+		{UBSA, -18000},
+		{XPIN, -18000},
+		{UBSP, -18000},
+		{UBSS, -18000},
+		{PULX, -18000},
+		{BAMX, -18000},
+		{XBOX, -18000},
+		{GLMX, -18000},
+		{CURX, -18000},
+		{BTNL, -21600},
+		{SUNT, -21600},
+		{ADVT, -18000},
+		{IMCS, -21600},
+		{THEM, 45900},
+		{LEDG, -18000},
+		{FUSD, -18000},
+		{MTUS, -18000},
+		{BVUS, -18000},
+		{MTSB, -18000},
+		{GOTC, -21600},
+		{VERT, -18000},
+		{MTXX, -18000},
+		{MKAA, -18000},
+		{MTXA, -18000},
+		{MTXS, -18000},
+		{MTXC, -18000},
+		{MTXM, -18000},
+		{VIRT, -18000},
+		{CGMI, -18000},
+		{ONEC, -18000},
+		{CORE, -18000},
+		{CICX, -18000},
+		{CBLC, -18000},
+		{CIOI, -18000},
+		{LQFI, -18000},
+		{LQED, -18000},
+		{TSAD, -18000},
+		{JPBX, -18000},
+		{JSEF, -18000},
+		{EDGE, -21600},
+		{IEXG, -18000},
+		{IEXD, -18000},
+		{IEXC, -18000},
+		{ICUS, -18000},
+		{XBMK, -18000},
+		{XEUS, -21600},
+		{XNYF, -18000},
+		{BAMP, -18000},
+		{AATS, -18000},
+		{AQUA, -18000},
 		{BAML, -18000},
-		{MLVX, -18000},
 		{MLCO, -18000},
+		{MLVX, -18000},
 		{BARX, -18000},
-		{BARD, -18000},
-		{BCDX, -18000},
 		{BBOK, -18000},
+		{BCDX, -18000},
 		{BARL, -18000},
+		{BARD, -18000},
+		{BBSF, -18000},
+		{BBSN, -18000},
 		{BGCF, -18000},
-		{FNCS, -18000},
+		{FNFX, -18000},
 		{BGCD, -18000},
+		{FNCS, -18000},
+		{FNFT, -18000},
+		{FNXB, -18000},
+		{BHSF, -18000},
 		{BIDS, -18000},
-		{BLTD, -18000},
 		{BPOL, -18000},
+		{BLTD, -18000},
 		{BNYC, -18000},
 		{VTEX, -18000},
 		{NYFX, -18000},
 		{BTEC, -18000},
-		{ICSU, -18000},
 		{BTEQ, -18000},
-		{GLLC, -18000},
-		{GOVX, -18000},
-		{GREE, -21600},
-		{GTCO, -18000},
-		{HEGX, -21600},
-		{HSFX, -18000},
-		{ICEL, -18000},
-		{AATS, -18000},
-		{AQUA, -18000},
+		{ICSU, -18000},
+		{CCFE, -21600},
 		{CDED, -18000},
-		{CGMI, -18000},
-		{CBLC, -18000},
-		{LQFI, -18000},
-		{CIOI, -18000},
-		{CORE, -18000},
-		{ONEC, -18000},
+		{CDEL, -18000},
+		{CMSF, -18000},
 		{CRED, -18000},
-		{CAES, -18000},
+		{CSLP, -18000},
 		{CSCL, -18000},
 		{CSVW, -18000},
+		{CAES, -18000},
 		{DBSX, -18000},
 		{DEAL, -18000},
-		{ERIS, -21600},
-		{FAST, -18000},
+		{EGMT, -18000},
+		{FINR, -18000},
+		{FINN, -18000},
+		{XADF, -18000},
+		{FINC, -18000},
+		{FINO, -18000},
+		{FINY, -18000},
+		{OOTC, -18000},
+		{FSEF, -18000},
 		{FXAL, -18000},
 		{FXCM, -18000},
+		{G1XX, -21600},
+		{GLLC, -18000},
+		{GLPS, -21600},
+		{GLPX, -18000},
+		{GOVX, -18000},
+		{GREE, -21600},
+		{GSCO, -18000},
+		{SGMT, -18000},
+		{GSEF, -18000},
+		{GTCO, -18000},
+		{GTSX, -18000},
+		{GTXS, -18000},
+		{HEGX, -21600},
+		{HPPO, -18000},
+		{HSFX, -18000},
+		{ICEL, -18000},
 		{IFUS, -18000},
-		{IEPA, -18000},
+		{TMCC, -18000},
+		{VABD, -18000},
+		{IMCC, -18000},
 		{IMFX, -18000},
-		{IMAG, -18000},
-		{IMBD, -18000},
+		{IFED, -18000},
+		{IEPA, -18000},
+		{IMCG, -18000},
+		{IMIR, -18000},
 		{IMCR, -18000},
 		{IMEN, -18000},
-		{IMIR, -18000},
 		{ICES, -18000},
-		{IMCG, -18000},
-		{VABD, -18000},
-		{TMCC, -18000},
-		{IFED, -18000},
-		{IMCC, -18000},
+		{IMAG, -18000},
+		{IMBD, -18000},
+		{ISDA, -18000},
 		{ITGI, -18000},
+		{JEFX, -18000},
+		{JNST, -18000},
 		{JPMX, -18000},
+		{JSES, -18000},
+		{JSJX, -18000},
 		{KNIG, -18000},
-		{KNCM, -18000},
+		{KNMX, -18000},
+		{ACKF, -18000},
 		{KNEM, -18000},
 		{KNLI, -18000},
-		{ACKF, -18000},
-		{LEVL, -18000},
-		{EBXV, -18000},
-		{XNYM, -18000},
-		{XNYL, -18000},
-		{XCEC, -18000},
-		{XNYE, -18000},
-		{XCUR, -18000},
-		{XCBO, -21600},
-		{BATO, -21600},
-		{BATS, -21600},
-		{BATY, -21600},
-		{EDDP, -21600},
-		{EDGA, -21600},
-		{EDGD, -21600},
-		{EDGX, -21600},
-		{BZXD, -21600},
-		{BYXD, -21600},
-		{C2OX, -21600},
-		{CBSX, -21600},
-		{EDGO, -21600},
-		{XCBF, -21600},
-		{XCBT, -21600},
-		{FCBT, -21600},
-		{XKBT, -21600},
-		{SMFE, -21600},
-		{XAQS, -18000},
-		{LQED, -18000},
-		{RICX, -18000},
-		{RICD, -18000},
-		{XCFF, -18000},
-		{XNAS, -18000},
-		{XNCM, -18000},
-		{XNDQ, -18000},
-		{XNGS, -18000},
-		{XNIM, -18000},
-		{XNMS, -18000},
-		{XPBT, -18000},
-		{XPHL, -18000},
-		{XBOS, -18000},
-		{XPHO, -18000},
-		{XPOR, -28800},
-		{PSXD, -18000},
-		{XBXO, -18000},
-		{XNFI, -18000},
-		{MELO, -18000},
-		{XPSX, -18000},
-		{NASD, -18000},
-		{XBRT, -18000},
-		{BOSD, -18000},
-		{TRCK, -18000},
-		{FICO, -28800},
-		{XFDA, -28800},
-		{XFCI, -28800},
-		{HPPO, -18000},
-		{GLPS, -21600},
-		{G1XX, -21600},
-		{GLMX, -18000},
-		{CODA, -21600},
-		{VERT, -18000},
+		{KNCM, -18000},
+		{LASF, -18000},
+		{LAVA, -18000},
+		{LAFX, -18000},
+		{LAFL, -18000},
+		{LAFD, -18000},
 		{LIUS, -18000},
 		{LIUH, -18000},
 		{LIFI, -18000},
-		{TSEF, -18000},
+		{LTAA, -18000},
+		{LMNX, -18000},
+		{MIHI, -18000},
+		{EPRD, -18000},
+		{EMLD, -18000},
+		{EPRL, -18000},
+		{MPRL, -18000},
+		{XMIO, -18000},
+		{SPHR, -18000},
 		{MSCO, -18000},
-		{MSPL, -18000},
 		{MSRP, -18000},
+		{MSPL, -18000},
+		{MSTC, -18000},
 		{MSTX, -18000},
-		{TERA, -18000},
-		{BAMX, -18000},
-		{XOTC, -28800},
+		{MSLP, -18000},
+		{MSLC, -18000},
 		{NBLX, -18000},
-		{XISX, -18000},
-		{GMNI, -18000},
-		{XISE, -18000},
-		{XISA, -18000},
-		{MCRY, -18000},
-		{SOHO, -18000},
-		{UBSA, -18000},
-		{UBSP, -18000},
-		{LASF, -18000},
-		{ADVT, -18000},
-		{WSAG, -18000},
-		{WABR, -18000},
-		{VNDM, -18000},
-		{SSTX, -18000},
+		{NFSC, -18000},
+		{NFSD, -18000},
+		{XSTM, -18000},
+		{NFSA, -18000},
+		{NMRA, -18000},
+		{NODX, 3600},
+		{NXUS, -18000},
 		{NYPC, -18000},
+		{OLLC, -18000},
 		{OPRA, -21600},
-		{OTCM, -18000},
-		{PINC, -18000},
-		{PINI, -18000},
-		{PINL, -18000},
-		{OTCB, -18000},
-		{OTCQ, -18000},
-		{PINX, -18000},
-		{PSGM, -18000},
-		{CAVE, -18000},
-		{EXPM, -18000},
+		{PIPE, -18000},
+		{PRSE, -18000},
+		{RICX, -18000},
+		{RICD, -18000},
+		{SGMA, -18000},
+		{SHAW, -18000},
+		{SHAD, -18000},
+		{SIGX, -18000},
+		{SOHO, -18000},
+		{SSTX, -18000},
+		{TERA, -18000},
+		{TFSU, -18000},
 		{TMID, -18000},
+		{TPSE, -18000},
+		{TPSV, -18000},
+		{TPSB, -18000},
+		{TRCK, -18000},
 		{TRUX, -18000},
-		{TRU1, -18000},
 		{TRU2, -18000},
-		{PIPE, -18000},
-		{PRSE, -18000},
-		{WELS, -18000},
-		{THRE, -18000},
-		{FXPS, -18000},
+		{TRU1, -18000},
 		{TRWB, -18000},
 		{BNDD, -18000},
 		{TWSF, -18000},
 		{DWSF, -18000},
-		{ARKX, -18000},
-		{GSEF, -18000},
-		{JSES, -18000},
+		{TRFX, -18000},
+		{TSEF, -18000},
+		{VFCM, -18000},
+		{WSAG, -18000},
+		{VNDM, -18000},
+		{WABR, -18000},
+		{XAQS, -18000},
+		{XARC, -21600},
+		{XAZX, -25200},
+		{XBTF, -18000},
+		{XCBT, -21600},
+		{FCBT, -21600},
+		{XKBT, -21600},
+		{XCCX, -21600},
+		{XCFF, -18000},
 		{XCME, -21600},
+		{FCME, -21600},
+		{XIMM, -21600},
+		{GLBX, -21600},
 		{XIOM, -21600},
-		{NYMS, -18000},
 		{CMES, -21600},
 		{CBTS, -21600},
+		{NYMS, -18000},
 		{CECS, -18000},
-		{FCME, -21600},
-		{GLBX, -21600},
-		{XIMM, -21600},
-		{MTXX, -18000},
-		{MTXM, -18000},
-		{MTXS, -18000},
-		{MTXC, -18000},
-		{MTXA, -18000},
-		{MKAA, -18000},
-		{THEM, 45900},
-		{VUSA, -18000},
-		{VFMI, -18000},
-		{VCRS, -18000},
-		{VALX, -18000},
-		{FINR, -28800},
-		{FINC, -18000},
-		{OOTC, -28800},
-		{FINN, -18000},
-		{FINO, -28800},
-		{FINY, -28800},
-		{XADF, -18000},
-		{TFSU, -18000},
-		{SGAS, -18000},
-		{SGA2, -18000},
-		{DBAB, -18000},
-		{TRAI, -18000},
-		{FTUS, -18000},
-		{JPMS, -18000},
-		{PUMA, -18000},
-		{PUMX, -18000},
-		{NXUS, -18000},
-		{XBOX, -18000},
-		{BBSF, -18000},
-		{NFSC, -18000},
-		{NFSA, -18000},
-		{NFSD, -18000},
-		{XSTM, -18000},
-		{XGMX, -14400},
-		{NODX, 3600},
-		{JLQD, -21600},
-		{JSJX, -18000},
-		{SGMA, -18000},
-		{SHAW, -18000},
-		{SHAD, -18000},
-		{GTXS, -18000},
-		{EGMT, -18000},
-		{FSEF, -18000},
-		{XMER, -21600},
-		{BTNL, -21600},
-		{DWFI, -18000},
+		{XCRC, -21600},
+		{XCSC, -18000},
+		{XCUR, -18000},
 		{XELX, -18000},
-		{XMGE, -21600},
-		{HRTF, -18000},
-		{NMRA, -18000},
-		{BLUE, -18000},
+		{XGMX, -14400},
 		{XINS, -18000},
+		{BLKX, -18000},
 		{INCA, -18000},
-		{MOCX, -18000},
+		{IIDX, -18000},
+		{IBLX, -18000},
+		{RCBX, -18000},
 		{ICRO, -18000},
-		{BLKX, -18000},
 		{ICBX, -18000},
-		{SCXO, -21600},
-		{SCXS, -21600},
-		{SCXA, -21600},
-		{SCXM, -21600},
-		{SCXF, -21600},
-		{ZERO, -21600},
-		{JPBX, -18000},
-		{BKKT, -18000},
-		{IEXG, -18000},
-		{IEXD, -18000},
-		{MAGM, -18000},
-		{GSCO, -18000},
-		{SGMT, -18000},
-		{LTAA, -18000},
-		{LMNX, -18000},
-		{BHSF, -18000},
-		{TSAD, -18000},
-		{IMCS, -21600},
-		{XOCH, -21600},
-		{MEMX, -18000},
-		{MEMD, -18000},
-		{XPUS, -18000},
-		{SAGE, -28800},
-		{CMSF, -18000},
-		{UBSS, -18000},
-		{GOTC, -21600},
-		{GTSX, -18000},
+		{MOCX, -18000},
+		{XISX, -18000},
+		{XISE, -18000},
+		{GMNI, -18000},
+		{XTPZ, -18000},
+		{MCRY, -18000},
+		{XISA, -18000},
+		{XMAC, -21600},
+		{XMER, -21600},
+		{XMGE, -21600},
+		{XMID, -21600},
+		{XNAS, -18000},
+		{XNFI, -18000},
+		{ESPD, -18000},
+		{MELO, -18000},
+		{NASD, -18000},
+		{XNMS, -18000},
+		{XNDQ, -18000},
+		{XNGS, -18000},
+		{XNCM, -18000},
+		{XNIM, -18000},
+		{XBOS, -18000},
+		{BOSD, -18000},
+		{XBXO, -18000},
+		{XPOR, -18000},
+		{XPSX, -18000},
+		{XBRT, -18000},
+		{PSXD, -18000},
+		{XPBT, -18000},
+		{XPHO, -18000},
+		{XPHL, -18000},
+		{XNQL, -18000},
+		{XNYC, -18000},
+		{XNYM, -18000},
+		{XCEC, -18000},
+		{XNYE, -18000},
+		{XNYL, -18000},
 		{XNYS, -18000},
-		{ALDP, -18000},
-		{AMXO, -18000},
-		{ARCD, -18000},
-		{ARCO, -18000},
+		{CISD, -18000},
 		{XCHI, -21600},
-		{NYSD, -18000},
 		{XCIS, -18000},
+		{ALDP, -18000},
+		{ARCX, -18000},
 		{XASE, -18000},
 		{XNLI, -18000},
-		{ARCX, -18000},
-		{CISD, -18000},
-		{GLPX, -18000},
-		{MIHI, -18000},
-		{XMIO, -18000},
-		{EPRL, -18000},
-		{EMLD, -18000},
-		{MPRL, -18000},
-		{EPRD, -18000},
-		{PIPR, 0},
-		{PJCX, 0},
-		{TPSE, -18000},
-		{TPSV, -18000},
-		{VIRT, -18000},
-		{XTRD, -18000},
-		{TSBX, -18000},
-		{ISDA, -18000},
-		{DASH, -21600},
-		{VFCM, -18000},
-		{OLLC, -18000},
-		{COHR, -21600},
-		{STFX, -18000},
-		{JEFX, -18000},
-		{OTCN, -18000},
-		{JNST, -18000},
-		{CURX, -18000},
-		{IATS, 0},
-		{SUNT, -21600},
-		{INCR, -18000},
-		{ASPI, -18000},
-		{ASMT, -18000},
-		{ASPN, -18000},
-		{HRTX, -18000},
-		{YKNA, -21600},
-		{RCMA, -18000},
-		{LTSE, -28800},
-		{FREX, -21600},
-		{CCMX, -18000},
-		{TRCX, -18000},
-		{LEDG, -18000},
+		{NYSD, -18000},
+		{AMXO, -18000},
+		{ARCD, -18000},
+		{ARCO, -18000},
+		{XOTC, -18000},
+		{XPSE, -28800},
 		{XSEF, -18000},
-		{CALH, -18000},
-		{CPGX, -18000},
-		{NLAX, -18000},
-		{LAMP, -18000},
-		{MTUS, -18000},
-		{BVUS, -18000},
-		{MTSB, -18000},
-		{CLST, -18000},
-		{EQUS, -18000},
-		{BSTX, -18000},
-		{XTXD, -18000},
-		{STRM, -18000},
-		{BNPC, -18000},
-		{KLSH, -18000},
-		{CGXS, -18000},
-		{AMLG, -28800},
-		{UBEC, -18000},
-		{GFAM, -18000},
-		{FNIX, -18000},
-		{LATG, -18000},
-		{BVUR, -10800},
+		{ASEF, -21600},
+		{OTCI, -18000},
+		{SPTX, -18000},
+		{CAST, -21600},
+		{USEF, -18000},
+		{SFOX, -28800},
+		{RAJA, -18000},
+		{LPSF, -18000},
+		{SPAX, -18000},
+		{LESI, -18000},
+		{INTL, -18000},
+		{U360, -18000},
+		{EDXM, -18000},
+		{IBKR, 0},
+		{IATS, 0},
+		{IEOS, 0},
+		{RTXF, -18000},
+		{XIMX, -21600},
+		{CFIM, -18000},
+		{BNDS, -18000},
+		{NPMS, -18000},
+		{OCTL, -18000},
+		{BNPH, -18000},
+		{DWIN, -18000},
+		{OCTC, -18000},
+		{IBCO, 0},
+		{IEXA, -18000},
+		{RBCS, -18000},
+		{LAKE, -18000},
 		{UFEX, -10800},
+		{BVUR, -10800},
 		{XMNT, -10800},
 		{XCET, 18000},
 		{XCUE, 18000},
-		{XUNI, 18000},
 		{XKCE, 18000},
 		{XSTE, 18000},
-		{GXMA, 39600},
+		{XUNI, 18000},
 		{POTC, 39600},
+		{GXMA, 39600},
 		{BVCA, -14400},
+		{XCAR, -14400},
 		{HSTC, 25200},
-		{XHNX, 25200},
 		{XHNF, 25200},
+		{XHNX, 25200},
 		{XSTC, 25200},
 		{XLUS, 7200},
 		{XZIM, 7200},
-		{XXXX, 0},
-		{XOFF, 0},
+		{VFEX, 7200},
 		{BILT, 0},
+		{XOFF, 0},
+		{XXXX, 0},
 	}
 
 	for _, tt := range tests {
@@ -4157,6 +5369,2683 @@ func TestTimeZoneSeconds(t *testing.T) {
 	}
 }
 
+//nolint:funlen
+func TestTimeZone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		mic  MIC
+		zone string
+	}{
+		{MIC("FOO"), "UTC"}, // unknown
+		{XALS, "Europe/Tirane"},
+		{XTIR, "Europe/Tirane"},
+		{XALG, "Africa/Algiers"},
+		{XBDV, "Africa/Luanda"},
+		{XFTX, "America/Antigua"},
+		{XA1X, "America/Argentina/Buenos_Aires"},
+		{BACE, "America/Argentina/Buenos_Aires"},
+		{BCFS, "America/Argentina/Buenos_Aires"},
+		{XMVL, "America/Argentina/Buenos_Aires"},
+		{ROFX, "America/Argentina/Buenos_Aires"},
+		{XBCC, "America/Argentina/Buenos_Aires"},
+		{MVCX, "America/Argentina/Buenos_Aires"},
+		{XBCM, "America/Argentina/Buenos_Aires"},
+		{XBCX, "America/Argentina/Buenos_Aires"},
+		{XBUE, "America/Argentina/Buenos_Aires"},
+		{XMEV, "America/Argentina/Buenos_Aires"},
+		{XCNF, "America/Argentina/Buenos_Aires"},
+		{XMAB, "America/Argentina/Buenos_Aires"},
+		{XMTB, "America/Argentina/Buenos_Aires"},
+		{XROS, "America/Argentina/Buenos_Aires"},
+		{XROX, "America/Argentina/Buenos_Aires"},
+		{XTUC, "America/Argentina/Buenos_Aires"},
+		{XARM, "Asia/Yerevan"},
+		{XLQC, "Australia/Sydney"},
+		{XFEX, "Australia/Sydney"},
+		{BLXA, "Australia/Sydney"},
+		{APXL, "Australia/Sydney"},
+		{CGMA, "Australia/Sydney"},
+		{CSAU, "Australia/Sydney"},
+		{CFAU, "Australia/Sydney"},
+		{NECD, "Australia/Sydney"},
+		{AWBX, "Australia/Melbourne"},
+		{AWEX, "Australia/Sydney"},
+		{BLEV, "Australia/Melbourne"},
+		{CHIA, "Australia/Sydney"},
+		{CXAR, "Australia/Sydney"},
+		{CXAC, "Australia/Sydney"},
+		{CXAP, "Australia/Sydney"},
+		{CXAM, "Australia/Sydney"},
+		{CXAQ, "Australia/Sydney"},
+		{CXAV, "Australia/Sydney"},
+		{CXAF, "Australia/Sydney"},
+		//nolint:misspell
+		{CXAN, "Australia/Sydney"},
+		{CXAW, "Australia/Sydney"},
+		{CXAB, "Australia/Sydney"},
+		{CXAI, "Australia/Sydney"},
+		{CLAU, "Australia/Sydney"},
+		{MAQX, "Australia/Sydney"},
+		{MACB, "Australia/Sydney"},
+		{MEAU, "Australia/Sydney"},
+		{MSAL, "Australia/Sydney"},
+		{NSXB, "Australia/Melbourne"},
+		{SIGA, "Australia/Sydney"},
+		{SIMV, "Australia/Sydney"},
+		{XAOM, "Australia/Sydney"},
+		{XASX, "Australia/Sydney"},
+		{NZFX, "Australia/Sydney"},
+		{ASXC, "Australia/Sydney"},
+		{ASXT, "Australia/Sydney"},
+		{ASXB, "Australia/Sydney"},
+		{ASXV, "Australia/Sydney"},
+		{ASXP, "Australia/Sydney"},
+		{XSFE, "Australia/Sydney"},
+		{XNEC, "Australia/Sydney"},
+		{XYIE, "Australia/Sydney"},
+		{GFAU, "Australia/Sydney"},
+		{BGCA, "Australia/Sydney"},
+		{POTL, "Australia/Sydney"},
+		{LLAT, "Europe/Vienna"},
+		{UCBA, "Europe/Vienna"},
+		{SLHB, "Europe/Vienna"},
+		{BKSK, "Europe/Vienna"},
+		{APAW, "Europe/Vienna"},
+		{OBKL, "Europe/Vienna"},
+		{RBIV, "Europe/Vienna"},
+		{RLBO, "Europe/Vienna"},
+		{XRCB, "Europe/Vienna"},
+		{EGSI, "Europe/Vienna"},
+		{XOTB, "Europe/Vienna"},
+		{XWBO, "Europe/Vienna"},
+		{WBGF, "Europe/Vienna"},
+		{XCEG, "Europe/Vienna"},
+		{EXAA, "Europe/Vienna"},
+		{WBAH, "Europe/Vienna"},
+		{WBDM, "Europe/Vienna"},
+		{XVIE, "Europe/Vienna"},
+		{FPWB, "Europe/Vienna"},
+		{RVSA, "Europe/Vienna"},
+		{BSEX, "Asia/Baku"},
+		{XIBE, "Asia/Baku"},
+		{XBAA, "America/Nassau"},
+		{BFEX, "Asia/Bahrain"},
+		{XBAH, "Asia/Bahrain"},
+		{XCHG, "Asia/Dhaka"},
+		{XDHA, "Asia/Dhaka"},
+		{XBAB, "America/Barbados"},
+		{XBIS, "America/Barbados"},
+		{BAJM, "America/Barbados"},
+		{BCSE, "Europe/Minsk"},
+		{SMBB, "Europe/Brussels"},
+		{KBCB, "Europe/Brussels"},
+		{BELB, "Europe/Brussels"},
+		{BELF, "Europe/Brussels"},
+		{BNPF, "Europe/Brussels"},
+		{BKBR, "Europe/Brussels"},
+		{BKBF, "Europe/Brussels"},
+		{BEAM, "Europe/Brussels"},
+		{MTSD, "Europe/Brussels"},
+		{MTSF, "Europe/Brussels"},
+		{BMTS, "Europe/Brussels"},
+		{BLPX, "Europe/Brussels"},
+		{FRRF, "Europe/Brussels"},
+		{XANT, "Europe/Brussels"},
+		{XBFO, "Europe/Brussels"},
+		{XBRU, "Europe/Brussels"},
+		{TNLK, "Europe/Brussels"},
+		{ENXB, "Europe/Brussels"},
+		{ALXB, "Europe/Brussels"},
+		{MLXB, "Europe/Brussels"},
+		{VPXB, "Europe/Brussels"},
+		{TNLB, "Europe/Brussels"},
+		{XBRD, "Europe/Brussels"},
+		{DBRU, "Europe/Brussels"},
+		{X24EX, "Atlantic/Bermuda"},
+		{GTXE, "Atlantic/Bermuda"},
+		{XBDA, "Atlantic/Bermuda"},
+		{XBOL, "America/La_Paz"},
+		{XBLB, "Europe/Sarajevo"},
+		{BLBF, "Europe/Sarajevo"},
+		{XSSE, "Europe/Sarajevo"},
+		{XBOT, "Africa/Gaborone"},
+		{BOTE, "Africa/Gaborone"},
+		{BOTV, "Africa/Gaborone"},
+		{XBMF, "America/Sao_Paulo"},
+		{XBSP, "America/Sao_Paulo"},
+		{BCMM, "America/Sao_Paulo"},
+		{BOVM, "America/Sao_Paulo"},
+		{BRIX, "America/Sao_Paulo"},
+		{BVMF, "America/Sao_Paulo"},
+		{CETI, "America/Sao_Paulo"},
+		{SELC, "America/Sao_Paulo"},
+		{XBBF, "America/Sao_Paulo"},
+		{XBVP, "America/Sao_Paulo"},
+		{XRIO, "America/Sao_Paulo"},
+		{XSOM, "America/Sao_Paulo"},
+		{UCBG, "Europe/Sofia"},
+		{BEBG, "Europe/Sofia"},
+		{T212, "Europe/Sofia"},
+		{BDSK, "Europe/Sofia"},
+		{BGHX, "Europe/Sofia"},
+		{IBEX, "Europe/Sofia"},
+		{MBUL, "Europe/Sofia"},
+		{GMBG, "Europe/Sofia"},
+		{XBUL, "Europe/Sofia"},
+		{ZBUL, "Europe/Sofia"},
+		{JBUL, "Europe/Sofia"},
+		{GBUL, "Europe/Sofia"},
+		{PBUL, "Europe/Sofia"},
+		{IBUL, "Europe/Sofia"},
+		{ABUL, "Europe/Sofia"},
+		{LBUL, "Europe/Sofia"},
+		{EUFN, "Europe/Sofia"},
+		{XCSX, "Asia/Phnom_Penh"},
+		{XDSX, "Africa/Douala"},
+		{XCNQ, "America/Toronto"},
+		{PURE, "America/Toronto"},
+		{CSE2, "America/Toronto"},
+		{EQCA, "America/Toronto"},
+		{NEOE, "America/Toronto"},
+		{NEOD, "America/Toronto"},
+		{NEON, "America/Toronto"},
+		{NEOC, "America/Toronto"},
+		{BNSX, "America/Toronto"},
+		{TMXS, "America/Toronto"},
+		{ATSA, "America/Toronto"},
+		{CAND, "America/Toronto"},
+		{CANX, "America/Toronto"},
+		{CHIC, "America/Toronto"},
+		{XCX2, "America/Toronto"},
+		{COTC, "America/Toronto"},
+		{IFCA, "America/Winnipeg"},
+		{XWCE, "America/Winnipeg"},
+		{IVZX, "America/Toronto"},
+		{LICA, "America/Toronto"},
+		{MATN, "America/Toronto"},
+		{NGXC, "America/Edmonton"},
+		{OMGA, "America/Toronto"},
+		{LYNX, "America/Toronto"},
+		{XALB, "America/Edmonton"},
+		{XATS, "America/Toronto"},
+		{XATX, "America/Toronto"},
+		{ADRK, "America/Toronto"},
+		{XBBK, "America/Toronto"},
+		{XCXD, "America/Toronto"},
+		{XICX, "America/Toronto"},
+		{XMOC, "America/Toronto"},
+		{XMOD, "America/Toronto"},
+		{XMOO, "America/Toronto"},
+		{XTFE, "America/Toronto"},
+		{XTOE, "America/Toronto"},
+		{XTSE, "America/Toronto"},
+		{XDRK, "America/Toronto"},
+		{XTSX, "America/Toronto"},
+		{VDRK, "America/Toronto"},
+		{XTNX, "America/Vancouver"},
+		{XVSE, "America/Vancouver"},
+		{XBVC, "Atlantic/Cape_Verde"},
+		{XCAY, "America/Cayman"},
+		{BOVA, "America/Santiago"},
+		{XBCL, "America/Santiago"},
+		{XSGO, "America/Santiago"},
+		{GSXC, "Asia/Shanghai"},
+		{CCFX, "Asia/Shanghai"},
+		{CSSX, "Asia/Shanghai"},
+		{SGEX, "Asia/Shanghai"},
+		{XCFE, "Asia/Shanghai"},
+		{CFBC, "Asia/Shanghai"},
+		{XDCE, "Asia/Shanghai"},
+		{XSGE, "Asia/Shanghai"},
+		{XINE, "Asia/Shanghai"},
+		{XSHE, "Asia/Shanghai"},
+		{XSEC, "Asia/Shanghai"},
+		{XSHG, "Asia/Shanghai"},
+		{XSSC, "Asia/Shanghai"},
+		{XSME, "Asia/Shanghai"},
+		{XZCE, "Asia/Shanghai"},
+		{BJSE, "Asia/Shanghai"},
+		{NEEQ, "Asia/Shanghai"},
+		{XBOG, "America/Bogota"},
+		{XBNV, "America/Costa_Rica"},
+		{XOTP, "Europe/Zagreb"},
+		{XCRO, "Europe/Zagreb"},
+		{XTRZ, "Europe/Zagreb"},
+		{XVAR, "Europe/Zagreb"},
+		{XZAG, "Europe/Zagreb"},
+		{XZAM, "Europe/Zagreb"},
+		{ZAPA, "Europe/Zagreb"},
+		{XZAP, "Europe/Zagreb"},
+		{DCSX, "America/Curacao"},
+		{TMCY, "Asia/Nicosia"},
+		{ATLN, "Asia/Nicosia"},
+		{ATHL, "Asia/Nicosia"},
+		{BCSC, "Asia/Nicosia"},
+		{CFIF, "Asia/Nicosia"},
+		{GPBC, "Asia/Nicosia"},
+		{RENC, "Asia/Nicosia"},
+		{SIBC, "Asia/Nicosia"},
+		{MKAP, "Asia/Nicosia"},
+		{XCYS, "Asia/Nicosia"},
+		{XCYO, "Asia/Nicosia"},
+		{XECM, "Asia/Nicosia"},
+		{XMME, "Asia/Nicosia"},
+		{ETOR, "Asia/Nicosia"},
+		{CSAS, "Europe/Prague"},
+		{KOME, "Europe/Prague"},
+		{RFBK, "Europe/Prague"},
+		{UBCZ, "Europe/Prague"},
+		{CSOB, "Europe/Prague"},
+		{CELP, "Europe/Prague"},
+		{CESI, "Europe/Prague"},
+		{FTFS, "Europe/Prague"},
+		{FTFM, "Europe/Prague"},
+		{WOOD, "Europe/Prague"},
+		{XPRA, "Europe/Prague"},
+		{XPRM, "Europe/Prague"},
+		{STRT, "Europe/Prague"},
+		{SPAD, "Europe/Prague"},
+		{XPXE, "Europe/Prague"},
+		{XRMZ, "Europe/Prague"},
+		{XRMO, "Europe/Prague"},
+		{PATF, "Europe/Prague"},
+		{ATAD, "Europe/Prague"},
+		{GXGR, "Europe/Copenhagen"},
+		{GXGM, "Europe/Copenhagen"},
+		{GXGF, "Europe/Copenhagen"},
+		{JYSI, "Europe/Copenhagen"},
+		{ABSI, "Europe/Copenhagen"},
+		{ALSI, "Europe/Copenhagen"},
+		{SBSI, "Europe/Copenhagen"},
+		{LASP, "Europe/Copenhagen"},
+		{SKSI, "Europe/Copenhagen"},
+		{NYSI, "Europe/Copenhagen"},
+		{JBSI, "Europe/Copenhagen"},
+		{SNSI, "Europe/Copenhagen"},
+		{DAMP, "Europe/Copenhagen"},
+		{DASI, "Europe/Copenhagen"},
+		{DKTC, "Europe/Copenhagen"},
+		{NPGA, "Europe/Copenhagen"},
+		{SXSI, "Europe/Copenhagen"},
+		{XCSE, "Europe/Copenhagen"},
+		{DSME, "Europe/Copenhagen"},
+		{DCSE, "Europe/Copenhagen"},
+		{MNDK, "Europe/Copenhagen"},
+		{FNDK, "Europe/Copenhagen"},
+		{DNDK, "Europe/Copenhagen"},
+		{MCSE, "Europe/Copenhagen"},
+		{XFND, "Europe/Copenhagen"},
+		{XTRA, "Europe/Copenhagen"},
+		{XBVR, "America/Santo_Domingo"},
+		{XCVD, "America/Santo_Domingo"},
+		{XGUA, "America/Guayaquil"},
+		{XQUI, "America/Guayaquil"},
+		{NILX, "Africa/Cairo"},
+		{XCAI, "Africa/Cairo"},
+		{XSVA, "America/El_Salvador"},
+		{LMNR, "Europe/Tallinn"},
+		{SWEE, "Europe/Tallinn"},
+		{XTAR, "Europe/Tallinn"},
+		{XTAL, "Europe/Tallinn"},
+		{XTAA, "Europe/Tallinn"},
+		{FNEE, "Europe/Tallinn"},
+		{VMFX, "Atlantic/Faroe"},
+		{XSPS, "Pacific/Fiji"},
+		{AXSI, "Europe/Helsinki"},
+		{XNOR, "Europe/Helsinki"},
+		{OPCO, "Europe/Helsinki"},
+		{FGEX, "Europe/Helsinki"},
+		{XFOM, "Europe/Helsinki"},
+		{XHEL, "Europe/Helsinki"},
+		{FSME, "Europe/Helsinki"},
+		{MNFI, "Europe/Helsinki"},
+		{FNFI, "Europe/Helsinki"},
+		{DNFI, "Europe/Helsinki"},
+		{DHEL, "Europe/Helsinki"},
+		{MHEL, "Europe/Helsinki"},
+		{ESLO, "Europe/Paris"},
+		{ENSL, "Europe/Paris"},
+		{BRED, "Europe/Paris"},
+		{HBFR, "Europe/Paris"},
+		{RBCC, "Europe/Paris"},
+		{SGMU, "Europe/Paris"},
+		{SGMV, "Europe/Paris"},
+		{SGMW, "Europe/Paris"},
+		{WFSE, "Europe/Paris"},
+		{MKTF, "Europe/Paris"},
+		{AQEU, "Europe/Paris"},
+		{AQED, "Europe/Paris"},
+		{AQEA, "Europe/Paris"},
+		{GIPB, "Europe/Paris"},
+		{GSPX, "Europe/Paris"},
+		{ELXE, "Europe/Paris"},
+		{TRXE, "Europe/Paris"},
+		{XTXE, "Europe/Paris"},
+		{GMES, "Europe/Paris"},
+		{GMEO, "Europe/Paris"},
+		{MHBP, "Europe/Paris"},
+		{TPIC, "Europe/Paris"},
+		{TPEE, "Europe/Paris"},
+		{TPIR, "Europe/Paris"},
+		{TPIO, "Europe/Paris"},
+		{LNEQ, "Europe/Paris"},
+		{LNFI, "Europe/Paris"},
+		{MLEX, "Europe/Paris"},
+		{MLER, "Europe/Paris"},
+		{MLES, "Europe/Paris"},
+		{NOWX, "Europe/Paris"},
+		{MSAX, "Europe/Paris"},
+		{MSCX, "Europe/Paris"},
+		{MSNT, "Europe/Paris"},
+		{ICOT, "Europe/Paris"},
+		{ICOR, "Europe/Paris"},
+		{TLCM, "Europe/Paris"},
+		{TPEU, "Europe/Paris"},
+		{TPER, "Europe/Paris"},
+		{TPFR, "Europe/Paris"},
+		{TEPG, "Europe/Paris"},
+		{TEPX, "Europe/Paris"},
+		{TEPR, "Europe/Paris"},
+		{TEPM, "Europe/Paris"},
+		{TEPI, "Europe/Paris"},
+		{TEPF, "Europe/Paris"},
+		{CMCI, "Europe/Paris"},
+		{MUBP, "Europe/Paris"},
+		{SMBP, "Europe/Paris"},
+		{EDRF, "Europe/Paris"},
+		{EXSE, "Europe/Paris"},
+		{SGOE, "Europe/Paris"},
+		{EXSY, "Europe/Paris"},
+		{EXYY, "Europe/Paris"},
+		{EXSF, "Europe/Paris"},
+		{EXSP, "Europe/Paris"},
+		{EXSD, "Europe/Paris"},
+		{EXSB, "Europe/Paris"},
+		{EXSH, "Europe/Paris"},
+		{BPSX, "Europe/Paris"},
+		{HPCX, "Europe/Paris"},
+		{HPCS, "Europe/Paris"},
+		{HPCO, "Europe/Paris"},
+		{HPCV, "Europe/Paris"},
+		{MSSA, "Europe/Paris"},
+		{ODDO, "Europe/Paris"},
+		{ODOC, "Europe/Paris"},
+		{AACA, "Europe/Paris"},
+		{BNPA, "Europe/Paris"},
+		{BNPS, "Europe/Paris"},
+		{XSGA, "Europe/Paris"},
+		{KOTF, "Europe/Paris"},
+		{TDON, "Europe/Paris"},
+		{TSAF, "Europe/Paris"},
+		{AURB, "Europe/Paris"},
+		{GFPO, "Europe/Paris"},
+		{AURO, "Europe/Paris"},
+		{NATX, "Europe/Paris"},
+		{COAL, "Europe/Paris"},
+		{EPEX, "Europe/Paris"},
+		{FMTS, "Europe/Paris"},
+		{GMTF, "Europe/Paris"},
+		{LCHC, "Europe/Paris"},
+		{XAFR, "Europe/Paris"},
+		{XBLN, "Europe/Paris"},
+		{XFMN, "Europe/Paris"},
+		{XPAR, "Europe/Paris"},
+		{XBLK, "Europe/Paris"},
+		{XETF, "Europe/Paris"},
+		{XAPA, "Europe/Paris"},
+		{XMLI, "Europe/Paris"},
+		{ALXP, "Europe/Paris"},
+		{XSPM, "Europe/Paris"},
+		{MTCH, "Europe/Paris"},
+		{XMAT, "Europe/Paris"},
+		{XMON, "Europe/Paris"},
+		{DPAR, "Europe/Paris"},
+		{XPOW, "Europe/Paris"},
+		{NABP, "Europe/Paris"},
+		{XGSE, "Asia/Tbilisi"},
+		{SEBA, "Europe/Berlin"},
+		{SSWM, "Europe/Berlin"},
+		{OLBB, "Europe/Berlin"},
+		{SPEX, "Europe/Berlin"},
+		{CSDA, "Europe/Berlin"},
+		{CDSI, "Europe/Berlin"},
+		{SMBD, "Europe/Berlin"},
+		{UBSD, "Europe/Berlin"},
+		{UBSL, "Europe/Berlin"},
+		{UBSE, "Europe/London"},
+		{UBSI, "Europe/Berlin"},
+		{SMFF, "Europe/Berlin"},
+		{SCAG, "Europe/Berlin"},
+		{NCME, "Europe/Berlin"},
+		{GSBE, "Europe/Berlin"},
+		{GSEI, "Europe/Berlin"},
+		{MHEU, "Europe/Berlin"},
+		{LBCW, "Europe/Berlin"},
+		{JEFE, "Europe/Berlin"},
+		{JESI, "Europe/Berlin"},
+		{RBCG, "Europe/Berlin"},
+		{UCDE, "Europe/Berlin"},
+		{MSEU, "Europe/Berlin"},
+		{MESI, "Europe/Berlin"},
+		{DEKA, "Europe/Berlin"},
+		{LIGA, "Europe/Berlin"},
+		{MHBD, "Europe/Berlin"},
+		{IKBS, "Europe/Berlin"},
+		{CGMD, "Europe/Berlin"},
+		{CGEE, "Europe/Berlin"},
+		{CGEC, "Europe/Berlin"},
+		{CGET, "Europe/Berlin"},
+		{JPEU, "Europe/Berlin"},
+		{HELA, "Europe/Berlin"},
+		{NESI, "Europe/Berlin"},
+		{LBBW, "Europe/Berlin"},
+		{LBWL, "Europe/Berlin"},
+		{LBWS, "Europe/Berlin"},
+		{BLBB, "Europe/Berlin"},
+		{BLFX, "Europe/Berlin"},
+		{BLEQ, "Europe/Berlin"},
+		{BLIQ, "Europe/Berlin"},
+		{BSFX, "Europe/Berlin"},
+		{BDEA, "Europe/Berlin"},
+		{BLBS, "Europe/Berlin"},
+		{TPDE, "Europe/Berlin"},
+		{TSFG, "Europe/Berlin"},
+		{TSFF, "Europe/Berlin"},
+		{EUWA, "Europe/Berlin"},
+		{SSBI, "Europe/Berlin"},
+		{SSBM, "Europe/Berlin"},
+		{DBAG, "Europe/Berlin"},
+		{DBMO, "Europe/Berlin"},
+		{DBLN, "Europe/Berlin"},
+		{DBES, "Europe/Berlin"},
+		{VWDX, "Europe/Berlin"},
+		{VWDA, "Europe/Berlin"},
+		{VTPS, "Europe/Berlin"},
+		{VTLS, "Europe/Berlin"},
+		{NORD, "Europe/Berlin"},
+		{BINV, "Europe/Berlin"},
+		{NLBX, "Europe/Berlin"},
+		{TGAT, "Europe/Berlin"},
+		{XGAT, "Europe/Berlin"},
+		{TGSI, "Europe/Berlin"},
+		{XGRM, "Europe/Berlin"},
+		{VONT, "Europe/Berlin"},
+		{CBKA, "Europe/Berlin"},
+		{CBKS, "Europe/Berlin"},
+		{CBKC, "Europe/Berlin"},
+		{CBKD, "Europe/Berlin"},
+		{CBKF, "Europe/Berlin"},
+		{CBKE, "Europe/Berlin"},
+		{CBKG, "Europe/Berlin"},
+		{DZBK, "Europe/Berlin"},
+		{BGSI, "Europe/Berlin"},
+		{BGFX, "Europe/Berlin"},
+		{BGFI, "Europe/Berlin"},
+		{LSSI, "Europe/Berlin"},
+		{BAAD, "Europe/Berlin"},
+		{HSBT, "Europe/Berlin"},
+		{DAPA, "Europe/Berlin"},
+		{XEEE, "Europe/Berlin"},
+		{XPSF, "Europe/Berlin"},
+		{XPOT, "Europe/Berlin"},
+		{XEER, "Europe/Berlin"},
+		{XEEO, "Europe/Berlin"},
+		{FICX, "Europe/Berlin"},
+		{XMUN, "Europe/Berlin"},
+		{MUND, "Europe/Berlin"},
+		{MUNC, "Europe/Berlin"},
+		{MUNB, "Europe/Berlin"},
+		{MUNA, "Europe/Berlin"},
+		{PLUS, "Europe/Berlin"},
+		{XHCE, "Europe/Berlin"},
+		{XRTR, "Europe/Berlin"},
+		{X360T, "Europe/Berlin"},
+		{CATS, "Europe/Berlin"},
+		{DBOX, "Europe/Berlin"},
+		{AUTO, "Europe/Berlin"},
+		{ECAG, "Europe/Berlin"},
+		{ECGS, "Europe/Berlin"},
+		{EFTP, "Europe/Berlin"},
+		{GMEX, "Europe/Berlin"},
+		{XBER, "Europe/Berlin"},
+		{BERA, "Europe/Berlin"},
+		{BERB, "Europe/Berlin"},
+		{BERC, "Europe/Berlin"},
+		{ZOBX, "Europe/Berlin"},
+		{EQTA, "Europe/Berlin"},
+		{EQTB, "Europe/Berlin"},
+		{EQTC, "Europe/Berlin"},
+		{EQTD, "Europe/Berlin"},
+		{XEQT, "Europe/Berlin"},
+		{XBRE, "Europe/Berlin"},
+		{XDTB, "Europe/Berlin"},
+		{XDUS, "Europe/Berlin"},
+		{DUSA, "Europe/Berlin"},
+		{DUSB, "Europe/Berlin"},
+		{DUSC, "Europe/Berlin"},
+		{DUSD, "Europe/Berlin"},
+		{XQTX, "Europe/Berlin"},
+		{XDWZ, "Europe/Berlin"},
+		{XECB, "Europe/Berlin"},
+		{XECC, "Europe/Berlin"},
+		{XETI, "Europe/Berlin"},
+		{XETD, "Europe/Berlin"},
+		{XETC, "Europe/Berlin"},
+		{XETR, "Europe/Berlin"},
+		{XEUB, "Europe/Berlin"},
+		{XETV, "Europe/Berlin"},
+		{XETW, "Europe/Berlin"},
+		{XETU, "Europe/Berlin"},
+		{XETS, "Europe/Berlin"},
+		{XETB, "Europe/Berlin"},
+		{XETA, "Europe/Berlin"},
+		{XETE, "Europe/Berlin"},
+		{XETX, "Europe/Berlin"},
+		{XEMA, "Europe/Berlin"},
+		{XEMI, "Europe/Berlin"},
+		{XEMB, "Europe/Berlin"},
+		{XEUP, "Europe/Berlin"},
+		{XEHQ, "Europe/Berlin"},
+		{XERT, "Europe/Berlin"},
+		{XERE, "Europe/Berlin"},
+		{XEUM, "Europe/Berlin"},
+		{XEUR, "Europe/Berlin"},
+		{XFRA, "Europe/Berlin"},
+		{FRAV, "Europe/Berlin"},
+		{FRAW, "Europe/Berlin"},
+		{FRAU, "Europe/Berlin"},
+		{FRAS, "Europe/Berlin"},
+		{XDBC, "Europe/Berlin"},
+		{XDBV, "Europe/Berlin"},
+		{FRAA, "Europe/Berlin"},
+		{FRAD, "Europe/Berlin"},
+		{FRAB, "Europe/Berlin"},
+		{XDBX, "Europe/Berlin"},
+		{XNEW, "Europe/Berlin"},
+		{XHAM, "Europe/Berlin"},
+		{HAMA, "Europe/Berlin"},
+		{HAMB, "Europe/Berlin"},
+		{HAMM, "Europe/Berlin"},
+		{HAML, "Europe/Berlin"},
+		{HAMN, "Europe/Berlin"},
+		{XHAN, "Europe/Berlin"},
+		{HANB, "Europe/Berlin"},
+		{HANA, "Europe/Berlin"},
+		{XINV, "Europe/Berlin"},
+		{XSCO, "Europe/Berlin"},
+		{XSC2, "Europe/Berlin"},
+		{XSC3, "Europe/Berlin"},
+		{XSC1, "Europe/Berlin"},
+		{XSTU, "Europe/Berlin"},
+		{STUF, "Europe/Berlin"},
+		{XSTP, "Europe/Berlin"},
+		{STUE, "Europe/Berlin"},
+		{XDEX, "Europe/Berlin"},
+		{XSTF, "Europe/Berlin"},
+		{STUC, "Europe/Berlin"},
+		{STUD, "Europe/Berlin"},
+		{STUB, "Europe/Berlin"},
+		{STUA, "Europe/Berlin"},
+		{EUWX, "Europe/Berlin"},
+		{XXSC, "Europe/Berlin"},
+		{DBDX, "Europe/Berlin"},
+		{XIGG, "Europe/Berlin"},
+		{EBLX, "Europe/Berlin"},
+		{ERFQ, "Europe/Berlin"},
+		{ENTW, "Europe/Berlin"},
+		{TRBX, "Europe/Berlin"},
+		{BOCF, "Europe/Berlin"},
+		{X360X, "Europe/Berlin"},
+		{SCLB, "Europe/Berlin"},
+		{X21XX, "Europe/Berlin"},
+		{XGHA, "Africa/Accra"},
+		{GSXL, "Europe/Gibraltar"},
+		{PBGR, "Europe/Athens"},
+		{ABFI, "Europe/Athens"},
+		{ERBX, "Europe/Athens"},
+		{HEMO, "Europe/Athens"},
+		{HESP, "Europe/Athens"},
+		{HEDE, "Europe/Athens"},
+		{HGSP, "Europe/Athens"},
+		{AAPA, "Europe/Athens"},
+		{ASEX, "Europe/Athens"},
+		{HOTC, "Europe/Athens"},
+		{XADE, "Europe/Athens"},
+		{ENAX, "Europe/Athens"},
+		{XATH, "Europe/Athens"},
+		{XIPO, "Europe/Athens"},
+		{EUAX, "Europe/Athens"},
+		{HDAT, "Europe/Athens"},
+		{XGTG, "America/Guatemala"},
+		{XCIE, "Europe/Guernsey"},
+		{GSCI, "America/Guyana"},
+		{XHON, "America/Tegucigalpa"},
+		{XBCV, "America/Tegucigalpa"},
+		{OSDS, "Asia/Hong_Kong"},
+		{BAIP, "Asia/Hong_Kong"},
+		{BACR, "Asia/Hong_Kong"},
+		{BASP, "Asia/Hong_Kong"},
+		{BAEP, "Asia/Hong_Kong"},
+		{BASE, "Asia/Hong_Kong"},
+		{TFSD, "Asia/Hong_Kong"},
+		{TRAS, "Asia/Hong_Kong"},
+		{GSAL, "Asia/Hong_Kong"},
+		{GSPL, "Asia/Hong_Kong"},
+		{GSXH, "Asia/Hong_Kong"},
+		{SIGH, "Asia/Hong_Kong"},
+		{GSXM, "Asia/Hong_Kong"},
+		{TWHK, "Asia/Hong_Kong"},
+		{CGMH, "Asia/Hong_Kong"},
+		{CSHK, "Asia/Hong_Kong"},
+		{CFHK, "Asia/Hong_Kong"},
+		{CLHK, "Asia/Hong_Kong"},
+		{DBHK, "Asia/Hong_Kong"},
+		{EOTC, "Asia/Hong_Kong"},
+		{HKME, "Asia/Hong_Kong"},
+		{HSXA, "Asia/Hong_Kong"},
+		{JPMI, "Asia/Hong_Kong"},
+		{MAQH, "Asia/Hong_Kong"},
+		{MEHK, "Asia/Hong_Kong"},
+		{TOCP, "Asia/Hong_Kong"},
+		{UBSX, "Asia/Hong_Kong"},
+		{XCGS, "Asia/Hong_Kong"},
+		{XHKF, "Asia/Hong_Kong"},
+		{XHKG, "Asia/Hong_Kong"},
+		{SHSC, "Asia/Hong_Kong"},
+		{SZSC, "Asia/Hong_Kong"},
+		{XGEM, "Asia/Hong_Kong"},
+		{XIHK, "Asia/Hong_Kong"},
+		{XPST, "Asia/Hong_Kong"},
+		{GSXN, "Asia/Hong_Kong"},
+		{EBHU, "Europe/Budapest"},
+		{UCHU, "Europe/Budapest"},
+		{CONC, "Europe/Budapest"},
+		{KHHU, "Europe/Budapest"},
+		{CIBH, "Europe/Budapest"},
+		{OTPB, "Europe/Budapest"},
+		{RBHU, "Europe/Budapest"},
+		{ERST, "Europe/Budapest"},
+		{KELR, "Europe/Budapest"},
+		{HUDX, "Europe/Budapest"},
+		{HUPX, "Europe/Budapest"},
+		{KCCP, "Europe/Budapest"},
+		{QMTF, "Europe/Budapest"},
+		{XQLX, "Europe/Budapest"},
+		{XBCE, "Europe/Budapest"},
+		{XBUD, "Europe/Budapest"},
+		{BETA, "Europe/Budapest"},
+		{XBND, "Europe/Budapest"},
+		{XTND, "Europe/Budapest"},
+		{XGAS, "Europe/Budapest"},
+		{XICE, "Atlantic/Reykjavik"},
+		{FNIS, "Atlantic/Reykjavik"},
+		{MICE, "Atlantic/Reykjavik"},
+		{DNIS, "Atlantic/Reykjavik"},
+		{ISEC, "Atlantic/Reykjavik"},
+		{DICE, "Atlantic/Reykjavik"},
+		{MNIS, "Atlantic/Reykjavik"},
+		{MCXX, "Asia/Kolkata"},
+		{IINX, "Asia/Kolkata"},
+		{ACEX, "Asia/Kolkata"},
+		{CDSL, "Asia/Kolkata"},
+		{ASTR, "Asia/Kolkata"},
+		{FXCL, "Asia/Kolkata"},
+		{FXSW, "Asia/Kolkata"},
+		{ICXL, "Asia/Kolkata"},
+		{ISEX, "Asia/Kolkata"},
+		{NBOT, "Asia/Kolkata"},
+		{NMCE, "Asia/Kolkata"},
+		{OTCX, "Asia/Kolkata"},
+		{PXIL, "Asia/Kolkata"},
+		{XBAN, "Asia/Kolkata"},
+		{XBOM, "Asia/Kolkata"},
+		{BSME, "Asia/Kolkata"},
+		{XCAL, "Asia/Kolkata"},
+		{XDES, "Asia/Kolkata"},
+		{XIMC, "Asia/Kolkata"},
+		{XMDS, "Asia/Kolkata"},
+		{XNCD, "Asia/Kolkata"},
+		{XNSE, "Asia/Kolkata"},
+		{INSE, "Asia/Kolkata"},
+		{XUSE, "Asia/Kolkata"},
+		{RITS, "Asia/Kolkata"},
+		{XSUR, "Asia/Jakarta"},
+		{XJKT, "Asia/Jakarta"},
+		{ICDX, "Asia/Jakarta"},
+		{XBBJ, "Asia/Jakarta"},
+		{XIDX, "Asia/Jakarta"},
+		{XJNB, "Asia/Jakarta"},
+		{IFBX, "Asia/Tehran"},
+		{IMEX, "Asia/Tehran"},
+		{XTEH, "Asia/Tehran"},
+		{XIQS, "Asia/Baghdad"},
+		{SIDX, "Europe/Dublin"},
+		{AILT, "Europe/Dublin"},
+		{MAQE, "Europe/Dublin"},
+		{CFIL, "Europe/Dublin"},
+		{BMLI, "Europe/Dublin"},
+		{BMLS, "Europe/Dublin"},
+		{BMLX, "Europe/Dublin"},
+		{BMSI, "Europe/Dublin"},
+		{ITGL, "Europe/Dublin"},
+		{XPOS, "Europe/Dublin"},
+		{XRFQ, "Europe/Dublin"},
+		{XPAC, "Europe/Dublin"},
+		{CEPL, "Europe/Dublin"},
+		{TDGF, "Europe/Dublin"},
+		{BKDM, "Europe/Dublin"},
+		{CSGI, "Europe/Dublin"},
+		{EUCC, "Europe/Dublin"},
+		{MCID, "Europe/Dublin"},
+		{MSEL, "Europe/Dublin"},
+		{HREU, "Europe/Dublin"},
+		{EQIE, "Europe/Dublin"},
+		{EQSE, "Europe/Dublin"},
+		{RMTF, "Europe/Dublin"},
+		{FXRS, "Europe/Dublin"},
+		{FXRQ, "Europe/Dublin"},
+		{FXFM, "Europe/Dublin"},
+		{ICUR, "Europe/Dublin"},
+		{ICXR, "Europe/Dublin"},
+		{IFXC, "Europe/Dublin"},
+		{IFXA, "Europe/Dublin"},
+		{IFXR, "Europe/Dublin"},
+		{DAVY, "Europe/Dublin"},
+		{BBIE, "Europe/Dublin"},
+		{BBIS, "Europe/Dublin"},
+		{LEUE, "Europe/Dublin"},
+		{LEUF, "Europe/Dublin"},
+		{SISI, "Europe/Dublin"},
+		{SEMX, "Europe/Dublin"},
+		{XDUB, "Europe/Dublin"},
+		{EDBT, "Europe/Dublin"},
+		{EDGL, "Europe/Dublin"},
+		{XMSM, "Europe/Dublin"},
+		{XESM, "Europe/Dublin"},
+		{XEYE, "Europe/Dublin"},
+		{XATL, "Europe/Dublin"},
+		{XASM, "Europe/Dublin"},
+		{XIEX, "Europe/Dublin"},
+		{DDUB, "Europe/Dublin"},
+		{VFIL, "Europe/Dublin"},
+		{VFSI, "Europe/Dublin"},
+		{VFXO, "Europe/Dublin"},
+		{AREX, "Europe/Dublin"},
+		{XFNX, "Europe/Dublin"},
+		{XCDE, "Europe/Dublin"},
+		{XEBI, "Europe/Dublin"},
+		{IBSI, "Europe/Dublin"},
+		{GBSI, "Europe/Dublin"},
+		{TMEU, "Europe/Dublin"},
+		{XTAE, "Asia/Jerusalem"},
+		{UBIM, "Europe/Rome"},
+		{IMMH, "Europe/Rome"},
+		{BNLD, "Europe/Rome"},
+		{BREA, "Europe/Rome"},
+		{BPAS, "Europe/Rome"},
+		{CREM, "Europe/Rome"},
+		{UCIT, "Europe/Rome"},
+		{MUBM, "Europe/Rome"},
+		{ISBA, "Europe/Rome"},
+		{ISBV, "Europe/Rome"},
+		{BEIS, "Europe/Rome"},
+		{IBIS, "Europe/Rome"},
+		{IBEQ, "Europe/Rome"},
+		{AKIS, "Europe/Rome"},
+		{DDTX, "Europe/Rome"},
+		{UBIS, "Europe/Rome"},
+		{FBSI, "Europe/Rome"},
+		{MTSO, "Europe/Rome"},
+		{MCAD, "Europe/Rome"},
+		{EBMX, "Europe/Rome"},
+		{MTSM, "Europe/Rome"},
+		{BOND, "Europe/Rome"},
+		{SSOB, "Europe/Rome"},
+		{MTSC, "Europe/Rome"},
+		{MSWP, "Europe/Rome"},
+		{ITSM, "Europe/Rome"},
+		{MTAX, "Europe/Rome"},
+		{XTLX, "Europe/Rome"},
+		{CGIT, "Europe/Rome"},
+		{CGQD, "Europe/Rome"},
+		{CGDB, "Europe/Rome"},
+		{CGTR, "Europe/Rome"},
+		{CGND, "Europe/Rome"},
+		{CGEB, "Europe/Rome"},
+		{CGQT, "Europe/Rome"},
+		{CGCM, "Europe/Rome"},
+		{CGGD, "Europe/Rome"},
+		{EMID, "Europe/Rome"},
+		{EMIB, "Europe/Rome"},
+		{EMIR, "Europe/Rome"},
+		{EMDR, "Europe/Rome"},
+		{HMTF, "Europe/Rome"},
+		{HCER, "Europe/Rome"},
+		{HMOD, "Europe/Rome"},
+		{HRFQ, "Europe/Rome"},
+		{TLAB, "Europe/Rome"},
+		{XGME, "Europe/Rome"},
+		{XMIF, "Europe/Rome"},
+		{XMIL, "Europe/Rome"},
+		{ETLX, "Europe/Rome"},
+		{SEDX, "Europe/Rome"},
+		{ETFP, "Europe/Rome"},
+		{XMOT, "Europe/Rome"},
+		{MOTX, "Europe/Rome"},
+		{EXGM, "Europe/Rome"},
+		{MTAH, "Europe/Rome"},
+		{ATFX, "Europe/Rome"},
+		{MIVX, "Europe/Rome"},
+		{XAIM, "Europe/Rome"},
+		{XDMI, "Europe/Rome"},
+		{MACX, "Europe/Rome"},
+		{MTAA, "Europe/Rome"},
+		{BGEM, "Europe/Rome"},
+		{DMIL, "Europe/Rome"},
+		{XABJ, "Africa/Abidjan"},
+		{XBRV, "Africa/Abidjan"},
+		{XJAM, "America/Jamaica"},
+		{MUDX, "Asia/Tokyo"},
+		{BNPX, "Asia/Tokyo"},
+		{SBIJ, "Asia/Tokyo"},
+		{SBIU, "Asia/Tokyo"},
+		{XSBI, "Asia/Tokyo"},
+		{SBIV, "Asia/Tokyo"},
+		{BAJD, "Asia/Tokyo"},
+		{TWJP, "Asia/Tokyo"},
+		{TWJT, "Asia/Tokyo"},
+		{BASX, "Asia/Tokyo"},
+		{UTSL, "Asia/Tokyo"},
+		{XIJP, "Asia/Tokyo"},
+		{JASR, "Asia/Tokyo"},
+		{DRCT, "Asia/Tokyo"},
+		{CITX, "Asia/Tokyo"},
+		{CITD, "Asia/Tokyo"},
+		{CSJP, "Asia/Tokyo"},
+		{CFJP, "Asia/Tokyo"},
+		{NMRJ, "Asia/Tokyo"},
+		{NMSX, "Asia/Tokyo"},
+		{NXJP, "Asia/Tokyo"},
+		{NXVW, "Asia/Tokyo"},
+		{ICSH, "Asia/Tokyo"},
+		{ICSZ, "Asia/Tokyo"},
+		{ICKR, "Asia/Tokyo"},
+		{ICHK, "Asia/Tokyo"},
+		{NXSE, "Asia/Tokyo"},
+		{ICTW, "Asia/Tokyo"},
+		{NXFO, "Asia/Tokyo"},
+		{NXBX, "Asia/Tokyo"},
+		{JATA, "Asia/Tokyo"},
+		{XHER, "Asia/Tokyo"},
+		{BETP, "Asia/Tokyo"},
+		{CHIJ, "Asia/Tokyo"},
+		{KAIX, "Asia/Tokyo"},
+		{CHIS, "Asia/Tokyo"},
+		{CHIV, "Asia/Tokyo"},
+		{CLJP, "Asia/Tokyo"},
+		{CMET, "Asia/Tokyo"},
+		{KABU, "Asia/Tokyo"},
+		{VKAB, "Asia/Tokyo"},
+		{LXJP, "Asia/Tokyo"},
+		{MAQJ, "Asia/Tokyo"},
+		{MIZX, "Asia/Tokyo"},
+		{MSMS, "Asia/Tokyo"},
+		{SIGJ, "Asia/Tokyo"},
+		{XCCE, "Asia/Tokyo"},
+		{XFFE, "Asia/Tokyo"},
+		{XFKA, "Asia/Tokyo"},
+		{XHIR, "Asia/Tokyo"},
+		{XJPX, "Asia/Tokyo"},
+		{XTK1, "Asia/Tokyo"},
+		{XJAS, "Asia/Tokyo"},
+		{XTKS, "Asia/Tokyo"},
+		{XOSE, "Asia/Tokyo"},
+		{XTAM, "Asia/Tokyo"},
+		{XTK3, "Asia/Tokyo"},
+		{XTK2, "Asia/Tokyo"},
+		{XOSJ, "Asia/Tokyo"},
+		{XKAC, "Asia/Tokyo"},
+		{XKGT, "Asia/Tokyo"},
+		{XKKT, "Asia/Tokyo"},
+		{XKST, "Asia/Tokyo"},
+		{XKYO, "Asia/Tokyo"},
+		{XNGO, "Asia/Tokyo"},
+		{XNII, "Asia/Tokyo"},
+		{XNKS, "Asia/Tokyo"},
+		{XNST, "Asia/Tokyo"},
+		{XOSM, "Asia/Tokyo"},
+		{XOST, "Asia/Tokyo"},
+		{XSAP, "Asia/Tokyo"},
+		{XTFF, "Asia/Tokyo"},
+		{XTKA, "Asia/Tokyo"},
+		{XTKO, "Asia/Tokyo"},
+		{XTKT, "Asia/Tokyo"},
+		{XYKT, "Asia/Tokyo"},
+		{ODXE, "Asia/Tokyo"},
+		{ODST, "Asia/Tokyo"},
+		{SMBC, "Asia/Tokyo"},
+		{CILH, "Asia/Tokyo"},
+		{TICT, "Asia/Tokyo"},
+		{BGCJ, "Asia/Tokyo"},
+		{JEFA, "Asia/Tokyo"},
+		{XAMM, "Asia/Amman"},
+		{AMNL, "Asia/Amman"},
+		{CCEX, "Asia/Almaty"},
+		{AIXK, "Asia/Almaty"},
+		{ETSC, "Asia/Almaty"},
+		{XKAZ, "Asia/Almaty"},
+		{ITSL, "Asia/Almaty"},
+		{XNAI, "Africa/Nairobi"},
+		{GSXK, "Asia/Seoul"},
+		{KOCN, "Asia/Seoul"},
+		{XKFB, "Asia/Seoul"},
+		{XKOR, "Asia/Seoul"},
+		{XKRX, "Asia/Seoul"},
+		{XKON, "Asia/Seoul"},
+		{XKFE, "Asia/Seoul"},
+		{XKOS, "Asia/Seoul"},
+		{XKCM, "Asia/Seoul"},
+		{XKEM, "Asia/Seoul"},
+		{XKUW, "Asia/Kuwait"},
+		{XKSE, "Asia/Bishkek"},
+		{XLAO, "Asia/Vientiane"},
+		{SWLV, "Europe/Riga"},
+		{XRIS, "Europe/Riga"},
+		{FNLV, "Europe/Riga"},
+		{XBEY, "Asia/Beirut"},
+		{XLSM, "Africa/Tripoli"},
+		{XNOM, "Europe/Vaduz"},
+		{XLLB, "Europe/Vaduz"},
+		{XVPB, "Europe/Vaduz"},
+		{XLGT, "Europe/Vaduz"},
+		{ARTX, "Europe/Vaduz"},
+		{SEBL, "Europe/Vilnius"},
+		{SWLT, "Europe/Vilnius"},
+		{BAPX, "Europe/Vilnius"},
+		{GETB, "Europe/Vilnius"},
+		{NASB, "Europe/Vilnius"},
+		{XLIT, "Europe/Vilnius"},
+		{XVIA, "Europe/Vilnius"},
+		{FNLT, "Europe/Vilnius"},
+		{BGLU, "Europe/Luxembourg"},
+		{BILU, "Europe/Luxembourg"},
+		{BLUX, "Europe/Luxembourg"},
+		{DBLX, "Europe/Luxembourg"},
+		{DHLX, "Europe/Luxembourg"},
+		{KBLL, "Europe/Luxembourg"},
+		{KBLC, "Europe/Luxembourg"},
+		{KBLS, "Europe/Luxembourg"},
+		{KBLT, "Europe/Luxembourg"},
+		{MIBL, "Europe/Luxembourg"},
+		{BCEE, "Europe/Luxembourg"},
+		{RBCB, "Europe/Luxembourg"},
+		{RBSI, "Europe/Luxembourg"},
+		{XVES, "Europe/Luxembourg"},
+		{CCLX, "Europe/Luxembourg"},
+		{XLUX, "Europe/Luxembourg"},
+		{EMTF, "Europe/Luxembourg"},
+		{BDPL, "Europe/Luxembourg"},
+		{XMAE, "Europe/Skopje"},
+		{XMDG, "Indian/Antananarivo"},
+		{XMSW, "Africa/Blantyre"},
+		{XLOF, "Asia/Kuala_Lumpur"},
+		{XKLS, "Asia/Kuala_Lumpur"},
+		{MESQ, "Asia/Kuala_Lumpur"},
+		{XLFX, "Asia/Kuala_Lumpur"},
+		{XRBM, "Asia/Kuala_Lumpur"},
+		{MALX, "Indian/Maldives"},
+		{EWSM, "Europe/Malta"},
+		{XMAL, "Europe/Malta"},
+		{IFSM, "Europe/Malta"},
+		{PROS, "Europe/Malta"},
+		{COMG, "Europe/Malta"},
+		{AFEX, "Indian/Mauritius"},
+		{GBOT, "Indian/Mauritius"},
+		{XAFX, "Indian/Mauritius"},
+		{XMAU, "Indian/Mauritius"},
+		{BIVA, "America/Mexico_City"},
+		{CGMX, "America/Mexico_City"},
+		{XEMD, "America/Mexico_City"},
+		{XMEX, "America/Mexico_City"},
+		{XMOL, "Europe/Chisinau"},
+		{XULA, "Asia/Ulaanbaatar"},
+		{XMNX, "Europe/Podgorica"},
+		{XCAS, "Africa/Casablanca"},
+		{XBVM, "Africa/Maputo"},
+		{XMAP, "Africa/Maputo"},
+		{XNAM, "Africa/Windhoek"},
+		{XNEP, "Asia/Kathmandu"},
+		{XAUK, "Pacific/Auckland"},
+		{XNEE, "Pacific/Auckland"},
+		{XNZE, "Pacific/Auckland"},
+		{NZXC, "Pacific/Auckland"},
+		{NZXM, "Pacific/Auckland"},
+		{XMAN, "America/Managua"},
+		{NASX, "Africa/Lagos"},
+		{XNSA, "Africa/Lagos"},
+		{NORX, "Europe/Oslo"},
+		{STEE, "Europe/Oslo"},
+		{BULK, "Europe/Oslo"},
+		{ELUK, "Europe/Oslo"},
+		{ELNO, "Europe/Oslo"},
+		{ELSE, "Europe/Oslo"},
+		{ELEU, "Europe/Oslo"},
+		{FREI, "Europe/Oslo"},
+		{XOSL, "Europe/Oslo"},
+		{XOAS, "Europe/Oslo"},
+		{MERK, "Europe/Oslo"},
+		{XOBD, "Europe/Oslo"},
+		{XOAA, "Europe/Oslo"},
+		{XOSA, "Europe/Oslo"},
+		{BURG, "Europe/Oslo"},
+		{MERD, "Europe/Oslo"},
+		{XOAM, "Europe/Oslo"},
+		{BURM, "Europe/Oslo"},
+		{XOSC, "Europe/Oslo"},
+		{XOAD, "Europe/Oslo"},
+		{XOSD, "Europe/Oslo"},
+		{NIBR, "Europe/Oslo"},
+		{DOSL, "Europe/Oslo"},
+		{SPTR, "Europe/Oslo"},
+		{ICAS, "Europe/Oslo"},
+		{OAPA, "Europe/Oslo"},
+		{SB1M, "Europe/Oslo"},
+		{XABG, "Europe/Oslo"},
+		{CNSI, "Europe/Oslo"},
+		{XDNB, "Europe/Oslo"},
+		{NEXO, "Europe/Oslo"},
+		{FISH, "Europe/Oslo"},
+		{FSHX, "Europe/Oslo"},
+		{NOPS, "Europe/Oslo"},
+		{NOSC, "Europe/Oslo"},
+		{NOTC, "Europe/Oslo"},
+		{OSLC, "Europe/Oslo"},
+		{XIMA, "Europe/Oslo"},
+		{INFT, "Europe/Oslo"},
+		{FNDS, "Europe/Oslo"},
+		{IFFX, "Europe/Oslo"},
+		{QUNT, "Europe/Oslo"},
+		{HWHE, "Europe/Oslo"},
+		{XMUS, "Asia/Muscat"},
+		{MSXB, "Asia/Muscat"},
+		{MSXO, "Asia/Muscat"},
+		{XISL, "Asia/Karachi"},
+		{XKAR, "Asia/Karachi"},
+		{XLAH, "Asia/Karachi"},
+		{NCEL, "Asia/Karachi"},
+		{XPAE, "Asia/Hebron"},
+		{XPTY, "America/Panama"},
+		{XPOM, "Pacific/Port_Moresby"},
+		{XVPA, "America/Asuncion"},
+		{XLIM, "America/Lima"},
+		{CLPH, "Asia/Manila"},
+		{PDEX, "Asia/Manila"},
+		{XPHS, "Asia/Manila"},
+		{CAPL, "Europe/Warsaw"},
+		{PARK, "Europe/Warsaw"},
+		{BNPP, "Europe/Warsaw"},
+		{PKOP, "Europe/Warsaw"},
+		{INGW, "Europe/Warsaw"},
+		{MBPL, "Europe/Warsaw"},
+		{SIAB, "Europe/Warsaw"},
+		{BHWA, "Europe/Warsaw"},
+		{BPKO, "Europe/Warsaw"},
+		{IENG, "Europe/Warsaw"},
+		{HBPL, "Europe/Warsaw"},
+		{WBKP, "Europe/Warsaw"},
+		{PTPG, "Europe/Warsaw"},
+		{MTSP, "Europe/Warsaw"},
+		{KDPW, "Europe/Warsaw"},
+		{XWAR, "Europe/Warsaw"},
+		{XGLO, "Europe/Warsaw"},
+		{WIND, "Europe/Warsaw"},
+		{WOPO, "Europe/Warsaw"},
+		{PLPD, "Europe/Warsaw"},
+		{XNCO, "Europe/Warsaw"},
+		{WIPO, "Europe/Warsaw"},
+		{CETO, "Europe/Warsaw"},
+		{RPWC, "Europe/Warsaw"},
+		{TBSP, "Europe/Warsaw"},
+		{TBSA, "Europe/Warsaw"},
+		{BOSP, "Europe/Warsaw"},
+		{WETP, "Europe/Warsaw"},
+		{PLPO, "Europe/Warsaw"},
+		{PLPS, "Europe/Warsaw"},
+		{WBLC, "Europe/Warsaw"},
+		{WBCL, "Europe/Warsaw"},
+		{WBON, "Europe/Warsaw"},
+		{WMTF, "Europe/Warsaw"},
+		{WDER, "Europe/Warsaw"},
+		{WCDE, "Europe/Warsaw"},
+		{POEE, "Europe/Warsaw"},
+		{WGAS, "Europe/Warsaw"},
+		{PLPX, "Europe/Warsaw"},
+		{NSSA, "Europe/Warsaw"},
+		{MSDM, "Europe/Warsaw"},
+		{XBRY, "Europe/Warsaw"},
+		{MBCP, "Europe/Lisbon"},
+		{OMIP, "Europe/Lisbon"},
+		{XLIS, "Europe/Lisbon"},
+		{ENXL, "Europe/Lisbon"},
+		{ALXL, "Europe/Lisbon"},
+		{MFOX, "Europe/Lisbon"},
+		{WQXL, "Europe/Lisbon"},
+		{DLIS, "Europe/Lisbon"},
+		{BFPT, "Europe/Lisbon"},
+		{MDIP, "Europe/Lisbon"},
+		{OMIC, "Europe/Lisbon"},
+		{OPEX, "Europe/Lisbon"},
+		{PMTS, "Europe/Lisbon"},
+		{DSMD, "Asia/Qatar"},
+		{TRPX, "Indian/Mahe"},
+		{SECC, "Indian/Mahe"},
+		{SECD, "Indian/Mahe"},
+		{SECE, "Indian/Mahe"},
+		{SEDC, "Indian/Mahe"},
+		{BTRL, "Europe/Bucharest"},
+		{OTPR, "Europe/Bucharest"},
+		{BRDE, "Europe/Bucharest"},
+		{BRDS, "Europe/Bucharest"},
+		{BRDL, "Europe/Bucharest"},
+		{BMFX, "Europe/Bucharest"},
+		{SBMF, "Europe/Bucharest"},
+		{BMFM, "Europe/Bucharest"},
+		{BMFA, "Europe/Bucharest"},
+		{XBRM, "Europe/Bucharest"},
+		{EMCE, "Europe/Bucharest"},
+		{BRMF, "Europe/Bucharest"},
+		{XBSE, "Europe/Bucharest"},
+		{XCAN, "Europe/Bucharest"},
+		{XRAS, "Europe/Bucharest"},
+		{XBSD, "Europe/Bucharest"},
+		{XRPM, "Europe/Bucharest"},
+		{RRSI, "Europe/Bucharest"},
+		{SPBE, "Europe/Moscow"},
+		{RUSX, "Europe/Moscow"},
+		{XMOS, "Europe/Moscow"},
+		{XPET, "Europe/Moscow"},
+		{MISX, "Europe/Moscow"},
+		{RTSX, "Europe/Moscow"},
+		{XSIC, "Asia/Novosibirsk"},
+		{XMIC, "Europe/Moscow"},
+		{URCE, "Asia/Yekaterinburg"},
+		{XROV, "Europe/Moscow"},
+		{IXSP, "Europe/Moscow"},
+		{NAMX, "Europe/Moscow"},
+		{NNCS, "Europe/Moscow"},
+		{RPDX, "Europe/Moscow"},
+		{SPIM, "Europe/Moscow"},
+		{XAPI, "Asia/Vladivostok"},
+		{XPIC, "Europe/Moscow"},
+		{XRUS, "Europe/Moscow"},
+		{XSAM, "Europe/Samara"},
+		{XSIB, "Asia/Novosibirsk"},
+		{XVLA, "Asia/Vladivostok"},
+		{ROTC, "Africa/Kigali"},
+		{RSEX, "Africa/Kigali"},
+		{XECS, "America/St_Kitts"},
+		{SVXI, "America/St_Vincent"},
+		{XSAU, "Asia/Riyadh"},
+		{XBEL, "Europe/Belgrade"},
+		{BBLX, "Asia/Singapore"},
+		{OSSG, "Asia/Singapore"},
+		{CNOD, "Asia/Singapore"},
+		{EQOS, "Asia/Singapore"},
+		{EQOC, "Asia/Singapore"},
+		{EQOD, "Asia/Singapore"},
+		{ENMS, "Asia/Singapore"},
+		{BTBS, "Asia/Singapore"},
+		{SYFX, "Asia/Singapore"},
+		{TSIG, "Asia/Singapore"},
+		{APEX, "Asia/Singapore"},
+		{APCL, "Asia/Singapore"},
+		{TWSG, "Asia/Singapore"},
+		{MASG, "Asia/Singapore"},
+		{MALM, "Asia/Singapore"},
+		{RFQS, "Asia/Singapore"},
+		{CLTD, "Asia/Singapore"},
+		{CHIE, "Asia/Singapore"},
+		{ECAL, "Asia/Singapore"},
+		{EEAL, "Asia/Singapore"},
+		{IFSG, "Asia/Singapore"},
+		{JADX, "Asia/Singapore"},
+		{RTSP, "Asia/Singapore"},
+		{FXSM, "Asia/Singapore"},
+		{SMEX, "Asia/Singapore"},
+		{TFSA, "Asia/Singapore"},
+		{XSES, "Asia/Singapore"},
+		{XSCA, "Asia/Singapore"},
+		{XSBT, "Asia/Singapore"},
+		{XSCL, "Asia/Singapore"},
+		{XSIM, "Asia/Singapore"},
+		{XSCE, "Asia/Singapore"},
+		{TERM, "Asia/Singapore"},
+		{XABX, "Asia/Singapore"},
+		{TRDC, "Asia/Singapore"},
+		{XAND, "Asia/Singapore"},
+		{XANM, "Asia/Singapore"},
+		{LMAS, "Asia/Singapore"},
+		{GFSG, "Asia/Singapore"},
+		{BGSG, "Asia/Singapore"},
+		{ICPS, "Asia/Singapore"},
+		{XANS, "Asia/Singapore"},
+		{CBSK, "Europe/Bratislava"},
+		{XRMS, "Europe/Bratislava"},
+		{SPXE, "Europe/Bratislava"},
+		{XBRA, "Europe/Bratislava"},
+		{EBRA, "Europe/Bratislava"},
+		{VUBA, "Europe/Bratislava"},
+		{SKBB, "Europe/Ljubljana"},
+		{XLJS, "Europe/Ljubljana"},
+		{XLJU, "Europe/Ljubljana"},
+		{XLJM, "Europe/Ljubljana"},
+		{XSOP, "Europe/Ljubljana"},
+		{RMMS, "Africa/Johannesburg"},
+		{RMMX, "Africa/Johannesburg"},
+		{X4AXE, "Africa/Johannesburg"},
+		{ZARX, "Africa/Johannesburg"},
+		{A2XX, "Africa/Johannesburg"},
+		{XJSE, "Africa/Johannesburg"},
+		{JSER, "Africa/Johannesburg"},
+		{ZFXM, "Africa/Johannesburg"},
+		{JSEB, "Africa/Johannesburg"},
+		{XBES, "Africa/Johannesburg"},
+		{ALTX, "Africa/Johannesburg"},
+		{XSAF, "Africa/Johannesburg"},
+		{XSFA, "Africa/Johannesburg"},
+		{YLDX, "Africa/Johannesburg"},
+		{EESX, "Africa/Johannesburg"},
+		{CBMS, "Africa/Johannesburg"},
+		{POSE, "Europe/Madrid"},
+		{TEUR, "Europe/Madrid"},
+		{CECA, "Europe/Madrid"},
+		{BSAB, "Europe/Madrid"},
+		{DOWM, "Europe/Madrid"},
+		{DOWE, "Europe/Madrid"},
+		{IBER, "Europe/Madrid"},
+		{ALLT, "Europe/Madrid"},
+		{CSMD, "Europe/Madrid"},
+		{SIMD, "Europe/Madrid"},
+		{AGBP, "Europe/Madrid"},
+		{ABAN, "Europe/Madrid"},
+		{BCMA, "Europe/Madrid"},
+		{CABK, "Europe/Madrid"},
+		{SANT, "Europe/Madrid"},
+		{CIMD, "Europe/Madrid"},
+		{CIMA, "Europe/Madrid"},
+		{CIMV, "Europe/Madrid"},
+		{CIMB, "Europe/Madrid"},
+		{CIME, "Europe/Madrid"},
+		{BBVA, "Europe/Madrid"},
+		{CAPI, "Europe/Madrid"},
+		{CMAP, "Europe/Madrid"},
+		{TPES, "Europe/Madrid"},
+		{TOMF, "Europe/Madrid"},
+		{TOMG, "Europe/Madrid"},
+		{IBGH, "Europe/Madrid"},
+		{BMEX, "Europe/Madrid"},
+		{MABX, "Europe/Madrid"},
+		{GROW, "Europe/Madrid"},
+		{XMFX, "Europe/Madrid"},
+		{XMCE, "Europe/Madrid"},
+		{BMEA, "Europe/Madrid"},
+		{SEND, "Europe/Madrid"},
+		{XDRF, "Europe/Madrid"},
+		{MARF, "Europe/Madrid"},
+		{BMCL, "Europe/Madrid"},
+		{MERF, "Europe/Madrid"},
+		{XBIL, "Europe/Madrid"},
+		{XMAD, "Europe/Madrid"},
+		{SBIL, "Europe/Madrid"},
+		{SBAR, "Europe/Madrid"},
+		{XVAL, "Europe/Madrid"},
+		{XBAR, "Europe/Madrid"},
+		{XLAT, "Europe/Madrid"},
+		{XMEF, "Europe/Madrid"},
+		{XMPW, "Europe/Madrid"},
+		{XMRV, "Europe/Madrid"},
+		{SCLE, "Europe/Madrid"},
+		{MIBG, "Europe/Madrid"},
+		{MDRV, "Europe/Madrid"},
+		{OMEL, "Europe/Madrid"},
+		{PAVE, "Europe/Madrid"},
+		{XBAV, "Europe/Madrid"},
+		{XDPA, "Europe/Madrid"},
+		{XFCM, "Europe/Madrid"},
+		{XNAF, "Europe/Madrid"},
+		{XSRM, "Europe/Madrid"},
+		{XCOL, "Asia/Colombo"},
+		{XKHA, "Africa/Khartoum"},
+		{XSWA, "Africa/Mbabane"},
+		{PEPW, "Europe/Stockholm"},
+		{PEPQ, "Europe/Stockholm"},
+		{PEPY, "Europe/Stockholm"},
+		{PEPH, "Europe/Stockholm"},
+		{PEPM, "Europe/Stockholm"},
+		{XSAT, "Europe/Stockholm"},
+		{SPDK, "Europe/Stockholm"},
+		{SPNO, "Europe/Stockholm"},
+		{SPFI, "Europe/Stockholm"},
+		{SPEU, "Europe/Stockholm"},
+		{GFKS, "Europe/Stockholm"},
+		{SEBX, "Europe/Stockholm"},
+		{SEBS, "Europe/Stockholm"},
+		{ENSX, "Europe/Stockholm"},
+		{XABC, "Europe/Stockholm"},
+		{SVEX, "Europe/Stockholm"},
+		{SVES, "Europe/Stockholm"},
+		{CASI, "Europe/Stockholm"},
+		{SWBI, "Europe/Stockholm"},
+		{NAPA, "Europe/Stockholm"},
+		{CRYD, "Europe/Stockholm"},
+		{CRYX, "Europe/Stockholm"},
+		{XNGM, "Europe/Stockholm"},
+		{XNDX, "Europe/Stockholm"},
+		{XNMR, "Europe/Stockholm"},
+		{NSME, "Europe/Stockholm"},
+		{NMTF, "Europe/Stockholm"},
+		{XOME, "Europe/Stockholm"},
+		{XSTO, "Europe/Stockholm"},
+		{NOCO, "Europe/Stockholm"},
+		{SSME, "Europe/Stockholm"},
+		{GBWB, "Europe/Stockholm"},
+		{MOSE, "Europe/Stockholm"},
+		{DOSE, "Europe/Stockholm"},
+		{ESTO, "Europe/Stockholm"},
+		{ONSE, "Europe/Stockholm"},
+		{DKED, "Europe/Stockholm"},
+		{DKFI, "Europe/Stockholm"},
+		{NOED, "Europe/Stockholm"},
+		{SEED, "Europe/Stockholm"},
+		{PNED, "Europe/Stockholm"},
+		{USWB, "Europe/Stockholm"},
+		{NOFI, "Europe/Stockholm"},
+		{FIED, "Europe/Stockholm"},
+		{EBON, "Europe/Stockholm"},
+		{EUWB, "Europe/Stockholm"},
+		{XOPV, "Europe/Stockholm"},
+		{NASN, "Europe/Stockholm"},
+		{DNSE, "Europe/Stockholm"},
+		{FNSE, "Europe/Stockholm"},
+		{CSTO, "Europe/Stockholm"},
+		{MNSE, "Europe/Stockholm"},
+		{MSTO, "Europe/Stockholm"},
+		{DSTO, "Europe/Stockholm"},
+		{USOB, "Europe/Stockholm"},
+		{SEWB, "Europe/Stockholm"},
+		{DKWB, "Europe/Stockholm"},
+		{NOWB, "Europe/Stockholm"},
+		{SEOB, "Europe/Stockholm"},
+		{DKOB, "Europe/Stockholm"},
+		{EUOB, "Europe/Stockholm"},
+		{GBOB, "Europe/Stockholm"},
+		{NOOB, "Europe/Stockholm"},
+		{NSPO, "Europe/Stockholm"},
+		{XSWX, "Europe/Zurich"},
+		{XSDX, "Europe/Zurich"},
+		{XDLP, "Europe/Zurich"},
+		{XSWM, "Europe/Zurich"},
+		{XSLS, "Europe/Zurich"},
+		{XSEB, "Europe/Zurich"},
+		{XBTR, "Europe/Zurich"},
+		{XVTX, "Europe/Zurich"},
+		{XQOD, "Europe/Zurich"},
+		{XQMH, "Europe/Zurich"},
+		{CBOE, "Europe/Zurich"},
+		{XICB, "Europe/Zurich"},
+		{EUCH, "Europe/Zurich"},
+		{EUSP, "Europe/Zurich"},
+		{EURM, "Europe/Zurich"},
+		{EUSC, "Europe/Zurich"},
+		{CSAG, "Europe/Zurich"},
+		{CSOT, "Europe/Zurich"},
+		{CCMS, "Europe/Zurich"},
+		{CSZH, "Europe/Zurich"},
+		{OTXB, "Europe/Zurich"},
+		{ROSR, "Europe/Zurich"},
+		{XREP, "Europe/Zurich"},
+		{XROT, "Europe/Zurich"},
+		{EBSS, "Europe/Zurich"},
+		{EBSC, "Europe/Zurich"},
+		{VLEX, "Europe/Zurich"},
+		{AIXE, "Europe/Zurich"},
+		{DOTS, "Europe/Zurich"},
+		{S3FM, "Europe/Zurich"},
+		{STOX, "Europe/Zurich"},
+		{XSTV, "Europe/Zurich"},
+		{XSCU, "Europe/Zurich"},
+		{XSTX, "Europe/Zurich"},
+		{UBSG, "Europe/Zurich"},
+		{UBSC, "Europe/Zurich"},
+		{UBSF, "Europe/Zurich"},
+		{UBST, "Europe/Zurich"},
+		{XBRN, "Europe/Zurich"},
+		{EQWB, "Europe/Zurich"},
+		{XSWO, "Europe/Zurich"},
+		{ZKBX, "Europe/Zurich"},
+		{KMUX, "Europe/Zurich"},
+		{RULE, "Europe/Zurich"},
+		{BXDA, "Europe/Zurich"},
+		{TDXS, "Europe/Zurich"},
+		{XDSE, "Asia/Damascus"},
+		{GSXT, "Asia/Taipei"},
+		{CFTW, "Asia/Taipei"},
+		{ROCO, "Asia/Taipei"},
+		{XIME, "Asia/Taipei"},
+		{XTAD, "Asia/Taipei"},
+		{XTAF, "Asia/Taipei"},
+		{XTAI, "Asia/Taipei"},
+		{XDAR, "Africa/Dar_es_Salaam"},
+		{AFET, "Asia/Bangkok"},
+		{BEEX, "Asia/Bangkok"},
+		{TFEX, "Asia/Bangkok"},
+		{XBKK, "Asia/Bangkok"},
+		{XMAI, "Asia/Bangkok"},
+		{XBKF, "Asia/Bangkok"},
+		{RR4G, "Europe/Amsterdam"},
+		{TRNL, "Europe/Amsterdam"},
+		{ABNC, "Europe/Amsterdam"},
+		{JNSI, "Europe/Amsterdam"},
+		{MANL, "Europe/Amsterdam"},
+		{MXNL, "Europe/Amsterdam"},
+		{RFQN, "Europe/Amsterdam"},
+		{CAVD, "Europe/Amsterdam"},
+		{JLEU, "Europe/Amsterdam"},
+		{MHBE, "Europe/Amsterdam"},
+		{BTAM, "Europe/Amsterdam"},
+		{BTQE, "Europe/Amsterdam"},
+		{CABV, "Europe/Amsterdam"},
+		{TOWR, "Europe/Amsterdam"},
+		{FLWX, "Europe/Amsterdam"},
+		{FLTR, "Europe/Amsterdam"},
+		{FLTB, "Europe/Amsterdam"},
+		{IPNL, "Europe/Amsterdam"},
+		{ISWP, "Europe/Amsterdam"},
+		{ISWO, "Europe/Amsterdam"},
+		{ISWN, "Europe/Amsterdam"},
+		{ISWT, "Europe/Amsterdam"},
+		{EBSN, "Europe/Amsterdam"},
+		{NEXY, "Europe/Amsterdam"},
+		{RESF, "Europe/Amsterdam"},
+		{EBSF, "Europe/Amsterdam"},
+		{IEBS, "Europe/Amsterdam"},
+		{EBSI, "Europe/Amsterdam"},
+		{RESE, "Europe/Amsterdam"},
+		{EBSD, "Europe/Amsterdam"},
+		{ECEU, "Europe/Amsterdam"},
+		{OHVO, "Europe/Amsterdam"},
+		{MUSN, "Europe/Amsterdam"},
+		{NIBC, "Europe/Amsterdam"},
+		{CCRM, "Europe/Amsterdam"},
+		{CEDX, "Europe/Amsterdam"},
+		{BARU, "Europe/Amsterdam"},
+		{BEUO, "Europe/Amsterdam"},
+		{BEUT, "Europe/Amsterdam"},
+		{CCXE, "Europe/Amsterdam"},
+		{BEUP, "Europe/Amsterdam"},
+		{BEUF, "Europe/Amsterdam"},
+		{CEUD, "Europe/Amsterdam"},
+		{BEUD, "Europe/Amsterdam"},
+		{CEUX, "Europe/Amsterdam"},
+		{CEUO, "Europe/Amsterdam"},
+		{BEUE, "Europe/Amsterdam"},
+		{CEUE, "Europe/Amsterdam"},
+		{CAPA, "Europe/Amsterdam"},
+		{LISZ, "Europe/Amsterdam"},
+		{NWNV, "Europe/Amsterdam"},
+		{ABNA, "Europe/Amsterdam"},
+		{TQEX, "Europe/Amsterdam"},
+		{TQEB, "Europe/Amsterdam"},
+		{TQEM, "Europe/Amsterdam"},
+		{TQEA, "Europe/Amsterdam"},
+		{IMCT, "Europe/Amsterdam"},
+		{MUBE, "Europe/Amsterdam"},
+		{BAPE, "Europe/Amsterdam"},
+		{BTFE, "Europe/Amsterdam"},
+		{COMM, "Europe/Amsterdam"},
+		{NPEX, "Europe/Amsterdam"},
+		{AFSA, "Europe/Amsterdam"},
+		{AFSO, "Europe/Amsterdam"},
+		{AFSX, "Europe/Amsterdam"},
+		{AFSL, "Europe/Amsterdam"},
+		{AFSI, "Europe/Amsterdam"},
+		{ETPA, "Europe/Amsterdam"},
+		{RABO, "Europe/Amsterdam"},
+		{INGB, "Europe/Amsterdam"},
+		{INGE, "Europe/Amsterdam"},
+		{INGF, "Europe/Amsterdam"},
+		{CPTX, "Europe/Amsterdam"},
+		{TOMX, "Europe/Amsterdam"},
+		{TOMD, "Europe/Amsterdam"},
+		{NDEX, "Europe/Amsterdam"},
+		{IMCO, "Europe/Amsterdam"},
+		{IMEQ, "Europe/Amsterdam"},
+		{NDXS, "Europe/Amsterdam"},
+		{XNXC, "Europe/Amsterdam"},
+		{XNXD, "Europe/Amsterdam"},
+		{AFSE, "Europe/Amsterdam"},
+		{CLMX, "Europe/Amsterdam"},
+		{ECXE, "Europe/Amsterdam"},
+		{HCHC, "Europe/Amsterdam"},
+		{NLPX, "Europe/Amsterdam"},
+		{TWEU, "Europe/Amsterdam"},
+		{TWEM, "Europe/Amsterdam"},
+		{TWEA, "Europe/Amsterdam"},
+		{TWEO, "Europe/Amsterdam"},
+		{XACE, "Europe/Amsterdam"},
+		{XAEX, "Europe/Amsterdam"},
+		{XAMS, "Europe/Amsterdam"},
+		{ALXA, "Europe/Amsterdam"},
+		{XEUC, "Europe/Amsterdam"},
+		{XEUI, "Europe/Amsterdam"},
+		{TNLA, "Europe/Amsterdam"},
+		{XHFT, "Europe/Amsterdam"},
+		{XEUE, "Europe/Amsterdam"},
+		{DAMS, "Europe/Amsterdam"},
+		{XEMS, "Europe/Amsterdam"},
+		{XFTA, "Europe/Amsterdam"},
+		{STXS, "Europe/Amsterdam"},
+		{CBAE, "Europe/Amsterdam"},
+		{LEBV, "Europe/Amsterdam"},
+		{GMGE, "Europe/Amsterdam"},
+		{D2XG, "Europe/Amsterdam"},
+		{D2XC, "Europe/Amsterdam"},
+		{XTRN, "America/Port_of_Spain"},
+		{XTUN, "Africa/Tunis"},
+		{BTUN, "Africa/Tunis"},
+		{TMEX, "Europe/Istanbul"},
+		{EWRM, "Europe/Istanbul"},
+		{EXTR, "Europe/Istanbul"},
+		{XEDA, "Europe/Istanbul"},
+		{XEID, "Europe/Istanbul"},
+		{XIAB, "Europe/Istanbul"},
+		{XIST, "Europe/Istanbul"},
+		{XPMS, "Europe/Istanbul"},
+		{XFNO, "Europe/Istanbul"},
+		{XDSM, "Europe/Istanbul"},
+		{XEQY, "Europe/Istanbul"},
+		{XTUR, "Europe/Istanbul"},
+		{ULTX, "Africa/Kampala"},
+		{XUGA, "Africa/Kampala"},
+		{UICE, "Europe/Kiev"},
+		{XNDU, "Europe/Kiev"},
+		{EESE, "Europe/Kiev"},
+		{PFTS, "Europe/Kiev"},
+		{PFTQ, "Europe/Kiev"},
+		{SEPE, "Europe/Kiev"},
+		{UKEX, "Europe/Kiev"},
+		{XDFB, "Europe/Kiev"},
+		{XKHR, "Europe/Kiev"},
+		{XKIE, "Europe/Kiev"},
+		{XKIS, "Europe/Kiev"},
+		{XODE, "Europe/Kiev"},
+		{XPRI, "Europe/Kiev"},
+		{XUAX, "Europe/Kiev"},
+		{XUKR, "Europe/Kiev"},
+		{MIDC, "Asia/Dubai"},
+		{DEXE, "Asia/Dubai"},
+		{GLOM, "Asia/Dubai"},
+		{IFAD, "Asia/Dubai"},
+		{GMGD, "Asia/Dubai"},
+		{DIFX, "Asia/Dubai"},
+		{DGCX, "Asia/Dubai"},
+		{DUMX, "Asia/Dubai"},
+		{KRME, "Asia/Dubai"},
+		{MATX, "Asia/Dubai"},
+		{XADS, "Asia/Dubai"},
+		{XDFM, "Asia/Dubai"},
+		{VMEX, "Asia/Dubai"},
+		{ABXX, "Asia/Dubai"},
+		{ACXL, "Asia/Dubai"},
+		{ACXC, "Asia/Dubai"},
+		{RFIM, "Asia/Dubai"},
+		{XGGI, "Asia/Dubai"},
+		{DRSP, "Europe/London"},
+		{ZODM, "Europe/London"},
+		{TRUK, "Europe/London"},
+		{UGEN, "Europe/London"},
+		{IOTF, "Europe/London"},
+		{ILCM, "Europe/London"},
+		{IUOB, "Europe/London"},
+		{IOFB, "Europe/London"},
+		{IOFI, "Europe/London"},
+		{IOFX, "Europe/London"},
+		{IOGB, "Europe/London"},
+		{IOCD, "Europe/London"},
+		{IOED, "Europe/London"},
+		{IOGI, "Europe/London"},
+		{IOIR, "Europe/London"},
+		{IOMM, "Europe/London"},
+		{XUBS, "Europe/London"},
+		{XUMP, "Europe/London"},
+		{DBIX, "Europe/London"},
+		{DBDC, "Europe/London"},
+		{DBSE, "Europe/London"},
+		{DBCX, "Europe/London"},
+		{DBCR, "Europe/London"},
+		{UNGB, "Europe/London"},
+		{CFIC, "Europe/London"},
+		{TRAX, "Europe/London"},
+		{SIFX, "Europe/London"},
+		{ARAX, "Europe/London"},
+		{RABL, "Europe/London"},
+		{NTUK, "Europe/London"},
+		{EVOL, "Europe/London"},
+		{BSPL, "Europe/London"},
+		{BNPL, "Europe/London"},
+		{MSBI, "Europe/London"},
+		{MBSI, "Europe/London"},
+		{AQSE, "Europe/London"},
+		{AQST, "Europe/London"},
+		{AQSL, "Europe/London"},
+		{AQSN, "Europe/London"},
+		{AQSG, "Europe/London"},
+		{AQSF, "Europe/London"},
+		{AQSD, "Europe/London"},
+		{LELE, "Europe/London"},
+		{WFLB, "Europe/London"},
+		{NBFL, "Europe/London"},
+		{NEXX, "Europe/London"},
+		{NEXT, "Europe/London"},
+		{NEXN, "Europe/London"},
+		{NEXF, "Europe/London"},
+		{NEXG, "Europe/London"},
+		{NEXL, "Europe/London"},
+		{NEXD, "Europe/London"},
+		{MAXD, "Europe/London"},
+		{SKYX, "Europe/London"},
+		{XLCH, "Europe/London"},
+		{CLCH, "Europe/London"},
+		{BUYN, "Europe/London"},
+		{DAUK, "Europe/London"},
+		{BSLB, "Europe/London"},
+		{RBCM, "Europe/London"},
+		{VFGB, "Europe/London"},
+		{VFUK, "Europe/London"},
+		{VIUK, "Europe/London"},
+		{XPOL, "Europe/London"},
+		{XPAL, "Europe/London"},
+		{BBSX, "Europe/London"},
+		{JLSI, "Europe/London"},
+		{SCOT, "Europe/London"},
+		{XSGB, "Europe/London"},
+		{VAGM, "Europe/London"},
+		{BMCM, "Europe/London"},
+		{STFL, "Europe/London"},
+		{XALT, "Europe/London"},
+		{ARCH, "Europe/London"},
+		{ARDA, "Europe/London"},
+		{SNUK, "Europe/London"},
+		{CGMG, "Europe/London"},
+		{FNUK, "Europe/London"},
+		{CBNL, "Europe/London"},
+		{BGUK, "Europe/London"},
+		{BGFU, "Europe/London"},
+		{CLVE, "Europe/London"},
+		{CEPU, "Europe/London"},
+		{RTSL, "Europe/London"},
+		{TRFW, "Europe/London"},
+		{TRAL, "Europe/London"},
+		{ECNL, "Europe/London"},
+		{UBSB, "Europe/London"},
+		{UBSY, "Europe/London"},
+		{SSIL, "Europe/London"},
+		{BETX, "Europe/London"},
+		{BTLX, "Europe/London"},
+		{AQXE, "Europe/London"},
+		{EIXE, "Europe/London"},
+		{AQXA, "Europe/London"},
+		{AQXD, "Europe/London"},
+		{ICPM, "Europe/London"},
+		{UKRE, "Europe/London"},
+		{UKOR, "Europe/London"},
+		{IMSB, "Europe/London"},
+		{IMGI, "Europe/London"},
+		{IMRD, "Europe/London"},
+		{IMET, "Europe/London"},
+		{IMGB, "Europe/London"},
+		{IMMM, "Europe/London"},
+		{IMFD, "Europe/London"},
+		{IMCE, "Europe/London"},
+		{IMED, "Europe/London"},
+		{IMCM, "Europe/London"},
+		{IMCD, "Europe/London"},
+		{NCML, "Europe/London"},
+		{XPUK, "Europe/London"},
+		{STSI, "Europe/London"},
+		{MLIB, "Europe/London"},
+		{MHBL, "Europe/London"},
+		{EQLD, "Europe/London"},
+		{EQSL, "Europe/London"},
+		{BRGA, "Europe/London"},
+		{SPDX, "Europe/London"},
+		{ECSL, "Europe/London"},
+		{TPIS, "Europe/London"},
+		{TPMF, "Europe/London"},
+		{TPSY, "Europe/London"},
+		{TPMG, "Europe/London"},
+		{TPLF, "Europe/London"},
+		{CIBC, "Europe/London"},
+		{CIBP, "Europe/London"},
+		{ISSI, "Europe/London"},
+		{WSIL, "Europe/London"},
+		{MAKX, "Europe/London"},
+		{WSIN, "Europe/London"},
+		{WELN, "Europe/London"},
+		{BMLB, "Europe/London"},
+		{CBAL, "Europe/London"},
+		{MUSE, "Europe/London"},
+		{NABE, "Europe/London"},
+		{NABU, "Europe/London"},
+		{NABL, "Europe/London"},
+		{NABA, "Europe/London"},
+		{METZ, "Europe/London"},
+		{MUBL, "Europe/London"},
+		{SMBG, "Europe/London"},
+		{SMBE, "Europe/London"},
+		{MAQI, "Europe/London"},
+		{MAQU, "Europe/London"},
+		{NURO, "Europe/London"},
+		{XNLX, "Europe/London"},
+		{NURD, "Europe/London"},
+		{NOME, "Europe/London"},
+		{HRSI, "Europe/London"},
+		{R5FX, "Europe/London"},
+		{OCSI, "Europe/London"},
+		{LBCM, "Europe/London"},
+		{SBEX, "Europe/London"},
+		{VTBC, "Europe/London"},
+		{IPSX, "Europe/London"},
+		{IPXW, "Europe/London"},
+		{IPXP, "Europe/London"},
+		{ATLB, "Europe/London"},
+		{DAIW, "Europe/London"},
+		{IMTF, "Europe/London"},
+		{STAL, "Europe/London"},
+		{FXOP, "Europe/London"},
+		{TRDE, "Europe/London"},
+		{PFXD, "Europe/London"},
+		{TFSG, "Europe/London"},
+		{TCDS, "Europe/London"},
+		{NAVE, "Europe/London"},
+		{EMCH, "Europe/London"},
+		{VOLA, "Europe/London"},
+		{PARX, "Europe/London"},
+		{ELIX, "Europe/London"},
+		{TRDX, "Europe/London"},
+		{TFSS, "Europe/London"},
+		{DBVX, "Europe/London"},
+		{TFSC, "Europe/London"},
+		{OILX, "Europe/London"},
+		{TCME, "Europe/London"},
+		{TFSE, "Europe/London"},
+		{IGDL, "Europe/London"},
+		{ISWE, "Europe/London"},
+		{ISWV, "Europe/London"},
+		{JSSI, "Europe/London"},
+		{TWGP, "Europe/London"},
+		{GMGL, "Europe/London"},
+		{SEDR, "Europe/London"},
+		{ANTS, "Europe/London"},
+		{SGMX, "Europe/London"},
+		{SGMZ, "Europe/London"},
+		{SGMY, "Europe/London"},
+		{UBIN, "Europe/London"},
+		{CSLB, "Europe/London"},
+		{JISI, "Europe/London"},
+		{JEFS, "Europe/London"},
+		{GFIC, "Europe/London"},
+		{GFSM, "Europe/London"},
+		{GFSO, "Europe/London"},
+		{GFIF, "Europe/London"},
+		{GFIN, "Europe/London"},
+		{GFIR, "Europe/London"},
+		{XGFI, "Europe/London"},
+		{GFIM, "Europe/London"},
+		{MLXN, "Europe/London"},
+		{MLAX, "Europe/London"},
+		{MLVE, "Europe/London"},
+		{MLEU, "Europe/London"},
+		{JPCB, "Europe/London"},
+		{TDBL, "Europe/London"},
+		{ICEO, "Europe/London"},
+		{IECE, "Europe/London"},
+		{ISWA, "Europe/London"},
+		{ISWR, "Europe/London"},
+		{ISWC, "Europe/London"},
+		{ISWB, "Europe/London"},
+		{NWMS, "Europe/London"},
+		{RBCE, "Europe/London"},
+		{KBLM, "Europe/London"},
+		{XIEL, "Europe/London"},
+		{BRFQ, "Europe/London"},
+		{BNTW, "Europe/London"},
+		{BLOX, "Europe/London"},
+		{IOTC, "Europe/London"},
+		{BANA, "Europe/London"},
+		{BASI, "Europe/London"},
+		{BOAL, "Europe/London"},
+		{CSEC, "Europe/London"},
+		{MLIX, "Europe/London"},
+		{MLSI, "Europe/London"},
+		{MLRQ, "Europe/London"},
+		{SQUA, "Europe/London"},
+		{SSBT, "Europe/London"},
+		{SSFX, "Europe/London"},
+		{ARIA, "Europe/London"},
+		{DVFX, "Europe/London"},
+		{LOUI, "Europe/London"},
+		{CCEU, "Europe/London"},
+		{XTXM, "Europe/London"},
+		{VAGL, "Europe/London"},
+		{BCSL, "Europe/London"},
+		{BCSI, "Europe/London"},
+		{GFIB, "Europe/London"},
+		{GFBM, "Europe/London"},
+		{GFBO, "Europe/London"},
+		{SFCL, "Europe/London"},
+		{SUNB, "Europe/London"},
+		{SUNM, "Europe/London"},
+		{SUNO, "Europe/London"},
+		{TPSL, "Europe/London"},
+		{TSUK, "Europe/London"},
+		{TSMC, "Europe/London"},
+		{TSMG, "Europe/London"},
+		{TSMI, "Europe/London"},
+		{TSMB, "Europe/London"},
+		{TSMR, "Europe/London"},
+		{TPSO, "Europe/London"},
+		{TUOB, "Europe/London"},
+		{TEEG, "Europe/London"},
+		{TSCB, "Europe/London"},
+		{TSRE, "Europe/London"},
+		{TSCD, "Europe/London"},
+		{TSGB, "Europe/London"},
+		{TSFI, "Europe/London"},
+		{TSED, "Europe/London"},
+		{TSGI, "Europe/London"},
+		{TSMM, "Europe/London"},
+		{TSIR, "Europe/London"},
+		{TSFX, "Europe/London"},
+		{CGML, "Europe/London"},
+		{CGME, "Europe/London"},
+		{CGMC, "Europe/London"},
+		{CGMU, "Europe/London"},
+		{CGMT, "Europe/London"},
+		{MAQL, "Europe/London"},
+		{TPEL, "Europe/London"},
+		{TEMG, "Europe/London"},
+		{TEMC, "Europe/London"},
+		{TEFD, "Europe/London"},
+		{TEMF, "Europe/London"},
+		{TEMI, "Europe/London"},
+		{TIRD, "Europe/London"},
+		{TEMB, "Europe/London"},
+		{TEMR, "Europe/London"},
+		{TPEO, "Europe/London"},
+		{TEOF, "Europe/London"},
+		{TECO, "Europe/London"},
+		{IECL, "Europe/London"},
+		{TERE, "Europe/London"},
+		{TEFX, "Europe/London"},
+		{TEMM, "Europe/London"},
+		{TEGI, "Europe/London"},
+		{TEIR, "Europe/London"},
+		{TEGB, "Europe/London"},
+		{PVMF, "Europe/London"},
+		{LOYD, "Europe/London"},
+		{BOFS, "Europe/London"},
+		{BPLC, "Europe/London"},
+		{BBSI, "Europe/London"},
+		{BKLN, "Europe/London"},
+		{BKLF, "Europe/London"},
+		{FISU, "Europe/London"},
+		{PPEX, "Europe/London"},
+		{INVE, "Europe/London"},
+		{IFLS, "Europe/London"},
+		{EXOT, "Europe/London"},
+		{HSXE, "Europe/London"},
+		{ANZL, "Europe/London"},
+		{ANLP, "Europe/London"},
+		{ECHO, "Europe/London"},
+		{HSBC, "Europe/London"},
+		{STAN, "Europe/London"},
+		{VCMO, "Europe/London"},
+		{CSIN, "Europe/London"},
+		{CSSI, "Europe/London"},
+		{DOWG, "Europe/London"},
+		{AMPX, "Europe/London"},
+		{FXGB, "Europe/London"},
+		{TRSI, "Europe/London"},
+		{BTEE, "Europe/London"},
+		{BTQG, "Europe/London"},
+		{EBSX, "Europe/London"},
+		{EBSM, "Europe/London"},
+		{RBCT, "Europe/London"},
+		{RTSI, "Europe/London"},
+		{GSIB, "Europe/London"},
+		{BISI, "Europe/London"},
+		{MUFP, "Europe/London"},
+		{CCML, "Europe/London"},
+		{X3579, "Europe/London"},
+		{MHIP, "Europe/London"},
+		{WINS, "Europe/London"},
+		{WINX, "Europe/London"},
+		{TCML, "Europe/London"},
+		{FRTE, "Europe/London"},
+		{MUTI, "Europe/London"},
+		{NDCM, "Europe/London"},
+		{SPEC, "Europe/London"},
+		{BCRM, "Europe/London"},
+		{BART, "Europe/London"},
+		{BARO, "Europe/London"},
+		{BARK, "Europe/London"},
+		{MFXC, "Europe/London"},
+		{MFXR, "Europe/London"},
+		{MFXA, "Europe/London"},
+		{NOSI, "Europe/London"},
+		{AUTX, "Europe/London"},
+		{AUTB, "Europe/London"},
+		{AUTP, "Europe/London"},
+		{NEXS, "Europe/London"},
+		{EBSO, "Europe/London"},
+		{REST, "Europe/London"},
+		{BALT, "Europe/London"},
+		{BLTX, "Europe/London"},
+		{PEEL, "Europe/London"},
+		{XRSP, "Europe/London"},
+		{XPHX, "Europe/London"},
+		{PHSI, "Europe/London"},
+		{CAZE, "Europe/London"},
+		{JPSI, "Europe/London"},
+		{CSEU, "Europe/London"},
+		{SICS, "Europe/London"},
+		{CSBX, "Europe/London"},
+		{CSCF, "Europe/London"},
+		{CSFB, "Europe/London"},
+		{GRIF, "Europe/London"},
+		{GRIO, "Europe/London"},
+		{LMEC, "Europe/London"},
+		{KMTS, "Europe/London"},
+		{ICEU, "Europe/London"},
+		{FXMT, "Europe/London"},
+		{QMTS, "Europe/London"},
+		{XEDX, "Europe/London"},
+		{CRDL, "Europe/London"},
+		{BAIK, "Europe/London"},
+		{UMTS, "Europe/London"},
+		{XPLU, "Europe/London"},
+		{AFDL, "Europe/London"},
+		{BAPA, "Europe/London"},
+		{BCXE, "Europe/London"},
+		{BATE, "Europe/London"},
+		{BATF, "Europe/London"},
+		{BATD, "Europe/London"},
+		{CHIY, "Europe/London"},
+		{CHIO, "Europe/London"},
+		{BATP, "Europe/London"},
+		{CHID, "Europe/London"},
+		{BOTC, "Europe/London"},
+		{LISX, "Europe/London"},
+		{CHIX, "Europe/London"},
+		{BGCI, "Europe/London"},
+		{BGCM, "Europe/London"},
+		{BGCO, "Europe/London"},
+		{BGCB, "Europe/London"},
+		{BMTF, "Europe/London"},
+		{BOAT, "Europe/London"},
+		{BOSC, "Europe/London"},
+		{BRNX, "Europe/London"},
+		{CCO2, "Europe/London"},
+		{CHEV, "Europe/London"},
+		{BLNK, "Europe/London"},
+		{CMEE, "Europe/London"},
+		{CMED, "Europe/London"},
+		{CMEC, "Europe/London"},
+		{CMMT, "Europe/London"},
+		{CRYP, "Europe/London"},
+		{EMBX, "Europe/London"},
+		{ENCL, "Europe/London"},
+		{EXEU, "Europe/London"},
+		{EXSI, "Europe/London"},
+		{EXOR, "Europe/London"},
+		{EXVP, "Europe/London"},
+		{EXMP, "Europe/London"},
+		{EXLP, "Europe/London"},
+		{EXCP, "Europe/London"},
+		{EXBO, "Europe/London"},
+		{EXDC, "Europe/London"},
+		{FAIR, "Europe/London"},
+		{GEMX, "Europe/London"},
+		{GFIA, "Europe/London"},
+		{GMEG, "Europe/London"},
+		{XGDX, "Europe/London"},
+		{XLDX, "Europe/London"},
+		{XGCX, "Europe/London"},
+		{XGSX, "Europe/London"},
+		{GRSE, "Europe/London"},
+		{GSIL, "Europe/London"},
+		{GSBX, "Europe/London"},
+		{GSSI, "Europe/London"},
+		{IBAL, "Europe/London"},
+		{ICAP, "Europe/London"},
+		{ICEN, "Europe/London"},
+		{ISDX, "Europe/London"},
+		{WCLK, "Europe/London"},
+		{ICAH, "Europe/London"},
+		{ICSE, "Europe/London"},
+		{ICTQ, "Europe/London"},
+		{PLSX, "Europe/London"},
+		{IFEU, "Europe/London"},
+		{CXOT, "Europe/London"},
+		{CXRT, "Europe/London"},
+		{IFLX, "Europe/London"},
+		{IFLL, "Europe/London"},
+		{IFEN, "Europe/London"},
+		{IFLO, "Europe/London"},
+		{IFUT, "Europe/London"},
+		{KLEU, "Europe/London"},
+		{LCUR, "Europe/London"},
+		{LIQU, "Europe/London"},
+		{LIQH, "Europe/London"},
+		{LIQF, "Europe/London"},
+		{LMAX, "Europe/London"},
+		{LMAO, "Europe/London"},
+		{LMAE, "Europe/London"},
+		{LMAF, "Europe/London"},
+		{LMAD, "Europe/London"},
+		{LOTC, "Europe/London"},
+		{PLDX, "Europe/London"},
+		{LPPM, "Europe/London"},
+		{MAEL, "Europe/London"},
+		{MXLM, "Europe/London"},
+		{RFQU, "Europe/London"},
+		{MCUR, "Europe/London"},
+		{MCXR, "Europe/London"},
+		{MCXS, "Europe/London"},
+		{MFGL, "Europe/London"},
+		{MSIP, "Europe/London"},
+		{MSSI, "Europe/London"},
+		{MYTR, "Europe/London"},
+		{N2EX, "Europe/London"},
+		{NOFF, "Europe/London"},
+		{NXEU, "Europe/London"},
+		{NYMX, "Europe/London"},
+		{OFEX, "Europe/London"},
+		{OTCE, "Europe/London"},
+		{PIEU, "Europe/London"},
+		{PIRM, "Europe/London"},
+		{QWIX, "Europe/London"},
+		{RBSX, "Europe/London"},
+		{SECF, "Europe/London"},
+		{SHAR, "Europe/London"},
+		{SLXT, "Europe/London"},
+		{SPRZ, "Europe/London"},
+		{SSEX, "Europe/London"},
+		{SWAP, "Europe/London"},
+		{TFSV, "Europe/London"},
+		{TPIE, "Europe/London"},
+		{TPIM, "Europe/London"},
+		{TREU, "Europe/London"},
+		{TREA, "Europe/London"},
+		{TREO, "Europe/London"},
+		{TRQX, "Europe/London"},
+		{TRQS, "Europe/London"},
+		{TRQC, "Europe/London"},
+		{TRQM, "Europe/London"},
+		{TRQB, "Europe/London"},
+		{TRQA, "Europe/London"},
+		{TRQD, "Europe/London"},
+		{UKPX, "Europe/London"},
+		{VEGA, "Europe/London"},
+		{XCOR, "Europe/London"},
+		{XGCL, "Europe/London"},
+		{XIPE, "Europe/London"},
+		{XJWY, "Europe/London"},
+		{XLBM, "Europe/London"},
+		{XLCE, "Europe/London"},
+		{XLDN, "Europe/London"},
+		{TNLL, "Europe/London"},
+		{XSMP, "Europe/London"},
+		{ENSY, "Europe/London"},
+		{XLIF, "Europe/London"},
+		{XLME, "Europe/London"},
+		{XLON, "Europe/London"},
+		{XLOD, "Europe/London"},
+		{AIMX, "Europe/London"},
+		{XLOM, "Europe/London"},
+		{XLTO, "Europe/London"},
+		{XMLX, "Europe/London"},
+		{XMTS, "Europe/London"},
+		{MCZK, "Europe/London"},
+		{HUNG, "Europe/London"},
+		{EMTS, "Europe/London"},
+		{MTSA, "Europe/London"},
+		{GMTS, "Europe/London"},
+		{MTSG, "Europe/London"},
+		{IMTS, "Europe/London"},
+		{RMTS, "Europe/London"},
+		{AMTS, "Europe/London"},
+		{PORT, "Europe/London"},
+		{SLKK, "Europe/London"},
+		{VMTS, "Europe/London"},
+		{SMTS, "Europe/London"},
+		{UKGD, "Europe/London"},
+		{MTSS, "Europe/London"},
+		{MTSW, "Europe/London"},
+		{PRME, "Europe/London"},
+		{CMTS, "Europe/London"},
+		{TMTS, "Europe/London"},
+		{LMTS, "Europe/London"},
+		{EACM, "Europe/London"},
+		{BVUK, "Europe/London"},
+		{NMTS, "Europe/London"},
+		{USWP, "Europe/London"},
+		{XSWB, "Europe/London"},
+		{XTFN, "Europe/London"},
+		{XTUP, "Europe/London"},
+		{TPCD, "Europe/London"},
+		{TBLA, "Europe/London"},
+		{TPFD, "Europe/London"},
+		{TPSP, "Europe/London"},
+		{TPSD, "Europe/London"},
+		{TPRE, "Europe/London"},
+		{TPEQ, "Europe/London"},
+		{XTPE, "Europe/London"},
+		{TBEN, "Europe/London"},
+		{UKCA, "Europe/London"},
+		{XEBS, "Europe/London"},
+		{GFOX, "Europe/London"},
+		{BBVX, "Europe/London"},
+		{HPSX, "Europe/London"},
+		{HPSO, "Europe/London"},
+		{CMCM, "Europe/London"},
+		{TPID, "Europe/London"},
+		{TPDA, "Europe/London"},
+		{INGU, "Europe/London"},
+		{SISU, "Europe/London"},
+		{OTXT, "Europe/London"},
+		{TMUK, "Europe/London"},
+		{ALGO, "Europe/London"},
+		{GSLO, "Europe/London"},
+		{G360, "Europe/London"},
+		{AFTS, "Europe/London"},
+		{ACCX, "Europe/London"},
+		{JPJX, "Europe/London"},
+		{SFMP, "Europe/London"},
+		{PUND, "America/New_York"},
+		{XOCH, "America/Chicago"},
+		{OTCM, "America/New_York"},
+		{EXPM, "America/New_York"},
+		{CAVE, "America/New_York"},
+		{OTCB, "America/New_York"},
+		{PINL, "America/New_York"},
+		{PINI, "America/New_York"},
+		{PINX, "America/New_York"},
+		{OTCQ, "America/New_York"},
+		{PSGM, "America/New_York"},
+		{PINC, "America/New_York"},
+		{FREX, "America/Chicago"},
+		{IDXM, "America/New_York"},
+		{OCEA, "America/New_York"},
+		{GFAM, "America/New_York"},
+		{FNIX, "America/New_York"},
+		{LATG, "America/New_York"},
+		{UBEC, "America/New_York"},
+		{AMLG, "America/Los_Angeles"},
+		{KLSH, "America/New_York"},
+		{LEVL, "America/New_York"},
+		{EBXV, "America/New_York"},
+		{STRM, "America/New_York"},
+		{BNPC, "America/New_York"},
+		{CGXS, "America/New_York"},
+		{EQUS, "America/New_York"},
+		{XTXD, "America/New_York"},
+		{BSTX, "America/New_York"},
+		{CCMX, "America/New_York"},
+		{XCBO, "America/Chicago"},
+		{CONE, "America/Chicago"},
+		{CTWO, "America/Chicago"},
+		{C2OX, "America/Chicago"},
+		{EDGA, "America/Chicago"},
+		{EDGD, "America/Chicago"},
+		{EDGO, "America/Chicago"},
+		{EDGX, "America/Chicago"},
+		{BATS, "America/Chicago"},
+		{BATY, "America/Chicago"},
+		{BYXD, "America/Chicago"},
+		{BZXD, "America/Chicago"},
+		{EDDP, "America/Chicago"},
+		{BATO, "America/Chicago"},
+		{CBSX, "America/Chicago"},
+		{XCBF, "America/Chicago"},
+		{XCBD, "America/Chicago"},
+		{CLST, "America/New_York"},
+		{FAST, "America/New_York"},
+		{MEMX, "America/New_York"},
+		{MEMD, "America/New_York"},
+		{MEMM, "America/New_York"},
+		{MXOP, "America/New_York"},
+		{PIPR, "America/New_York"},
+		{PJCX, "America/New_York"},
+		{TRAI, "America/New_York"},
+		{COHR, "America/Chicago"},
+		{STFU, "America/New_York"},
+		{STFX, "America/New_York"},
+		{ATDF, "America/New_York"},
+		{LTSE, "America/Los_Angeles"},
+		{CALH, "America/New_York"},
+		{THRE, "America/New_York"},
+		{FXPS, "America/New_York"},
+		{FXNM, "America/New_York"},
+		{WEED, "America/New_York"},
+		{XWEE, "America/New_York"},
+		{BKKT, "America/New_York"},
+		{XPUS, "America/New_York"},
+		{JLQD, "America/Chicago"},
+		{JLEQ, "America/Chicago"},
+		{LAMP, "America/New_York"},
+		{RCMA, "America/New_York"},
+		{ERIS, "America/Chicago"},
+		{YKNA, "America/Chicago"},
+		{DASH, "America/Chicago"},
+		{TRCX, "America/New_York"},
+		{CODA, "America/Chicago"},
+		{PDQX, "America/Chicago"},
+		{PDQD, "America/Chicago"},
+		{SAGE, "America/Los_Angeles"},
+		{JPMS, "America/New_York"},
+		{ARKX, "America/New_York"},
+		{PUMA, "America/New_York"},
+		{PUMX, "America/New_York"},
+		{FTUS, "America/New_York"},
+		{CPGX, "America/New_York"},
+		{NLAX, "America/New_York"},
+		{DBAB, "America/New_York"},
+		{OTCN, "America/New_York"},
+		{SCXO, "America/Chicago"},
+		{ZERO, "America/Chicago"},
+		{SCXS, "America/Chicago"},
+		{SCXM, "America/Chicago"},
+		{SCXA, "America/Chicago"},
+		{SCXF, "America/Chicago"},
+		{SGAS, "America/New_York"},
+		{SGA2, "America/New_York"},
+		{SMFE, "America/Chicago"},
+		{BLUE, "America/New_York"},
+		{DWFI, "America/New_York"},
+		{XTRD, "America/New_York"},
+		{VUSA, "America/New_York"},
+		{VALX, "America/New_York"},
+		{VCRS, "America/New_York"},
+		{VFMI, "America/New_York"},
+		{WELX, "America/New_York"},
+		{WELS, "America/New_York"},
+		{HRTF, "America/New_York"},
+		{FICO, "America/Los_Angeles"},
+		{XFCI, "America/Los_Angeles"},
+		{XFDA, "America/Los_Angeles"},
+		{HRTX, "America/New_York"},
+		{INCR, "America/New_York"},
+		{ASPI, "America/New_York"},
+		{ASMT, "America/New_York"},
+		{ASPN, "America/New_York"},
+		{NTRL, "America/New_York"},
+		{TSBX, "America/New_York"},
+		{MAGM, "America/New_York"},
+		{UBSA, "America/New_York"},
+		{XPIN, "America/New_York"},
+		{UBSP, "America/New_York"},
+		{UBSS, "America/New_York"},
+		{PULX, "America/New_York"},
+		{BAMX, "America/New_York"},
+		{XBOX, "America/New_York"},
+		{GLMX, "America/New_York"},
+		{CURX, "America/New_York"},
+		{BTNL, "America/Chicago"},
+		{SUNT, "America/Chicago"},
+		{ADVT, "America/New_York"},
+		{IMCS, "America/Chicago"},
+		{THEM, "America/New_York"},
+		{LEDG, "America/New_York"},
+		{FUSD, "America/New_York"},
+		{MTUS, "America/New_York"},
+		{BVUS, "America/New_York"},
+		{MTSB, "America/New_York"},
+		{GOTC, "America/Chicago"},
+		{VERT, "America/New_York"},
+		{MTXX, "America/New_York"},
+		{MKAA, "America/New_York"},
+		{MTXA, "America/New_York"},
+		{MTXS, "America/New_York"},
+		{MTXC, "America/New_York"},
+		{MTXM, "America/New_York"},
+		{VIRT, "America/New_York"},
+		{CGMI, "America/New_York"},
+		{ONEC, "America/New_York"},
+		{CORE, "America/New_York"},
+		{CICX, "America/New_York"},
+		{CBLC, "America/New_York"},
+		{CIOI, "America/New_York"},
+		{LQFI, "America/New_York"},
+		{LQED, "America/New_York"},
+		{TSAD, "America/New_York"},
+		{JPBX, "America/New_York"},
+		{JSEF, "America/New_York"},
+		{EDGE, "America/Chicago"},
+		{IEXG, "America/New_York"},
+		{IEXD, "America/New_York"},
+		{IEXC, "America/New_York"},
+		{ICUS, "America/New_York"},
+		{XBMK, "America/New_York"},
+		{XEUS, "America/Chicago"},
+		{XNYF, "America/New_York"},
+		{BAMP, "America/New_York"},
+		{AATS, "America/New_York"},
+		{AQUA, "America/New_York"},
+		{BAML, "America/New_York"},
+		{MLCO, "America/New_York"},
+		{MLVX, "America/New_York"},
+		{BARX, "America/New_York"},
+		{BBOK, "America/New_York"},
+		{BCDX, "America/New_York"},
+		{BARL, "America/New_York"},
+		{BARD, "America/New_York"},
+		{BBSF, "America/New_York"},
+		{BBSN, "America/New_York"},
+		{BGCF, "America/New_York"},
+		{FNFX, "America/New_York"},
+		{BGCD, "America/New_York"},
+		{FNCS, "America/New_York"},
+		{FNFT, "America/New_York"},
+		{FNXB, "America/New_York"},
+		{BHSF, "America/New_York"},
+		{BIDS, "America/New_York"},
+		{BPOL, "America/New_York"},
+		{BLTD, "America/New_York"},
+		{BNYC, "America/New_York"},
+		{VTEX, "America/New_York"},
+		{NYFX, "America/New_York"},
+		{BTEC, "America/New_York"},
+		{BTEQ, "America/New_York"},
+		{ICSU, "America/New_York"},
+		{CCFE, "America/Chicago"},
+		{CDED, "America/New_York"},
+		{CDEL, "America/New_York"},
+		{CMSF, "America/New_York"},
+		{CRED, "America/New_York"},
+		{CSLP, "America/New_York"},
+		{CSCL, "America/New_York"},
+		{CSVW, "America/New_York"},
+		{CAES, "America/New_York"},
+		{DBSX, "America/New_York"},
+		{DEAL, "America/New_York"},
+		{EGMT, "America/New_York"},
+		{FINR, "America/New_York"},
+		{FINN, "America/New_York"},
+		{XADF, "America/New_York"},
+		{FINC, "America/New_York"},
+		{FINO, "America/New_York"},
+		{FINY, "America/New_York"},
+		{OOTC, "America/New_York"},
+		{FSEF, "America/New_York"},
+		{FXAL, "America/New_York"},
+		{FXCM, "America/New_York"},
+		{G1XX, "America/Chicago"},
+		{GLLC, "America/New_York"},
+		{GLPS, "America/Chicago"},
+		{GLPX, "America/New_York"},
+		{GOVX, "America/New_York"},
+		{GREE, "America/Chicago"},
+		{GSCO, "America/New_York"},
+		{SGMT, "America/New_York"},
+		{GSEF, "America/New_York"},
+		{GTCO, "America/New_York"},
+		{GTSX, "America/New_York"},
+		{GTXS, "America/New_York"},
+		{HEGX, "America/Chicago"},
+		{HPPO, "America/New_York"},
+		{HSFX, "America/New_York"},
+		{ICEL, "America/New_York"},
+		{IFUS, "America/New_York"},
+		{TMCC, "America/New_York"},
+		{VABD, "America/New_York"},
+		{IMCC, "America/New_York"},
+		{IMFX, "America/New_York"},
+		{IFED, "America/New_York"},
+		{IEPA, "America/New_York"},
+		{IMCG, "America/New_York"},
+		{IMIR, "America/New_York"},
+		{IMCR, "America/New_York"},
+		{IMEN, "America/New_York"},
+		{ICES, "America/New_York"},
+		{IMAG, "America/New_York"},
+		{IMBD, "America/New_York"},
+		{ISDA, "America/New_York"},
+		{ITGI, "America/New_York"},
+		{JEFX, "America/New_York"},
+		{JNST, "America/New_York"},
+		{JPMX, "America/New_York"},
+		{JSES, "America/New_York"},
+		{JSJX, "America/New_York"},
+		{KNIG, "America/New_York"},
+		{KNMX, "America/New_York"},
+		{ACKF, "America/New_York"},
+		{KNEM, "America/New_York"},
+		{KNLI, "America/New_York"},
+		{KNCM, "America/New_York"},
+		{LASF, "America/New_York"},
+		{LAVA, "America/New_York"},
+		{LAFX, "America/New_York"},
+		{LAFL, "America/New_York"},
+		{LAFD, "America/New_York"},
+		{LIUS, "America/New_York"},
+		{LIUH, "America/New_York"},
+		{LIFI, "America/New_York"},
+		{LTAA, "America/New_York"},
+		{LMNX, "America/New_York"},
+		{MIHI, "America/New_York"},
+		{EPRD, "America/New_York"},
+		{EMLD, "America/New_York"},
+		{EPRL, "America/New_York"},
+		{MPRL, "America/New_York"},
+		{XMIO, "America/New_York"},
+		{SPHR, "America/New_York"},
+		{MSCO, "America/New_York"},
+		{MSRP, "America/New_York"},
+		{MSPL, "America/New_York"},
+		{MSTC, "America/New_York"},
+		{MSTX, "America/New_York"},
+		{MSLP, "America/New_York"},
+		{MSLC, "America/New_York"},
+		{NBLX, "America/New_York"},
+		{NFSC, "America/New_York"},
+		{NFSD, "America/New_York"},
+		{XSTM, "America/New_York"},
+		{NFSA, "America/New_York"},
+		{NMRA, "America/New_York"},
+		{NODX, "America/New_York"},
+		{NXUS, "America/New_York"},
+		{NYPC, "America/New_York"},
+		{OLLC, "America/New_York"},
+		{OPRA, "America/Chicago"},
+		{PIPE, "America/New_York"},
+		{PRSE, "America/New_York"},
+		{RICX, "America/New_York"},
+		{RICD, "America/New_York"},
+		{SGMA, "America/New_York"},
+		{SHAW, "America/New_York"},
+		{SHAD, "America/New_York"},
+		{SIGX, "America/New_York"},
+		{SOHO, "America/New_York"},
+		{SSTX, "America/New_York"},
+		{TERA, "America/New_York"},
+		{TFSU, "America/New_York"},
+		{TMID, "America/New_York"},
+		{TPSE, "America/New_York"},
+		{TPSV, "America/New_York"},
+		{TPSB, "America/New_York"},
+		{TRCK, "America/New_York"},
+		{TRUX, "America/New_York"},
+		{TRU2, "America/New_York"},
+		{TRU1, "America/New_York"},
+		{TRWB, "America/New_York"},
+		{BNDD, "America/New_York"},
+		{TWSF, "America/New_York"},
+		{DWSF, "America/New_York"},
+		{TRFX, "America/New_York"},
+		{TSEF, "America/New_York"},
+		{VFCM, "America/New_York"},
+		{WSAG, "America/New_York"},
+		{VNDM, "America/New_York"},
+		{WABR, "America/New_York"},
+		{XAQS, "America/New_York"},
+		{XARC, "America/Chicago"},
+		{XAZX, "America/Phoenix"},
+		{XBTF, "America/New_York"},
+		{XCBT, "America/Chicago"},
+		{FCBT, "America/Chicago"},
+		{XKBT, "America/Chicago"},
+		{XCCX, "America/Chicago"},
+		{XCFF, "America/New_York"},
+		{XCME, "America/Chicago"},
+		{FCME, "America/Chicago"},
+		{XIMM, "America/Chicago"},
+		{GLBX, "America/Chicago"},
+		{XIOM, "America/Chicago"},
+		{CMES, "America/Chicago"},
+		{CBTS, "America/Chicago"},
+		{NYMS, "America/New_York"},
+		{CECS, "America/New_York"},
+		{XCRC, "America/Chicago"},
+		{XCSC, "America/New_York"},
+		{XCUR, "America/New_York"},
+		{XELX, "America/New_York"},
+		{XGMX, "America/Puerto_Rico"},
+		{XINS, "America/New_York"},
+		{BLKX, "America/New_York"},
+		{INCA, "America/New_York"},
+		{IIDX, "America/New_York"},
+		{IBLX, "America/New_York"},
+		{RCBX, "America/New_York"},
+		{ICRO, "America/New_York"},
+		{ICBX, "America/New_York"},
+		{MOCX, "America/New_York"},
+		{XISX, "America/New_York"},
+		{XISE, "America/New_York"},
+		{GMNI, "America/New_York"},
+		{XTPZ, "America/New_York"},
+		{MCRY, "America/New_York"},
+		{XISA, "America/New_York"},
+		{XMAC, "America/Chicago"},
+		{XMER, "America/Chicago"},
+		{XMGE, "America/Chicago"},
+		{XMID, "America/Chicago"},
+		{XNAS, "America/New_York"},
+		{XNFI, "America/New_York"},
+		{ESPD, "America/New_York"},
+		{MELO, "America/New_York"},
+		{NASD, "America/New_York"},
+		{XNMS, "America/New_York"},
+		{XNDQ, "America/New_York"},
+		{XNGS, "America/New_York"},
+		{XNCM, "America/New_York"},
+		{XNIM, "America/New_York"},
+		{XBOS, "America/New_York"},
+		{BOSD, "America/New_York"},
+		{XBXO, "America/New_York"},
+		{XPOR, "America/New_York"},
+		{XPSX, "America/New_York"},
+		{XBRT, "America/New_York"},
+		{PSXD, "America/New_York"},
+		{XPBT, "America/New_York"},
+		{XPHO, "America/New_York"},
+		{XPHL, "America/New_York"},
+		{XNQL, "America/New_York"},
+		{XNYC, "America/New_York"},
+		{XNYM, "America/New_York"},
+		{XCEC, "America/New_York"},
+		{XNYE, "America/New_York"},
+		{XNYL, "America/New_York"},
+		{XNYS, "America/New_York"},
+		{CISD, "America/New_York"},
+		{XCHI, "America/Chicago"},
+		{XCIS, "America/New_York"},
+		{ALDP, "America/New_York"},
+		{ARCX, "America/New_York"},
+		{XASE, "America/New_York"},
+		{XNLI, "America/New_York"},
+		{NYSD, "America/New_York"},
+		{AMXO, "America/New_York"},
+		{ARCD, "America/New_York"},
+		{ARCO, "America/New_York"},
+		{XOTC, "America/New_York"},
+		{XPSE, "America/Los_Angeles"},
+		{XSEF, "America/New_York"},
+		{ASEF, "America/Chicago"},
+		{OTCI, "America/New_York"},
+		{SPTX, "America/New_York"},
+		{CAST, "America/Chicago"},
+		{USEF, "America/New_York"},
+		{SFOX, "America/Los_Angeles"},
+		{RAJA, "America/New_York"},
+		{LPSF, "America/New_York"},
+		{SPAX, "America/New_York"},
+		{LESI, "America/New_York"},
+		{INTL, "America/New_York"},
+		{U360, "America/New_York"},
+		{EDXM, "America/New_York"},
+		{IBKR, "America/New_York"},
+		{IATS, "America/New_York"},
+		{IEOS, "America/New_York"},
+		{RTXF, "America/New_York"},
+		{XIMX, "America/Chicago"},
+		{CFIM, "America/New_York"},
+		{BNDS, "America/New_York"},
+		{NPMS, "America/New_York"},
+		{OCTL, "America/New_York"},
+		{BNPH, "America/New_York"},
+		{DWIN, "America/New_York"},
+		{OCTC, "America/New_York"},
+		{IBCO, "America/New_York"},
+		{IEXA, "America/New_York"},
+		{RBCS, "America/New_York"},
+		{LAKE, "America/New_York"},
+		{UFEX, "America/Montevideo"},
+		{BVUR, "America/Montevideo"},
+		{XMNT, "America/Montevideo"},
+		{XCET, "Asia/Tashkent"},
+		{XCUE, "Asia/Tashkent"},
+		{XKCE, "Asia/Tashkent"},
+		{XSTE, "Asia/Tashkent"},
+		{XUNI, "Asia/Tashkent"},
+		{POTC, "Pacific/Efate"},
+		{GXMA, "Pacific/Efate"},
+		{BVCA, "America/Caracas"},
+		{XCAR, "America/Caracas"},
+		{HSTC, "Asia/Ho_Chi_Minh"},
+		{XHNF, "Asia/Ho_Chi_Minh"},
+		{XHNX, "Asia/Ho_Chi_Minh"},
+		{XSTC, "Asia/Ho_Chi_Minh"},
+		{XLUS, "Africa/Lusaka"},
+		{XZIM, "Africa/Harare"},
+		{VFEX, "Africa/Harare"},
+		{BILT, "UTC"},
+		{XOFF, "UTC"},
+		{XXXX, "UTC"},
+	}
+
+	for _, tt := range tests {
+		exp := tt.zone
+		act := tt.mic.TimeZone()
+
+		if exp != act {
+			t.Errorf("%v.TimeZone(): expected '%v', actual '%v'", tt.mic, exp, act)
+		}
+	}
+}
+
 //nolint:funlen
 func TestIsPredefined(t *testing.T) {
 	t.Parallel()
@@ -4170,882 +8059,1098 @@ func TestIsPredefined(t *testing.T) {
 		{XTIR, true},
 		{XALG, true},
 		{XBDV, true},
+		{XFTX, true},
+		{XA1X, true},
 		{BACE, true},
 		{BCFS, true},
 		{XMVL, true},
-		{XCNF, true},
 		{ROFX, true},
-		{XMAB, true},
-		{XBUE, true},
-		{XMEV, true},
 		{XBCC, true},
 		{MVCX, true},
 		{XBCM, true},
 		{XBCX, true},
+		{XBUE, true},
+		{XMEV, true},
+		{XCNF, true},
+		{XMAB, true},
 		{XMTB, true},
 		{XROS, true},
 		{XROX, true},
 		{XTUC, true},
 		{XARM, true},
+		{XLQC, true},
+		{XFEX, true},
+		{BLXA, true},
+		{APXL, true},
+		{CGMA, true},
+		{CSAU, true},
+		{CFAU, true},
+		{NECD, true},
 		{AWBX, true},
 		{AWEX, true},
-		{APXL, true},
+		{BLEV, true},
 		{CHIA, true},
-		{CXAF, true},
-		{CXAP, true},
-		{CXAW, true},
 		{CXAR, true},
 		{CXAC, true},
+		{CXAP, true},
+		{CXAM, true},
 		{CXAQ, true},
 		{CXAV, true},
-		{CXAM, true},
+		{CXAF, true},
 		//nolint:misspell
 		{CXAN, true},
+		{CXAW, true},
+		{CXAB, true},
+		{CXAI, true},
+		{CLAU, true},
 		{MAQX, true},
 		{MACB, true},
-		{CSAU, true},
-		{CFAU, true},
+		{MEAU, true},
 		{MSAL, true},
-		{XYIE, true},
-		{CLAU, true},
-		{XNEC, true},
+		{NSXB, true},
+		{SIGA, true},
+		{SIMV, true},
+		{XAOM, true},
 		{XASX, true},
+		{NZFX, true},
 		{ASXC, true},
-		{ASXP, true},
 		{ASXT, true},
+		{ASXB, true},
 		{ASXV, true},
+		{ASXP, true},
 		{XSFE, true},
-		{ASXB, true},
-		{SIMV, true},
-		{XFEX, true},
-		{SIGA, true},
-		{NSXB, true},
-		{CGMA, true},
-		{BLXA, true},
-		{BLEV, true},
-		{XLQC, true},
+		{XNEC, true},
+		{XYIE, true},
+		{GFAU, true},
+		{BGCA, true},
+		{POTL, true},
+		{LLAT, true},
+		{UCBA, true},
+		{SLHB, true},
 		{BKSK, true},
+		{APAW, true},
+		{OBKL, true},
+		{RBIV, true},
+		{RLBO, true},
 		{XRCB, true},
 		{EGSI, true},
-		{RBIV, true},
-		{APAW, true},
+		{XOTB, true},
 		{XWBO, true},
+		{WBGF, true},
+		{XCEG, true},
 		{EXAA, true},
-		{WBDM, true},
 		{WBAH, true},
+		{WBDM, true},
 		{XVIE, true},
-		{RLBO, true},
-		{UCBA, true},
-		{OBKL, true},
-		{SLHB, true},
-		{LLAT, true},
+		{FPWB, true},
+		{RVSA, true},
 		{BSEX, true},
 		{XIBE, true},
 		{XBAA, true},
 		{BFEX, true},
 		{XBAH, true},
-		{XDHA, true},
 		{XCHG, true},
+		{XDHA, true},
 		{XBAB, true},
-		{BAJM, true},
 		{XBIS, true},
+		{BAJM, true},
 		{BCSE, true},
-		{BLPX, true},
+		{SMBB, true},
 		{KBCB, true},
+		{BELB, true},
+		{BELF, true},
+		{BNPF, true},
 		{BKBR, true},
 		{BKBF, true},
+		{BEAM, true},
+		{MTSD, true},
+		{MTSF, true},
+		{BMTS, true},
+		{BLPX, true},
+		{FRRF, true},
+		{XANT, true},
+		{XBFO, true},
 		{XBRU, true},
+		{TNLK, true},
+		{ENXB, true},
 		{ALXB, true},
 		{MLXB, true},
-		{TNLB, true},
 		{VPXB, true},
+		{TNLB, true},
 		{XBRD, true},
-		{TNLK, true},
-		{BEAM, true},
-		{BMTS, true},
-		{MTSD, true},
-		{MTSF, true},
-		{SMBB, true},
-		{BELB, true},
-		{BELF, true},
-		{BNPF, true},
-		{XBDA, true},
+		{DBRU, true},
 		{X24EX, true},
 		{GTXE, true},
+		{XBDA, true},
 		{XBOL, true},
 		{XBLB, true},
 		{BLBF, true},
 		{XSSE, true},
 		{XBOT, true},
-		{BOTV, true},
 		{BOTE, true},
+		{BOTV, true},
+		{XBMF, true},
+		{XBSP, true},
 		{BCMM, true},
 		{BOVM, true},
 		{BRIX, true},
 		{BVMF, true},
 		{CETI, true},
 		{SELC, true},
+		{XBBF, true},
+		{XBVP, true},
+		{XRIO, true},
+		{XSOM, true},
+		{UCBG, true},
+		{BEBG, true},
+		{T212, true},
+		{BDSK, true},
+		{BGHX, true},
+		{IBEX, true},
+		{MBUL, true},
+		{GMBG, true},
 		{XBUL, true},
-		{ABUL, true},
 		{ZBUL, true},
-		{GBUL, true},
 		{JBUL, true},
+		{GBUL, true},
 		{PBUL, true},
 		{IBUL, true},
-		{IBEX, true},
-		{MBUL, true},
-		{BDSK, true},
-		{T212, true},
-		{BEBG, true},
-		{BGHX, true},
+		{ABUL, true},
+		{LBUL, true},
+		{EUFN, true},
 		{XCSX, true},
 		{XDSX, true},
+		{XCNQ, true},
+		{PURE, true},
+		{CSE2, true},
+		{EQCA, true},
+		{NEOE, true},
+		{NEOD, true},
+		{NEON, true},
+		{NEOC, true},
+		{BNSX, true},
+		{TMXS, true},
+		{ATSA, true},
+		{CAND, true},
 		{CANX, true},
 		{CHIC, true},
 		{XCX2, true},
+		{COTC, true},
 		{IFCA, true},
+		{XWCE, true},
+		{IVZX, true},
 		{LICA, true},
 		{MATN, true},
-		{XTSX, true},
-		{XTNX, true},
-		{VDRK, true},
-		{NEOE, true},
-		{NEOC, true},
-		{NEON, true},
-		{NEOD, true},
-		{XCNQ, true},
-		{PURE, true},
-		{CAND, true},
+		{NGXC, true},
 		{OMGA, true},
 		{LYNX, true},
+		{XALB, true},
 		{XATS, true},
+		{XATX, true},
+		{ADRK, true},
 		{XBBK, true},
-		{XTSE, true},
-		{XDRK, true},
-		{NGXC, true},
-		{XICX, true},
-		{BNSX, true},
-		{COTC, true},
-		{IVZX, true},
 		{XCXD, true},
+		{XICX, true},
 		{XMOC, true},
 		{XMOD, true},
-		{EQCA, true},
+		{XMOO, true},
+		{XTFE, true},
+		{XTOE, true},
+		{XTSE, true},
+		{XDRK, true},
+		{XTSX, true},
+		{VDRK, true},
+		{XTNX, true},
+		{XVSE, true},
 		{XBVC, true},
 		{XCAY, true},
 		{BOVA, true},
 		{XBCL, true},
 		{XSGO, true},
+		{GSXC, true},
 		{CCFX, true},
 		{CSSX, true},
-		{XDCE, true},
+		{SGEX, true},
 		{XCFE, true},
 		{CFBC, true},
-		{SGEX, true},
+		{XDCE, true},
 		{XSGE, true},
 		{XINE, true},
 		{XSHE, true},
 		{XSEC, true},
 		{XSHG, true},
 		{XSSC, true},
-		{GSXC, true},
+		{XSME, true},
 		{XZCE, true},
+		{BJSE, true},
+		{NEEQ, true},
 		{XBOG, true},
 		{XBNV, true},
+		{XOTP, true},
 		{XCRO, true},
 		{XTRZ, true},
+		{XVAR, true},
 		{XZAG, true},
+		{XZAM, true},
 		{ZAPA, true},
 		{XZAP, true},
-		{XOTP, true},
 		{DCSX, true},
-		{XCYS, true},
-		{XCYO, true},
-		{XECM, true},
-		{BCSC, true},
-		{ATHL, true},
-		{MKAP, true},
+		{TMCY, true},
 		{ATLN, true},
+		{ATHL, true},
+		{BCSC, true},
 		{CFIF, true},
-		{SIBC, true},
-		{RENC, true},
 		{GPBC, true},
-		{TMCY, true},
+		{RENC, true},
+		{SIBC, true},
+		{MKAP, true},
+		{XCYS, true},
+		{XCYO, true},
+		{XECM, true},
+		{XMME, true},
+		{ETOR, true},
+		{CSAS, true},
+		{KOME, true},
+		{RFBK, true},
+		{UBCZ, true},
+		{CSOB, true},
+		{CELP, true},
+		{CESI, true},
 		{FTFS, true},
 		{FTFM, true},
+		{WOOD, true},
+		{XPRA, true},
+		{XPRM, true},
+		{STRT, true},
+		{SPAD, true},
 		{XPXE, true},
 		{XRMZ, true},
 		{XRMO, true},
-		{XPRA, true},
-		{XPRM, true},
-		{WOOD, true},
-		{RFBK, true},
-		{CSOB, true},
-		{CELP, true},
-		{CESI, true},
-		{KOME, true},
-		{CSAS, true},
-		{UBCZ, true},
+		{PATF, true},
+		{ATAD, true},
+		{GXGR, true},
+		{GXGM, true},
+		{GXGF, true},
+		{JYSI, true},
+		{ABSI, true},
+		{ALSI, true},
+		{SBSI, true},
+		{LASP, true},
+		{SKSI, true},
+		{NYSI, true},
+		{JBSI, true},
+		{SNSI, true},
+		{DAMP, true},
+		{DASI, true},
 		{DKTC, true},
+		{NPGA, true},
+		{SXSI, true},
 		{XCSE, true},
-		{DCSE, true},
 		{DSME, true},
-		{MCSE, true},
+		{DCSE, true},
 		{MNDK, true},
 		{FNDK, true},
 		{DNDK, true},
-		{NYSI, true},
-		{DASI, true},
-		{JBSI, true},
-		{ABSI, true},
-		{SBSI, true},
-		{NPGA, true},
-		{SKSI, true},
-		{SXSI, true},
-		{LASP, true},
-		{SNSI, true},
-		{JYSI, true},
-		{ALSI, true},
+		{MCSE, true},
+		{XFND, true},
+		{XTRA, true},
 		{XBVR, true},
+		{XCVD, true},
 		{XGUA, true},
 		{XQUI, true},
-		{XCAI, true},
 		{NILX, true},
+		{XCAI, true},
 		{XSVA, true},
+		{LMNR, true},
+		{SWEE, true},
+		{XTAR, true},
 		{XTAL, true},
+		{XTAA, true},
 		{FNEE, true},
-		{SWEE, true},
-		{LMNR, true},
 		{VMFX, true},
 		{XSPS, true},
-		{OPCO, true},
+		{AXSI, true},
 		{XNOR, true},
+		{OPCO, true},
 		{FGEX, true},
+		{XFOM, true},
 		{XHEL, true},
-		{MNFI, true},
-		{DHEL, true},
-		{DNFI, true},
 		{FSME, true},
+		{MNFI, true},
 		{FNFI, true},
+		{DNFI, true},
+		{DHEL, true},
 		{MHEL, true},
-		{GMTF, true},
-		{COAL, true},
-		{EPEX, true},
-		{FMTS, true},
+		{ESLO, true},
+		{ENSL, true},
+		{BRED, true},
+		{HBFR, true},
+		{RBCC, true},
+		{SGMU, true},
+		{SGMV, true},
+		{SGMW, true},
+		{WFSE, true},
+		{MKTF, true},
+		{AQEU, true},
+		{AQED, true},
+		{AQEA, true},
 		{GIPB, true},
 		{GSPX, true},
-		{XBLN, true},
-		{AACA, true},
+		{ELXE, true},
+		{TRXE, true},
+		{XTXE, true},
+		{GMES, true},
+		{GMEO, true},
+		{MHBP, true},
+		{TPIC, true},
+		{TPEE, true},
+		{TPIR, true},
+		{TPIO, true},
+		{LNEQ, true},
+		{LNFI, true},
+		{MLEX, true},
+		{MLER, true},
+		{MLES, true},
+		{NOWX, true},
+		{MSAX, true},
+		{MSCX, true},
+		{MSNT, true},
+		{ICOT, true},
+		{ICOR, true},
+		{TLCM, true},
 		{TPEU, true},
 		{TPER, true},
+		{TPFR, true},
+		{TEPG, true},
+		{TEPX, true},
+		{TEPR, true},
+		{TEPM, true},
+		{TEPI, true},
+		{TEPF, true},
 		{CMCI, true},
-		{NATX, true},
-		{HPCX, true},
-		{HPCO, true},
-		{HPCV, true},
-		{HPCS, true},
-		{TDON, true},
-		{NOWX, true},
-		{AQEU, true},
-		{AQEA, true},
-		{AQED, true},
-		{XAFR, true},
-		{TSAF, true},
-		{ELXE, true},
-		{TRXE, true},
-		{RBCC, true},
+		{MUBP, true},
+		{SMBP, true},
+		{EDRF, true},
+		{EXSE, true},
+		{SGOE, true},
 		{EXSY, true},
 		{EXYY, true},
-		{EXSB, true},
-		{EXSD, true},
 		{EXSF, true},
 		{EXSP, true},
+		{EXSD, true},
+		{EXSB, true},
 		{EXSH, true},
-		{MHBP, true},
-		{GMES, true},
-		{GMEO, true},
-		{EDRF, true},
 		{BPSX, true},
+		{HPCX, true},
+		{HPCS, true},
+		{HPCO, true},
+		{HPCV, true},
+		{MSSA, true},
 		{ODDO, true},
-		{SMBP, true},
-		{MUBP, true},
+		{ODOC, true},
+		{AACA, true},
+		{BNPA, true},
+		{BNPS, true},
 		{XSGA, true},
+		{KOTF, true},
+		{TDON, true},
+		{TSAF, true},
 		{AURB, true},
 		{GFPO, true},
 		{AURO, true},
-		{ODOC, true},
-		{BNPS, true},
-		{SGOE, true},
-		{XPOW, true},
-		{HBFR, true},
-		{XTXE, true},
-		{BNPA, true},
-		{TPIC, true},
-		{TPIR, true},
-		{TPIO, true},
-		{TPEE, true},
+		{NATX, true},
+		{COAL, true},
+		{EPEX, true},
+		{FMTS, true},
+		{GMTF, true},
 		{LCHC, true},
+		{XAFR, true},
+		{XBLN, true},
+		{XFMN, true},
 		{XPAR, true},
+		{XBLK, true},
+		{XETF, true},
 		{XAPA, true},
-		{MTCH, true},
-		{XMAT, true},
 		{XMLI, true},
 		{ALXP, true},
-		{XBLK, true},
-		{XETF, true},
-		{XMON, true},
 		{XSPM, true},
-		{KOTF, true},
-		{MSAX, true},
-		{MSCX, true},
-		{MSNT, true},
-		{MKTF, true},
-		{SGMU, true},
-		{SGMV, true},
-		{SGMW, true},
-		{ICOT, true},
-		{TLCM, true},
-		{ICOR, true},
-		{MLEX, true},
-		{MLER, true},
-		{MLES, true},
-		{EXSE, true},
-		{MAQE, true},
-		{WFSE, true},
-		{BRED, true},
-		{ESLO, true},
-		{ENSL, true},
+		{MTCH, true},
+		{XMAT, true},
+		{XMON, true},
+		{DPAR, true},
+		{XPOW, true},
+		{NABP, true},
 		{XGSE, true},
-		{CATS, true},
-		{X360T, true},
-		{DBOX, true},
-		{AUTO, true},
-		{NORD, true},
-		{FICX, true},
-		{XBER, true},
-		{BERA, true},
-		{BERB, true},
-		{BERC, true},
-		{EQTA, true},
-		{EQTB, true},
-		{EQTC, true},
-		{EQTD, true},
-		{XEQT, true},
-		{ZOBX, true},
-		{NLBX, true},
-		{MHBD, true},
+		{SEBA, true},
+		{SSWM, true},
+		{OLBB, true},
+		{SPEX, true},
+		{CSDA, true},
+		{CDSI, true},
+		{SMBD, true},
+		{UBSD, true},
+		{UBSL, true},
+		{UBSE, true},
+		{UBSI, true},
+		{SMFF, true},
+		{SCAG, true},
+		{NCME, true},
+		{GSBE, true},
+		{GSEI, true},
+		{MHEU, true},
 		{LBCW, true},
-		{XECB, true},
-		{XSTU, true},
-		{EUWX, true},
-		{XSTF, true},
-		{STUC, true},
-		{STUD, true},
-		{STUA, true},
-		{STUB, true},
-		{XDEX, true},
+		{JEFE, true},
+		{JESI, true},
+		{RBCG, true},
+		{UCDE, true},
 		{MSEU, true},
 		{MESI, true},
-		{SMFF, true},
-		{SCAG, true},
-		{XXSC, true},
+		{DEKA, true},
+		{LIGA, true},
+		{MHBD, true},
+		{IKBS, true},
 		{CGMD, true},
+		{CGEE, true},
 		{CGEC, true},
 		{CGET, true},
-		{CGEE, true},
-		{XMUN, true},
-		{MUNC, true},
-		{MUNA, true},
-		{MUNB, true},
-		{MUND, true},
-		{NCME, true},
-		{XECC, true},
-		{EUWA, true},
-		{XEEE, true},
-		{XEEO, true},
-		{XEER, true},
-		{XPSF, true},
-		{XPOT, true},
-		{ECAG, true},
-		{ECGS, true},
-		{XEUP, true},
-		{XERE, true},
-		{XEUM, true},
-		{XEHQ, true},
-		{XERT, true},
-		{XEUR, true},
-		{XETR, true},
-		{XETA, true},
-		{XETB, true},
-		{XETW, true},
-		{XETV, true},
-		{XETU, true},
-		{XETS, true},
-		{XFRA, true},
-		{FRAU, true},
-		{FRAA, true},
-		{FRAB, true},
-		{FRAV, true},
-		{FRAW, true},
-		{FRAS, true},
-		{XHAM, true},
-		{HAMM, true},
-		{HAMA, true},
-		{HAMB, true},
-		{HAMN, true},
-		{HAML, true},
-		{XHAN, true},
-		{HANA, true},
-		{HANB, true},
+		{JPEU, true},
 		{HELA, true},
-		{XSCO, true},
-		{XSC2, true},
-		{XSC1, true},
-		{XSC3, true},
+		{NESI, true},
+		{LBBW, true},
+		{LBWL, true},
+		{LBWS, true},
 		{BLBB, true},
+		{BLFX, true},
 		{BLEQ, true},
-		{BSFX, true},
 		{BLIQ, true},
+		{BSFX, true},
 		{BDEA, true},
 		{BLBS, true},
-		{BLFX, true},
+		{TPDE, true},
+		{TSFG, true},
+		{TSFF, true},
+		{EUWA, true},
+		{SSBI, true},
+		{SSBM, true},
 		{DBAG, true},
-		{DBES, true},
-		{DBLN, true},
 		{DBMO, true},
+		{DBLN, true},
+		{DBES, true},
 		{VWDX, true},
-		{VTLS, true},
-		{VTPS, true},
 		{VWDA, true},
-		{SSBI, true},
-		{SSBM, true},
-		{XDUS, true},
-		{XQTX, true},
-		{DUSA, true},
-		{DUSB, true},
-		{DUSC, true},
-		{DUSD, true},
-		{NESI, true},
-		{UCDE, true},
-		{DZBK, true},
-		{SMBD, true},
-		{IKBS, true},
-		{DAPA, true},
-		{JEFE, true},
-		{JESI, true},
-		{BAAD, true},
-		{SPEX, true},
-		{HSBT, true},
+		{VTPS, true},
+		{VTLS, true},
+		{NORD, true},
+		{BINV, true},
+		{NLBX, true},
 		{TGAT, true},
-		{TGSI, true},
 		{XGAT, true},
+		{TGSI, true},
 		{XGRM, true},
+		{VONT, true},
 		{CBKA, true},
-		{CBKE, true},
+		{CBKS, true},
+		{CBKC, true},
 		{CBKD, true},
 		{CBKF, true},
+		{CBKE, true},
 		{CBKG, true},
-		{CBKC, true},
-		{CBKS, true},
-		{LSSI, true},
+		{DZBK, true},
 		{BGSI, true},
-		{BGFI, true},
 		{BGFX, true},
-		{CSDA, true},
-		{CDSI, true},
-		{UBSL, true},
-		{UBSI, true},
-		{BINV, true},
-		{LBBW, true},
-		{LBWS, true},
-		{LBWL, true},
-		{JPEU, true},
-		{OLBB, true},
-		{LIGA, true},
-		{GSBE, true},
-		{GSEI, true},
-		{VONT, true},
-		{DEKA, true},
+		{BGFI, true},
+		{LSSI, true},
+		{BAAD, true},
+		{HSBT, true},
+		{DAPA, true},
+		{XEEE, true},
+		{XPSF, true},
+		{XPOT, true},
+		{XEER, true},
+		{XEEO, true},
+		{FICX, true},
+		{XMUN, true},
+		{MUND, true},
+		{MUNC, true},
+		{MUNB, true},
+		{MUNA, true},
+		{PLUS, true},
+		{XHCE, true},
+		{XRTR, true},
+		{X360T, true},
+		{CATS, true},
+		{DBOX, true},
+		{AUTO, true},
+		{ECAG, true},
+		{ECGS, true},
+		{EFTP, true},
+		{GMEX, true},
+		{XBER, true},
+		{BERA, true},
+		{BERB, true},
+		{BERC, true},
+		{ZOBX, true},
+		{EQTA, true},
+		{EQTB, true},
+		{EQTC, true},
+		{EQTD, true},
+		{XEQT, true},
+		{XBRE, true},
+		{XDTB, true},
+		{XDUS, true},
+		{DUSA, true},
+		{DUSB, true},
+		{DUSC, true},
+		{DUSD, true},
+		{XQTX, true},
+		{XDWZ, true},
+		{XECB, true},
+		{XECC, true},
+		{XETI, true},
+		{XETD, true},
+		{XETC, true},
+		{XETR, true},
+		{XEUB, true},
+		{XETV, true},
+		{XETW, true},
+		{XETU, true},
+		{XETS, true},
+		{XETB, true},
+		{XETA, true},
+		{XETE, true},
+		{XETX, true},
+		{XEMA, true},
+		{XEMI, true},
+		{XEMB, true},
+		{XEUP, true},
+		{XEHQ, true},
+		{XERT, true},
+		{XERE, true},
+		{XEUM, true},
+		{XEUR, true},
+		{XFRA, true},
+		{FRAV, true},
+		{FRAW, true},
+		{FRAU, true},
+		{FRAS, true},
+		{XDBC, true},
+		{XDBV, true},
+		{FRAA, true},
+		{FRAD, true},
+		{FRAB, true},
+		{XDBX, true},
+		{XNEW, true},
+		{XHAM, true},
+		{HAMA, true},
+		{HAMB, true},
+		{HAMM, true},
+		{HAML, true},
+		{HAMN, true},
+		{XHAN, true},
+		{HANB, true},
+		{HANA, true},
 		{XINV, true},
-		{RBCG, true},
-		{MHEU, true},
-		{SEBA, true},
+		{XSCO, true},
+		{XSC2, true},
+		{XSC3, true},
+		{XSC1, true},
+		{XSTU, true},
+		{STUF, true},
+		{XSTP, true},
+		{STUE, true},
+		{XDEX, true},
+		{XSTF, true},
+		{STUC, true},
+		{STUD, true},
+		{STUB, true},
+		{STUA, true},
+		{EUWX, true},
+		{XXSC, true},
+		{DBDX, true},
+		{XIGG, true},
+		{EBLX, true},
+		{ERFQ, true},
+		{ENTW, true},
+		{TRBX, true},
+		{BOCF, true},
+		{X360X, true},
+		{SCLB, true},
+		{X21XX, true},
 		{XGHA, true},
 		{GSXL, true},
-		{HDAT, true},
-		{ASEX, true},
-		{HOTC, true},
-		{XADE, true},
-		{XATH, true},
-		{ENAX, true},
-		{XIPO, true},
 		{PBGR, true},
 		{ABFI, true},
-		{AAPA, true},
+		{ERBX, true},
 		{HEMO, true},
 		{HESP, true},
 		{HEDE, true},
-		{ERBX, true},
+		{HGSP, true},
+		{AAPA, true},
+		{ASEX, true},
+		{HOTC, true},
+		{XADE, true},
+		{ENAX, true},
+		{XATH, true},
+		{XIPO, true},
+		{EUAX, true},
+		{HDAT, true},
 		{XGTG, true},
 		{XCIE, true},
 		{GSCI, true},
+		{XHON, true},
 		{XBCV, true},
-		{HSXA, true},
-		{CGMH, true},
-		{DBHK, true},
-		{EOTC, true},
-		{JPMI, true},
-		{XCGS, true},
-		{XHKF, true},
-		{CLHK, true},
-		{BASE, true},
+		{OSDS, true},
 		{BAIP, true},
-		{BAEP, true},
+		{BACR, true},
 		{BASP, true},
-		{UBSX, true},
-		{TOCP, true},
+		{BAEP, true},
+		{BASE, true},
 		{TFSD, true},
 		{TRAS, true},
 		{GSAL, true},
-		{SIGH, true},
-		{GSXH, true},
 		{GSPL, true},
-		{MAQH, true},
+		{GSXH, true},
+		{SIGH, true},
+		{GSXM, true},
 		{TWHK, true},
-		{XPST, true},
-		{XIHK, true},
+		{CGMH, true},
 		{CSHK, true},
 		{CFHK, true},
+		{CLHK, true},
+		{DBHK, true},
+		{EOTC, true},
+		{HKME, true},
+		{HSXA, true},
+		{JPMI, true},
+		{MAQH, true},
+		{MEHK, true},
+		{TOCP, true},
+		{UBSX, true},
+		{XCGS, true},
+		{XHKF, true},
 		{XHKG, true},
 		{SHSC, true},
 		{SZSC, true},
 		{XGEM, true},
-		{HUPX, true},
-		{XGAS, true},
-		{KELR, true},
-		{ERST, true},
-		{XBUD, true},
-		{BETA, true},
-		{XBND, true},
-		{XTND, true},
+		{XIHK, true},
+		{XPST, true},
+		{GSXN, true},
 		{EBHU, true},
-		{CONC, true},
 		{UCHU, true},
+		{CONC, true},
 		{KHHU, true},
-		{HUDX, true},
+		{CIBH, true},
 		{OTPB, true},
 		{RBHU, true},
+		{ERST, true},
+		{KELR, true},
+		{HUDX, true},
+		{HUPX, true},
 		{KCCP, true},
-		{CIBH, true},
+		{QMTF, true},
+		{XQLX, true},
+		{XBCE, true},
+		{XBUD, true},
+		{BETA, true},
+		{XBND, true},
+		{XTND, true},
+		{XGAS, true},
 		{XICE, true},
-		{DICE, true},
 		{FNIS, true},
+		{MICE, true},
 		{DNIS, true},
+		{ISEC, true},
+		{DICE, true},
 		{MNIS, true},
-		{MICE, true},
-		{ICXL, true},
-		{ACEX, true},
-		{IINX, true},
-		{ISEX, true},
 		{MCXX, true},
-		{XUSE, true},
-		{XDES, true},
-		{XBAN, true},
+		{IINX, true},
+		{ACEX, true},
 		{CDSL, true},
-		{FXSW, true},
-		{FXCL, true},
 		{ASTR, true},
+		{FXCL, true},
+		{FXSW, true},
+		{ICXL, true},
+		{ISEX, true},
+		{NBOT, true},
+		{NMCE, true},
 		{OTCX, true},
 		{PXIL, true},
-		{XNSE, true},
-		{INSE, true},
-		{XNCD, true},
-		{XCAL, true},
+		{XBAN, true},
 		{XBOM, true},
 		{BSME, true},
+		{XCAL, true},
+		{XDES, true},
 		{XIMC, true},
-		{NMCE, true},
-		{NBOT, true},
 		{XMDS, true},
+		{XNCD, true},
+		{XNSE, true},
+		{INSE, true},
+		{XUSE, true},
+		{RITS, true},
+		{XSUR, true},
+		{XJKT, true},
 		{ICDX, true},
-		{XJNB, true},
 		{XBBJ, true},
 		{XIDX, true},
+		{XJNB, true},
+		{IFBX, true},
 		{IMEX, true},
 		{XTEH, true},
-		{IFBX, true},
 		{XIQS, true},
-		{XCDE, true},
-		{BBIE, true},
-		{BBIS, true},
-		{CSGI, true},
+		{SIDX, true},
+		{AILT, true},
+		{MAQE, true},
+		{CFIL, true},
+		{BMLI, true},
+		{BMLS, true},
+		{BMLX, true},
+		{BMSI, true},
 		{ITGL, true},
 		{XPOS, true},
-		{XPAC, true},
 		{XRFQ, true},
-		{RMTF, true},
-		{FXRQ, true},
-		{FXFM, true},
-		{FXRS, true},
-		{CFIL, true},
-		{BKDM, true},
+		{XPAC, true},
+		{CEPL, true},
 		{TDGF, true},
-		{AREX, true},
+		{BKDM, true},
+		{CSGI, true},
+		{EUCC, true},
 		{MCID, true},
 		{MSEL, true},
+		{HREU, true},
 		{EQIE, true},
 		{EQSE, true},
-		{LEUE, true},
-		{LEUF, true},
+		{RMTF, true},
+		{FXRS, true},
+		{FXRQ, true},
+		{FXFM, true},
+		{ICUR, true},
+		{ICXR, true},
 		{IFXC, true},
-		{IFXR, true},
 		{IFXA, true},
-		{BMLI, true},
-		{BMLS, true},
+		{IFXR, true},
+		{DAVY, true},
+		{BBIE, true},
+		{BBIS, true},
+		{LEUE, true},
+		{LEUF, true},
 		{SISI, true},
-		{CEPL, true},
+		{SEMX, true},
 		{XDUB, true},
-		{XMSM, true},
-		{XEYE, true},
 		{EDBT, true},
 		{EDGL, true},
-		{XATL, true},
+		{XMSM, true},
 		{XESM, true},
+		{XEYE, true},
+		{XATL, true},
+		{XASM, true},
+		{XIEX, true},
+		{DDUB, true},
 		{VFIL, true},
-		{VFXO, true},
 		{VFSI, true},
-		{EUCC, true},
+		{VFXO, true},
+		{AREX, true},
+		{XFNX, true},
+		{XCDE, true},
 		{XEBI, true},
-		{ICUR, true},
-		{ICXR, true},
-		{DAVY, true},
-		{HREU, true},
-		{SEMX, true},
-		{SIDX, true},
-		{AILT, true},
+		{IBSI, true},
+		{GBSI, true},
+		{TMEU, true},
 		{XTAE, true},
-		{HMTF, true},
-		{HMOD, true},
-		{HRFQ, true},
-		{HCER, true},
-		{EMID, true},
-		{EMDR, true},
-		{EMIR, true},
-		{EMIB, true},
-		{ISBA, true},
-		{DDTX, true},
-		{BREA, true},
+		{UBIM, true},
 		{IMMH, true},
 		{BNLD, true},
-		{XGME, true},
+		{BREA, true},
+		{BPAS, true},
+		{CREM, true},
 		{UCIT, true},
+		{MUBM, true},
+		{ISBA, true},
+		{ISBV, true},
+		{BEIS, true},
 		{IBIS, true},
 		{IBEQ, true},
-		{ISBV, true},
+		{AKIS, true},
+		{DDTX, true},
+		{UBIS, true},
+		{FBSI, true},
 		{MTSO, true},
-		{MTSC, true},
-		{SSOB, true},
-		{EBMX, true},
 		{MCAD, true},
-		{BEIS, true},
-		{MUBM, true},
-		{CREM, true},
-		{UBIS, true},
+		{EBMX, true},
+		{MTSM, true},
+		{BOND, true},
+		{SSOB, true},
+		{MTSC, true},
+		{MSWP, true},
+		{ITSM, true},
+		{MTAX, true},
+		{XTLX, true},
 		{CGIT, true},
-		{CGCM, true},
-		{CGND, true},
+		{CGQD, true},
 		{CGDB, true},
-		{CGEB, true},
 		{CGTR, true},
-		{CGQD, true},
-		{CGGD, true},
+		{CGND, true},
+		{CGEB, true},
 		{CGQT, true},
-		{FBSI, true},
+		{CGCM, true},
+		{CGGD, true},
+		{EMID, true},
+		{EMIB, true},
+		{EMIR, true},
+		{EMDR, true},
+		{HMTF, true},
+		{HCER, true},
+		{HMOD, true},
+		{HRFQ, true},
+		{TLAB, true},
+		{XGME, true},
+		{XMIF, true},
 		{XMIL, true},
-		{XDMI, true},
-		{XMOT, true},
 		{ETLX, true},
+		{SEDX, true},
+		{ETFP, true},
+		{XMOT, true},
+		{MOTX, true},
+		{EXGM, true},
 		{MTAH, true},
 		{ATFX, true},
-		{XAIM, true},
-		{MOTX, true},
-		{ETFP, true},
 		{MIVX, true},
+		{XAIM, true},
+		{XDMI, true},
+		{MACX, true},
 		{MTAA, true},
-		{SEDX, true},
-		{AKIS, true},
+		{BGEM, true},
+		{DMIL, true},
+		{XABJ, true},
 		{XBRV, true},
 		{XJAM, true},
+		{MUDX, true},
+		{BNPX, true},
+		{SBIJ, true},
+		{SBIU, true},
+		{XSBI, true},
+		{SBIV, true},
+		{BAJD, true},
+		{TWJP, true},
+		{TWJT, true},
+		{BASX, true},
+		{UTSL, true},
+		{XIJP, true},
+		{JASR, true},
 		{DRCT, true},
-		{CHIJ, true},
-		{CHIV, true},
-		{KAIX, true},
-		{CHIS, true},
 		{CITX, true},
 		{CITD, true},
 		{CSJP, true},
 		{CFJP, true},
-		{MSMS, true},
-		{XKAC, true},
 		{NMRJ, true},
+		{NMSX, true},
 		{NXJP, true},
-		{NXSE, true},
 		{NXVW, true},
-		{ICHK, true},
-		{ICKR, true},
 		{ICSH, true},
 		{ICSZ, true},
+		{ICKR, true},
+		{ICHK, true},
+		{NXSE, true},
 		{ICTW, true},
-		{NMSX, true},
+		{NXFO, true},
+		{NXBX, true},
+		{JATA, true},
+		{XHER, true},
+		{BETP, true},
+		{CHIJ, true},
+		{KAIX, true},
+		{CHIS, true},
+		{CHIV, true},
 		{CLJP, true},
-		{XTFF, true},
-		{XNGO, true},
-		{XNKS, true},
-		{BASX, true},
-		{XTKT, true},
+		{CMET, true},
+		{KABU, true},
+		{VKAB, true},
+		{LXJP, true},
 		{MAQJ, true},
-		{SBIJ, true},
-		{SBIV, true},
-		{SBIU, true},
-		{XSBI, true},
+		{MIZX, true},
+		{MSMS, true},
 		{SIGJ, true},
-		{BETP, true},
+		{XCCE, true},
+		{XFFE, true},
 		{XFKA, true},
-		{TWJP, true},
-		{TWJT, true},
-		{LXJP, true},
-		{XSAP, true},
-		{MIZX, true},
-		{CMET, true},
-		{UTSL, true},
-		{BAJD, true},
+		{XHIR, true},
 		{XJPX, true},
-		{XTAM, true},
 		{XTK1, true},
-		{XTK2, true},
-		{XTK3, true},
-		{XTKS, true},
 		{XJAS, true},
+		{XTKS, true},
 		{XOSE, true},
+		{XTAM, true},
+		{XTK3, true},
+		{XTK2, true},
 		{XOSJ, true},
-		{MUDX, true},
-		{BNPX, true},
+		{XKAC, true},
+		{XKGT, true},
+		{XKKT, true},
+		{XKST, true},
+		{XKYO, true},
+		{XNGO, true},
+		{XNII, true},
+		{XNKS, true},
+		{XNST, true},
+		{XOSM, true},
+		{XOST, true},
+		{XSAP, true},
+		{XTFF, true},
+		{XTKA, true},
+		{XTKO, true},
+		{XTKT, true},
+		{XYKT, true},
+		{ODXE, true},
+		{ODST, true},
+		{SMBC, true},
+		{CILH, true},
+		{TICT, true},
+		{BGCJ, true},
+		{JEFA, true},
 		{XAMM, true},
 		{AMNL, true},
-		{ETSC, true},
 		{CCEX, true},
 		{AIXK, true},
+		{ETSC, true},
 		{XKAZ, true},
+		{ITSL, true},
 		{XNAI, true},
-		{XKFB, true},
 		{GSXK, true},
+		{KOCN, true},
+		{XKFB, true},
+		{XKOR, true},
 		{XKRX, true},
+		{XKON, true},
 		{XKFE, true},
 		{XKOS, true},
-		{XKON, true},
 		{XKCM, true},
 		{XKEM, true},
 		{XKUW, true},
 		{XKSE, true},
 		{XLAO, true},
+		{SWLV, true},
 		{XRIS, true},
 		{FNLV, true},
-		{SWLV, true},
 		{XBEY, true},
 		{XLSM, true},
+		{XNOM, true},
+		{XLLB, true},
 		{XVPB, true},
 		{XLGT, true},
-		{XLLB, true},
-		{XNOM, true},
-		{BAPX, true},
-		{SWLT, true},
+		{ARTX, true},
 		{SEBL, true},
+		{SWLT, true},
+		{BAPX, true},
 		{GETB, true},
+		{NASB, true},
 		{XLIT, true},
+		{XVIA, true},
 		{FNLT, true},
-		{CCLX, true},
+		{BGLU, true},
+		{BILU, true},
+		{BLUX, true},
 		{DBLX, true},
 		{DHLX, true},
-		{XVES, true},
-		{BLUX, true},
-		{BILU, true},
 		{KBLL, true},
-		{KBLT, true},
-		{KBLS, true},
 		{KBLC, true},
+		{KBLS, true},
+		{KBLT, true},
+		{MIBL, true},
+		{BCEE, true},
 		{RBCB, true},
 		{RBSI, true},
+		{XVES, true},
+		{CCLX, true},
 		{XLUX, true},
 		{EMTF, true},
-		{BCEE, true},
-		{BGLU, true},
+		{BDPL, true},
 		{XMAE, true},
 		{XMDG, true},
 		{XMSW, true},
-		{XLFX, true},
+		{XLOF, true},
 		{XKLS, true},
 		{MESQ, true},
+		{XLFX, true},
 		{XRBM, true},
 		{MALX, true},
-		{COMG, true},
+		{EWSM, true},
 		{XMAL, true},
 		{IFSM, true},
 		{PROS, true},
-		{GBOT, true},
-		{XMAU, true},
+		{COMG, true},
 		{AFEX, true},
+		{GBOT, true},
 		{XAFX, true},
+		{XMAU, true},
+		{BIVA, true},
 		{CGMX, true},
-		{XMEX, true},
 		{XEMD, true},
-		{BIVA, true},
+		{XMEX, true},
 		{XMOL, true},
 		{XULA, true},
 		{XMNX, true},
 		{XCAS, true},
 		{XBVM, true},
+		{XMAP, true},
 		{XNAM, true},
 		{XNEP, true},
-		{NZFX, true},
+		{XAUK, true},
+		{XNEE, true},
 		{XNZE, true},
+		{NZXC, true},
+		{NZXM, true},
 		{XMAN, true},
-		{XNSA, true},
 		{NASX, true},
-		{FISH, true},
-		{FSHX, true},
-		{SPTR, true},
+		{XNSA, true},
+		{NORX, true},
+		{STEE, true},
+		{BULK, true},
+		{ELUK, true},
+		{ELNO, true},
+		{ELSE, true},
+		{ELEU, true},
+		{FREI, true},
 		{XOSL, true},
 		{XOAS, true},
-		{XOSC, true},
+		{MERK, true},
 		{XOBD, true},
-		{XOAM, true},
+		{XOAA, true},
 		{XOSA, true},
+		{BURG, true},
 		{MERD, true},
-		{MERK, true},
+		{XOAM, true},
+		{BURM, true},
+		{XOSC, true},
+		{XOAD, true},
 		{XOSD, true},
-		{XOAA, true},
 		{NIBR, true},
-		{XOAD, true},
+		{DOSL, true},
+		{SPTR, true},
+		{ICAS, true},
+		{OAPA, true},
 		{SB1M, true},
+		{XABG, true},
+		{CNSI, true},
+		{XDNB, true},
+		{NEXO, true},
+		{FISH, true},
+		{FSHX, true},
 		{NOPS, true},
-		{NORX, true},
-		{ELUK, true},
-		{FREI, true},
-		{ELEU, true},
-		{ELSE, true},
-		{ELNO, true},
+		{NOSC, true},
 		{NOTC, true},
-		{XDNB, true},
 		{OSLC, true},
-		{OAPA, true},
-		{CNSI, true},
 		{XIMA, true},
-		{NOSC, true},
-		{NEXO, true},
-		{XABG, true},
+		{INFT, true},
+		{FNDS, true},
+		{IFFX, true},
+		{QUNT, true},
+		{HWHE, true},
 		{XMUS, true},
-		{NCEL, true},
+		{MSXB, true},
+		{MSXO, true},
+		{XISL, true},
 		{XKAR, true},
+		{XLAH, true},
+		{NCEL, true},
 		{XPAE, true},
 		{XPTY, true},
 		{XPOM, true},
@@ -5054,1168 +9159,1558 @@ func TestIsPredefined(t *testing.T) {
 		{CLPH, true},
 		{PDEX, true},
 		{XPHS, true},
+		{CAPL, true},
+		{PARK, true},
+		{BNPP, true},
 		{PKOP, true},
-		{PTPG, true},
-		{MBPL, true},
 		{INGW, true},
-		{HBPL, true},
-		{BPKO, true},
+		{MBPL, true},
 		{SIAB, true},
-		{PARK, true},
+		{BHWA, true},
+		{BPKO, true},
+		{IENG, true},
+		{HBPL, true},
+		{WBKP, true},
+		{PTPG, true},
+		{MTSP, true},
+		{KDPW, true},
 		{XWAR, true},
-		{WDER, true},
-		{WETP, true},
+		{XGLO, true},
 		{WIND, true},
-		{WMTF, true},
-		{WBCL, true},
-		{WBON, true},
-		{BOSP, true},
-		{RPWC, true},
-		{TBSP, true},
+		{WOPO, true},
+		{PLPD, true},
 		{XNCO, true},
 		{WIPO, true},
-		{PLPD, true},
+		{CETO, true},
+		{RPWC, true},
+		{TBSP, true},
+		{TBSA, true},
+		{BOSP, true},
+		{WETP, true},
 		{PLPO, true},
 		{PLPS, true},
-		{TBSA, true},
-		{XGLO, true},
-		{WBKP, true},
-		{BNPP, true},
-		{CAPL, true},
-		{BHWA, true},
-		{OMIC, true},
-		{OPEX, true},
+		{WBLC, true},
+		{WBCL, true},
+		{WBON, true},
+		{WMTF, true},
+		{WDER, true},
+		{WCDE, true},
+		{POEE, true},
+		{WGAS, true},
+		{PLPX, true},
+		{NSSA, true},
+		{MSDM, true},
+		{XBRY, true},
+		{MBCP, true},
 		{OMIP, true},
 		{XLIS, true},
-		{ALXL, true},
 		{ENXL, true},
+		{ALXL, true},
 		{MFOX, true},
 		{WQXL, true},
+		{DLIS, true},
 		{BFPT, true},
+		{MDIP, true},
+		{OMIC, true},
+		{OPEX, true},
+		{PMTS, true},
 		{DSMD, true},
 		{TRPX, true},
-		{SECE, true},
 		{SECC, true},
 		{SECD, true},
+		{SECE, true},
 		{SEDC, true},
+		{BTRL, true},
+		{OTPR, true},
 		{BRDE, true},
 		{BRDS, true},
 		{BRDL, true},
+		{BMFX, true},
+		{SBMF, true},
+		{BMFM, true},
+		{BMFA, true},
 		{XBRM, true},
+		{EMCE, true},
+		{BRMF, true},
 		{XBSE, true},
 		{XCAN, true},
 		{XRAS, true},
 		{XBSD, true},
 		{XRPM, true},
-		{OTPR, true},
-		{BTRL, true},
+		{RRSI, true},
+		{SPBE, true},
+		{RUSX, true},
+		{XMOS, true},
+		{XPET, true},
 		{MISX, true},
 		{RTSX, true},
-		{RPDX, true},
+		{XSIC, true},
+		{XMIC, true},
+		{URCE, true},
+		{XROV, true},
+		{IXSP, true},
 		{NAMX, true},
+		{NNCS, true},
+		{RPDX, true},
 		{SPIM, true},
-		{RUSX, true},
+		{XAPI, true},
+		{XPIC, true},
 		{XRUS, true},
 		{XSAM, true},
-		{XMOS, true},
 		{XSIB, true},
-		{XPET, true},
-		{XPIC, true},
-		{SPBE, true},
+		{XVLA, true},
 		{ROTC, true},
 		{RSEX, true},
 		{XECS, true},
 		{SVXI, true},
 		{XSAU, true},
 		{XBEL, true},
-		{CLTD, true},
-		{JADX, true},
+		{BBLX, true},
+		{OSSG, true},
+		{CNOD, true},
+		{EQOS, true},
+		{EQOC, true},
+		{EQOD, true},
+		{ENMS, true},
+		{BTBS, true},
+		{SYFX, true},
+		{TSIG, true},
 		{APEX, true},
 		{APCL, true},
+		{TWSG, true},
+		{MASG, true},
+		{MALM, true},
+		{RFQS, true},
+		{CLTD, true},
+		{CHIE, true},
 		{ECAL, true},
+		{EEAL, true},
+		{IFSG, true},
+		{JADX, true},
+		{RTSP, true},
+		{FXSM, true},
 		{SMEX, true},
 		{TFSA, true},
-		{SYFX, true},
-		{TSIG, true},
-		{BTBS, true},
 		{XSES, true},
-		{XSIM, true},
+		{XSCA, true},
 		{XSBT, true},
+		{XSCL, true},
+		{XSIM, true},
 		{XSCE, true},
-		{XSCA, true},
-		{IFSG, true},
-		{MASG, true},
-		{MALM, true},
-		{EEAL, true},
-		{RTSP, true},
-		{TWSG, true},
-		{ENMS, true},
-		{EQOS, true},
-		{EQOC, true},
-		{EQOD, true},
+		{TERM, true},
+		{XABX, true},
+		{TRDC, true},
+		{XAND, true},
+		{XANM, true},
+		{LMAS, true},
+		{GFSG, true},
+		{BGSG, true},
+		{ICPS, true},
+		{XANS, true},
+		{CBSK, true},
+		{XRMS, true},
 		{SPXE, true},
 		{XBRA, true},
 		{EBRA, true},
-		{CBSK, true},
+		{VUBA, true},
+		{SKBB, true},
+		{XLJS, true},
 		{XLJU, true},
 		{XLJM, true},
-		{SKBB, true},
 		{XSOP, true},
+		{RMMS, true},
+		{RMMX, true},
+		{X4AXE, true},
+		{ZARX, true},
+		{A2XX, true},
 		{XJSE, true},
+		{JSER, true},
+		{ZFXM, true},
+		{JSEB, true},
 		{XBES, true},
+		{ALTX, true},
 		{XSAF, true},
 		{XSFA, true},
 		{YLDX, true},
-		{ALTX, true},
-		{JSEB, true},
-		{JSER, true},
-		{ZFXM, true},
-		{A2XX, true},
-		{X4AXE, true},
-		{ZARX, true},
-		{RMMS, true},
-		{RMMX, true},
-		{MIBG, true},
-		{MDRV, true},
-		{SANT, true},
-		{XDPA, true},
-		{CABK, true},
-		{IBGH, true},
-		{OMEL, true},
-		{PAVE, true},
-		{XNAF, true},
+		{EESX, true},
+		{CBMS, true},
+		{POSE, true},
+		{TEUR, true},
+		{CECA, true},
+		{BSAB, true},
+		{DOWM, true},
+		{DOWE, true},
+		{IBER, true},
+		{ALLT, true},
+		{CSMD, true},
+		{SIMD, true},
+		{AGBP, true},
+		{ABAN, true},
 		{BCMA, true},
+		{CABK, true},
+		{SANT, true},
 		{CIMD, true},
-		{CIMB, true},
+		{CIMA, true},
 		{CIMV, true},
+		{CIMB, true},
 		{CIME, true},
-		{CIMA, true},
 		{BBVA, true},
 		{CAPI, true},
 		{CMAP, true},
-		{ABAN, true},
+		{TPES, true},
+		{TOMF, true},
+		{TOMG, true},
+		{IBGH, true},
 		{BMEX, true},
 		{MABX, true},
+		{GROW, true},
+		{XMFX, true},
+		{XMCE, true},
+		{BMEA, true},
 		{SEND, true},
-		{XBAR, true},
-		{XBIL, true},
 		{XDRF, true},
-		{XLAT, true},
+		{MARF, true},
+		{BMCL, true},
+		{MERF, true},
+		{XBIL, true},
 		{XMAD, true},
-		{XMRV, true},
+		{SBIL, true},
+		{SBAR, true},
 		{XVAL, true},
+		{XBAR, true},
+		{XLAT, true},
+		{XMEF, true},
 		{XMPW, true},
-		{XMFX, true},
-		{SBAR, true},
-		{SBIL, true},
-		{MERF, true},
-		{BMCL, true},
-		{BMEA, true},
-		{MARF, true},
-		{GROW, true},
-		{ALLT, true},
-		{CSMD, true},
-		{SIMD, true},
-		{AGBP, true},
-		{DOWM, true},
-		{DOWE, true},
-		{TPES, true},
-		{TOMG, true},
-		{IBER, true},
-		{CECA, true},
-		{BSAB, true},
-		{TEUR, true},
+		{XMRV, true},
+		{SCLE, true},
+		{MIBG, true},
+		{MDRV, true},
+		{OMEL, true},
+		{PAVE, true},
+		{XBAV, true},
+		{XDPA, true},
+		{XFCM, true},
+		{XNAF, true},
+		{XSRM, true},
 		{XCOL, true},
 		{XKHA, true},
 		{XSWA, true},
+		{PEPW, true},
+		{PEPQ, true},
+		{PEPY, true},
+		{PEPH, true},
+		{PEPM, true},
+		{XSAT, true},
+		{SPDK, true},
+		{SPNO, true},
+		{SPFI, true},
+		{SPEU, true},
+		{GFKS, true},
+		{SEBX, true},
+		{SEBS, true},
+		{ENSX, true},
+		{XABC, true},
 		{SVEX, true},
 		{SVES, true},
-		{XABC, true},
-		{XNGM, true},
-		{NMTF, true},
-		{NSME, true},
-		{CRYX, true},
 		{CASI, true},
-		{CRYD, true},
-		{XSAT, true},
-		{SEBS, true},
-		{GFKS, true},
 		{SWBI, true},
+		{NAPA, true},
+		{CRYD, true},
+		{CRYX, true},
+		{XNGM, true},
+		{XNDX, true},
+		{XNMR, true},
+		{NSME, true},
+		{NMTF, true},
+		{XOME, true},
 		{XSTO, true},
-		{ESTO, true},
-		{FIED, true},
-		{DSTO, true},
-		{DOSE, true},
-		{DNSE, true},
-		{EBON, true},
-		{NOFI, true},
-		{MSTO, true},
+		{NOCO, true},
 		{SSME, true},
-		{CSTO, true},
-		{MNSE, true},
-		{SEED, true},
+		{GBWB, true},
 		{MOSE, true},
+		{DOSE, true},
+		{ESTO, true},
+		{ONSE, true},
 		{DKED, true},
+		{DKFI, true},
 		{NOED, true},
-		{XOPV, true},
-		{FNSE, true},
+		{SEED, true},
 		{PNED, true},
-		{EUWB, true},
 		{USWB, true},
-		{GBWB, true},
-		{DKFI, true},
-		{ONSE, true},
-		{NAPA, true},
-		{PEPW, true},
-		{PEPM, true},
-		{PEPQ, true},
-		{PEPH, true},
-		{PEPY, true},
-		{OTXB, true},
-		{CCMS, true},
-		{CSAG, true},
-		{CSOT, true},
-		{XBRN, true},
-		{S3FM, true},
-		{CSZH, true},
-		{UBSG, true},
-		{UBSF, true},
-		{UBSC, true},
-		{STOX, true},
-		{XSCU, true},
-		{XSTV, true},
-		{XSTX, true},
-		{DOTS, true},
-		{EBSS, true},
-		{EBSC, true},
+		{NOFI, true},
+		{FIED, true},
+		{EBON, true},
+		{EUWB, true},
+		{XOPV, true},
+		{NASN, true},
+		{DNSE, true},
+		{FNSE, true},
+		{CSTO, true},
+		{MNSE, true},
+		{MSTO, true},
+		{DSTO, true},
+		{USOB, true},
+		{SEWB, true},
+		{DKWB, true},
+		{NOWB, true},
+		{SEOB, true},
+		{DKOB, true},
+		{EUOB, true},
+		{GBOB, true},
+		{NOOB, true},
+		{NSPO, true},
 		{XSWX, true},
-		{XBTR, true},
+		{XSDX, true},
+		{XDLP, true},
 		{XSWM, true},
+		{XSLS, true},
 		{XSEB, true},
-		{XQMH, true},
-		{XSDX, true},
+		{XBTR, true},
+		{XVTX, true},
 		{XQOD, true},
-		{XDLP, true},
+		{XQMH, true},
 		{CBOE, true},
-		{VLEX, true},
+		{XICB, true},
+		{EUCH, true},
+		{EUSP, true},
+		{EURM, true},
+		{EUSC, true},
+		{CSAG, true},
+		{CSOT, true},
+		{CCMS, true},
+		{CSZH, true},
+		{OTXB, true},
 		{ROSR, true},
-		{XROT, true},
 		{XREP, true},
+		{XROT, true},
+		{EBSS, true},
+		{EBSC, true},
+		{VLEX, true},
+		{AIXE, true},
+		{DOTS, true},
+		{S3FM, true},
+		{STOX, true},
+		{XSTV, true},
+		{XSCU, true},
+		{XSTX, true},
+		{UBSG, true},
+		{UBSC, true},
+		{UBSF, true},
+		{UBST, true},
+		{XBRN, true},
+		{EQWB, true},
+		{XSWO, true},
 		{ZKBX, true},
 		{KMUX, true},
+		{RULE, true},
+		{BXDA, true},
+		{TDXS, true},
 		{XDSE, true},
+		{GSXT, true},
+		{CFTW, true},
 		{ROCO, true},
+		{XIME, true},
+		{XTAD, true},
 		{XTAF, true},
 		{XTAI, true},
-		{CFTW, true},
-		{GSXT, true},
 		{XDAR, true},
-		{BEEX, true},
 		{AFET, true},
+		{BEEX, true},
+		{TFEX, true},
 		{XBKK, true},
-		{XBKF, true},
 		{XMAI, true},
-		{TFEX, true},
-		{CLMX, true},
+		{XBKF, true},
+		{RR4G, true},
+		{TRNL, true},
+		{ABNC, true},
+		{JNSI, true},
 		{MANL, true},
 		{MXNL, true},
+		{RFQN, true},
+		{CAVD, true},
+		{JLEU, true},
+		{MHBE, true},
+		{BTAM, true},
+		{BTQE, true},
+		{CABV, true},
+		{TOWR, true},
 		{FLWX, true},
 		{FLTR, true},
 		{FLTB, true},
-		{MHBE, true},
-		{JLEU, true},
-		{NDEX, true},
-		{IMCO, true},
-		{IMEQ, true},
-		{NDXS, true},
-		{INGB, true},
-		{INGE, true},
-		{INGF, true},
-		{ETPA, true},
-		{XAMS, true},
-		{TNLA, true},
-		{XEUC, true},
-		{XEUE, true},
-		{XEUI, true},
-		{HCHC, true},
-		{COMM, true},
+		{IPNL, true},
+		{ISWP, true},
+		{ISWO, true},
+		{ISWN, true},
+		{ISWT, true},
 		{EBSN, true},
+		{NEXY, true},
 		{RESF, true},
 		{EBSF, true},
 		{IEBS, true},
+		{EBSI, true},
+		{RESE, true},
+		{EBSD, true},
 		{ECEU, true},
-		{BTAM, true},
-		{BTQE, true},
-		{CAVD, true},
-		{ABNA, true},
-		{TWEU, true},
-		{TWEA, true},
-		{TWEM, true},
-		{TWEO, true},
-		{NLPX, true},
-		{RABO, true},
-		{XEMS, true},
-		{CABV, true},
-		{TOWR, true},
-		{NPEX, true},
-		{BAPE, true},
-		{BTFE, true},
-		{AFSA, true},
-		{AFSL, true},
-		{AFSX, true},
-		{AFSI, true},
-		{AFSO, true},
-		{NWNV, true},
+		{OHVO, true},
+		{MUSN, true},
+		{NIBC, true},
+		{CCRM, true},
+		{CEDX, true},
+		{BARU, true},
+		{BEUO, true},
+		{BEUT, true},
 		{CCXE, true},
-		{CEUX, true},
-		{CEUD, true},
 		{BEUP, true},
+		{BEUF, true},
+		{CEUD, true},
+		{BEUD, true},
+		{CEUX, true},
 		{CEUO, true},
-		{LISZ, true},
+		{BEUE, true},
+		{CEUE, true},
 		{CAPA, true},
+		{LISZ, true},
+		{NWNV, true},
+		{ABNA, true},
 		{TQEX, true},
-		{TQEA, true},
-		{TQEM, true},
 		{TQEB, true},
-		{MUBE, true},
-		{CCRM, true},
-		{BEUO, true},
-		{BEUT, true},
-		{BARU, true},
-		{CEDX, true},
+		{TQEM, true},
+		{TQEA, true},
 		{IMCT, true},
-		{NIBC, true},
+		{MUBE, true},
+		{BAPE, true},
+		{BTFE, true},
+		{COMM, true},
+		{NPEX, true},
+		{AFSA, true},
+		{AFSO, true},
+		{AFSX, true},
+		{AFSL, true},
+		{AFSI, true},
+		{ETPA, true},
+		{RABO, true},
+		{INGB, true},
+		{INGE, true},
+		{INGF, true},
 		{CPTX, true},
-		{MUSN, true},
-		{TRNL, true},
-		{AFSE, true},
-		{ISWP, true},
-		{ISWN, true},
-		{ISWO, true},
-		{ISWT, true},
+		{TOMX, true},
+		{TOMD, true},
+		{NDEX, true},
+		{IMCO, true},
+		{IMEQ, true},
+		{NDXS, true},
 		{XNXC, true},
 		{XNXD, true},
-		{OHVO, true},
-		{JNSI, true},
-		{ABNC, true},
-		{XTRN, true},
-		{XTUN, true},
-		{EXTR, true},
-		{XEID, true},
-		{XEDA, true},
-		{XIST, true},
-		{XEQY, true},
-		{XFNO, true},
-		{XPMS, true},
-		{XDSM, true},
-		{TMEX, true},
-		{EWRM, true},
-		{ULTX, true},
-		{XUGA, true},
-		{EESE, true},
-		{XKHR, true},
-		{XKIE, true},
-		{XDFB, true},
-		{XKIS, true},
-		{PFTS, true},
-		{PFTQ, true},
-		{UKEX, true},
-		{SEPE, true},
-		{XUAX, true},
-		{UICE, true},
-		{XODE, true},
-		{XUKR, true},
-		{XNDU, true},
-		{XPRI, true},
-		{DGCX, true},
-		{DIFX, true},
-		{DUMX, true},
-		{XDFM, true},
-		{GMGD, true},
-		{XADS, true},
-		{GLOM, true},
-		{DEXE, true},
-		{KRME, true},
-		{MATX, true},
-		{IFAD, true},
-		{ABXX, true},
-		{MIDC, true},
-		{BALT, true},
-		{BLTX, true},
-		{BGCI, true},
-		{BGCO, true},
-		{BGCB, true},
-		{BOAT, true},
-		{BOSC, true},
-		{GFIC, true},
-		{GFIF, true},
-		{GFIN, true},
-		{GFIR, true},
-		{GFSO, true},
-		{GFSM, true},
-		{BTEE, true},
-		{BTQG, true},
-		{EBSM, true},
-		{BCXE, true},
-		{BATE, true},
-		{CHIX, true},
-		{BATF, true},
-		{CHIO, true},
-		{BATP, true},
-		{BATD, true},
-		{BOTC, true},
-		{CHID, true},
-		{LISX, true},
-		{EXEU, true},
-		{EXMP, true},
-		{EXOR, true},
-		{EXVP, true},
-		{EXBO, true},
-		{EXCP, true},
-		{EXSI, true},
-		{EXDC, true},
-		{EXLP, true},
-		{CCO2, true},
-		{CHEV, true},
-		{BLNK, true},
-		{NDCM, true},
-		{FAIR, true},
-		{GEMX, true},
-		{IFEU, true},
-		{CXRT, true},
-		{IFLO, true},
-		{IFLL, true},
-		{CXOT, true},
-		{IFUT, true},
-		{IFLX, true},
-		{IFEN, true},
-		{ISWA, true},
-		{ISWR, true},
-		{ISWB, true},
-		{ISWC, true},
-		{KLEU, true},
-		{LIQU, true},
-		{LIQH, true},
-		{LIQF, true},
-		{LMAX, true},
-		{LMAD, true},
-		{LMAE, true},
-		{LMAF, true},
-		{LMAO, true},
-		{LPPM, true},
-		{MAEL, true},
-		{MXLM, true},
-		{MFGL, true},
-		{XLDN, true},
-		{TNLL, true},
-		{XSMP, true},
-		{ENSY, true},
-		{MAQI, true},
-		{VAGL, true},
-		{GMEG, true},
-		{XLDX, true},
-		{XGDX, true},
-		{XGSX, true},
-		{XGCX, true},
-		{BRNX, true},
-		{TPSL, true},
-		{TSMR, true},
-		{CSIN, true},
-		{CSSI, true},
-		{XSWB, true},
-		{QWIX, true},
-		{RBSX, true},
-		{MCUR, true},
-		{MCXR, true},
-		{MCXS, true},
-		{UKPX, true},
-		{ENCL, true},
+		{AFSE, true},
+		{CLMX, true},
+		{ECXE, true},
+		{HCHC, true},
+		{NLPX, true},
+		{TWEU, true},
+		{TWEM, true},
+		{TWEA, true},
+		{TWEO, true},
+		{XACE, true},
+		{XAEX, true},
+		{XAMS, true},
+		{ALXA, true},
+		{XEUC, true},
+		{XEUI, true},
+		{TNLA, true},
+		{XHFT, true},
+		{XEUE, true},
+		{DAMS, true},
+		{XEMS, true},
+		{XFTA, true},
+		{STXS, true},
+		{CBAE, true},
+		{LEBV, true},
+		{GMGE, true},
+		{D2XG, true},
+		{D2XC, true},
+		{XTRN, true},
+		{XTUN, true},
+		{BTUN, true},
+		{TMEX, true},
+		{EWRM, true},
+		{EXTR, true},
+		{XEDA, true},
+		{XEID, true},
+		{XIAB, true},
+		{XIST, true},
+		{XPMS, true},
+		{XFNO, true},
+		{XDSM, true},
+		{XEQY, true},
+		{XTUR, true},
+		{ULTX, true},
+		{XUGA, true},
+		{UICE, true},
+		{XNDU, true},
+		{EESE, true},
+		{PFTS, true},
+		{PFTQ, true},
+		{SEPE, true},
+		{UKEX, true},
+		{XDFB, true},
+		{XKHR, true},
+		{XKIE, true},
+		{XKIS, true},
+		{XODE, true},
+		{XPRI, true},
+		{XUAX, true},
+		{XUKR, true},
+		{MIDC, true},
+		{DEXE, true},
+		{GLOM, true},
+		{IFAD, true},
+		{GMGD, true},
+		{DIFX, true},
+		{DGCX, true},
+		{DUMX, true},
+		{KRME, true},
+		{MATX, true},
+		{XADS, true},
+		{XDFM, true},
+		{VMEX, true},
+		{ABXX, true},
+		{ACXL, true},
+		{ACXC, true},
+		{RFIM, true},
+		{XGGI, true},
+		{DRSP, true},
+		{ZODM, true},
+		{TRUK, true},
+		{UGEN, true},
+		{IOTF, true},
+		{ILCM, true},
+		{IUOB, true},
+		{IOFB, true},
+		{IOFI, true},
+		{IOFX, true},
+		{IOGB, true},
+		{IOCD, true},
+		{IOED, true},
+		{IOGI, true},
+		{IOIR, true},
+		{IOMM, true},
+		{XUBS, true},
+		{XUMP, true},
+		{DBIX, true},
+		{DBDC, true},
+		{DBSE, true},
+		{DBCX, true},
+		{DBCR, true},
+		{UNGB, true},
+		{CFIC, true},
 		{TRAX, true},
-		{CCEU, true},
-		{RBCE, true},
-		{EXOT, true},
-		{GRIF, true},
-		{GRIO, true},
-		{CSLB, true},
-		{GSIL, true},
-		{GSSI, true},
-		{XGCL, true},
-		{XCOR, true},
-		{GRSE, true},
-		{VEGA, true},
-		{ISSI, true},
-		{LOYD, true},
-		{SPRZ, true},
-		{MYTR, true},
-		{N2EX, true},
-		{CLVE, true},
-		{CIBC, true},
-		{CGMG, true},
-		{AUTX, true},
-		{AUTB, true},
-		{AUTP, true},
-		{AFDL, true},
-		{VCMO, true},
-		{PIEU, true},
-		{PIRM, true},
+		{SIFX, true},
+		{ARAX, true},
+		{RABL, true},
+		{NTUK, true},
+		{EVOL, true},
+		{BSPL, true},
+		{BNPL, true},
+		{MSBI, true},
+		{MBSI, true},
+		{AQSE, true},
+		{AQST, true},
+		{AQSL, true},
+		{AQSN, true},
+		{AQSG, true},
+		{AQSF, true},
+		{AQSD, true},
+		{LELE, true},
+		{WFLB, true},
+		{NBFL, true},
+		{NEXX, true},
+		{NEXT, true},
+		{NEXN, true},
+		{NEXF, true},
+		{NEXG, true},
+		{NEXL, true},
+		{NEXD, true},
+		{MAXD, true},
+		{SKYX, true},
+		{XLCH, true},
+		{CLCH, true},
+		{BUYN, true},
+		{DAUK, true},
+		{BSLB, true},
+		{RBCM, true},
+		{VFGB, true},
+		{VFUK, true},
+		{VIUK, true},
+		{XPOL, true},
+		{XPAL, true},
+		{BBSX, true},
+		{JLSI, true},
+		{SCOT, true},
+		{XSGB, true},
+		{VAGM, true},
+		{BMCM, true},
+		{STFL, true},
 		{XALT, true},
+		{ARCH, true},
+		{ARDA, true},
+		{SNUK, true},
+		{CGMG, true},
+		{FNUK, true},
+		{CBNL, true},
+		{BGUK, true},
+		{BGFU, true},
+		{CLVE, true},
+		{CEPU, true},
 		{RTSL, true},
-		{TRAL, true},
 		{TRFW, true},
-		{BCSL, true},
-		{BCSI, true},
-		{TRDE, true},
-		{TCDS, true},
-		{TRDX, true},
-		{ELIX, true},
-		{PFXD, true},
-		{PARX, true},
-		{XLBM, true},
-		{SNUK, true},
-		{TWGP, true},
-		{PPEX, true},
-		{BMLB, true},
-		{NEXS, true},
-		{REST, true},
-		{EBSO, true},
-		{TRQX, true},
-		{TRQM, true},
-		{TRQB, true},
-		{TRQA, true},
-		{TRQC, true},
-		{SPEC, true},
-		{BMTF, true},
-		{SQUA, true},
-		{ICEO, true},
-		{IECL, true},
-		{BSLB, true},
+		{TRAL, true},
+		{ECNL, true},
 		{UBSB, true},
 		{UBSY, true},
-		{AMPX, true},
-		{VIUK, true},
-		{XPOL, true},
-		{XPAL, true},
-		{VFGB, true},
-		{XIEL, true},
-		{BLOX, true},
-		{BRFQ, true},
-		{BNTW, true},
-		{IOTC, true},
-		{SWAP, true},
-		{NOSI, true},
+		{SSIL, true},
+		{BETX, true},
+		{BTLX, true},
+		{AQXE, true},
+		{EIXE, true},
+		{AQXA, true},
+		{AQXD, true},
 		{ICPM, true},
-		{IMGI, true},
-		{IMET, true},
-		{UKOR, true},
 		{UKRE, true},
-		{IMGB, true},
+		{UKOR, true},
 		{IMSB, true},
+		{IMGI, true},
 		{IMRD, true},
-		{IMED, true},
+		{IMET, true},
+		{IMGB, true},
 		{IMMM, true},
-		{IMCE, true},
 		{IMFD, true},
-		{CEPU, true},
-		{CCML, true},
-		{FNUK, true},
-		{NXEU, true},
-		{LCUR, true},
-		{IBAL, true},
-		{SMBE, true},
-		{TREU, true},
-		{TREO, true},
-		{TREA, true},
-		{ATLB, true},
-		{CIBP, true},
-		{BGUK, true},
-		{BGFU, true},
-		{ECSL, true},
+		{IMCE, true},
+		{IMED, true},
+		{IMCM, true},
+		{IMCD, true},
+		{NCML, true},
 		{XPUK, true},
-		{NWMS, true},
-		{NOFF, true},
-		{VTBC, true},
-		{TCML, true},
-		{TDBL, true},
+		{STSI, true},
+		{MLIB, true},
+		{MHBL, true},
+		{EQLD, true},
+		{EQSL, true},
+		{BRGA, true},
 		{SPDX, true},
-		{INVE, true},
-		{BETX, true},
-		{BTLX, true},
-		{SSIL, true},
-		{SECF, true},
-		{SGMX, true},
-		{SGMZ, true},
-		{SGMY, true},
-		{SHAR, true},
-		{MFXC, true},
-		{MFXA, true},
-		{MFXR, true},
+		{ECSL, true},
+		{TPIS, true},
+		{TPMF, true},
+		{TPSY, true},
+		{TPMG, true},
+		{TPLF, true},
+		{CIBC, true},
+		{CIBP, true},
+		{ISSI, true},
+		{WSIL, true},
+		{MAKX, true},
+		{WSIN, true},
+		{WELN, true},
+		{BMLB, true},
+		{CBAL, true},
+		{MUSE, true},
+		{NABE, true},
+		{NABU, true},
+		{NABL, true},
+		{NABA, true},
+		{METZ, true},
+		{MUBL, true},
+		{SMBG, true},
+		{SMBE, true},
+		{MAQI, true},
+		{MAQU, true},
+		{NURO, true},
+		{XNLX, true},
+		{NURD, true},
+		{NOME, true},
+		{HRSI, true},
+		{R5FX, true},
 		{OCSI, true},
-		{EQLD, true},
-		{EQSL, true},
-		{LOUI, true},
-		{CSEC, true},
-		{AQXE, true},
-		{AQXA, true},
-		{EIXE, true},
+		{LBCM, true},
+		{SBEX, true},
+		{VTBC, true},
 		{IPSX, true},
 		{IPXW, true},
 		{IPXP, true},
-		{IFLS, true},
-		{FISU, true},
-		{SFCL, true},
-		{XMTS, true},
-		{BVUK, true},
-		{UKGD, true},
-		{BCRM, true},
-		{BART, true},
-		{BARO, true},
-		{BARK, true},
-		{BOFS, true},
-		{LBCM, true},
-		{TPEO, true},
-		{TEMM, true},
-		{TEFX, true},
-		{TEGB, true},
-		{TERE, true},
-		{TECO, true},
-		{TEIR, true},
-		{TEGI, true},
-		{PVMF, true},
-		{JPSI, true},
+		{ATLB, true},
+		{DAIW, true},
+		{IMTF, true},
+		{STAL, true},
+		{FXOP, true},
+		{TRDE, true},
+		{PFXD, true},
+		{TFSG, true},
+		{TCDS, true},
+		{NAVE, true},
+		{EMCH, true},
+		{VOLA, true},
+		{PARX, true},
+		{ELIX, true},
+		{TRDX, true},
+		{TFSS, true},
+		{DBVX, true},
+		{TFSC, true},
+		{OILX, true},
+		{TCME, true},
+		{TFSE, true},
+		{IGDL, true},
+		{ISWE, true},
+		{ISWV, true},
+		{JSSI, true},
+		{TWGP, true},
+		{GMGL, true},
+		{SEDR, true},
+		{ANTS, true},
+		{SGMX, true},
+		{SGMZ, true},
+		{SGMY, true},
+		{UBIN, true},
+		{CSLB, true},
+		{JISI, true},
+		{JEFS, true},
+		{GFIC, true},
+		{GFSM, true},
+		{GFSO, true},
+		{GFIF, true},
+		{GFIN, true},
+		{GFIR, true},
+		{XGFI, true},
+		{GFIM, true},
+		{MLXN, true},
+		{MLAX, true},
+		{MLVE, true},
+		{MLEU, true},
+		{JPCB, true},
+		{TDBL, true},
+		{ICEO, true},
+		{IECE, true},
+		{ISWA, true},
+		{ISWR, true},
+		{ISWC, true},
+		{ISWB, true},
+		{NWMS, true},
+		{RBCE, true},
+		{KBLM, true},
+		{XIEL, true},
+		{BRFQ, true},
+		{BNTW, true},
+		{BLOX, true},
+		{IOTC, true},
+		{BANA, true},
+		{BASI, true},
+		{BOAL, true},
+		{CSEC, true},
+		{MLIX, true},
+		{MLSI, true},
+		{MLRQ, true},
+		{SQUA, true},
 		{SSBT, true},
 		{SSFX, true},
+		{ARIA, true},
+		{DVFX, true},
+		{LOUI, true},
+		{CCEU, true},
+		{XTXM, true},
+		{VAGL, true},
+		{BCSL, true},
+		{BCSI, true},
+		{GFIB, true},
+		{GFBM, true},
+		{GFBO, true},
+		{SFCL, true},
+		{SUNB, true},
+		{SUNM, true},
+		{SUNO, true},
+		{TPSL, true},
+		{TSUK, true},
+		{TSMC, true},
+		{TSMG, true},
+		{TSMI, true},
+		{TSMB, true},
+		{TSMR, true},
 		{TPSO, true},
+		{TUOB, true},
+		{TEEG, true},
 		{TSCB, true},
+		{TSRE, true},
+		{TSCD, true},
 		{TSGB, true},
 		{TSFI, true},
-		{TUOB, true},
 		{TSED, true},
 		{TSGI, true},
-		{TSCD, true},
 		{TSMM, true},
-		{TSRE, true},
-		{EMBX, true},
-		{STFL, true},
-		{CRYP, true},
-		{HRSI, true},
-		{UBIN, true},
-		{JPCB, true},
-		{XTXM, true},
-		{SUNB, true},
-		{SUNO, true},
-		{GFIB, true},
-		{GFBM, true},
-		{GFBO, true},
-		{METZ, true},
-		{GSIB, true},
-		{BISI, true},
-		{MUSE, true},
-		{NABL, true},
-		{NABA, true},
-		{MUFP, true},
-		{BANA, true},
-		{BOAL, true},
-		{BASI, true},
-		{DVFX, true},
-		{XLON, true},
-		{XLOD, true},
-		{XLOM, true},
-		{AIMX, true},
-		{MUBL, true},
-		{CSEU, true},
-		{CSCF, true},
-		{SICS, true},
-		{CSBX, true},
-		{XLCH, true},
-		{CLCH, true},
-		{BUYN, true},
-		{MUTI, true},
-		{ANZL, true},
-		{ANLP, true},
-		{SKYX, true},
-		{CMEE, true},
-		{CMEC, true},
-		{PEEL, true},
-		{XRSP, true},
-		{XPHX, true},
-		{WINS, true},
-		{WINX, true},
-		{CBNL, true},
-		{IMTF, true},
-		{DAIW, true},
-		{KBLM, true},
+		{TSIR, true},
+		{TSFX, true},
+		{CGML, true},
+		{CGME, true},
+		{CGMC, true},
+		{CGMU, true},
+		{CGMT, true},
+		{MAQL, true},
 		{TPEL, true},
-		{TEMI, true},
-		{TEMB, true},
+		{TEMG, true},
+		{TEMC, true},
 		{TEFD, true},
+		{TEMF, true},
+		{TEMI, true},
 		{TIRD, true},
+		{TEMB, true},
 		{TEMR, true},
-		{TEMF, true},
-		{CGML, true},
-		{CGMU, true},
-		{CGMC, true},
-		{CGMT, true},
-		{XUBS, true},
-		{XUMP, true},
+		{TPEO, true},
+		{TEOF, true},
+		{TECO, true},
+		{IECL, true},
+		{TERE, true},
+		{TEFX, true},
+		{TEMM, true},
+		{TEGI, true},
+		{TEIR, true},
+		{TEGB, true},
+		{PVMF, true},
+		{LOYD, true},
+		{BOFS, true},
+		{BPLC, true},
+		{BBSI, true},
+		{BKLN, true},
+		{BKLF, true},
+		{FISU, true},
+		{PPEX, true},
+		{INVE, true},
+		{IFLS, true},
+		{EXOT, true},
+		{HSXE, true},
+		{ANZL, true},
+		{ANLP, true},
+		{ECHO, true},
+		{HSBC, true},
+		{STAN, true},
+		{VCMO, true},
+		{CSIN, true},
+		{CSSI, true},
+		{DOWG, true},
+		{AMPX, true},
+		{FXGB, true},
+		{TRSI, true},
+		{BTEE, true},
+		{BTQG, true},
+		{EBSX, true},
+		{EBSM, true},
 		{RBCT, true},
 		{RTSI, true},
-		{MHIP, true},
+		{GSIB, true},
+		{BISI, true},
+		{MUFP, true},
+		{CCML, true},
 		{X3579, true},
-		{NCML, true},
-		{MHBL, true},
-		{JISI, true},
-		{JEFS, true},
-		{BPLC, true},
-		{BBSI, true},
-		{ARAX, true},
-		{ARCH, true},
-		{NEXX, true},
-		{NEXT, true},
-		{NEXN, true},
-		{NEXF, true},
-		{NEXG, true},
-		{NEXD, true},
-		{NEXL, true},
-		{CMMT, true},
-		{MAQL, true},
-		{BMCM, true},
-		{MAQU, true},
-		{MAXD, true},
-		{OTCE, true},
-		{CBAL, true},
-		{JSSI, true},
-		{VAGM, true},
-		{XSGB, true},
-		{NABE, true},
-		{NABU, true},
-		{IGDL, true},
-		{ISWV, true},
-		{ISWE, true},
-		{ARIA, true},
-		{MSIP, true},
-		{MSSI, true},
-		{TPIE, true},
-		{NBFL, true},
-		{WSIL, true},
+		{MHIP, true},
+		{WINS, true},
+		{WINX, true},
+		{TCML, true},
 		{FRTE, true},
-		{SBEX, true},
-		{SMBG, true},
-		{BAPA, true},
+		{MUTI, true},
+		{NDCM, true},
+		{SPEC, true},
+		{BCRM, true},
+		{BART, true},
+		{BARO, true},
+		{BARK, true},
+		{MFXC, true},
+		{MFXR, true},
+		{MFXA, true},
+		{NOSI, true},
+		{AUTX, true},
+		{AUTB, true},
+		{AUTP, true},
+		{NEXS, true},
+		{EBSO, true},
+		{REST, true},
+		{BALT, true},
+		{BLTX, true},
+		{PEEL, true},
+		{XRSP, true},
+		{XPHX, true},
+		{PHSI, true},
+		{CAZE, true},
+		{JPSI, true},
+		{CSEU, true},
+		{SICS, true},
+		{CSBX, true},
+		{CSCF, true},
+		{CSFB, true},
+		{GRIF, true},
+		{GRIO, true},
 		{LMEC, true},
-		{RBCM, true},
-		{ANTS, true},
-		{GMGL, true},
-		{FXOP, true},
-		{R5FX, true},
-		{BNPL, true},
-		{STAN, true},
-		{ECHO, true},
-		{XLME, true},
-		{TRSI, true},
-		{FXGB, true},
-		{EVOL, true},
-		{BRGA, true},
-		{HSBC, true},
+		{KMTS, true},
+		{ICEU, true},
+		{FXMT, true},
+		{QMTS, true},
+		{XEDX, true},
+		{CRDL, true},
+		{BAIK, true},
+		{UMTS, true},
+		{XPLU, true},
+		{AFDL, true},
+		{BAPA, true},
+		{BCXE, true},
+		{BATE, true},
+		{BATF, true},
+		{BATD, true},
+		{CHIY, true},
+		{CHIO, true},
+		{BATP, true},
+		{CHID, true},
+		{BOTC, true},
+		{LISX, true},
+		{CHIX, true},
+		{BGCI, true},
+		{BGCM, true},
+		{BGCO, true},
+		{BGCB, true},
+		{BMTF, true},
+		{BOAT, true},
+		{BOSC, true},
+		{BRNX, true},
+		{CCO2, true},
+		{CHEV, true},
+		{BLNK, true},
+		{CMEE, true},
+		{CMED, true},
+		{CMEC, true},
+		{CMMT, true},
+		{CRYP, true},
+		{EMBX, true},
+		{ENCL, true},
+		{EXEU, true},
+		{EXSI, true},
+		{EXOR, true},
+		{EXVP, true},
+		{EXMP, true},
+		{EXLP, true},
+		{EXCP, true},
+		{EXBO, true},
+		{EXDC, true},
+		{FAIR, true},
+		{GEMX, true},
+		{GFIA, true},
+		{GMEG, true},
+		{XGDX, true},
+		{XLDX, true},
+		{XGCX, true},
+		{XGSX, true},
+		{GRSE, true},
+		{GSIL, true},
+		{GSBX, true},
+		{GSSI, true},
+		{IBAL, true},
+		{ICAP, true},
+		{ICEN, true},
+		{ISDX, true},
+		{WCLK, true},
+		{ICAH, true},
+		{ICSE, true},
+		{ICTQ, true},
+		{PLSX, true},
+		{IFEU, true},
+		{CXOT, true},
+		{CXRT, true},
+		{IFLX, true},
+		{IFLL, true},
+		{IFEN, true},
+		{IFLO, true},
+		{IFUT, true},
+		{KLEU, true},
+		{LCUR, true},
+		{LIQU, true},
+		{LIQH, true},
+		{LIQF, true},
+		{LMAX, true},
+		{LMAO, true},
+		{LMAE, true},
+		{LMAF, true},
+		{LMAD, true},
 		{LOTC, true},
 		{PLDX, true},
-		{IOTF, true},
-		{IOIR, true},
-		{IOMM, true},
-		{IOFX, true},
-		{IUOB, true},
-		{IOCD, true},
-		{IOGI, true},
-		{IOED, true},
-		{IOGB, true},
-		{IOFI, true},
-		{ILCM, true},
+		{LPPM, true},
+		{MAEL, true},
+		{MXLM, true},
+		{RFQU, true},
+		{MCUR, true},
+		{MCXR, true},
+		{MCXS, true},
+		{MFGL, true},
+		{MSIP, true},
+		{MSSI, true},
+		{MYTR, true},
+		{N2EX, true},
+		{NOFF, true},
+		{NXEU, true},
+		{NYMX, true},
+		{OFEX, true},
+		{OTCE, true},
+		{PIEU, true},
+		{PIRM, true},
+		{QWIX, true},
+		{RBSX, true},
+		{SECF, true},
+		{SHAR, true},
+		{SLXT, true},
+		{SPRZ, true},
 		{SSEX, true},
-		{MLIX, true},
-		{MLSI, true},
-		{MLRQ, true},
-		{MAKX, true},
-		{WSIN, true},
-		{WELN, true},
-		{SCOT, true},
-		{BBSX, true},
-		{BKLN, true},
-		{BKLF, true},
-		{DOWG, true},
-		{WFLB, true},
-		{LELE, true},
-		{MSBI, true},
-		{MBSI, true},
-		{BSPL, true},
-		{SIFX, true},
-		{CFIC, true},
-		{RABL, true},
-		{NTUK, true},
-		{UNGB, true},
+		{SWAP, true},
+		{TFSV, true},
+		{TPIE, true},
+		{TPIM, true},
+		{TREU, true},
+		{TREA, true},
+		{TREO, true},
+		{TRQX, true},
+		{TRQS, true},
+		{TRQC, true},
+		{TRQM, true},
+		{TRQB, true},
+		{TRQA, true},
+		{TRQD, true},
+		{UKPX, true},
+		{VEGA, true},
+		{XCOR, true},
+		{XGCL, true},
+		{XIPE, true},
+		{XJWY, true},
+		{XLBM, true},
+		{XLCE, true},
+		{XLDN, true},
+		{TNLL, true},
+		{XSMP, true},
+		{ENSY, true},
+		{XLIF, true},
+		{XLME, true},
+		{XLON, true},
+		{XLOD, true},
+		{AIMX, true},
+		{XLOM, true},
+		{XLTO, true},
+		{XMLX, true},
+		{XMTS, true},
+		{MCZK, true},
+		{HUNG, true},
+		{EMTS, true},
+		{MTSA, true},
+		{GMTS, true},
+		{MTSG, true},
+		{IMTS, true},
+		{RMTS, true},
+		{AMTS, true},
+		{PORT, true},
+		{SLKK, true},
+		{VMTS, true},
+		{SMTS, true},
+		{UKGD, true},
+		{MTSS, true},
+		{MTSW, true},
+		{PRME, true},
+		{CMTS, true},
+		{TMTS, true},
+		{LMTS, true},
+		{EACM, true},
+		{BVUK, true},
+		{NMTS, true},
+		{USWP, true},
+		{XSWB, true},
+		{XTFN, true},
+		{XTUP, true},
+		{TPCD, true},
+		{TBLA, true},
+		{TPFD, true},
+		{TPSP, true},
+		{TPSD, true},
+		{TPRE, true},
+		{TPEQ, true},
+		{XTPE, true},
+		{TBEN, true},
+		{UKCA, true},
+		{XEBS, true},
+		{GFOX, true},
+		{BBVX, true},
+		{HPSX, true},
+		{HPSO, true},
+		{CMCM, true},
+		{TPID, true},
+		{TPDA, true},
+		{INGU, true},
+		{SISU, true},
+		{OTXT, true},
+		{TMUK, true},
+		{ALGO, true},
+		{GSLO, true},
+		{G360, true},
+		{AFTS, true},
+		{ACCX, true},
+		{JPJX, true},
+		{SFMP, true},
+		{PUND, true},
+		{XOCH, true},
+		{OTCM, true},
+		{EXPM, true},
+		{CAVE, true},
+		{OTCB, true},
+		{PINL, true},
+		{PINI, true},
+		{PINX, true},
+		{OTCQ, true},
+		{PSGM, true},
+		{PINC, true},
+		{FREX, true},
+		{IDXM, true},
+		{OCEA, true},
+		{GFAM, true},
+		{FNIX, true},
+		{LATG, true},
+		{UBEC, true},
+		{AMLG, true},
+		{KLSH, true},
+		{LEVL, true},
+		{EBXV, true},
+		{STRM, true},
+		{BNPC, true},
+		{CGXS, true},
+		{EQUS, true},
+		{XTXD, true},
+		{BSTX, true},
+		{CCMX, true},
+		{XCBO, true},
+		{CONE, true},
+		{CTWO, true},
+		{C2OX, true},
+		{EDGA, true},
+		{EDGD, true},
+		{EDGO, true},
+		{EDGX, true},
+		{BATS, true},
+		{BATY, true},
+		{BYXD, true},
+		{BZXD, true},
+		{EDDP, true},
+		{BATO, true},
+		{CBSX, true},
+		{XCBF, true},
+		{XCBD, true},
+		{CLST, true},
+		{FAST, true},
+		{MEMX, true},
+		{MEMD, true},
+		{MEMM, true},
+		{MXOP, true},
+		{PIPR, true},
+		{PJCX, true},
+		{TRAI, true},
+		{COHR, true},
+		{STFU, true},
+		{STFX, true},
+		{ATDF, true},
+		{LTSE, true},
+		{CALH, true},
+		{THRE, true},
+		{FXPS, true},
+		{FXNM, true},
+		{WEED, true},
+		{XWEE, true},
+		{BKKT, true},
+		{XPUS, true},
+		{JLQD, true},
+		{JLEQ, true},
+		{LAMP, true},
+		{RCMA, true},
+		{ERIS, true},
+		{YKNA, true},
+		{DASH, true},
+		{TRCX, true},
+		{CODA, true},
+		{PDQX, true},
+		{PDQD, true},
+		{SAGE, true},
+		{JPMS, true},
+		{ARKX, true},
+		{PUMA, true},
+		{PUMX, true},
+		{FTUS, true},
+		{CPGX, true},
+		{NLAX, true},
+		{DBAB, true},
+		{OTCN, true},
+		{SCXO, true},
+		{ZERO, true},
+		{SCXS, true},
+		{SCXM, true},
+		{SCXA, true},
+		{SCXF, true},
+		{SGAS, true},
+		{SGA2, true},
+		{SMFE, true},
+		{BLUE, true},
+		{DWFI, true},
+		{XTRD, true},
+		{VUSA, true},
+		{VALX, true},
+		{VCRS, true},
+		{VFMI, true},
+		{WELX, true},
+		{WELS, true},
+		{HRTF, true},
+		{FICO, true},
+		{XFCI, true},
+		{XFDA, true},
+		{HRTX, true},
+		{INCR, true},
+		{ASPI, true},
+		{ASMT, true},
+		{ASPN, true},
+		{NTRL, true},
+		{TSBX, true},
+		{MAGM, true},
+		{UBSA, true},
+		{XPIN, true},
+		{UBSP, true},
+		{UBSS, true},
+		{PULX, true},
+		{BAMX, true},
+		{XBOX, true},
+		{GLMX, true},
+		{CURX, true},
+		{BTNL, true},
+		{SUNT, true},
+		{ADVT, true},
+		{IMCS, true},
+		{THEM, true},
+		{LEDG, true},
+		{FUSD, true},
+		{MTUS, true},
+		{BVUS, true},
+		{MTSB, true},
+		{GOTC, true},
+		{VERT, true},
+		{MTXX, true},
+		{MKAA, true},
+		{MTXA, true},
+		{MTXS, true},
+		{MTXC, true},
+		{MTXM, true},
+		{VIRT, true},
+		{CGMI, true},
+		{ONEC, true},
+		{CORE, true},
+		{CICX, true},
+		{CBLC, true},
+		{CIOI, true},
+		{LQFI, true},
+		{LQED, true},
+		{TSAD, true},
+		{JPBX, true},
+		{JSEF, true},
+		{EDGE, true},
+		{IEXG, true},
+		{IEXD, true},
+		{IEXC, true},
+		{ICUS, true},
+		{XBMK, true},
+		{XEUS, true},
+		{XNYF, true},
+		{BAMP, true},
+		{AATS, true},
+		{AQUA, true},
 		{BAML, true},
-		{MLVX, true},
 		{MLCO, true},
+		{MLVX, true},
 		{BARX, true},
-		{BARD, true},
-		{BCDX, true},
 		{BBOK, true},
+		{BCDX, true},
 		{BARL, true},
+		{BARD, true},
+		{BBSF, true},
+		{BBSN, true},
 		{BGCF, true},
-		{FNCS, true},
+		{FNFX, true},
 		{BGCD, true},
+		{FNCS, true},
+		{FNFT, true},
+		{FNXB, true},
+		{BHSF, true},
 		{BIDS, true},
-		{BLTD, true},
 		{BPOL, true},
+		{BLTD, true},
 		{BNYC, true},
 		{VTEX, true},
 		{NYFX, true},
 		{BTEC, true},
-		{ICSU, true},
 		{BTEQ, true},
-		{GLLC, true},
-		{GOVX, true},
-		{GREE, true},
-		{GTCO, true},
-		{HEGX, true},
-		{HSFX, true},
-		{ICEL, true},
-		{AATS, true},
-		{AQUA, true},
+		{ICSU, true},
+		{CCFE, true},
 		{CDED, true},
-		{CGMI, true},
-		{CBLC, true},
-		{LQFI, true},
-		{CIOI, true},
-		{CORE, true},
-		{ONEC, true},
+		{CDEL, true},
+		{CMSF, true},
 		{CRED, true},
-		{CAES, true},
+		{CSLP, true},
 		{CSCL, true},
 		{CSVW, true},
+		{CAES, true},
 		{DBSX, true},
 		{DEAL, true},
-		{ERIS, true},
-		{FAST, true},
+		{EGMT, true},
+		{FINR, true},
+		{FINN, true},
+		{XADF, true},
+		{FINC, true},
+		{FINO, true},
+		{FINY, true},
+		{OOTC, true},
+		{FSEF, true},
 		{FXAL, true},
 		{FXCM, true},
+		{G1XX, true},
+		{GLLC, true},
+		{GLPS, true},
+		{GLPX, true},
+		{GOVX, true},
+		{GREE, true},
+		{GSCO, true},
+		{SGMT, true},
+		{GSEF, true},
+		{GTCO, true},
+		{GTSX, true},
+		{GTXS, true},
+		{HEGX, true},
+		{HPPO, true},
+		{HSFX, true},
+		{ICEL, true},
 		{IFUS, true},
-		{IEPA, true},
+		{TMCC, true},
+		{VABD, true},
+		{IMCC, true},
 		{IMFX, true},
-		{IMAG, true},
-		{IMBD, true},
+		{IFED, true},
+		{IEPA, true},
+		{IMCG, true},
+		{IMIR, true},
 		{IMCR, true},
 		{IMEN, true},
-		{IMIR, true},
 		{ICES, true},
-		{IMCG, true},
-		{VABD, true},
-		{TMCC, true},
-		{IFED, true},
-		{IMCC, true},
+		{IMAG, true},
+		{IMBD, true},
+		{ISDA, true},
 		{ITGI, true},
+		{JEFX, true},
+		{JNST, true},
 		{JPMX, true},
+		{JSES, true},
+		{JSJX, true},
 		{KNIG, true},
-		{KNCM, true},
+		{KNMX, true},
+		{ACKF, true},
 		{KNEM, true},
 		{KNLI, true},
-		{ACKF, true},
-		{LEVL, true},
-		{EBXV, true},
-		{XNYM, true},
-		{XNYL, true},
-		{XCEC, true},
-		{XNYE, true},
-		{XCUR, true},
-		{XCBO, true},
-		{BATO, true},
-		{BATS, true},
-		{BATY, true},
-		{EDDP, true},
-		{EDGA, true},
-		{EDGD, true},
-		{EDGX, true},
-		{BZXD, true},
-		{BYXD, true},
-		{C2OX, true},
-		{CBSX, true},
-		{EDGO, true},
-		{XCBF, true},
-		{XCBT, true},
-		{FCBT, true},
-		{XKBT, true},
-		{SMFE, true},
-		{XAQS, true},
-		{LQED, true},
-		{RICX, true},
-		{RICD, true},
-		{XCFF, true},
-		{XNAS, true},
-		{XNCM, true},
-		{XNDQ, true},
-		{XNGS, true},
-		{XNIM, true},
-		{XNMS, true},
-		{XPBT, true},
-		{XPHL, true},
-		{XBOS, true},
-		{XPHO, true},
-		{XPOR, true},
-		{PSXD, true},
-		{XBXO, true},
-		{XNFI, true},
-		{MELO, true},
-		{XPSX, true},
-		{NASD, true},
-		{XBRT, true},
-		{BOSD, true},
-		{TRCK, true},
-		{FICO, true},
-		{XFDA, true},
-		{XFCI, true},
-		{HPPO, true},
-		{GLPS, true},
-		{G1XX, true},
-		{GLMX, true},
-		{CODA, true},
-		{VERT, true},
+		{KNCM, true},
+		{LASF, true},
+		{LAVA, true},
+		{LAFX, true},
+		{LAFL, true},
+		{LAFD, true},
 		{LIUS, true},
 		{LIUH, true},
 		{LIFI, true},
-		{TSEF, true},
+		{LTAA, true},
+		{LMNX, true},
+		{MIHI, true},
+		{EPRD, true},
+		{EMLD, true},
+		{EPRL, true},
+		{MPRL, true},
+		{XMIO, true},
+		{SPHR, true},
 		{MSCO, true},
-		{MSPL, true},
 		{MSRP, true},
+		{MSPL, true},
+		{MSTC, true},
 		{MSTX, true},
-		{TERA, true},
-		{BAMX, true},
-		{XOTC, true},
+		{MSLP, true},
+		{MSLC, true},
 		{NBLX, true},
-		{XISX, true},
-		{GMNI, true},
-		{XISE, true},
-		{XISA, true},
-		{MCRY, true},
-		{SOHO, true},
-		{UBSA, true},
-		{UBSP, true},
-		{LASF, true},
-		{ADVT, true},
-		{WSAG, true},
-		{WABR, true},
-		{VNDM, true},
-		{SSTX, true},
+		{NFSC, true},
+		{NFSD, true},
+		{XSTM, true},
+		{NFSA, true},
+		{NMRA, true},
+		{NODX, true},
+		{NXUS, true},
 		{NYPC, true},
+		{OLLC, true},
 		{OPRA, true},
-		{OTCM, true},
-		{PINC, true},
-		{PINI, true},
-		{PINL, true},
-		{OTCB, true},
-		{OTCQ, true},
-		{PINX, true},
-		{PSGM, true},
-		{CAVE, true},
-		{EXPM, true},
+		{PIPE, true},
+		{PRSE, true},
+		{RICX, true},
+		{RICD, true},
+		{SGMA, true},
+		{SHAW, true},
+		{SHAD, true},
+		{SIGX, true},
+		{SOHO, true},
+		{SSTX, true},
+		{TERA, true},
+		{TFSU, true},
 		{TMID, true},
+		{TPSE, true},
+		{TPSV, true},
+		{TPSB, true},
+		{TRCK, true},
 		{TRUX, true},
-		{TRU1, true},
 		{TRU2, true},
-		{PIPE, true},
-		{PRSE, true},
-		{WELS, true},
-		{THRE, true},
-		{FXPS, true},
+		{TRU1, true},
 		{TRWB, true},
 		{BNDD, true},
 		{TWSF, true},
 		{DWSF, true},
-		{ARKX, true},
-		{GSEF, true},
-		{JSES, true},
+		{TRFX, true},
+		{TSEF, true},
+		{VFCM, true},
+		{WSAG, true},
+		{VNDM, true},
+		{WABR, true},
+		{XAQS, true},
+		{XARC, true},
+		{XAZX, true},
+		{XBTF, true},
+		{XCBT, true},
+		{FCBT, true},
+		{XKBT, true},
+		{XCCX, true},
+		{XCFF, true},
 		{XCME, true},
+		{FCME, true},
+		{XIMM, true},
+		{GLBX, true},
 		{XIOM, true},
-		{NYMS, true},
 		{CMES, true},
 		{CBTS, true},
+		{NYMS, true},
 		{CECS, true},
-		{FCME, true},
-		{GLBX, true},
-		{XIMM, true},
-		{MTXX, true},
-		{MTXM, true},
-		{MTXS, true},
-		{MTXC, true},
-		{MTXA, true},
-		{MKAA, true},
-		{THEM, true},
-		{VUSA, true},
-		{VFMI, true},
-		{VCRS, true},
-		{VALX, true},
-		{FINR, true},
-		{FINC, true},
-		{OOTC, true},
-		{FINN, true},
-		{FINO, true},
-		{FINY, true},
-		{XADF, true},
-		{TFSU, true},
-		{SGAS, true},
-		{SGA2, true},
-		{DBAB, true},
-		{TRAI, true},
-		{FTUS, true},
-		{JPMS, true},
-		{PUMA, true},
-		{PUMX, true},
-		{NXUS, true},
-		{XBOX, true},
-		{BBSF, true},
-		{NFSC, true},
-		{NFSA, true},
-		{NFSD, true},
-		{XSTM, true},
-		{XGMX, true},
-		{NODX, true},
-		{JLQD, true},
-		{JSJX, true},
-		{SGMA, true},
-		{SHAW, true},
-		{SHAD, true},
-		{GTXS, true},
-		{EGMT, true},
-		{FSEF, true},
-		{XMER, true},
-		{BTNL, true},
-		{DWFI, true},
+		{XCRC, true},
+		{XCSC, true},
+		{XCUR, true},
 		{XELX, true},
-		{XMGE, true},
-		{HRTF, true},
-		{NMRA, true},
-		{BLUE, true},
+		{XGMX, true},
 		{XINS, true},
+		{BLKX, true},
 		{INCA, true},
-		{MOCX, true},
+		{IIDX, true},
+		{IBLX, true},
+		{RCBX, true},
 		{ICRO, true},
-		{BLKX, true},
 		{ICBX, true},
-		{SCXO, true},
-		{SCXS, true},
-		{SCXA, true},
-		{SCXM, true},
-		{SCXF, true},
-		{ZERO, true},
-		{JPBX, true},
-		{BKKT, true},
-		{IEXG, true},
-		{IEXD, true},
-		{MAGM, true},
-		{GSCO, true},
-		{SGMT, true},
-		{LTAA, true},
-		{LMNX, true},
-		{BHSF, true},
-		{TSAD, true},
-		{IMCS, true},
-		{XOCH, true},
-		{MEMX, true},
-		{MEMD, true},
-		{XPUS, true},
-		{SAGE, true},
-		{CMSF, true},
-		{UBSS, true},
-		{GOTC, true},
-		{GTSX, true},
+		{MOCX, true},
+		{XISX, true},
+		{XISE, true},
+		{GMNI, true},
+		{XTPZ, true},
+		{MCRY, true},
+		{XISA, true},
+		{XMAC, true},
+		{XMER, true},
+		{XMGE, true},
+		{XMID, true},
+		{XNAS, true},
+		{XNFI, true},
+		{ESPD, true},
+		{MELO, true},
+		{NASD, true},
+		{XNMS, true},
+		{XNDQ, true},
+		{XNGS, true},
+		{XNCM, true},
+		{XNIM, true},
+		{XBOS, true},
+		{BOSD, true},
+		{XBXO, true},
+		{XPOR, true},
+		{XPSX, true},
+		{XBRT, true},
+		{PSXD, true},
+		{XPBT, true},
+		{XPHO, true},
+		{XPHL, true},
+		{XNQL, true},
+		{XNYC, true},
+		{XNYM, true},
+		{XCEC, true},
+		{XNYE, true},
+		{XNYL, true},
 		{XNYS, true},
-		{ALDP, true},
-		{AMXO, true},
-		{ARCD, true},
-		{ARCO, true},
+		{CISD, true},
 		{XCHI, true},
-		{NYSD, true},
 		{XCIS, true},
+		{ALDP, true},
+		{ARCX, true},
 		{XASE, true},
 		{XNLI, true},
-		{ARCX, true},
-		{CISD, true},
-		{GLPX, true},
-		{MIHI, true},
-		{XMIO, true},
-		{EPRL, true},
-		{EMLD, true},
-		{MPRL, true},
-		{EPRD, true},
-		{PIPR, true},
-		{PJCX, true},
-		{TPSE, true},
-		{TPSV, true},
-		{VIRT, true},
-		{XTRD, true},
-		{TSBX, true},
-		{ISDA, true},
-		{DASH, true},
-		{VFCM, true},
-		{OLLC, true},
-		{COHR, true},
-		{STFX, true},
-		{JEFX, true},
-		{OTCN, true},
-		{JNST, true},
-		{CURX, true},
-		{IATS, true},
-		{SUNT, true},
-		{INCR, true},
-		{ASPI, true},
-		{ASMT, true},
-		{ASPN, true},
-		{HRTX, true},
-		{YKNA, true},
-		{RCMA, true},
-		{LTSE, true},
-		{FREX, true},
-		{CCMX, true},
-		{TRCX, true},
-		{LEDG, true},
+		{NYSD, true},
+		{AMXO, true},
+		{ARCD, true},
+		{ARCO, true},
+		{XOTC, true},
+		{XPSE, true},
 		{XSEF, true},
-		{CALH, true},
-		{CPGX, true},
-		{NLAX, true},
-		{LAMP, true},
-		{MTUS, true},
-		{BVUS, true},
-		{MTSB, true},
-		{CLST, true},
-		{EQUS, true},
-		{BSTX, true},
-		{XTXD, true},
-		{STRM, true},
-		{BNPC, true},
-		{KLSH, true},
-		{CGXS, true},
-		{AMLG, true},
-		{UBEC, true},
-		{GFAM, true},
-		{FNIX, true},
-		{LATG, true},
-		{BVUR, true},
+		{ASEF, true},
+		{OTCI, true},
+		{SPTX, true},
+		{CAST, true},
+		{USEF, true},
+		{SFOX, true},
+		{RAJA, true},
+		{LPSF, true},
+		{SPAX, true},
+		{LESI, true},
+		{INTL, true},
+		{U360, true},
+		{EDXM, true},
+		{IBKR, true},
+		{IATS, true},
+		{IEOS, true},
+		{RTXF, true},
+		{XIMX, true},
+		{CFIM, true},
+		{BNDS, true},
+		{NPMS, true},
+		{OCTL, true},
+		{BNPH, true},
+		{DWIN, true},
+		{OCTC, true},
+		{IBCO, true},
+		{IEXA, true},
+		{RBCS, true},
+		{LAKE, true},
 		{UFEX, true},
+		{BVUR, true},
 		{XMNT, true},
 		{XCET, true},
 		{XCUE, true},
-		{XUNI, true},
 		{XKCE, true},
 		{XSTE, true},
-		{GXMA, true},
+		{XUNI, true},
 		{POTC, true},
+		{GXMA, true},
 		{BVCA, true},
+		{XCAR, true},
 		{HSTC, true},
-		{XHNX, true},
 		{XHNF, true},
+		{XHNX, true},
 		{XSTC, true},
 		{XLUS, true},
 		{XZIM, true},
-		{XXXX, true},
-		{XOFF, true},
+		{VFEX, true},
 		{BILT, true},
+		{XOFF, true},
+		{XXXX, true},
 	}
 
 	for _, tt := range tests {
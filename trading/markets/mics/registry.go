@@ -0,0 +1,330 @@
+package mics
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entry is the ISO 10383 reference data of a MIC held by a Registry.
+// The zero dates denote missing dates.
+type Entry struct {
+	MIC                MIC
+	OperatingMIC       MIC
+	Name               string
+	LegalEntity        string
+	LEI                string
+	Category           Category
+	Acronym            string
+	Country            string
+	City               string
+	Website            string
+	Status             Status
+	CreationDate       time.Time
+	LastUpdateDate     time.Time
+	LastValidationDate time.Time
+	ExpiryDate         time.Time
+	Comments           string
+}
+
+// IsActive indicates if the MIC of this entry is not expired.
+func (e *Entry) IsActive() bool {
+	return e.Status != StatusExpired
+}
+
+// IsOperational indicates if the MIC of this entry is an operational MIC, not a segment MIC.
+func (e *Entry) IsOperational() bool {
+	return e.MIC == e.OperatingMIC
+}
+
+// Changes reports how a data release loaded into a Registry changed it.
+// The MICs are listed in the order of the data release.
+type Changes struct {
+	// Added are the MICs which were not known before.
+	Added []MIC
+
+	// Modified are the known MICs with changed reference data, except the Expired ones.
+	Modified []MIC
+
+	// Expired are the known active MICs which are expired in the data release.
+	Expired []MIC
+}
+
+// Registry is a thread-safe registry of the ISO 10383 reference data of MICs.
+//
+// It starts with the compiled-in predefined MICs and merges newer ISO 10383 data releases
+// loaded at runtime, so a long-running service picks up new venues without a rebuild.
+// The MICs not present in a data release are kept as they are.
+//
+// The MIC methods like Name, Category or Location always use the compiled-in data;
+// the MICs known only to a registry have no time zone and are located in UTC.
+//
+// Use the NewRegistry to create a properly initialized new instance.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[MIC]*Entry
+}
+
+const dateLayout = "20060102"
+
+// The ISO 10383 CSV columns.
+const (
+	columnMIC            = "MIC"
+	columnOperatingMIC   = "OPERATING MIC"
+	columnName           = "MARKET NAME-INSTITUTION DESCRIPTION"
+	columnLegalEntity    = "LEGAL ENTITY NAME"
+	columnLEI            = "LEI"
+	columnCategory       = "MARKET CATEGORY CODE"
+	columnAcronym        = "ACRONYM"
+	columnCountry        = "ISO COUNTRY CODE (ISO 3166)"
+	columnCity           = "CITY"
+	columnWebsite        = "WEBSITE"
+	columnStatus         = "STATUS"
+	columnCreation       = "CREATION DATE"
+	columnLastUpdate     = "LAST UPDATE DATE"
+	columnLastValidation = "LAST VALIDATION DATE"
+	columnExpiry         = "EXPIRY DATE"
+	columnComments       = "COMMENTS"
+)
+
+var (
+	errInvalidCSVHeader = errors.New("invalid CSV header")
+	errInvalidMIC       = errors.New("MIC should have 4 upper case alphanumeric symbols")
+	errInvalidCountry   = errors.New("ISO 3166 country code should have 2 symbols")
+	errInvalidStatus    = errors.New("status should be ACTIVE, UPDATED or EXPIRED")
+	errInvalidDate      = errors.New("date should be in YYYYMMDD format")
+)
+
+// NewRegistry creates a new registry containing the compiled-in predefined MICs.
+func NewRegistry() *Registry {
+	r := Registry{entries: make(map[MIC]*Entry, len(predefined))}
+
+	for i := range predefined {
+		d := &predefined[i]
+		r.entries[d.mic] = &Entry{
+			MIC:                d.mic,
+			OperatingMIC:       d.operational,
+			Name:               d.name,
+			LegalEntity:        d.legalEntity,
+			LEI:                d.lei,
+			Category:           d.category,
+			Acronym:            d.acronym,
+			Country:            d.country,
+			City:               d.city,
+			Website:            d.website,
+			Status:             d.status,
+			CreationDate:       date(d.created),
+			LastUpdateDate:     date(d.updated),
+			LastValidationDate: date(d.validated),
+			ExpiryDate:         date(d.expires),
+			Comments:           d.comments,
+		}
+	}
+
+	return &r
+}
+
+// Lookup returns a copy of the reference data of a MIC.
+// The boolean is false if the MIC is not known to the registry.
+func (r *Registry) Lookup(m MIC) (Entry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if e, ok := r.entries[m]; ok {
+		return *e, true
+	}
+
+	return Entry{}, false
+}
+
+// Entries returns copies of all entries of the registry sorted by MIC.
+func (r *Registry) Entries() []Entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	es := make([]Entry, 0, len(r.entries))
+	for _, e := range r.entries {
+		es = append(es, *e)
+	}
+
+	sort.Slice(es, func(i, j int) bool { return es[i].MIC < es[j].MIC })
+
+	return es
+}
+
+// Len returns the number of MICs known to the registry.
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.entries)
+}
+
+// LoadCSV merges an ISO 10383 data release in the CSV format published at
+// https://www.iso20022.org/market-identifier-codes and reports the changes.
+//
+// The first row is a header with the ISO 10383 column names, e.g. MIC, OPERATING MIC or STATUS,
+// in any order. The MIC, OPERATING MIC, ISO COUNTRY CODE (ISO 3166) and STATUS columns are required.
+// The dates are in the YYYYMMDD format, the websites are converted to lower case.
+//
+// The data release is merged atomically: if any row is invalid, the registry is not changed.
+func (r *Registry) LoadCSV(rd io.Reader) (Changes, error) {
+	cr := csv.NewReader(rd)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return Changes{}, fmt.Errorf("cannot read MICs: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return Changes{}, errInvalidCSVHeader
+	}
+
+	columns, err := parseHeader(rows[0])
+	if err != nil {
+		return Changes{}, err
+	}
+
+	es := make([]*Entry, len(rows)-1)
+
+	for i, row := range rows[1:] {
+		if es[i], err = parseEntry(columns, row); err != nil {
+			return Changes{}, fmt.Errorf("cannot parse MIC at row %d: %w", i+2, err) //nolint:gomnd
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.merge(es), nil
+}
+
+// merge merges the entries and reports the changes, the caller should hold the lock.
+func (r *Registry) merge(es []*Entry) Changes {
+	var c Changes
+
+	for _, e := range es {
+		old, ok := r.entries[e.MIC]
+
+		switch {
+		case !ok:
+			c.Added = append(c.Added, e.MIC)
+		case old.IsActive() && !e.IsActive():
+			c.Expired = append(c.Expired, e.MIC)
+		case *old != *e: // All dates are in UTC, so they are comparable.
+			c.Modified = append(c.Modified, e.MIC)
+		default:
+			continue
+		}
+
+		r.entries[e.MIC] = e
+	}
+
+	return c
+}
+
+// parseHeader returns the indices of the known columns.
+func parseHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+
+	for i, h := range header {
+		columns[strings.ToUpper(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+
+	for _, h := range []string{columnMIC, columnOperatingMIC, columnCountry, columnStatus} {
+		if _, ok := columns[h]; !ok {
+			return nil, fmt.Errorf("missing column '%s': %w", h, errInvalidCSVHeader)
+		}
+	}
+
+	return columns, nil
+}
+
+//nolint:cyclop
+func parseEntry(columns map[string]int, row []string) (*Entry, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+
+		return ""
+	}
+
+	e := Entry{
+		MIC:          MIC(field(columnMIC)),
+		OperatingMIC: MIC(field(columnOperatingMIC)),
+		Name:         field(columnName),
+		LegalEntity:  field(columnLegalEntity),
+		LEI:          field(columnLEI),
+		Category:     Category(field(columnCategory)),
+		Acronym:      field(columnAcronym),
+		Country:      field(columnCountry),
+		City:         field(columnCity),
+		Website:      strings.ToLower(field(columnWebsite)),
+		Status:       Status(field(columnStatus)),
+		Comments:     field(columnComments),
+	}
+
+	for _, m := range []MIC{e.MIC, e.OperatingMIC} {
+		if !isValidMIC(m) {
+			return nil, fmt.Errorf("'%s': %w", m, errInvalidMIC)
+		}
+	}
+
+	if len(e.Country) != 2 { //nolint:gomnd
+		return nil, fmt.Errorf("'%s': %w", e.Country, errInvalidCountry)
+	}
+
+	switch e.Status {
+	case StatusActive, StatusUpdated, StatusExpired:
+	default:
+		return nil, fmt.Errorf("'%s': %w", e.Status, errInvalidStatus)
+	}
+
+	dates := []struct {
+		column string
+		t      *time.Time
+	}{
+		{columnCreation, &e.CreationDate},
+		{columnLastUpdate, &e.LastUpdateDate},
+		{columnLastValidation, &e.LastValidationDate},
+		{columnExpiry, &e.ExpiryDate},
+	}
+
+	for _, d := range dates {
+		s := field(d.column)
+		if s == "" {
+			continue
+		}
+
+		t, err := time.Parse(dateLayout, s)
+		if err != nil {
+			return nil, fmt.Errorf("%s '%s': %w", strings.ToLower(d.column), s, errInvalidDate)
+		}
+
+		*d.t = t
+	}
+
+	return &e, nil
+}
+
+func isValidMIC(m MIC) bool {
+	if len(m) != 4 { //nolint:gomnd
+		return false
+	}
+
+	for _, c := range m {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
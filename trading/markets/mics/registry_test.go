@@ -0,0 +1,175 @@
+//nolint:testpackage
+package mics
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const registryHeader = `"MIC","OPERATING MIC","OPRT/SGMT","MARKET NAME-INSTITUTION DESCRIPTION","LEGAL ENTITY NAME",` +
+	`"LEI","MARKET CATEGORY CODE","ACRONYM","ISO COUNTRY CODE (ISO 3166)","CITY","WEBSITE","STATUS",` +
+	`"CREATION DATE","LAST UPDATE DATE","LAST VALIDATION DATE","EXPIRY DATE","COMMENTS"` + "\n"
+
+func TestRegistryNew(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry()
+	if act := r.Len(); act != len(predefined) {
+		t.Errorf("Len(): expected %v, actual %v", len(predefined), act)
+	}
+
+	e, ok := r.Lookup(XPAR)
+	if !ok {
+		t.Fatal("Lookup(XPAR): expected to be found")
+	}
+
+	exp := Entry{
+		MIC:            XPAR,
+		OperatingMIC:   XPAR,
+		Name:           "EURONEXT - EURONEXT PARIS",
+		LegalEntity:    "EURONEXT PARIS SA",
+		LEI:            "969500HMVSZ0TCV65D58",
+		Category:       RegulatedMarket,
+		Country:        "FR",
+		City:           "PARIS",
+		Website:        "www.euronext.com",
+		Status:         StatusActive,
+		CreationDate:   time.Date(2005, 6, 27, 0, 0, 0, 0, time.UTC),
+		LastUpdateDate: time.Date(2023, 10, 23, 0, 0, 0, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(e, exp) {
+		t.Errorf("Lookup(XPAR): expected %v, actual %v", exp, e)
+	}
+
+	if !e.IsActive() || !e.IsOperational() {
+		t.Error("Lookup(XPAR): expected active operational MIC")
+	}
+
+	if _, ok := r.Lookup(MIC("FOO")); ok {
+		t.Error("Lookup(FOO): expected not to be found")
+	}
+
+	es := r.Entries()
+	if len(es) != len(predefined) {
+		t.Errorf("Entries(): expected %v entries, actual %v", len(predefined), len(es))
+	}
+
+	for i := 1; i < len(es); i++ {
+		if es[i-1].MIC >= es[i].MIC {
+			t.Errorf("Entries(): expected sorted MICs, got %v before %v", es[i-1].MIC, es[i].MIC)
+		}
+	}
+}
+
+func TestRegistryLoadCSV(t *testing.T) {
+	t.Parallel()
+
+	const data = registryHeader +
+		`"XPAR","XPAR","OPRT","EURONEXT - EURONEXT PARIS","EURONEXT PARIS SA","969500HMVSZ0TCV65D58","RMKT","",` +
+		`"FR","PARIS","WWW.EURONEXT.COM","ACTIVE","20050627","20231023","","",""` + "\n" +
+		`"XNGS","XNAS","SGMT","NASDAQ/NGS (GLOBAL SELECT MARKET)","","","NSPD","NGS",` +
+		`"US","NEW YORK","WWW.NASDAQ.COM","EXPIRED","20060724","20250101","","20250101",""` + "\n" +
+		`"XA1X","XA1X","OPRT","A1","","","NSPD","","AR","BUENOS AIRES","","EXPIRED",` +
+		`"20030401","20110124","","20110124","NO LONGER IN USE."` + "\n" +
+		`"NEWX","NEWX","OPRT","NEW EXCHANGE","NEW EXCHANGE LTD","","MLTF","NEWEX",` +
+		`"GB","LONDON","WWW.NEWEX.COM","ACTIVE","20250301","20250301","","",""` + "\n"
+
+	r := NewRegistry()
+	n := r.Len()
+
+	c, err := r.LoadCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadCSV(): unexpected error: %v", err)
+	}
+
+	exp := Changes{Added: []MIC{"NEWX"}, Modified: []MIC{XA1X}, Expired: []MIC{XNGS}}
+	if !reflect.DeepEqual(c, exp) {
+		t.Errorf("LoadCSV(): expected %v, actual %v", exp, c)
+	}
+
+	if act := r.Len(); act != n+1 {
+		t.Errorf("Len(): expected %v, actual %v", n+1, act)
+	}
+
+	e, ok := r.Lookup("NEWX")
+	if !ok || e.Category != MultilateralTradingFacility || e.Website != "www.newex.com" || !e.IsActive() ||
+		!e.CreationDate.Equal(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)) || !e.ExpiryDate.IsZero() {
+		t.Errorf("Lookup(NEWX): unexpected %v", e)
+	}
+
+	if e, _ := r.Lookup(XNGS); e.IsActive() || !e.ExpiryDate.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Lookup(XNGS): expected expired, actual %v", e)
+	}
+
+	if e, _ := r.Lookup(XA1X); e.Comments != "NO LONGER IN USE." {
+		t.Errorf("Lookup(XA1X): expected new comments, actual %v", e.Comments)
+	}
+
+	if !XNGS.IsActive() {
+		t.Error("XNGS.IsActive(): expected compiled-in data to be unchanged")
+	}
+
+	c, err = r.LoadCSV(strings.NewReader(data))
+	if err != nil || !reflect.DeepEqual(c, Changes{}) {
+		t.Errorf("LoadCSV() again: expected no changes, actual %v, %v", c, err)
+	}
+}
+
+func TestRegistryLoadCSVRelease(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Open("ISO10383_MIC.27-May-2024.csv")
+	if err != nil {
+		t.Fatalf("cannot open data release: %v", err)
+	}
+	defer f.Close()
+
+	r := NewRegistry()
+
+	c, err := r.LoadCSV(f)
+	if err != nil {
+		t.Fatalf("LoadCSV(): unexpected error: %v", err)
+	}
+
+	// Only the GLBX record overridden by the code generation differs from the data release.
+	if !reflect.DeepEqual(c.Modified, []MIC{GLBX}) || len(c.Expired) != 0 {
+		t.Errorf("LoadCSV(): expected the compiled-in data release to be unchanged except GLBX, actual %v", c)
+	}
+}
+
+func TestRegistryLoadCSVErrors(t *testing.T) {
+	t.Parallel()
+
+	const row = `"XPAR","XPAR","OPRT","","","","RMKT","","FR","PARIS","","ACTIVE","20050627","","","",""` + "\n"
+
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"empty", "", errInvalidCSVHeader},
+		{"missing column", `"MIC","OPERATING MIC","STATUS"` + "\n", errInvalidCSVHeader},
+		{"invalid MIC", registryHeader + strings.Replace(row, `"XPAR"`, `"xpar"`, 1), errInvalidMIC},
+		{"invalid operating MIC", registryHeader + strings.Replace(row, `,"XPAR"`, `,"XPA"`, 1), errInvalidMIC},
+		{"invalid country", registryHeader + strings.Replace(row, `"FR"`, `"FRA"`, 1), errInvalidCountry},
+		{"invalid status", registryHeader + strings.Replace(row, `"ACTIVE"`, `"DELETED"`, 1), errInvalidStatus},
+		{"invalid date", registryHeader + strings.Replace(row, `"20050627"`, `"2005-06-27"`, 1), errInvalidDate},
+	}
+
+	for _, tt := range tests {
+		r := NewRegistry()
+
+		_, err := r.LoadCSV(strings.NewReader(tt.data + `"NEWX","NEWX","OPRT","","","","MLTF","","GB","","","ACTIVE","","","","",""`))
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: expected error %v, actual %v", tt.name, tt.err, err)
+		}
+
+		if _, ok := r.Lookup("NEWX"); ok {
+			t.Errorf("%s: expected the registry not to be changed", tt.name)
+		}
+	}
+}
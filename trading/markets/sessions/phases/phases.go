@@ -0,0 +1,103 @@
+// Package phases enumerates phases of an exchange trading day.
+package phases
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Phase enumerates phases of an exchange trading day.
+type Phase int
+
+const (
+	// PreOpen is a pre-opening phase when orders can be entered but are not matched.
+	PreOpen Phase = iota + 1
+
+	// OpeningAuction is an opening auction which uncrosses the order book accumulated during the pre-opening.
+	OpeningAuction
+
+	// Continuous is a continuous trading phase.
+	Continuous
+
+	// ClosingAuction is a closing auction which determines the closing price.
+	ClosingAuction
+
+	// PostClose is a post-closing phase when orders can be entered, modified or canceled but are not matched.
+	PostClose
+	last
+)
+
+const (
+	unknown        = "unknown"
+	preOpen        = "preOpen"
+	openingAuction = "openingAuction"
+	continuous     = "continuous"
+	closingAuction = "closingAuction"
+	postClose      = "postClose"
+)
+
+var errUnknownPhase = errors.New("unknown session phase")
+
+// String implements the fmt.Stringer interface.
+func (p Phase) String() string {
+	switch p {
+	case PreOpen:
+		return preOpen
+	case OpeningAuction:
+		return openingAuction
+	case Continuous:
+		return continuous
+	case ClosingAuction:
+		return closingAuction
+	case PostClose:
+		return postClose
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this session phase is known.
+func (p Phase) IsKnown() bool {
+	return p >= PreOpen && p < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (p Phase) MarshalJSON() ([]byte, error) {
+	str := p.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownPhase)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (p *Phase) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case preOpen:
+		*p = PreOpen
+	case openingAuction:
+		*p = OpeningAuction
+	case continuous:
+		*p = Continuous
+	case closingAuction:
+		*p = ClosingAuction
+	case postClose:
+		*p = PostClose
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownPhase)
+	}
+
+	return nil
+}
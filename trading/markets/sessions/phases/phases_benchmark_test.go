@@ -0,0 +1,29 @@
+//nolint:testpackage
+package phases
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := PostClose
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := PostClose
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var p Phase
+
+	bs := []byte("\"postClose\"")
+	for i := 0; i < b.N; i++ {
+		_ = p.UnmarshalJSON(bs)
+	}
+}
@@ -0,0 +1,148 @@
+//nolint:testpackage
+package phases
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		p    Phase
+		text string
+	}{
+		{PreOpen, preOpen},
+		{OpeningAuction, openingAuction},
+		{Continuous, continuous},
+		{ClosingAuction, closingAuction},
+		{PostClose, postClose},
+		{last, unknown},
+		{Phase(0), unknown},
+		{Phase(9999), unknown},
+		{Phase(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.p.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.p, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		p       Phase
+		boolean bool
+	}{
+		{PreOpen, true},
+		{OpeningAuction, true},
+		{Continuous, true},
+		{ClosingAuction, true},
+		{PostClose, true},
+		{last, false},
+		{Phase(0), false},
+		{Phase(9999), false},
+		{Phase(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.p.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.p, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		p         Phase
+		json      string
+		succeeded bool
+	}{
+		{PreOpen, "\"preOpen\"", true},
+		{OpeningAuction, "\"openingAuction\"", true},
+		{Continuous, "\"continuous\"", true},
+		{ClosingAuction, "\"closingAuction\"", true},
+		{PostClose, "\"postClose\"", true},
+		{last, nilstr, false},
+		{Phase(9999), nilstr, false},
+		{Phase(-9999), nilstr, false},
+		{Phase(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.p.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.p, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.p)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.p, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Phase
+	tests := []struct {
+		p         Phase
+		json      string
+		succeeded bool
+	}{
+		{PreOpen, "\"preOpen\"", true},
+		{OpeningAuction, "\"openingAuction\"", true},
+		{Continuous, "\"continuous\"", true},
+		{ClosingAuction, "\"closingAuction\"", true},
+		{PostClose, "\"postClose\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.p
+		bs := []byte(tt.json)
+
+		var p Phase
+
+		err := p.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != p {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, p)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package sessions
+
+//nolint:gofumpt
+import (
+	"time"
+
+	"mbg/trading/markets/mics"
+	"mbg/trading/markets/sessions/phases"
+	"mbg/trading/time/holidays"
+	"mbg/trading/time/holidays/calendars"
+)
+
+// Predefined returns a new instance of the predefined trading session schedule
+// of the equity market of an exchange identified by the MIC.
+// The segment MICs are resolved to their operating MICs.
+//
+// Returns false if there is no predefined schedule for the MIC.
+func Predefined(mic mics.MIC) (*Schedule, bool) {
+	switch op := mic.OperationalMIC(); op {
+	case mics.XAMS, mics.XPAR, mics.XBRU, mics.XLIS:
+		return euronext(mic), true
+	case mics.XSTO:
+		return nasdaqNordic(mic, calendars.Sweden{}, 0), true
+	case mics.XCSE:
+		return nasdaqNordic(mic, calendars.Denmark{}, 0), true
+	case mics.XHEL:
+		return nasdaqNordic(mic, calendars.Finland{}, time.Hour), true
+	case mics.XICE:
+		return &Schedule{
+			MIC:      mic,
+			Calendar: calendars.Iceland{},
+			Periods: []Period{
+				{phases.PreOpen, hm(9, 0), hm(9, 30)},
+				{phases.Continuous, hm(9, 30), hm(15, 30)},
+				{phases.ClosingAuction, hm(15, 30), hm(15, 35)},
+			},
+		}, true
+	case mics.XNYS, mics.XNAS:
+		return unitedStates(mic), true
+	case mics.XSWX:
+		return &Schedule{
+			MIC:      mic,
+			Calendar: calendars.Switzerland{},
+			Periods: []Period{
+				{phases.PreOpen, hm(6, 0), hm(9, 0)},
+				{phases.Continuous, hm(9, 0), hm(17, 20)},
+				{phases.ClosingAuction, hm(17, 20), hm(17, 30)},
+			},
+		}, true
+	case mics.XOSL:
+		return &Schedule{
+			MIC:      mic,
+			Calendar: calendars.Norway{},
+			Periods: []Period{
+				{phases.PreOpen, hm(8, 15), hm(9, 0)},
+				{phases.Continuous, hm(9, 0), hm(16, 20)},
+				{phases.ClosingAuction, hm(16, 20), hm(16, 25)},
+			},
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+// euronext returns the Euronext cash market schedule.
+//
+// Trading closes early at 14:05 on Christmas Eve and New Year's Eve.
+func euronext(mic mics.MIC) *Schedule {
+	return &Schedule{
+		MIC:      mic,
+		Calendar: calendars.EuroNext{},
+		Periods: []Period{
+			{phases.PreOpen, hm(7, 15), hm(9, 0)},
+			{phases.Continuous, hm(9, 0), hm(17, 30)},
+			{phases.ClosingAuction, hm(17, 30), hm(17, 35)},
+		},
+		HalfDayPeriods: []Period{
+			{phases.PreOpen, hm(7, 15), hm(9, 0)},
+			{phases.Continuous, hm(9, 0), hm(14, 0)},
+			{phases.ClosingAuction, hm(14, 0), hm(14, 5)},
+		},
+		HalfDay: func(t time.Time) bool {
+			_, m, d := t.Date()
+
+			return m == time.December && (d == 24 || d == 31)
+		},
+	}
+}
+
+// unitedStates returns the US equity market schedule.
+//
+// Trading closes early at 13:00 on the day before Independence Day,
+// the day after Thanksgiving Day and on Christmas Eve.
+func unitedStates(mic mics.MIC) *Schedule {
+	return &Schedule{
+		MIC:      mic,
+		Calendar: calendars.UnitedStates{},
+		Periods: []Period{
+			{phases.PreOpen, hm(4, 0), hm(9, 30)},
+			{phases.Continuous, hm(9, 30), hm(16, 0)},
+			{phases.PostClose, hm(16, 0), hm(20, 0)},
+		},
+		HalfDayPeriods: []Period{
+			{phases.PreOpen, hm(4, 0), hm(9, 30)},
+			{phases.Continuous, hm(9, 30), hm(13, 0)},
+			{phases.PostClose, hm(13, 0), hm(17, 0)},
+		},
+		HalfDay: func(t time.Time) bool {
+			_, m, d := t.Date()
+
+			switch m {
+			case time.July:
+				return d == 3
+			case time.November:
+				return t.Weekday() == time.Friday && d >= 23 && d <= 29
+			case time.December:
+				return d == 24
+			default:
+				return false
+			}
+		},
+	}
+}
+
+// nasdaqNordic returns the Nasdaq Nordic cash market schedule shifted by the given local time offset.
+func nasdaqNordic(mic mics.MIC, cal holidays.Calendarer, shift time.Duration) *Schedule {
+	return &Schedule{
+		MIC:      mic,
+		Calendar: cal,
+		Periods: []Period{
+			{phases.PreOpen, hm(8, 0) + shift, hm(9, 0) + shift},
+			{phases.Continuous, hm(9, 0) + shift, hm(17, 25) + shift},
+			{phases.ClosingAuction, hm(17, 25) + shift, hm(17, 30) + shift},
+			{phases.PostClose, hm(17, 30) + shift, hm(18, 15) + shift},
+		},
+	}
+}
+
+// hm returns an offset from midnight for the given hours and minutes.
+func hm(h, m int) time.Duration {
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+}
@@ -0,0 +1,115 @@
+//nolint:testpackage
+package sessions
+
+//nolint:gofumpt
+import (
+	"testing"
+	"time"
+
+	"mbg/trading/markets/mics"
+)
+
+func TestPredefined(t *testing.T) {
+	t.Parallel()
+
+	for _, m := range []mics.MIC{mics.XAMS, mics.XPAR, mics.XBRU, mics.XLIS, mics.XSTO, mics.XCSE, mics.XHEL,
+		mics.XICE, mics.XOSL, mics.XNYS, mics.XNAS, mics.XSWX} {
+		s, ok := Predefined(m)
+		if !ok {
+			t.Errorf("Predefined(%v): expected a schedule", m)
+
+			continue
+		}
+
+		if s.MIC != m {
+			t.Errorf("Predefined(%v): expected MIC %v, actual %v", m, m, s.MIC)
+		}
+
+		if len(s.Periods) == 0 || s.Calendar == nil {
+			t.Errorf("Predefined(%v): expected periods and a calendar", m)
+		}
+	}
+
+	if _, ok := Predefined(mics.MIC("ABCD")); ok {
+		t.Error("Predefined(ABCD): expected false")
+	}
+}
+
+func TestPredefinedEuronext(t *testing.T) {
+	t.Parallel()
+
+	s, _ := Predefined(mics.XPAR)
+	cet := time.FixedZone("CET", 3600)
+
+	tests := []struct {
+		t    time.Time
+		open bool
+	}{
+		{time.Date(2021, 12, 23, 17, 0, 0, 0, cet), true},
+		{time.Date(2021, 12, 23, 17, 34, 0, 0, cet), true},
+		{time.Date(2021, 12, 23, 17, 35, 0, 0, cet), false},
+		{time.Date(2021, 12, 24, 14, 4, 0, 0, cet), true},
+		{time.Date(2021, 12, 24, 14, 5, 0, 0, cet), false},
+		{time.Date(2021, 12, 24, 16, 0, 0, 0, cet), false},
+		{time.Date(2021, 1, 1, 12, 0, 0, 0, cet), false},
+	}
+
+	for _, tt := range tests {
+		if open := s.IsOpen(tt.t); open != tt.open {
+			t.Errorf("IsOpen(%v): expected %v, actual %v", tt.t, tt.open, open)
+		}
+	}
+
+	exp := time.Date(2021, 12, 27, 9, 0, 0, 0, cet)
+	if o, _ := s.NextOpen(time.Date(2021, 12, 24, 16, 0, 0, 0, cet)); !o.Equal(exp) {
+		t.Errorf("NextOpen(): expected %v, actual %v", exp, o)
+	}
+
+	exp = time.Date(2021, 12, 24, 14, 5, 0, 0, cet)
+	if c, _ := s.PreviousClose(time.Date(2021, 12, 26, 12, 0, 0, 0, cet)); !c.Equal(exp) {
+		t.Errorf("PreviousClose(): expected %v, actual %v", exp, c)
+	}
+}
+
+func TestPredefinedUnitedStates(t *testing.T) {
+	t.Parallel()
+
+	s, _ := Predefined(mics.XNYS)
+	est := time.FixedZone("EST", -5*3600)
+
+	tests := []struct {
+		t    time.Time
+		open bool
+	}{
+		{time.Date(2021, 11, 24, 15, 59, 0, 0, est), true},
+		{time.Date(2021, 11, 25, 12, 0, 0, 0, est), false},
+		{time.Date(2021, 11, 26, 12, 59, 0, 0, est), true},
+		{time.Date(2021, 11, 26, 13, 0, 0, 0, est), false},
+		{time.Date(2021, 12, 24, 12, 0, 0, 0, est), false},
+		{time.Date(2021, 12, 23, 15, 0, 0, 0, est), true},
+		{time.Date(2023, 7, 3, 13, 30, 0, 0, est), false},
+		// The daylight saving time: 9:30 EDT is 8:30 EST.
+		{time.Date(2021, 7, 15, 8, 30, 0, 0, est), true},
+		{time.Date(2021, 7, 15, 8, 29, 0, 0, est), false},
+		{time.Date(2021, 7, 15, 14, 59, 0, 0, est), true},
+		{time.Date(2021, 7, 15, 15, 0, 0, 0, est), false},
+	}
+
+	for _, tt := range tests {
+		if open := s.IsOpen(tt.t); open != tt.open {
+			t.Errorf("IsOpen(%v): expected %v, actual %v", tt.t, tt.open, open)
+		}
+	}
+
+	// The session boundaries follow the transitions: the open on Friday before and Monday after
+	// the start of the daylight saving time on 2021-03-14 is 14:30 and 13:30 UTC.
+	exp := time.Date(2021, 3, 15, 13, 30, 0, 0, time.UTC)
+	if o, _ := s.NextOpen(time.Date(2021, 3, 12, 22, 0, 0, 0, time.UTC)); !o.Equal(exp) {
+		t.Errorf("NextOpen(): expected %v, actual %v", exp, o)
+	}
+
+	exp = time.Date(2021, 3, 12, 14, 30, 0, 0, time.UTC)
+	if o, _ := s.NextOpen(time.Date(2021, 3, 12, 6, 0, 0, 0, time.UTC)); !o.Equal(exp) {
+		t.Errorf("NextOpen(): expected %v, actual %v", exp, o)
+	}
+}
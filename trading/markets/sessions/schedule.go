@@ -0,0 +1,252 @@
+// Package sessions provides exchange trading session schedules
+// with intraday phases, breaks, holidays and half days.
+package sessions
+
+//nolint:gofumpt
+import (
+	"time"
+
+	"mbg/trading/markets/mics"
+	"mbg/trading/markets/sessions/phases"
+	"mbg/trading/time/holidays"
+)
+
+// maxSearchDays limits the number of days searched by NextOpen and PreviousClose.
+const maxSearchDays = 3660
+
+// Period is a phase of a trading day defined by the exchange-local wall clock offsets from midnight.
+type Period struct {
+	// Phase is the phase of the trading day.
+	Phase phases.Phase
+
+	// Start is the offset of the start of the period from the local midnight.
+	Start time.Duration
+
+	// End is the offset of the end (exclusive) of the period from the local midnight.
+	End time.Duration
+}
+
+// Session is a phase of a trading day on a specific date.
+type Session struct {
+	// Phase is the phase of the trading day.
+	Phase phases.Phase
+
+	// Start is the start date and time of the session.
+	Start time.Time
+
+	// End is the end date and time (exclusive) of the session.
+	End time.Time
+}
+
+// Schedule is a trading session schedule of an exchange.
+//
+// A trading day consists of time-ordered non-overlapping periods. The gaps between
+// periods, like lunch breaks, are closed. The market is open during the opening auction,
+// continuous trading and closing auction phases.
+type Schedule struct {
+	// MIC is an ISO 10383 Market Identifier Code of the exchange.
+	MIC mics.MIC
+
+	// Location is the time zone of the exchange.
+	// If nil, the MIC.Location observing the daylight saving time is used.
+	Location *time.Location
+
+	// Calendar is the holiday calendar of the exchange, nil means no holidays at all.
+	Calendar holidays.Calendarer
+
+	// Periods are the time-ordered periods of a regular trading day.
+	Periods []Period
+
+	// HalfDayPeriods are the time-ordered periods of a shortened trading day.
+	HalfDayPeriods []Period
+
+	// HalfDay indicates whether a date is a shortened trading day, nil means no shortened days.
+	// Like the Calendar, it is called with the midnight UTC of the exchange-local date.
+	HalfDay func(time.Time) bool
+}
+
+// Loc returns the time zone of the exchange.
+func (s *Schedule) Loc() *time.Location {
+	if s.Location != nil {
+		return s.Location
+	}
+
+	return s.MIC.Location()
+}
+
+// IsTradingDay indicates whether the exchange-local date of the given time is a trading day.
+func (s *Schedule) IsTradingDay(t time.Time) bool {
+	t = t.In(s.Loc())
+	y, m, d := t.Date()
+
+	return s.isTradingDay(y, m, d)
+}
+
+// IsHalfDay indicates whether the exchange-local date of the given time is a shortened trading day.
+func (s *Schedule) IsHalfDay(t time.Time) bool {
+	t = t.In(s.Loc())
+	y, m, d := t.Date()
+
+	return s.isTradingDay(y, m, d) && s.isHalfDay(y, m, d)
+}
+
+// PhaseAt returns the phase of the trading day at the given time.
+// Returns false if the given time is outside of any period.
+func (s *Schedule) PhaseAt(t time.Time) (phases.Phase, bool) {
+	for _, ss := range s.daySessions(t.In(s.Loc())) {
+		if !t.Before(ss.Start) && t.Before(ss.End) {
+			return ss.Phase, true
+		}
+	}
+
+	return 0, false
+}
+
+// IsOpen indicates whether the market is open for trading at the given time.
+func (s *Schedule) IsOpen(t time.Time) bool {
+	p, ok := s.PhaseAt(t)
+
+	return ok && isTrading(p)
+}
+
+// NextOpen returns the earliest time after the given time when the market opens,
+// including re-openings after breaks. Returns false if there is no such time
+// within ten years.
+func (s *Schedule) NextOpen(t time.Time) (time.Time, bool) {
+	day := t.In(s.Loc())
+
+	for i := 0; i < maxSearchDays; i++ {
+		for _, o := range openings(s.daySessions(day)) {
+			if o.Start.After(t) {
+				return o.Start, true
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, false
+}
+
+// PreviousClose returns the latest time not after the given time when the market closed,
+// including closings before breaks. Returns false if there is no such time
+// within ten years.
+func (s *Schedule) PreviousClose(t time.Time) (time.Time, bool) {
+	day := t.In(s.Loc())
+
+	for i := 0; i < maxSearchDays; i++ {
+		os := openings(s.daySessions(day))
+		for j := len(os) - 1; j >= 0; j-- {
+			if !os[j].End.After(t) {
+				return os[j].End, true
+			}
+		}
+
+		day = day.AddDate(0, 0, -1)
+	}
+
+	return time.Time{}, false
+}
+
+// SessionsBetween returns the time-ordered sessions overlapping the time interval [from, to).
+func (s *Schedule) SessionsBetween(from, to time.Time) []Session {
+	ss := []Session{}
+	loc := s.Loc()
+	last := midnight(to.In(loc))
+
+	for day := midnight(from.In(loc)); !day.After(last); day = day.AddDate(0, 0, 1) {
+		for _, x := range s.daySessions(day) {
+			if x.End.After(from) && x.Start.Before(to) {
+				ss = append(ss, x)
+			}
+		}
+	}
+
+	return ss
+}
+
+// daySessions returns the sessions on the exchange-local date of the given time.
+func (s *Schedule) daySessions(t time.Time) []Session {
+	y, m, d := t.Date()
+	if !s.isTradingDay(y, m, d) {
+		return nil
+	}
+
+	ps := s.Periods
+	if s.isHalfDay(y, m, d) {
+		ps = s.HalfDayPeriods
+	}
+
+	loc := t.Location()
+	ss := make([]Session, len(ps))
+
+	for i, p := range ps {
+		ss[i] = Session{Phase: p.Phase, Start: wallClock(y, m, d, p.Start, loc), End: wallClock(y, m, d, p.End, loc)}
+	}
+
+	return ss
+}
+
+func (s *Schedule) isTradingDay(y int, m time.Month, d int) bool {
+	if s.Calendar == nil {
+		return true
+	}
+
+	return !s.Calendar.IsHoliday(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+func (s *Schedule) isHalfDay(y int, m time.Month, d int) bool {
+	if s.HalfDay == nil {
+		return false
+	}
+
+	return s.HalfDay(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// openings merges adjacent trading sessions into continuous open intervals.
+func openings(ss []Session) []Session {
+	var os []Session
+
+	for _, x := range ss {
+		if !isTrading(x.Phase) {
+			continue
+		}
+
+		if l := len(os) - 1; l >= 0 && !x.Start.After(os[l].End) {
+			os[l].End = x.End
+
+			continue
+		}
+
+		os = append(os, x)
+	}
+
+	return os
+}
+
+//nolint:exhaustive
+func isTrading(p phases.Phase) bool {
+	switch p {
+	case phases.OpeningAuction, phases.Continuous, phases.ClosingAuction:
+		return true
+	default:
+		return false
+	}
+}
+
+// wallClock returns the time at the given offset from the midnight in the given location
+// using the wall clock, so that the offsets are preserved across daylight saving transitions.
+func wallClock(y int, m time.Month, d int, offset time.Duration, loc *time.Location) time.Time {
+	h := int(offset / time.Hour)
+	mi := int(offset % time.Hour / time.Minute)
+	sec := int(offset % time.Minute / time.Second)
+	nsec := int(offset % time.Second)
+
+	return time.Date(y, m, d, h, mi, sec, nsec, loc)
+}
+
+func midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
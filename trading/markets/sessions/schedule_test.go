@@ -0,0 +1,168 @@
+//nolint:testpackage
+package sessions
+
+//nolint:gofumpt
+import (
+	"testing"
+	"time"
+
+	"mbg/trading/markets/mics"
+	"mbg/trading/markets/sessions/phases"
+	"mbg/trading/time/holidays/calendars"
+)
+
+// lunchSchedule returns a schedule with a lunch break, a half day on Fridays and weekend holidays.
+func lunchSchedule() *Schedule {
+	return &Schedule{
+		MIC:      mics.XTKS,
+		Location: time.FixedZone("JST", 9*60*60),
+		Calendar: calendars.WeekendsOnly{},
+		Periods: []Period{
+			{phases.PreOpen, hm(8, 0), hm(9, 0)},
+			{phases.OpeningAuction, hm(9, 0), hm(9, 1)},
+			{phases.Continuous, hm(9, 1), hm(11, 30)},
+			{phases.Continuous, hm(12, 30), hm(15, 0)},
+		},
+		HalfDayPeriods: []Period{
+			{phases.Continuous, hm(9, 0), hm(11, 30)},
+		},
+		HalfDay: func(t time.Time) bool { return t.Weekday() == time.Friday },
+	}
+}
+
+func jst(d, h, m int) time.Time {
+	// June 2021: 7th is Monday, 11th is Friday, 12th and 13th is weekend.
+	return time.Date(2021, time.June, d, h, m, 0, 0, time.FixedZone("JST", 9*60*60))
+}
+
+func TestScheduleIsOpen(t *testing.T) {
+	t.Parallel()
+
+	s := lunchSchedule()
+
+	tests := []struct {
+		t     time.Time
+		open  bool
+		phase phases.Phase
+		in    bool
+	}{
+		{jst(7, 7, 59), false, 0, false},
+		{jst(7, 8, 0), false, phases.PreOpen, true},
+		{jst(7, 9, 0), true, phases.OpeningAuction, true},
+		{jst(7, 10, 0), true, phases.Continuous, true},
+		{jst(7, 11, 30), false, 0, false},
+		{jst(7, 12, 30), true, phases.Continuous, true},
+		{jst(7, 15, 0), false, 0, false},
+		{jst(11, 11, 0), true, phases.Continuous, true},
+		{jst(11, 13, 0), false, 0, false},
+		{jst(12, 10, 0), false, 0, false},
+		{jst(7, 10, 0).UTC(), true, phases.Continuous, true},
+	}
+
+	for _, tt := range tests {
+		if open := s.IsOpen(tt.t); open != tt.open {
+			t.Errorf("IsOpen(%v): expected %v, actual %v", tt.t, tt.open, open)
+		}
+
+		if p, in := s.PhaseAt(tt.t); p != tt.phase || in != tt.in {
+			t.Errorf("PhaseAt(%v): expected %v, %v, actual %v, %v", tt.t, tt.phase, tt.in, p, in)
+		}
+	}
+}
+
+func TestScheduleNextOpenPreviousClose(t *testing.T) {
+	t.Parallel()
+
+	s := lunchSchedule()
+
+	tests := []struct {
+		t         time.Time
+		nextOpen  time.Time
+		prevClose time.Time
+	}{
+		{jst(7, 8, 0), jst(7, 9, 0), jst(4, 11, 30)},
+		{jst(7, 9, 0), jst(7, 12, 30), jst(4, 11, 30)},
+		{jst(7, 11, 30), jst(7, 12, 30), jst(7, 11, 30)},
+		{jst(7, 16, 0), jst(8, 9, 0), jst(7, 15, 0)},
+		{jst(11, 12, 0), jst(14, 9, 0), jst(11, 11, 30)},
+		{jst(13, 12, 0), jst(14, 9, 0), jst(11, 11, 30)},
+	}
+
+	for _, tt := range tests {
+		if o, ok := s.NextOpen(tt.t); !ok || !o.Equal(tt.nextOpen) {
+			t.Errorf("NextOpen(%v): expected %v, actual %v", tt.t, tt.nextOpen, o)
+		}
+
+		if c, ok := s.PreviousClose(tt.t); !ok || !c.Equal(tt.prevClose) {
+			t.Errorf("PreviousClose(%v): expected %v, actual %v", tt.t, tt.prevClose, c)
+		}
+	}
+
+	none := &Schedule{MIC: mics.XTKS, Calendar: alwaysHoliday{}, Periods: s.Periods}
+
+	if _, ok := none.NextOpen(jst(7, 0, 0)); ok {
+		t.Error("NextOpen(): expected false when every day is a holiday")
+	}
+
+	if _, ok := none.PreviousClose(jst(7, 0, 0)); ok {
+		t.Error("PreviousClose(): expected false when every day is a holiday")
+	}
+}
+
+func TestScheduleSessionsBetween(t *testing.T) {
+	t.Parallel()
+
+	s := lunchSchedule()
+
+	ss := s.SessionsBetween(jst(10, 12, 0), jst(14, 9, 0))
+	exp := []Session{
+		{phases.Continuous, jst(10, 12, 30), jst(10, 15, 0)},
+		{phases.Continuous, jst(11, 9, 0), jst(11, 11, 30)},
+		{phases.PreOpen, jst(14, 8, 0), jst(14, 9, 0)},
+	}
+
+	if len(ss) != len(exp) {
+		t.Fatalf("SessionsBetween(): expected %v sessions, actual %v", len(exp), ss)
+	}
+
+	for i, e := range exp {
+		if ss[i].Phase != e.Phase || !ss[i].Start.Equal(e.Start) || !ss[i].End.Equal(e.End) {
+			t.Errorf("SessionsBetween()[%v]: expected %v, actual %v", i, e, ss[i])
+		}
+	}
+
+	if ss := s.SessionsBetween(jst(12, 0, 0), jst(13, 23, 0)); len(ss) != 0 {
+		t.Errorf("SessionsBetween() on weekend: expected no sessions, actual %v", ss)
+	}
+}
+
+func TestScheduleTradingAndHalfDays(t *testing.T) {
+	t.Parallel()
+
+	s := lunchSchedule()
+
+	if !s.IsTradingDay(jst(7, 0, 0)) || s.IsHalfDay(jst(7, 0, 0)) {
+		t.Error("June 7: expected a regular trading day")
+	}
+
+	if !s.IsTradingDay(jst(11, 0, 0)) || !s.IsHalfDay(jst(11, 0, 0)) {
+		t.Error("June 11: expected a half day")
+	}
+
+	if s.IsTradingDay(jst(12, 0, 0)) || s.IsHalfDay(jst(12, 0, 0)) {
+		t.Error("June 12: expected a holiday")
+	}
+
+	// June 7, 00:30 JST is June 6, 15:30 UTC (Sunday).
+	if !s.IsTradingDay(jst(7, 0, 30).UTC()) {
+		t.Error("June 7 in UTC: expected the exchange-local date to be used")
+	}
+
+	if loc := (&Schedule{MIC: mics.XPAR}).Loc(); loc.String() != "Europe/Paris" {
+		t.Errorf("Loc(): expected Europe/Paris, actual %v", loc)
+	}
+}
+
+type alwaysHoliday struct{}
+
+func (alwaysHoliday) IsHoliday(time.Time) bool { return true }
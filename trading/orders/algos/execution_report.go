@@ -0,0 +1,117 @@
+package algos
+
+//nolint:gofumpt
+import (
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/orders"
+	"mbg/trading/orders/reports"
+	"mbg/trading/orders/status"
+)
+
+// executionReport is a report event for an order in a single instrument.
+type executionReport struct {
+	order                orders.OrderSingle
+	transactionTime      time.Time
+	status               status.OrderStatus
+	reportType           reports.OrderReportType
+	id                   string
+	note                 string
+	replaceSourceOrder   orders.OrderSingle
+	replaceTargetOrder   orders.OrderSingle
+	lastFillPrice        float64
+	averagePrice         float64
+	lastFillQuantity     float64
+	leavesQuantity       float64
+	cumulativeQuantity   float64
+	lastFillCommission   float64
+	cumulativeCommission float64
+	commissionCurrency   currencies.Currency
+}
+
+// Order is the underlying order for this execution report.
+func (r *executionReport) Order() orders.OrderSingle {
+	return r.order
+}
+
+// TransactionTime is the date and time when the business represented by this report occurred.
+func (r *executionReport) TransactionTime() time.Time {
+	return r.transactionTime
+}
+
+// Status is the current state of an order as understood by the broker.
+func (r *executionReport) Status() status.OrderStatus {
+	return r.status
+}
+
+// ReportType identifies an action of this report.
+func (r *executionReport) ReportType() reports.OrderReportType {
+	return r.reportType
+}
+
+// ID is a unique identifier of this report as assigned by the sell-side.
+func (r *executionReport) ID() string {
+	return r.id
+}
+
+// Note is a free-format text that accompany this report.
+func (r *executionReport) Note() string {
+	return r.note
+}
+
+// ReplaceSourceOrder is the replace source order.
+// Filled when report type is Replaced or ReplaceRejected.
+func (r *executionReport) ReplaceSourceOrder() orders.OrderSingle {
+	return r.replaceSourceOrder
+}
+
+// ReplaceTargetOrder is the replace target order.
+// Filled when report type is Replaced or ReplaceRejected.
+func (r *executionReport) ReplaceTargetOrder() orders.OrderSingle {
+	return r.replaceTargetOrder
+}
+
+// LastFillPrice is the price (in order instrument's currency) of the last fill.
+func (r *executionReport) LastFillPrice() float64 {
+	return r.lastFillPrice
+}
+
+// AveragePrice is an average price (in order instrument's currency) of all fills.
+func (r *executionReport) AveragePrice() float64 {
+	return r.averagePrice
+}
+
+// LastFillQuantity is the quantity bought or sold on the last fill.
+func (r *executionReport) LastFillQuantity() float64 {
+	return r.lastFillQuantity
+}
+
+// LeavesQuantity is the quantity open for further execution.
+//
+// If the order status is Canceled, Expired or Rejected (in which case
+// the order is no longer active) then this could be 0, otherwise
+//   Order.Quantity - CumulativeQuantity.
+func (r *executionReport) LeavesQuantity() float64 {
+	return r.leavesQuantity
+}
+
+// CumulativeQuantity is the total quantity filled.
+func (r *executionReport) CumulativeQuantity() float64 {
+	return r.cumulativeQuantity
+}
+
+// LastFillCommission is the commission (in commission currency) of the last fill.
+func (r *executionReport) LastFillCommission() float64 {
+	return r.lastFillCommission
+}
+
+// CumulativeCommission is the total commission (in commission currency) for all fills.
+func (r *executionReport) CumulativeCommission() float64 {
+	return r.cumulativeCommission
+}
+
+// CommissionCurrency is a commission currency.
+func (r *executionReport) CommissionCurrency() currencies.Currency {
+	return r.commissionCurrency
+}
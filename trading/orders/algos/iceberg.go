@@ -0,0 +1,52 @@
+package algos
+
+//nolint:gofumpt
+import (
+	"fmt"
+
+	"mbg/trading/data"
+	"mbg/trading/orders"
+	"mbg/trading/orders/status"
+	"mbg/trading/orders/types"
+)
+
+// Iceberg algorithm shows only a display quantity of a limit parent order to the market at a time.
+//
+// When the displayed child order is completely filled, it is refilled with a new child order
+// for the display quantity or the remaining quantity, whichever is smaller. A child order
+// canceled, expired or rejected by the broker is not refilled.
+type Iceberg struct {
+	// DisplayQuantity is the quantity of every child order.
+	DisplayQuantity float64
+}
+
+var (
+	errDisplayQuantity = fmt.Errorf("display quantity should be positive: %w", errInvalidAlgorithm)
+	errIcebergType     = fmt.Errorf("iceberg parent order should be a limit order: %w", errInvalidAlgorithm)
+)
+
+func (a *Iceberg) validate(order orders.OrderSingle) error {
+	switch {
+	case a.DisplayQuantity <= 0:
+		return errDisplayQuantity
+	case order.Type != types.Limit:
+		return errIcebergType
+	}
+
+	return nil
+}
+
+func (a *Iceberg) start(t *Ticket) {
+	t.submit(a.DisplayQuantity, 0)
+}
+
+func (a *Iceberg) trade(_ *Ticket, _ *data.Trade) {}
+
+func (a *Iceberg) update(t *Ticket) {
+	n := len(t.children)
+	if n == 0 || t.active() > 0 || t.children[n-1].ticket.Status() != status.Filled {
+		return
+	}
+
+	t.submit(a.DisplayQuantity, 0)
+}
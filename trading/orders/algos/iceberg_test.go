@@ -0,0 +1,44 @@
+//nolint:testpackage
+package algos
+
+import (
+	"testing"
+
+	"mbg/trading/orders/status"
+)
+
+func TestIceberg(t *testing.T) {
+	t.Parallel()
+
+	p, s, _ := newTestTicket(t, testParent(250), &Iceberg{DisplayQuantity: 100})
+	checkQuantities(t, "start", s, 100)
+
+	s.tickets[0].fill(60, 100)
+	checkQuantities(t, "partial fill", s, 100)
+
+	s.tickets[0].fill(40, 100)
+	checkQuantities(t, "refill", s, 100, 100)
+
+	s.tickets[1].fill(100, 100)
+	checkQuantities(t, "last refill", s, 100, 100, 50)
+
+	s.tickets[2].fill(50, 100)
+
+	if p.Status() != status.Filled || p.LastReport().CumulativeQuantity() != 250 {
+		t.Errorf("Iceberg: expected filled 250, actual %v %v", p.Status(), p.LastReport().CumulativeQuantity())
+	}
+}
+
+func TestIcebergCanceledChild(t *testing.T) {
+	t.Parallel()
+
+	p, s, _ := newTestTicket(t, testParent(250), &Iceberg{DisplayQuantity: 100})
+
+	s.tickets[0].fill(30, 100)
+	s.tickets[0].Cancel()
+	checkQuantities(t, "canceled child", s, 100)
+
+	if p.Status() != status.PartiallyFilled {
+		t.Errorf("Iceberg: expected partially filled, actual %v", p.Status())
+	}
+}
@@ -0,0 +1,184 @@
+package algos
+
+//nolint:gofumpt
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/orders"
+	"mbg/trading/orders/reports"
+	"mbg/trading/orders/status"
+)
+
+const mockCommissionRate = 0.01
+
+var errMockSubmit = errors.New("mock submit error")
+
+// mockOrderSingleTicket is a mock ticket which executes the requests immediately
+// and notifies about every new execution report.
+type mockOrderSingleTicket struct {
+	order              orders.OrderSingle
+	id                 string
+	status             status.OrderStatus
+	reports            []orders.OrderSingleExecutionReport
+	averagePrice       float64
+	cumulativeQuantity float64
+	commission         float64
+	notify             func(orders.OrderSingleTicket)
+}
+
+// Order is the underlying order for this ticket.
+func (m *mockOrderSingleTicket) Order() orders.OrderSingle {
+	return m.order
+}
+
+// ClientOrderID is a unique identifier for an order as assigned by the buy-side.
+func (m *mockOrderSingleTicket) ClientOrderID() string {
+	return m.id
+}
+
+// OrderID is a unique identifier for an order as assigned by the sell-side.
+func (m *mockOrderSingleTicket) OrderID() string {
+	return m.id
+}
+
+// Status is the current state of an order as understood by the broker.
+func (m *mockOrderSingleTicket) Status() status.OrderStatus {
+	return m.status
+}
+
+// LastReport is the last order report, nil if not any.
+func (m *mockOrderSingleTicket) LastReport() orders.OrderSingleExecutionReport {
+	if len(m.reports) == 0 {
+		return nil
+	}
+
+	return m.reports[len(m.reports)-1]
+}
+
+// Reports provides a collection of all order reports in the chronological order.
+func (m *mockOrderSingleTicket) Reports() []orders.OrderSingleExecutionReport {
+	return m.reports
+}
+
+// CancelReplace replaces the order immediately.
+func (m *mockOrderSingleTicket) CancelReplace(replacementOrder orders.OrderSingle) {
+	if m.status.IsTerminal() {
+		return
+	}
+
+	source := m.order
+	m.order = replacementOrder
+	m.report(reports.Replaced, 0, 0, source)
+}
+
+// Cancel cancels the order immediately.
+func (m *mockOrderSingleTicket) Cancel() {
+	if m.status.IsTerminal() {
+		return
+	}
+
+	m.status = status.Canceled
+	m.report(reports.Canceled, 0, 0, orders.OrderSingle{})
+}
+
+// reject rejects the order.
+func (m *mockOrderSingleTicket) reject() {
+	m.status = status.Rejected
+	m.report(reports.Rejected, 0, 0, orders.OrderSingle{})
+}
+
+// fill fills the order with a given quantity and price, charging a commission of 0.01 per unit.
+func (m *mockOrderSingleTicket) fill(quantity, price float64) {
+	m.averagePrice = (m.averagePrice*m.cumulativeQuantity + price*quantity) / (m.cumulativeQuantity + quantity)
+	m.cumulativeQuantity += quantity
+	m.commission += mockCommissionRate * quantity
+
+	t := reports.PartiallyFilled
+	m.status = status.PartiallyFilled
+
+	if m.cumulativeQuantity >= m.order.Quantity {
+		t = reports.Filled
+		m.status = status.Filled
+	}
+
+	m.report(t, price, quantity, orders.OrderSingle{})
+}
+
+func (m *mockOrderSingleTicket) report(t reports.OrderReportType, price, quantity float64, source orders.OrderSingle) {
+	r := &executionReport{
+		order:              m.order,
+		transactionTime:    time.Date(2021, time.November, 1, 10, 0, len(m.reports), 0, time.UTC),
+		status:             m.status,
+		reportType:         t,
+		id:                 m.id + "-" + strconv.Itoa(len(m.reports)+1),
+		lastFillPrice:      price,
+		averagePrice:       m.averagePrice,
+		lastFillQuantity:   quantity,
+		leavesQuantity:     m.order.Quantity - m.cumulativeQuantity,
+		cumulativeQuantity: m.cumulativeQuantity,
+	}
+
+	if quantity > 0 {
+		r.lastFillCommission = mockCommissionRate * quantity
+	}
+
+	r.cumulativeCommission = m.commission
+	r.commissionCurrency = currencies.USD
+
+	if t == reports.Replaced {
+		r.replaceSourceOrder = source
+		r.replaceTargetOrder = m.order
+	}
+
+	if m.status.IsTerminal() {
+		r.leavesQuantity = 0
+	}
+
+	m.reports = append(m.reports, r)
+
+	if m.notify != nil {
+		m.notify(m)
+	}
+}
+
+// mockOrderSingleSubmitter is a mock submitter which accepts or rejects orders immediately
+// and routes the execution reports to a parent ticket.
+type mockOrderSingleSubmitter struct {
+	tickets []*mockOrderSingleTicket
+	parent  *Ticket
+	fail    bool
+	reject  bool
+	cancel  bool
+}
+
+// Submit places a new order and returns a ticket to track it.
+func (m *mockOrderSingleSubmitter) Submit(order orders.OrderSingle) (orders.OrderSingleTicket, error) {
+	if m.fail {
+		return nil, errMockSubmit
+	}
+
+	if m.cancel && m.parent != nil {
+		// The parent order is canceled while the order is being submitted.
+		m.parent.Cancel()
+	}
+
+	t := &mockOrderSingleTicket{order: order, id: strconv.Itoa(len(m.tickets) + 1), status: status.New}
+	t.report(reports.New, 0, 0, orders.OrderSingle{})
+
+	if m.reject {
+		t.reject()
+	}
+
+	t.notify = func(ticket orders.OrderSingleTicket) {
+		if m.parent != nil {
+			m.parent.Update(ticket)
+		}
+	}
+
+	m.tickets = append(m.tickets, t)
+
+	return t, nil
+}
@@ -0,0 +1,67 @@
+package algos
+
+//nolint:gofumpt
+import (
+	"fmt"
+	"math"
+
+	"mbg/trading/data"
+	"mbg/trading/orders"
+)
+
+// POV (percent of volume) algorithm participates in the market volume with a given rate.
+//
+// The market volume is accumulated from the trades since the start, including the fills
+// of the own child orders. Whenever the committed quantity falls behind the participation
+// target by at least the minimum quantity, a child order covering the shortfall is submitted.
+type POV struct {
+	// Rate is the participation rate, (0, 1], e.g. 0.1 for 10% of the market volume.
+	Rate float64
+
+	// MinQuantity is the minimum quantity of a child order, smaller shortfalls are accumulated.
+	// The last child order submits the whole remaining quantity.
+	MinQuantity float64
+
+	// LotSize, if positive, is a quantity the child orders are rounded down to a multiple of.
+	LotSize float64
+
+	volume float64
+}
+
+var (
+	errRate        = fmt.Errorf("participation rate should be in the range (0, 1]: %w", errInvalidAlgorithm)
+	errMinQuantity = fmt.Errorf("minimum quantity should not be negative: %w", errInvalidAlgorithm)
+)
+
+func (a *POV) validate(_ orders.OrderSingle) error {
+	switch {
+	case a.Rate <= 0 || a.Rate > 1:
+		return errRate
+	case a.MinQuantity < 0:
+		return errMinQuantity
+	case a.LotSize < 0:
+		return errLotSize
+	}
+
+	return nil
+}
+
+func (a *POV) start(_ *Ticket) {
+	a.volume = 0
+}
+
+func (a *POV) trade(t *Ticket, trade *data.Trade) {
+	if trade.Volume <= 0 {
+		return
+	}
+
+	a.volume += trade.Volume
+
+	target := math.Min(a.Rate*a.volume, t.order.Quantity)
+	if shortfall := target - t.committed(); shortfall >= math.Max(a.MinQuantity, epsilon) ||
+		target >= t.order.Quantity {
+		t.submit(shortfall, a.LotSize)
+	}
+}
+
+func (a *POV) update(_ *Ticket) {}
@@ -0,0 +1,44 @@
+//nolint:testpackage
+package algos
+
+//nolint:gofumpt
+import (
+	"testing"
+
+	"mbg/trading/data"
+	"mbg/trading/orders/status"
+)
+
+func TestPOV(t *testing.T) {
+	t.Parallel()
+
+	p, s, _ := newTestTicket(t, testParent(200), &POV{Rate: 0.1, MinQuantity: 50})
+
+	trade := func(volume float64) {
+		p.UpdateTrade(&data.Trade{Time: at(10, 0), Price: 100, Volume: volume})
+	}
+
+	trade(300)
+	checkQuantities(t, "30 behind", s)
+
+	trade(0)
+	trade(300)
+	checkQuantities(t, "60 behind", s, 60)
+
+	s.tickets[0].fill(60, 100)
+	trade(1000)
+	checkQuantities(t, "100 behind", s, 60, 100)
+
+	trade(1000)
+	checkQuantities(t, "capped", s, 60, 100, 40)
+
+	s.tickets[1].fill(100, 100)
+	s.tickets[2].fill(40, 100)
+
+	if p.Status() != status.Filled {
+		t.Errorf("POV: expected filled, actual %v", p.Status())
+	}
+
+	trade(1000)
+	checkQuantities(t, "after fill", s, 60, 100, 40)
+}
@@ -0,0 +1,217 @@
+package algos
+
+//nolint:gofumpt
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"mbg/trading/data"
+	"mbg/trading/orders"
+)
+
+// TWAP (time-weighted average price) algorithm slices the parent order into equal child orders
+// submitted at regular intervals between the start and the end time.
+//
+// Each slice tops the committed quantity up to the fraction of the parent quantity due by the slice,
+// so the quantity of a slice which could not be submitted is carried over to the next one.
+// The quantity of a child order rejected, expired or canceled by the broker is submitted again
+// as soon as the child order is completed, until the end time. The parent order is expired
+// when the end time has passed and no child orders are active.
+type TWAP struct {
+	// Start is the time of the first slice.
+	Start time.Time
+
+	// End is the end of the period, the last slice is submitted before it.
+	End time.Time
+
+	// Slices is the number of the child orders.
+	Slices int
+
+	// Randomization is a fraction of the slice interval, [0, 1), by which the submission time
+	// of every slice is randomly delayed from the beginning of its interval. Zero disables the randomization.
+	Randomization float64
+
+	// Rand is a source of the random delays. If nil, a source seeded with the current time is used.
+	Rand *rand.Rand
+
+	// LotSize, if positive, is a quantity the child orders are rounded down to a multiple of.
+	// The last slice submits the whole remaining quantity.
+	LotSize float64
+
+	slicing slicing
+}
+
+// VWAP (volume-weighted average price) algorithm slices the parent order following an intraday
+// volume profile. The period between the start and the end time is split into equal buckets,
+// one per profile value, and a child order is submitted at the beginning of every bucket.
+//
+// Each slice tops the committed quantity up to the fraction of the parent quantity due by the end of
+// the bucket, so the quantity of a slice which could not be submitted is carried over to the next one.
+// The quantity of a child order rejected, expired or canceled by the broker is submitted again
+// as soon as the child order is completed, until the end time. The parent order is expired
+// when the end time has passed and no child orders are active.
+type VWAP struct {
+	// Start is the beginning of the first bucket.
+	Start time.Time
+
+	// End is the end of the last bucket.
+	End time.Time
+
+	// Profile is the expected relative market volume in every bucket, e.g. the percentages
+	// of the daily volume. The values should not be negative and should have a positive sum.
+	Profile []float64
+
+	// LotSize, if positive, is a quantity the child orders are rounded down to a multiple of.
+	// The last slice submits the whole remaining quantity.
+	LotSize float64
+
+	slicing slicing
+}
+
+// slicing is the working state of the TWAP and VWAP schedules.
+type slicing struct {
+	// due is the fraction of the parent quantity due by the last slice submitted.
+	due float64
+
+	// lot is the lot size of the child orders.
+	lot float64
+
+	// ended indicates if the end time has passed.
+	ended bool
+}
+
+const scheduleReminder = "algos.slice"
+
+var (
+	errPeriod        = fmt.Errorf("end time should be after the start time: %w", errInvalidAlgorithm)
+	errSlices        = fmt.Errorf("number of slices should be positive: %w", errInvalidAlgorithm)
+	errRandomization = fmt.Errorf("randomization should be in the range [0, 1): %w", errInvalidAlgorithm)
+	errProfile       = fmt.Errorf(
+		"volume profile should have non-negative values with a positive sum: %w", errInvalidAlgorithm)
+	errLotSize = fmt.Errorf("lot size should not be negative: %w", errInvalidAlgorithm)
+)
+
+func (a *TWAP) validate(_ orders.OrderSingle) error {
+	switch {
+	case !a.End.After(a.Start):
+		return errPeriod
+	case a.Slices < 1:
+		return errSlices
+	case a.Randomization < 0 || a.Randomization >= 1:
+		return errRandomization
+	case a.LotSize < 0:
+		return errLotSize
+	}
+
+	return nil
+}
+
+func (a *TWAP) start(t *Ticket) {
+	if a.Rand == nil {
+		a.Rand = rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+	}
+
+	weights := make([]float64, a.Slices)
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	a.slicing.schedule(t, a.Start, a.End, weights, a.LotSize, func() float64 {
+		return a.Randomization * a.Rand.Float64()
+	})
+}
+
+func (a *TWAP) trade(_ *Ticket, _ *data.Trade) {}
+
+func (a *TWAP) update(t *Ticket) {
+	a.slicing.update(t)
+}
+
+func (a *VWAP) validate(_ orders.OrderSingle) error {
+	if !a.End.After(a.Start) {
+		return errPeriod
+	}
+
+	sum := 0.
+
+	for _, v := range a.Profile {
+		if v < 0 {
+			return errProfile
+		}
+
+		sum += v
+	}
+
+	switch {
+	case sum <= 0:
+		return errProfile
+	case a.LotSize < 0:
+		return errLotSize
+	}
+
+	return nil
+}
+
+func (a *VWAP) start(t *Ticket) {
+	a.slicing.schedule(t, a.Start, a.End, a.Profile, a.LotSize, func() float64 { return 0 })
+}
+
+func (a *VWAP) trade(_ *Ticket, _ *data.Trade) {}
+
+func (a *VWAP) update(t *Ticket) {
+	a.slicing.update(t)
+}
+
+// schedule adds the reminders of the slices, one per weight, at the beginning of equal intervals
+// delayed by a given fraction of the interval, and the reminder of the end time.
+func (s *slicing) schedule(t *Ticket, start, end time.Time, weights []float64, lot float64,
+	delay func() float64,
+) {
+	*s = slicing{lot: lot}
+
+	total := 0.
+	for _, w := range weights {
+		total += w
+	}
+
+	interval := end.Sub(start) / time.Duration(len(weights))
+	due := 0.
+
+	for i, w := range weights {
+		due += w
+		fraction := due / total
+
+		if i == len(weights)-1 {
+			fraction = 1
+		}
+
+		at := start.Add(interval*time.Duration(i) + time.Duration(delay()*float64(interval)))
+		t.addReminder(scheduleReminder, func() {
+			s.due = fraction
+			s.submit(t)
+		}, at)
+	}
+
+	t.addReminder(scheduleReminder, func() {
+		s.ended = true
+		t.expire()
+	}, end)
+}
+
+// update submits again the quantity of the completed child orders which is due but not filled
+// or expires the parent order after the end time.
+func (s *slicing) update(t *Ticket) {
+	if s.ended {
+		t.expire()
+	} else {
+		s.submit(t)
+	}
+}
+
+// submit tops the committed quantity up to the due fraction of the parent quantity.
+func (s *slicing) submit(t *Ticket) {
+	if s.due > 0 {
+		t.submit(s.due*t.order.Quantity-t.committed(), s.lot)
+	}
+}
@@ -0,0 +1,220 @@
+//nolint:testpackage
+package algos
+
+//nolint:gofumpt
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"mbg/trading/data"
+	"mbg/trading/orders/reports"
+	"mbg/trading/orders/status"
+	"mbg/trading/time/timepieces"
+)
+
+func TestTWAP(t *testing.T) {
+	t.Parallel()
+
+	p, s, tp := newTestTicket(t, testParent(1000), &TWAP{Start: at(10, 0), End: at(11, 0), Slices: 4})
+
+	checkQuantities(t, "before start", s)
+
+	tp.Synchronize(at(10, 0))
+	checkQuantities(t, "10:00", s, 250)
+
+	s.tickets[0].fill(250, 100)
+	tp.Synchronize(at(10, 29))
+	checkQuantities(t, "10:29", s, 250, 250)
+
+	tp.Synchronize(at(11, 0))
+	checkQuantities(t, "11:00", s, 250, 250, 250, 250)
+
+	for _, c := range s.tickets[1:] {
+		c.fill(250, 101)
+	}
+
+	if r := p.LastReport(); r.CumulativeQuantity() != 1000 || r.AveragePrice() != 100.75 || !p.Status().IsTerminal() {
+		t.Errorf("TWAP: expected 1000 filled at 100.75, actual %v at %v", r.CumulativeQuantity(), r.AveragePrice())
+	}
+}
+
+func TestTWAPRandomization(t *testing.T) {
+	t.Parallel()
+
+	const randomization = 0.5
+
+	_, s, tp := newTestTicket(t, testParent(1000), &TWAP{
+		Start: at(10, 0), End: at(11, 0), Slices: 4,
+		Randomization: randomization, Rand: rand.New(rand.NewSource(7)), //nolint:gosec
+	})
+
+	var times []time.Time
+
+	for m := 0; m <= 60; m++ {
+		tp.Synchronize(at(10, 0).Add(time.Duration(m) * time.Minute))
+
+		for len(times) < len(s.tickets) {
+			times = append(times, tp.Now())
+		}
+	}
+
+	checkQuantities(t, "randomized", s, 250, 250, 250, 250)
+
+	delayed := false
+
+	for i, tm := range times {
+		begin := at(10, 0).Add(time.Duration(i) * 15 * time.Minute)
+		end := begin.Add(time.Duration(randomization * float64(15*time.Minute)))
+
+		if tm.Before(begin) || tm.After(end.Add(time.Minute)) {
+			t.Errorf("slice %v: expected submission within [%v, %v], actual %v", i, begin, end, tm)
+		}
+
+		if tm.After(begin) {
+			delayed = true
+		}
+	}
+
+	if !delayed {
+		t.Errorf("randomized: expected some slices delayed, actual %v", times)
+	}
+}
+
+func TestTWAPCarryOver(t *testing.T) {
+	t.Parallel()
+
+	_, s, tp := newTestTicket(t, testParent(1000), &TWAP{Start: at(10, 0), End: at(11, 0), Slices: 4})
+
+	s.fail = true
+
+	tp.Synchronize(at(10, 0))
+	checkQuantities(t, "failed slice", s)
+
+	s.fail = false
+
+	tp.Synchronize(at(10, 15))
+	checkQuantities(t, "carried over", s, 500)
+}
+
+func TestTWAPCompletedByBroker(t *testing.T) {
+	t.Parallel()
+
+	p, s, tp := newTestTicket(t, testParent(1000), &TWAP{Start: at(10, 0), End: at(11, 0), Slices: 4})
+
+	tp.Synchronize(at(10, 45))
+	checkQuantities(t, "10:45", s, 250, 250, 250, 250)
+
+	for _, c := range s.tickets[:3] {
+		c.fill(250, 100)
+	}
+
+	tp.Synchronize(at(10, 50))
+	s.tickets[3].fill(50, 100)
+	s.tickets[3].reject()
+	checkQuantities(t, "rejected before end", s, 250, 250, 250, 250, 200)
+
+	tp.Synchronize(at(11, 0))
+
+	if p.Status() != status.PartiallyFilled {
+		t.Errorf("end with an active child: expected %v, actual %v", status.PartiallyFilled, p.Status())
+	}
+
+	s.tickets[4].fill(100, 100)
+	s.tickets[4].Cancel()
+	checkQuantities(t, "canceled after end", s, 250, 250, 250, 250, 200)
+
+	r := p.LastReport()
+	if p.Status() != status.Expired || r.ReportType() != reports.Expired ||
+		r.CumulativeQuantity() != 900 || r.LeavesQuantity() != 0 {
+		t.Errorf("canceled after end: expected expired with 900 filled, actual %v %v %v %v",
+			p.Status(), r.ReportType(), r.CumulativeQuantity(), r.LeavesQuantity())
+	}
+}
+
+func TestTWAPRejectedOnSubmission(t *testing.T) {
+	t.Parallel()
+
+	p, s, tp := newTestTicket(t, testParent(1000), &TWAP{Start: at(10, 0), End: at(11, 0), Slices: 4})
+
+	s.reject = true
+
+	tp.Synchronize(at(10, 15))
+	checkQuantities(t, "rejected", s, 250, 500)
+
+	tp.Synchronize(at(11, 0))
+	checkQuantities(t, "rejected", s, 250, 500, 750, 1000)
+
+	if p.Status() != status.Expired || p.LastReport().CumulativeQuantity() != 0 {
+		t.Errorf("end: expected expired with nothing filled, actual %v", p.Status())
+	}
+}
+
+func TestTWAPRealtime(t *testing.T) {
+	t.Parallel()
+
+	tp := timepieces.NewRealtimeTimepiece(nil)
+	defer tp.Stop()
+
+	now := tp.Now()
+	s := &mockOrderSingleSubmitter{reject: true}
+
+	p, err := NewTicket("P", testParent(1000), s, tp,
+		&TWAP{Start: now, End: now.Add(100 * time.Millisecond), Slices: 4})
+	if err != nil {
+		t.Fatalf("NewTicket(): unexpected error %v", err)
+	}
+
+	s.parent = p
+
+	if err := p.Start(); err != nil {
+		t.Fatalf("Start(): unexpected error %v", err)
+	}
+
+	// The slices are submitted on the timepiece goroutine concurrently with the updates.
+	trade := &data.Trade{Price: 100, Volume: 10}
+	deadline := time.Now().Add(5 * time.Second)
+
+	for p.Status() != status.Expired && time.Now().Before(deadline) {
+		for _, c := range p.Children() {
+			p.Update(c)
+		}
+
+		p.UpdateTrade(trade)
+		_ = p.Reports()
+
+		time.Sleep(time.Millisecond)
+	}
+
+	if p.Status() != status.Expired || len(p.Children()) != 4 {
+		t.Errorf("expected expired with 4 children, actual %v with %v", p.Status(), len(p.Children()))
+	}
+}
+
+func TestVWAP(t *testing.T) {
+	t.Parallel()
+
+	_, s, tp := newTestTicket(t, testParent(1000), &VWAP{
+		Start: at(10, 0), End: at(11, 0), Profile: []float64{40, 10, 0, 50},
+	})
+
+	tp.Synchronize(at(10, 0))
+	checkQuantities(t, "10:00", s, 400)
+
+	tp.Synchronize(at(10, 30))
+	checkQuantities(t, "10:30", s, 400, 100)
+
+	tp.Synchronize(at(10, 45))
+	checkQuantities(t, "10:45", s, 400, 100, 500)
+}
+
+func TestVWAPLotSize(t *testing.T) {
+	t.Parallel()
+
+	_, s, tp := newTestTicket(t, testParent(1000), &VWAP{
+		Start: at(10, 0), End: at(10, 30), Profile: []float64{1, 1, 1}, LotSize: 100,
+	})
+
+	tp.Synchronize(at(10, 30))
+	checkQuantities(t, "lots", s, 300, 300, 400)
+}
@@ -0,0 +1,493 @@
+// Package algos implements execution algorithms which work a large parent order
+// by slicing it into child orders over time.
+package algos
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/data"
+	"mbg/trading/orders"
+	"mbg/trading/orders/reports"
+	"mbg/trading/orders/status"
+	"mbg/trading/time/timepieces"
+)
+
+// Algorithm is an execution algorithm deciding when and how much of a parent order
+// to submit as child orders.
+//
+// An algorithm keeps the working state of a single parent order
+// and should not be shared between tickets.
+type Algorithm interface {
+	// validate checks the parameters of the algorithm for a given parent order.
+	validate(order orders.OrderSingle) error
+
+	// start is called when the parent order starts to be worked.
+	start(t *Ticket)
+
+	// trade is called on every market trade while the parent order is worked.
+	trade(t *Ticket, trade *data.Trade)
+
+	// update is called after a child order has changed while the parent order is worked.
+	update(t *Ticket)
+}
+
+// Ticket tracks a parent order worked by an execution algorithm.
+//
+// The fills of the child orders are aggregated into the execution reports of the parent order,
+// so that the CumulativeQuantity and the AveragePrice of the parent reflect all child fills.
+//
+// The ticket is driven by calling Update whenever a child ticket receives an execution report,
+// by calling UpdateTrade on every market trade, and by the reminders of the timepiece.
+//
+// The ticket is safe for a concurrent use, so the reminders may fire on a goroutine of the timepiece.
+// The calls are serialized with a mutex which is released while a child order is submitted or canceled,
+// so Update may be called re-entrantly from within the submitter and the child ticket methods.
+// The other child ticket methods are called with the mutex held and should not wait for Update.
+type Ticket struct {
+	mu                   sync.Mutex
+	id                   string
+	order                orders.OrderSingle
+	submitter            orders.OrderSingleSubmitter
+	timepiece            timepieces.Timepiece
+	algorithm            Algorithm
+	status               status.OrderStatus
+	reports              []orders.OrderSingleExecutionReport
+	children             []*child
+	cumulativeQuantity   float64
+	notional             float64
+	cumulativeCommission float64
+	commissionCurrency   currencies.Currency
+	submitting           bool
+}
+
+// child is a child order with the fills already aggregated into the parent.
+type child struct {
+	ticket               orders.OrderSingleTicket
+	report               orders.OrderSingleExecutionReport
+	cumulativeQuantity   float64
+	notional             float64
+	cumulativeCommission float64
+}
+
+var (
+	errInvalidAlgorithm = errors.New("invalid execution algorithm")
+	errNoAlgorithm      = fmt.Errorf("algorithm should not be nil: %w", errInvalidAlgorithm)
+	errQuantity         = fmt.Errorf("parent order quantity should be positive: %w", errInvalidAlgorithm)
+	errStarted          = errors.New("parent order has been already started")
+)
+
+// NewTicket creates a new ticket of a parent order with a given client order ID
+// to be worked by an execution algorithm.
+//
+// The child orders are placed with the submitter, the timepiece provides
+// the current time and schedules the algorithm reminders.
+func NewTicket(id string, order orders.OrderSingle, submitter orders.OrderSingleSubmitter,
+	timepiece timepieces.Timepiece, algorithm Algorithm,
+) (*Ticket, error) {
+	if algorithm == nil {
+		return nil, errNoAlgorithm
+	}
+
+	if order.Quantity <= 0 {
+		return nil, errQuantity
+	}
+
+	if err := algorithm.validate(order); err != nil {
+		return nil, err
+	}
+
+	return &Ticket{id: id, order: order, submitter: submitter, timepiece: timepiece, algorithm: algorithm}, nil
+}
+
+// Start starts working the parent order.
+func (t *Ticket) Start() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.status != 0 {
+		return errStarted
+	}
+
+	t.status = status.New
+	t.report(reports.New, 0, 0, 0)
+	t.algorithm.start(t)
+
+	return nil
+}
+
+// Update aggregates the fills of a child ticket which has received an execution report.
+//
+// Tickets not belonging to the parent order are ignored.
+func (t *Ticket) Update(ticket orders.OrderSingleTicket) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, c := range t.children {
+		if c.ticket == ticket {
+			t.aggregate(c)
+
+			break
+		}
+	}
+}
+
+// UpdateTrade updates the parent order with a market trade.
+func (t *Ticket) UpdateTrade(trade *data.Trade) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.working() {
+		t.algorithm.trade(t, trade)
+	}
+}
+
+// Children returns the tickets of the child orders in the order of submission.
+func (t *Ticket) Children() []orders.OrderSingleTicket {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tickets := make([]orders.OrderSingleTicket, len(t.children))
+	for i, c := range t.children {
+		tickets[i] = c.ticket
+	}
+
+	return tickets
+}
+
+// Order is the parent order. If there were any successful order replacements,
+// this will be the most recent version.
+func (t *Ticket) Order() orders.OrderSingle {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.order
+}
+
+// ClientOrderID is a unique identifier of the parent order as assigned by the buy-side.
+func (t *Ticket) ClientOrderID() string {
+	return t.id
+}
+
+// OrderID is a unique identifier of the parent order, the same as the ClientOrderID.
+func (t *Ticket) OrderID() string {
+	return t.id
+}
+
+// Status is the current state of the parent order.
+func (t *Ticket) Status() status.OrderStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.status
+}
+
+// LastReport is the last parent order report, nil if not any.
+func (t *Ticket) LastReport() orders.OrderSingleExecutionReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.reports) == 0 {
+		return nil
+	}
+
+	return t.reports[len(t.reports)-1]
+}
+
+// Reports provides a collection of all parent order reports in the chronological order.
+func (t *Ticket) Reports() []orders.OrderSingleExecutionReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]orders.OrderSingleExecutionReport(nil), t.reports...)
+}
+
+// CancelReplace changes the parameters of the parent order.
+// The new parameters apply to the child orders submitted afterwards.
+//
+// The replacement is rejected if it changes the order side or reduces the quantity
+// below the quantity already committed to the child orders.
+//
+// If the parent order has been completed or is being canceled, does nothing.
+func (t *Ticket) CancelReplace(replacementOrder orders.OrderSingle) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.working() {
+		return
+	}
+
+	source := t.order
+
+	if replacementOrder.Side != source.Side || replacementOrder.Quantity < t.committed() {
+		t.replaceReport(reports.ReplaceRejected, source, replacementOrder)
+
+		return
+	}
+
+	t.order = replacementOrder
+	t.replaceReport(reports.Replaced, source, replacementOrder)
+
+	if replacementOrder.Quantity <= t.cumulativeQuantity {
+		t.status = status.Filled
+		t.report(reports.Filled, 0, 0, 0)
+
+		return
+	}
+
+	t.algorithm.update(t)
+}
+
+// Cancel cancels the parent order and all its active child orders.
+// The parent order is canceled when all child orders are completed.
+//
+// If the parent order has been completed, does nothing.
+func (t *Ticket) Cancel() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.working() {
+		return
+	}
+
+	t.status = status.PendingCancel
+	t.report(reports.PendingCancel, 0, 0, 0)
+
+	for _, c := range t.children {
+		if !c.ticket.Status().IsTerminal() {
+			t.unlocked(c.ticket.Cancel)
+		}
+	}
+
+	t.completeCancel()
+}
+
+// working indicates if the algorithm is working the parent order.
+func (t *Ticket) working() bool {
+	return t.status == status.New || t.status == status.PartiallyFilled
+}
+
+// remaining is the parent quantity not committed to the child orders.
+func (t *Ticket) remaining() float64 {
+	return t.order.Quantity - t.committed()
+}
+
+// committed is the parent quantity filled or working in the child orders.
+func (t *Ticket) committed() float64 {
+	q := 0.
+
+	for _, c := range t.children {
+		if c.ticket.Status().IsTerminal() {
+			q += math.Max(c.cumulativeQuantity, filled(c.ticket))
+		} else {
+			q += c.ticket.Order().Quantity
+		}
+	}
+
+	return q
+}
+
+// active returns the number of the child orders not completed yet.
+func (t *Ticket) active() int {
+	n := 0
+
+	for _, c := range t.children {
+		if !c.ticket.Status().IsTerminal() {
+			n++
+		}
+	}
+
+	return n
+}
+
+// submit places a child order with a given quantity limited by the remaining quantity.
+// The quantity is rounded down to a multiple of the lot size, if positive, unless
+// it is the whole remaining quantity.
+//
+// Does nothing while another child order is being submitted, since its quantity is not committed yet.
+func (t *Ticket) submit(quantity, lot float64) {
+	if !t.working() || t.submitting {
+		return
+	}
+
+	remaining := t.remaining()
+
+	if quantity >= remaining {
+		quantity = remaining
+	} else if lot > 0 {
+		quantity = math.Floor(quantity/lot+epsilon) * lot
+	}
+
+	if quantity <= epsilon {
+		return
+	}
+
+	o := t.order
+	o.Quantity = quantity
+
+	var (
+		ticket orders.OrderSingleTicket
+		err    error
+	)
+
+	t.submitting = true
+	t.unlocked(func() { ticket, err = t.submitter.Submit(o) })
+	t.submitting = false
+
+	if err != nil {
+		// The quantity is carried over to the next child order.
+		return
+	}
+
+	c := &child{ticket: ticket}
+	t.children = append(t.children, c)
+
+	if !t.working() && !ticket.Status().IsTerminal() {
+		// The parent order has been canceled or completed while the child order was being submitted.
+		t.unlocked(ticket.Cancel)
+	}
+
+	// A child order rejected on submission is not resubmitted by the algorithm immediately,
+	// the quantity is carried over like on a failed submission.
+	t.submitting = ticket.Status() == status.Rejected
+	t.aggregate(c)
+	t.submitting = false
+}
+
+// aggregate adds new fills of a child order to the parent order.
+// Does nothing if the child order has no new execution reports.
+func (t *Ticket) aggregate(c *child) {
+	r := c.ticket.LastReport()
+	if r == nil || r == c.report {
+		return
+	}
+
+	c.report = r
+
+	if r.CumulativeQuantity() > c.cumulativeQuantity {
+		quantity := r.CumulativeQuantity() - c.cumulativeQuantity
+		notional := r.CumulativeQuantity()*r.AveragePrice() - c.notional
+		commission := r.CumulativeCommission() - c.cumulativeCommission
+
+		c.cumulativeQuantity += quantity
+		c.notional += notional
+		c.cumulativeCommission += commission
+
+		t.cumulativeQuantity += quantity
+		t.notional += notional
+		t.cumulativeCommission += commission
+		t.commissionCurrency = r.CommissionCurrency()
+
+		typ := reports.PartiallyFilled
+
+		switch {
+		case t.cumulativeQuantity >= t.order.Quantity-epsilon:
+			t.status = status.Filled
+			typ = reports.Filled
+		case t.status == status.New:
+			t.status = status.PartiallyFilled
+		}
+
+		t.report(typ, notional/quantity, quantity, commission)
+	}
+
+	switch {
+	case t.status == status.PendingCancel:
+		t.completeCancel()
+	case !t.submitting && t.working():
+		t.algorithm.update(t)
+	}
+}
+
+// expire completes the parent order as expired when no child orders are active.
+func (t *Ticket) expire() {
+	if t.working() && t.active() == 0 {
+		t.status = status.Expired
+		t.report(reports.Expired, 0, 0, 0)
+	}
+}
+
+// addReminder adds a reminder of the timepiece running an action while the parent order is worked.
+func (t *Ticket) addReminder(name string, action func(), at time.Time) {
+	t.timepiece.AddReminder(name, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		if t.working() {
+			action()
+		}
+	}, at)
+}
+
+// unlocked calls a function with the mutex released, so that it may call the ticket re-entrantly.
+func (t *Ticket) unlocked(f func()) {
+	t.mu.Unlock()
+	defer t.mu.Lock()
+
+	f()
+}
+
+// completeCancel cancels the parent order when no child orders are active.
+func (t *Ticket) completeCancel() {
+	if t.status == status.PendingCancel && t.active() == 0 {
+		t.status = status.Canceled
+		t.report(reports.Canceled, 0, 0, 0)
+	}
+}
+
+func (t *Ticket) report(typ reports.OrderReportType, price, quantity, commission float64) {
+	r := &executionReport{
+		order:                t.order,
+		transactionTime:      t.timepiece.Now(),
+		status:               t.status,
+		reportType:           typ,
+		id:                   t.id + "-" + strconv.Itoa(len(t.reports)+1),
+		lastFillPrice:        price,
+		averagePrice:         t.averagePrice(),
+		lastFillQuantity:     quantity,
+		leavesQuantity:       t.order.Quantity - t.cumulativeQuantity,
+		cumulativeQuantity:   t.cumulativeQuantity,
+		lastFillCommission:   commission,
+		cumulativeCommission: t.cumulativeCommission,
+		commissionCurrency:   t.commissionCurrency,
+	}
+
+	if t.status.IsTerminal() || r.leavesQuantity < 0 {
+		r.leavesQuantity = 0
+	}
+
+	t.reports = append(t.reports, r)
+}
+
+func (t *Ticket) replaceReport(typ reports.OrderReportType, source, target orders.OrderSingle) {
+	t.report(typ, 0, 0, 0)
+
+	r := t.reports[len(t.reports)-1].(*executionReport) //nolint:forcetypeassert
+	r.replaceSourceOrder = source
+	r.replaceTargetOrder = target
+}
+
+func (t *Ticket) averagePrice() float64 {
+	if t.cumulativeQuantity == 0 {
+		return 0
+	}
+
+	return t.notional / t.cumulativeQuantity
+}
+
+const epsilon = 1e-9
+
+func filled(ticket orders.OrderSingleTicket) float64 {
+	r := ticket.LastReport()
+	if r == nil {
+		return 0
+	}
+
+	return r.CumulativeQuantity()
+}
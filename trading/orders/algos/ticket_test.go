@@ -0,0 +1,285 @@
+//nolint:testpackage
+package algos
+
+//nolint:gofumpt
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/orders"
+	"mbg/trading/orders/reports"
+	"mbg/trading/orders/sides"
+	"mbg/trading/orders/status"
+	"mbg/trading/orders/types"
+	"mbg/trading/time/timepieces"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2021, time.November, 1, hour, minute, 0, 0, time.UTC)
+}
+
+func testParent(quantity float64) orders.OrderSingle {
+	return orders.OrderSingle{Side: sides.Buy, Type: types.Limit, Quantity: quantity, LimitPrice: 100}
+}
+
+func newTestTicket(t *testing.T, order orders.OrderSingle, algorithm Algorithm,
+) (*Ticket, *mockOrderSingleSubmitter, *timepieces.SynchronizedTimepiece) {
+	t.Helper()
+
+	s := &mockOrderSingleSubmitter{}
+	tp := timepieces.NewSynchronizedTimepiece(&timepieces.Params{}, at(9, 0))
+
+	p, err := NewTicket("P", order, s, tp, algorithm)
+	if err != nil {
+		t.Fatalf("NewTicket(): unexpected error %v", err)
+	}
+
+	s.parent = p
+
+	if err := p.Start(); err != nil {
+		t.Fatalf("Start(): unexpected error %v", err)
+	}
+
+	return p, s, tp
+}
+
+func checkQuantities(t *testing.T, name string, s *mockOrderSingleSubmitter, exp ...float64) {
+	t.Helper()
+
+	act := make([]float64, len(s.tickets))
+	for i, c := range s.tickets {
+		act[i] = c.order.Quantity
+	}
+
+	if len(act) != len(exp) {
+		t.Errorf("%v: expected child quantities %v, actual %v", name, exp, act)
+
+		return
+	}
+
+	for i := range exp {
+		if math.Abs(act[i]-exp[i]) > 1e-9 {
+			t.Errorf("%v: expected child quantities %v, actual %v", name, exp, act)
+
+			return
+		}
+	}
+}
+
+func TestTicketAggregation(t *testing.T) {
+	t.Parallel()
+
+	p, s, _ := newTestTicket(t, testParent(300), &Iceberg{DisplayQuantity: 200})
+
+	if r := p.LastReport(); p.Status() != status.New || r.ReportType() != reports.New || r.LeavesQuantity() != 300 {
+		t.Errorf("Start(): expected new parent, actual %v", p.Status())
+	}
+
+	if p.ClientOrderID() != "P" || p.OrderID() != "P" || len(p.Children()) != 1 {
+		t.Errorf("Start(): expected parent P with 1 child, actual %v with %v", p.ClientOrderID(), len(p.Children()))
+	}
+
+	s.tickets[0].fill(50, 100)
+	s.tickets[0].fill(150, 102)
+	s.tickets[1].fill(100, 104)
+
+	const (
+		avg     = (50*100 + 150*102 + 100*104) / 300.
+		lastAvg = (150 * 102) / 150.
+	)
+
+	tests := []struct {
+		typ                   reports.OrderReportType
+		st                    status.OrderStatus
+		last, lastQ, cum, avg float64
+	}{
+		{reports.New, status.New, 0, 0, 0, 0},
+		{reports.PartiallyFilled, status.PartiallyFilled, 100, 50, 50, 100},
+		{reports.PartiallyFilled, status.PartiallyFilled, lastAvg, 150, 200, (50*100 + 150*102) / 200.},
+		{reports.Filled, status.Filled, 104, 100, 300, avg},
+	}
+
+	if len(p.Reports()) != len(tests) {
+		t.Fatalf("Reports(): expected %v reports, actual %v", len(tests), len(p.Reports()))
+	}
+
+	for i, tt := range tests {
+		r := p.Reports()[i]
+		if r.ReportType() != tt.typ || r.Status() != tt.st || math.Abs(r.LastFillPrice()-tt.last) > 1e-9 ||
+			r.LastFillQuantity() != tt.lastQ || r.CumulativeQuantity() != tt.cum ||
+			math.Abs(r.AveragePrice()-tt.avg) > 1e-9 || r.LeavesQuantity() != 300-tt.cum {
+			t.Errorf("report %v: expected %v %v %v %v %v %v, actual %v %v %v %v %v %v", i,
+				tt.typ, tt.st, tt.last, tt.lastQ, tt.cum, tt.avg, r.ReportType(), r.Status(),
+				r.LastFillPrice(), r.LastFillQuantity(), r.CumulativeQuantity(), r.AveragePrice())
+		}
+	}
+
+	r := p.LastReport()
+	if math.Abs(r.CumulativeCommission()-3) > 1e-9 || math.Abs(r.LastFillCommission()-1) > 1e-9 ||
+		r.CommissionCurrency() != currencies.USD || r.ID() != "P-4" || !r.TransactionTime().Equal(at(9, 0)) {
+		t.Errorf("last report: unexpected commission %v %v %v, id %v, time %v", r.CumulativeCommission(),
+			r.LastFillCommission(), r.CommissionCurrency(), r.ID(), r.TransactionTime())
+	}
+
+	// Repeated updates do not aggregate the same fills twice.
+	p.Update(s.tickets[1])
+	p.Update(&mockOrderSingleTicket{})
+
+	if len(p.Reports()) != len(tests) {
+		t.Errorf("Update(): expected %v reports, actual %v", len(tests), len(p.Reports()))
+	}
+}
+
+func TestTicketCancel(t *testing.T) {
+	t.Parallel()
+
+	p, s, tp := newTestTicket(t, testParent(1000), &TWAP{Start: at(10, 0), End: at(11, 0), Slices: 4})
+
+	tp.Synchronize(at(10, 0))
+	s.tickets[0].fill(100, 100)
+	p.Cancel()
+
+	if p.Status() != status.Canceled || s.tickets[0].status != status.Canceled {
+		t.Errorf("Cancel(): expected canceled parent and child, actual %v %v", p.Status(), s.tickets[0].status)
+	}
+
+	n := len(p.Reports())
+	if r := p.Reports()[n-2]; r.ReportType() != reports.PendingCancel {
+		t.Errorf("Cancel(): expected pending cancel report, actual %v", r.ReportType())
+	}
+
+	r := p.LastReport()
+	if r.ReportType() != reports.Canceled || r.CumulativeQuantity() != 100 || r.LeavesQuantity() != 0 {
+		t.Errorf("Cancel(): expected canceled report with 100 filled, actual %v %v %v",
+			r.ReportType(), r.CumulativeQuantity(), r.LeavesQuantity())
+	}
+
+	tp.Synchronize(at(12, 0))
+	p.Cancel()
+	p.CancelReplace(testParent(2000))
+
+	if len(s.tickets) != 1 || len(p.Reports()) != n {
+		t.Errorf("after cancel: expected no changes, actual %v children, %v reports", len(s.tickets), len(p.Reports()))
+	}
+}
+
+func TestTicketCancelWhileSubmitting(t *testing.T) {
+	t.Parallel()
+
+	s := &mockOrderSingleSubmitter{cancel: true}
+	tp := timepieces.NewSynchronizedTimepiece(&timepieces.Params{}, at(9, 0))
+
+	p, err := NewTicket("P", testParent(300), s, tp, &Iceberg{DisplayQuantity: 100})
+	if err != nil {
+		t.Fatalf("NewTicket(): unexpected error %v", err)
+	}
+
+	s.parent = p
+
+	if err := p.Start(); err != nil {
+		t.Fatalf("Start(): unexpected error %v", err)
+	}
+
+	if p.Status() != status.Canceled || len(s.tickets) != 1 || s.tickets[0].status != status.Canceled {
+		t.Errorf("Start(): expected canceled parent and child, actual %v with %v children", p.Status(), len(s.tickets))
+	}
+}
+
+func TestTicketCancelReplace(t *testing.T) {
+	t.Parallel()
+
+	p, s, _ := newTestTicket(t, testParent(150), &Iceberg{DisplayQuantity: 100})
+
+	s.tickets[0].fill(100, 100)
+	checkQuantities(t, "before replace", s, 100, 50)
+
+	sell := testParent(300)
+	sell.Side = sides.Sell
+	p.CancelReplace(sell)
+
+	if r := p.LastReport(); r.ReportType() != reports.ReplaceRejected || r.ReplaceTargetOrder().Side != sides.Sell {
+		t.Errorf("side change: expected rejected replace, actual %v", r.ReportType())
+	}
+
+	p.CancelReplace(testParent(120))
+
+	if r := p.LastReport(); r.ReportType() != reports.ReplaceRejected || p.Order().Quantity != 150 {
+		t.Errorf("quantity below committed: expected rejected replace, actual %v", r.ReportType())
+	}
+
+	replacement := testParent(300)
+	replacement.LimitPrice = 99
+	p.CancelReplace(replacement)
+
+	if r := p.LastReport(); r.ReportType() != reports.Replaced || r.ReplaceSourceOrder().Quantity != 150 ||
+		r.ReplaceTargetOrder().Quantity != 300 || r.LeavesQuantity() != 200 {
+		t.Errorf("replace: expected replaced report, actual %v", r.ReportType())
+	}
+
+	s.tickets[1].fill(50, 100)
+	checkQuantities(t, "after replace", s, 100, 50, 100)
+
+	if s.tickets[2].order.LimitPrice != 99 {
+		t.Errorf("after replace: expected child limit price 99, actual %v", s.tickets[2].order.LimitPrice)
+	}
+}
+
+func TestTicketErrors(t *testing.T) {
+	t.Parallel()
+
+	s := &mockOrderSingleSubmitter{}
+	tp := timepieces.NewSynchronizedTimepiece(&timepieces.Params{}, at(9, 0))
+	market := testParent(100)
+	market.Type = types.Market
+
+	twap := func(slices int, randomization, lot float64) *TWAP {
+		return &TWAP{Start: at(10, 0), End: at(11, 0), Slices: slices, Randomization: randomization, LotSize: lot}
+	}
+
+	vwap := func(profile []float64, lot float64) *VWAP {
+		return &VWAP{Start: at(10, 0), End: at(11, 0), Profile: profile, LotSize: lot}
+	}
+
+	tests := []struct {
+		name      string
+		order     orders.OrderSingle
+		algorithm Algorithm
+		err       error
+	}{
+		{"nil", testParent(100), nil, errNoAlgorithm},
+		{"quantity", testParent(0), &Iceberg{DisplayQuantity: 10}, errQuantity},
+		{"twap period", testParent(100), &TWAP{Start: at(10, 0), End: at(10, 0), Slices: 1}, errPeriod},
+		{"twap slices", testParent(100), twap(0, 0, 0), errSlices},
+		{"twap randomization", testParent(100), twap(1, 1, 0), errRandomization},
+		{"twap lot", testParent(100), twap(1, 0, -1), errLotSize},
+		{"vwap period", testParent(100), &VWAP{Start: at(11, 0), End: at(10, 0), Profile: []float64{1}}, errPeriod},
+		{"vwap empty", testParent(100), vwap(nil, 0), errProfile},
+		{"vwap negative", testParent(100), vwap([]float64{2, -1}, 0), errProfile},
+		{"vwap lot", testParent(100), vwap([]float64{1}, -1), errLotSize},
+		{"pov rate", testParent(100), &POV{Rate: 1.1}, errRate},
+		{"pov zero rate", testParent(100), &POV{}, errRate},
+		{"pov minimum", testParent(100), &POV{Rate: 0.1, MinQuantity: -1}, errMinQuantity},
+		{"pov lot", testParent(100), &POV{Rate: 0.1, LotSize: -1}, errLotSize},
+		{"iceberg display", testParent(100), &Iceberg{}, errDisplayQuantity},
+		{"iceberg type", market, &Iceberg{DisplayQuantity: 10}, errIcebergType},
+	}
+
+	for _, tt := range tests {
+		p, err := NewTicket("P", tt.order, s, tp, tt.algorithm)
+		if p != nil || !errors.Is(err, tt.err) || !errors.Is(err, errInvalidAlgorithm) {
+			t.Errorf("%v: expected %v, actual %v", tt.name, tt.err, err)
+		}
+	}
+
+	p, _ := NewTicket("P", testParent(100), s, tp, &POV{Rate: 0.1})
+	if err := p.Start(); err != nil {
+		t.Errorf("Start(): unexpected error %v", err)
+	}
+
+	if err := p.Start(); !errors.Is(err, errStarted) {
+		t.Errorf("Start(): expected %v, actual %v", errStarted, err)
+	}
+}
@@ -0,0 +1,172 @@
+package orders
+
+//nolint:gofumpt
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"mbg/trading/orders/reports"
+	"mbg/trading/orders/status"
+)
+
+var errMockSubmit = errors.New("mock submit error")
+
+// mockOrderSingleTicket is a mock ticket which executes the requests immediately
+// and notifies about every new execution report.
+type mockOrderSingleTicket struct {
+	order              OrderSingle
+	id                 string
+	status             status.OrderStatus
+	reports            []OrderSingleExecutionReport
+	averagePrice       float64
+	cumulativeQuantity float64
+	pendingCancel      bool
+	cancels            int
+	notify             func(OrderSingleTicket)
+}
+
+// Order is the underlying order for this ticket.
+func (m *mockOrderSingleTicket) Order() OrderSingle {
+	return m.order
+}
+
+// ClientOrderID is a unique identifier for an order as assigned by the buy-side.
+func (m *mockOrderSingleTicket) ClientOrderID() string {
+	return m.id
+}
+
+// OrderID is a unique identifier for an order as assigned by the sell-side.
+func (m *mockOrderSingleTicket) OrderID() string {
+	return m.id
+}
+
+// Status is the current state of an order as understood by the broker.
+func (m *mockOrderSingleTicket) Status() status.OrderStatus {
+	return m.status
+}
+
+// LastReport is the last order report, nil if not any.
+func (m *mockOrderSingleTicket) LastReport() OrderSingleExecutionReport {
+	if len(m.reports) == 0 {
+		return nil
+	}
+
+	return m.reports[len(m.reports)-1]
+}
+
+// Reports provides a collection of all order reports in the chronological order.
+func (m *mockOrderSingleTicket) Reports() []OrderSingleExecutionReport {
+	return m.reports
+}
+
+// CancelReplace replaces the order immediately.
+func (m *mockOrderSingleTicket) CancelReplace(replacementOrder OrderSingle) {
+	if m.status.IsTerminal() {
+		return
+	}
+
+	source := m.order
+	m.order = replacementOrder
+	m.report(reports.Replaced, 0, 0, source)
+}
+
+// Cancel cancels the order immediately or, if the cancel is pending,
+// reports the pending cancel status on every request.
+func (m *mockOrderSingleTicket) Cancel() {
+	if m.status.IsTerminal() {
+		return
+	}
+
+	m.cancels++
+
+	if m.pendingCancel {
+		m.status = status.PendingCancel
+		m.report(reports.PendingCancel, 0, 0, OrderSingle{})
+
+		return
+	}
+
+	m.confirmCancel()
+}
+
+// confirmCancel cancels the order.
+func (m *mockOrderSingleTicket) confirmCancel() {
+	m.status = status.Canceled
+	m.report(reports.Canceled, 0, 0, OrderSingle{})
+}
+
+// fill fills the order with a given quantity and price.
+func (m *mockOrderSingleTicket) fill(quantity, price float64) {
+	m.averagePrice = (m.averagePrice*m.cumulativeQuantity + price*quantity) / (m.cumulativeQuantity + quantity)
+	m.cumulativeQuantity += quantity
+
+	t := reports.PartiallyFilled
+	m.status = status.PartiallyFilled
+
+	if m.cumulativeQuantity >= m.order.Quantity {
+		t = reports.Filled
+		m.status = status.Filled
+	}
+
+	m.report(t, price, quantity, OrderSingle{})
+}
+
+func (m *mockOrderSingleTicket) report(t reports.OrderReportType, price, quantity float64, source OrderSingle) {
+	r := &mockOrderSingleExecutionReport{
+		order:              m.order,
+		transactionTime:    time.Date(2021, time.November, 1, 10, 0, len(m.reports), 0, time.UTC),
+		status:             m.status,
+		reportType:         t,
+		id:                 m.id + "-" + strconv.Itoa(len(m.reports)+1),
+		lastFillPrice:      price,
+		averagePrice:       m.averagePrice,
+		lastFillQuantity:   quantity,
+		leavesQuantity:     m.order.Quantity - m.cumulativeQuantity,
+		cumulativeQuantity: m.cumulativeQuantity,
+	}
+
+	if t == reports.Replaced {
+		r.replaceSourceOrder = source
+		r.replaceTargetOrder = m.order
+	}
+
+	if m.status.IsTerminal() {
+		r.leavesQuantity = 0
+	}
+
+	m.reports = append(m.reports, r)
+
+	if m.notify != nil {
+		m.notify(m)
+	}
+}
+
+// mockOrderSingleSubmitter is a mock submitter which accepts orders immediately
+// and routes the execution reports to an order group.
+type mockOrderSingleSubmitter struct {
+	tickets       []*mockOrderSingleTicket
+	group         *OrderSingleGroup
+	fail          bool
+	pendingCancel bool
+}
+
+// Submit places a new order and returns a ticket to track it.
+func (m *mockOrderSingleSubmitter) Submit(order OrderSingle) (OrderSingleTicket, error) {
+	if m.fail {
+		return nil, errMockSubmit
+	}
+
+	t := &mockOrderSingleTicket{order: order, id: strconv.Itoa(len(m.tickets) + 1), status: status.New}
+	t.pendingCancel = m.pendingCancel
+	t.report(reports.New, 0, 0, OrderSingle{})
+	t.notify = func(ticket OrderSingleTicket) {
+		if m.group != nil {
+			_ = m.group.Update(ticket)
+		}
+	}
+
+	m.tickets = append(m.tickets, t)
+
+	return t, nil
+}
@@ -0,0 +1,389 @@
+package orders
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+
+	"mbg/trading/orders/status"
+	"mbg/trading/orders/types"
+)
+
+// OrderSingleGroup is a group of contingent orders in a single instrument,
+// where fills on one order (leg) automatically activate, resize or cancel the other legs.
+//
+// The following groups are supported:
+//   - one-cancels-other (OCO), where all legs are active at once; a fill on one leg
+//     reduces the quantity of the other legs by the filled quantity and cancels them
+//     when the leg is completely filled;
+//   - one-triggers-other (OTO), where the secondary legs are submitted only when the primary
+//     leg gets filled and are resized in proportion to the primary filled quantity as it grows;
+//   - bracket, where an entry order triggers a take-profit limit order and a stop-loss order
+//     sized to the filled entry quantity, and the two exit orders are one-cancels-other.
+//
+// When the target quantity of a triggered leg grows after its order has been filled,
+// or canceled by the group, a new order of the leg is submitted for the difference.
+// For instance, when the take-profit of a bracket is filled before the entry is complete,
+// the stop-loss is canceled, and the later entry fills are covered by a new pair
+// of the take-profit and the stop-loss orders. An order canceled or rejected by the broker
+// is not resubmitted.
+//
+// All changes to the legs are made via the ticket API (CancelReplace and Cancel),
+// so that every change produces the execution reports on the ticket of the leg.
+//
+// The group is driven by calling Update whenever a ticket of a leg receives an execution report.
+// Update may be called re-entrantly from within the ticket methods,
+// but the group is not safe for a concurrent use.
+type OrderSingleGroup struct {
+	submitter OrderSingleSubmitter
+	primary   *groupLeg
+	legs      []*groupLeg
+	exclusive bool
+	submitted bool
+	canceled  bool
+}
+
+// groupLeg is an order in a group, which may be submitted in several tickets.
+type groupLeg struct {
+	order      OrderSingle
+	tickets    []OrderSingleTicket
+	quantity   float64
+	submitting bool
+	canceling  bool
+}
+
+// groupEpsilon is the relative quantity below which a leg is considered to have reached its target.
+const groupEpsilon = 1e-9
+
+var (
+	errInvalidGroup      = errors.New("invalid order group")
+	errGroupLegs         = fmt.Errorf("group should have at least two orders: %w", errInvalidGroup)
+	errGroupQuantity     = fmt.Errorf("order quantity should be positive: %w", errInvalidGroup)
+	errGroupSubmitted    = errors.New("order group has been already submitted")
+	errBracketSide       = fmt.Errorf("exit orders should have the side opposite to the entry: %w", errInvalidGroup)
+	errBracketTakeProfit = fmt.Errorf("take-profit order should be a limit order: %w", errInvalidGroup)
+	errBracketStopLoss   = fmt.Errorf(
+		"stop-loss order should be a stop, a stop-limit or a trailing stop order: %w", errInvalidGroup)
+)
+
+// NewOneCancelsOther creates a one-cancels-other group of two or more orders.
+func NewOneCancelsOther(submitter OrderSingleSubmitter, orders ...OrderSingle) (*OrderSingleGroup, error) {
+	if len(orders) < 2 { //nolint:gomnd
+		return nil, errGroupLegs
+	}
+
+	legs, err := newGroupLegs(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderSingleGroup{submitter: submitter, legs: legs, exclusive: true}, nil
+}
+
+// NewOneTriggersOther creates a one-triggers-other group, where a primary order triggers
+// one or more secondary orders.
+//
+// The quantity of a secondary order is the fraction of its original quantity equal to
+// the filled fraction of the primary order.
+func NewOneTriggersOther(
+	submitter OrderSingleSubmitter, primary OrderSingle, secondary ...OrderSingle,
+) (*OrderSingleGroup, error) {
+	if len(secondary) < 1 {
+		return nil, errGroupLegs
+	}
+
+	legs, err := newGroupLegs(append([]OrderSingle{primary}, secondary...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderSingleGroup{submitter: submitter, primary: legs[0], legs: legs[1:]}, nil
+}
+
+// NewBracket creates a bracket group of an entry order, a take-profit limit order
+// and a stop-loss stop, stop-limit or trailing stop order.
+//
+// The exit orders should have the side opposite to the entry order,
+// their quantities are set to the quantity of the entry order.
+func NewBracket(submitter OrderSingleSubmitter, entry, takeProfit, stopLoss OrderSingle) (*OrderSingleGroup, error) {
+	if takeProfit.Type != types.Limit {
+		return nil, errBracketTakeProfit
+	}
+
+	switch stopLoss.Type {
+	case types.Stop, types.StopLimit, types.TrailingStop:
+	default:
+		return nil, errBracketStopLoss
+	}
+
+	if entry.Side.IsBuy() == takeProfit.Side.IsBuy() || entry.Side.IsBuy() == stopLoss.Side.IsBuy() {
+		return nil, errBracketSide
+	}
+
+	takeProfit.Quantity = entry.Quantity
+	stopLoss.Quantity = entry.Quantity
+
+	legs, err := newGroupLegs([]OrderSingle{entry, takeProfit, stopLoss})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderSingleGroup{submitter: submitter, primary: legs[0], legs: legs[1:], exclusive: true}, nil
+}
+
+// Submit places the initially active orders of the group: all orders of the one-cancels-other group
+// or the primary (entry) order of the one-triggers-other and bracket groups.
+//
+// If an order cannot be submitted, the group is canceled.
+func (g *OrderSingleGroup) Submit() error {
+	if g.submitted {
+		return errGroupSubmitted
+	}
+
+	g.submitted = true
+
+	if err := g.reconcile(); err != nil {
+		g.Cancel()
+
+		return err
+	}
+
+	return nil
+}
+
+// Update reconciles the group with the state of a ticket which has received an execution report.
+//
+// Tickets not belonging to the group are ignored. Returns an error if a triggered order
+// cannot be submitted, in which case the submission is retried on the next update.
+func (g *OrderSingleGroup) Update(ticket OrderSingleTicket) error {
+	for _, t := range g.Tickets() {
+		if t == ticket {
+			return g.reconcile()
+		}
+	}
+
+	return nil
+}
+
+// Cancel cancels all active orders of the group. The orders not triggered yet will never be submitted.
+func (g *OrderSingleGroup) Cancel() {
+	g.canceled = true
+
+	// A canceled group submits nothing, so there are no errors.
+	_ = g.reconcile()
+}
+
+// Tickets returns the tickets of the submitted orders, the primary (entry) order first,
+// followed by the tickets of every other leg in the order of submission.
+func (g *OrderSingleGroup) Tickets() []OrderSingleTicket {
+	tickets := make([]OrderSingleTicket, 0, len(g.legs)+1)
+
+	if g.primary != nil {
+		tickets = append(tickets, g.primary.tickets...)
+	}
+
+	for _, l := range g.legs {
+		tickets = append(tickets, l.tickets...)
+	}
+
+	return tickets
+}
+
+// Done indicates if all orders of the group are completed and no more orders will be submitted.
+func (g *OrderSingleGroup) Done() bool {
+	if !g.submitted && !g.canceled {
+		return false
+	}
+
+	if p := g.primary; p != nil {
+		t := p.ticket()
+		if t == nil {
+			return g.canceled && !p.submitting
+		}
+
+		if !t.Status().IsTerminal() {
+			return false
+		}
+	}
+
+	for i, l := range g.legs {
+		if l.submitting || g.due(l, g.target(i)) {
+			return false
+		}
+
+		if t := l.ticket(); t != nil && !t.Status().IsTerminal() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reconcile brings the legs in line with the filled quantities.
+//
+// The requested changes are recorded before calling the ticket methods,
+// so that re-entrant calls do not repeat them.
+func (g *OrderSingleGroup) reconcile() error {
+	if !g.submitted {
+		return nil
+	}
+
+	if p := g.primary; p != nil {
+		switch t := p.ticket(); {
+		case t == nil:
+			if p.submitting || g.canceled {
+				return nil
+			}
+
+			return g.submit(p, p.order.Quantity)
+		case g.canceled && !p.canceling && !t.Status().IsTerminal():
+			g.cancel(p)
+		}
+	}
+
+	for i, l := range g.legs {
+		target := g.target(i)
+
+		switch t := l.ticket(); {
+		case l.submitting:
+		case g.due(l, target):
+			return g.submit(l, target-l.filled())
+		case t == nil, l.canceling, t.Status().IsTerminal():
+		case g.canceled || target <= l.filled():
+			g.cancel(l)
+		case target-l.filledBefore() != l.quantity:
+			g.replace(l, target-l.filledBefore())
+		}
+	}
+
+	return nil
+}
+
+// due indicates if a new order of a leg should be submitted to reach a given target quantity.
+//
+// A leg is due if it has not been submitted yet or if its last order has been filled
+// or canceled by the group, and its filled quantity is below the target.
+func (g *OrderSingleGroup) due(l *groupLeg, target float64) bool {
+	if g.canceled || l.submitting {
+		return false
+	}
+
+	t := l.ticket()
+	if t == nil {
+		return target > 0
+	}
+
+	switch s := t.Status(); {
+	case s == status.Filled, s == status.Canceled && l.canceling:
+		return target-l.filled() > groupEpsilon*target
+	default:
+		return false
+	}
+}
+
+// target is the quantity the leg should have.
+func (g *OrderSingleGroup) target(i int) float64 {
+	q := g.legs[i].order.Quantity
+
+	if p := g.primary; p != nil {
+		q *= p.filled() / p.order.Quantity
+	}
+
+	if g.exclusive {
+		for j, l := range g.legs {
+			if j != i {
+				q -= l.filled()
+			}
+		}
+	}
+
+	return q
+}
+
+func (g *OrderSingleGroup) submit(l *groupLeg, quantity float64) error {
+	o := l.order
+	o.Quantity = quantity
+
+	l.submitting = true
+	t, err := g.submitter.Submit(o)
+	l.submitting = false
+
+	if err != nil {
+		return fmt.Errorf("cannot submit %v %v order: %w", o.Side, o.Type, err)
+	}
+
+	l.tickets = append(l.tickets, t)
+	l.quantity = quantity
+	l.canceling = false
+
+	return g.reconcile()
+}
+
+func (g *OrderSingleGroup) replace(l *groupLeg, quantity float64) {
+	t := l.ticket()
+	o := t.Order()
+	o.Quantity = quantity
+
+	l.quantity = quantity
+	t.CancelReplace(o)
+}
+
+func (g *OrderSingleGroup) cancel(l *groupLeg) {
+	l.canceling = true
+	l.ticket().Cancel()
+}
+
+// ticket returns the last submitted ticket of the leg, nil if not any.
+func (l *groupLeg) ticket() OrderSingleTicket {
+	if len(l.tickets) == 0 {
+		return nil
+	}
+
+	return l.tickets[len(l.tickets)-1]
+}
+
+// filled returns the quantity filled in all tickets of the leg.
+func (l *groupLeg) filled() float64 {
+	q := 0.
+	for _, t := range l.tickets {
+		q += filled(t)
+	}
+
+	return q
+}
+
+// filledBefore returns the quantity filled in the tickets of the leg before the last one.
+func (l *groupLeg) filledBefore() float64 {
+	if len(l.tickets) == 0 {
+		return 0
+	}
+
+	return l.filled() - filled(l.ticket())
+}
+
+func newGroupLegs(orders []OrderSingle) ([]*groupLeg, error) {
+	legs := make([]*groupLeg, len(orders))
+
+	for i, o := range orders {
+		if o.Quantity <= 0 {
+			return nil, errGroupQuantity
+		}
+
+		legs[i] = &groupLeg{order: o}
+	}
+
+	return legs, nil
+}
+
+func filled(ticket OrderSingleTicket) float64 {
+	if ticket == nil {
+		return 0
+	}
+
+	r := ticket.LastReport()
+	if r == nil {
+		return 0
+	}
+
+	return r.CumulativeQuantity()
+}
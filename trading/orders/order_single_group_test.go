@@ -0,0 +1,457 @@
+//nolint:testpackage
+package orders
+
+//nolint:gofumpt
+import (
+	"errors"
+	"testing"
+
+	"mbg/trading/orders/reports"
+	"mbg/trading/orders/sides"
+	"mbg/trading/orders/status"
+	"mbg/trading/orders/types"
+)
+
+func testGroupOrder(side sides.Side, typ types.OrderType, quantity, price float64) OrderSingle {
+	o := OrderSingle{Side: side, Type: typ, Quantity: quantity}
+
+	switch typ {
+	case types.Stop, types.TrailingStop:
+		o.StopPrice = price
+	default:
+		o.LimitPrice = price
+	}
+
+	return o
+}
+
+func checkLeg(t *testing.T, name string, m *mockOrderSingleTicket, s status.OrderStatus, q float64) {
+	t.Helper()
+
+	if m.status != s || m.order.Quantity != q {
+		t.Errorf("%v: expected status %v quantity %v, actual %v %v", name, s, q, m.status, m.order.Quantity)
+	}
+}
+
+func checkLastReport(t *testing.T, name string, m *mockOrderSingleTicket, r reports.OrderReportType) {
+	t.Helper()
+
+	if act := m.LastReport().ReportType(); act != r {
+		t.Errorf("%v: expected last report %v, actual %v", name, r, act)
+	}
+}
+
+func TestOrderSingleGroupOneCancelsOther(t *testing.T) {
+	t.Parallel()
+
+	s := &mockOrderSingleSubmitter{}
+
+	g, err := NewOneCancelsOther(s,
+		testGroupOrder(sides.Sell, types.Limit, 100, 110), testGroupOrder(sides.Sell, types.Stop, 100, 90))
+	if err != nil {
+		t.Fatalf("NewOneCancelsOther(): unexpected error %v", err)
+	}
+
+	s.group = g
+
+	if err := g.Submit(); err != nil {
+		t.Fatalf("Submit(): unexpected error %v", err)
+	}
+
+	if len(s.tickets) != 2 || len(g.Tickets()) != 2 || g.Done() {
+		t.Fatalf("Submit(): expected 2 active tickets, actual %v", len(s.tickets))
+	}
+
+	limit, stop := s.tickets[0], s.tickets[1]
+	checkLeg(t, "limit", limit, status.New, 100)
+	checkLeg(t, "stop", stop, status.New, 100)
+
+	limit.fill(40, 110)
+	checkLeg(t, "limit after partial fill", limit, status.PartiallyFilled, 100)
+	checkLeg(t, "stop after partial fill", stop, status.New, 60)
+	checkLastReport(t, "stop after partial fill", stop, reports.Replaced)
+
+	if r := stop.LastReport(); r.ReplaceSourceOrder().Quantity != 100 || r.ReplaceTargetOrder().Quantity != 60 {
+		t.Errorf("stop replace: expected 100 -> 60, actual %v -> %v",
+			r.ReplaceSourceOrder().Quantity, r.ReplaceTargetOrder().Quantity)
+	}
+
+	limit.fill(60, 110)
+	checkLeg(t, "limit after fill", limit, status.Filled, 100)
+	checkLeg(t, "stop after fill", stop, status.Canceled, 60)
+	checkLastReport(t, "stop after fill", stop, reports.Canceled)
+
+	if len(stop.Reports()) != 3 {
+		t.Errorf("stop: expected 3 reports, actual %v", len(stop.Reports()))
+	}
+
+	if !g.Done() {
+		t.Error("Done(): expected true, actual false")
+	}
+}
+
+func TestOrderSingleGroupOneTriggersOther(t *testing.T) {
+	t.Parallel()
+
+	s := &mockOrderSingleSubmitter{}
+
+	g, err := NewOneTriggersOther(s,
+		testGroupOrder(sides.Buy, types.Limit, 100, 100), testGroupOrder(sides.Sell, types.Limit, 50, 120))
+	if err != nil {
+		t.Fatalf("NewOneTriggersOther(): unexpected error %v", err)
+	}
+
+	s.group = g
+
+	if err := g.Submit(); err != nil {
+		t.Fatalf("Submit(): unexpected error %v", err)
+	}
+
+	if len(s.tickets) != 1 {
+		t.Fatalf("Submit(): expected 1 ticket, actual %v", len(s.tickets))
+	}
+
+	primary := s.tickets[0]
+	primary.fill(40, 100)
+
+	if len(s.tickets) != 2 {
+		t.Fatalf("primary partial fill: expected 2 tickets, actual %v", len(s.tickets))
+	}
+
+	secondary := s.tickets[1]
+	checkLeg(t, "secondary after partial fill", secondary, status.New, 20)
+	checkLastReport(t, "secondary after partial fill", secondary, reports.New)
+
+	primary.fill(60, 100)
+	checkLeg(t, "secondary after fill", secondary, status.New, 50)
+	checkLastReport(t, "secondary after fill", secondary, reports.Replaced)
+
+	if g.Done() {
+		t.Error("Done(): expected false, actual true")
+	}
+
+	secondary.fill(50, 120)
+
+	if !g.Done() || len(g.Tickets()) != 2 {
+		t.Errorf("Done(): expected true with 2 tickets, actual %v with %v tickets", g.Done(), len(g.Tickets()))
+	}
+
+	t.Run("secondary filled before primary", func(t *testing.T) {
+		t.Parallel()
+
+		s := &mockOrderSingleSubmitter{}
+
+		g, _ := NewOneTriggersOther(s,
+			testGroupOrder(sides.Buy, types.Limit, 100, 100), testGroupOrder(sides.Sell, types.Limit, 50, 120))
+		s.group = g
+
+		if err := g.Submit(); err != nil {
+			t.Fatalf("Submit(): unexpected error %v", err)
+		}
+
+		primary := s.tickets[0]
+		primary.fill(40, 100)
+
+		secondary := s.tickets[1]
+		secondary.fill(20, 120)
+		checkLeg(t, "secondary after fill", secondary, status.Filled, 20)
+
+		if g.Done() {
+			t.Error("Done() after secondary fill: expected false, actual true")
+		}
+
+		primary.fill(60, 100)
+
+		if len(s.tickets) != 3 || len(g.Tickets()) != 3 {
+			t.Fatalf("primary fill: expected 3 tickets, actual %v", len(s.tickets))
+		}
+
+		resubmitted := s.tickets[2]
+		checkLeg(t, "secondary after primary fill", secondary, status.Filled, 20)
+		checkLeg(t, "resubmitted secondary", resubmitted, status.New, 30)
+
+		if resubmitted.order.Side != sides.Sell || resubmitted.order.LimitPrice != 120 {
+			t.Errorf("resubmitted secondary: unexpected order %v", resubmitted.order)
+		}
+
+		if g.Done() {
+			t.Error("Done() after primary fill: expected false, actual true")
+		}
+
+		resubmitted.fill(30, 120)
+
+		if !g.Done() || len(s.tickets) != 3 {
+			t.Errorf("Done(): expected true with 3 tickets, actual %v with %v tickets", g.Done(), len(s.tickets))
+		}
+	})
+
+	t.Run("secondary canceled by broker", func(t *testing.T) {
+		t.Parallel()
+
+		s := &mockOrderSingleSubmitter{}
+
+		g, _ := NewOneTriggersOther(s,
+			testGroupOrder(sides.Buy, types.Limit, 100, 100), testGroupOrder(sides.Sell, types.Limit, 50, 120))
+		s.group = g
+
+		if err := g.Submit(); err != nil {
+			t.Fatalf("Submit(): unexpected error %v", err)
+		}
+
+		primary := s.tickets[0]
+		primary.fill(40, 100)
+		s.tickets[1].Cancel()
+		primary.fill(60, 100)
+
+		if len(s.tickets) != 2 || !g.Done() {
+			t.Errorf("Done(): expected true with 2 tickets, actual %v with %v tickets", g.Done(), len(s.tickets))
+		}
+	})
+}
+
+func TestOrderSingleGroupBracket(t *testing.T) {
+	t.Parallel()
+
+	newBracket := func(s *mockOrderSingleSubmitter) *OrderSingleGroup {
+		g, err := NewBracket(s, testGroupOrder(sides.Buy, types.Limit, 100, 100),
+			testGroupOrder(sides.Sell, types.Limit, 1, 110), testGroupOrder(sides.Sell, types.Stop, 1, 95))
+		if err != nil {
+			t.Fatalf("NewBracket(): unexpected error %v", err)
+		}
+
+		s.group = g
+
+		if err := g.Submit(); err != nil {
+			t.Fatalf("Submit(): unexpected error %v", err)
+		}
+
+		return g
+	}
+
+	t.Run("take profit", func(t *testing.T) {
+		t.Parallel()
+
+		s := &mockOrderSingleSubmitter{}
+		g := newBracket(s)
+
+		entry := s.tickets[0]
+		entry.fill(100, 100)
+
+		if len(s.tickets) != 3 {
+			t.Fatalf("entry fill: expected 3 tickets, actual %v", len(s.tickets))
+		}
+
+		tp, sl := s.tickets[1], s.tickets[2]
+		checkLeg(t, "take profit", tp, status.New, 100)
+		checkLeg(t, "stop loss", sl, status.New, 100)
+
+		if tp.order.Side != sides.Sell || tp.order.LimitPrice != 110 || sl.order.StopPrice != 95 {
+			t.Errorf("exits: unexpected orders %v, %v", tp.order, sl.order)
+		}
+
+		tp.fill(30, 110)
+		checkLeg(t, "stop loss after partial take profit", sl, status.New, 70)
+
+		tp.fill(70, 110)
+		checkLeg(t, "stop loss after take profit", sl, status.Canceled, 70)
+
+		if !g.Done() {
+			t.Error("Done(): expected true, actual false")
+		}
+	})
+
+	t.Run("partial entry", func(t *testing.T) {
+		t.Parallel()
+
+		s := &mockOrderSingleSubmitter{}
+		g := newBracket(s)
+
+		entry := s.tickets[0]
+		entry.fill(50, 100)
+
+		tp, sl := s.tickets[1], s.tickets[2]
+		checkLeg(t, "take profit", tp, status.New, 50)
+		checkLeg(t, "stop loss", sl, status.New, 50)
+
+		sl.fill(20, 95)
+		checkLeg(t, "take profit after partial stop loss", tp, status.New, 30)
+
+		entry.fill(50, 100)
+		checkLeg(t, "take profit after entry fill", tp, status.New, 80)
+		checkLeg(t, "stop loss after entry fill", sl, status.PartiallyFilled, 100)
+
+		sl.fill(80, 95)
+		checkLeg(t, "take profit after stop loss", tp, status.Canceled, 80)
+		checkLeg(t, "stop loss after stop loss", sl, status.Filled, 100)
+
+		if !g.Done() {
+			t.Error("Done(): expected true, actual false")
+		}
+	})
+
+	t.Run("take profit before entry fill", func(t *testing.T) {
+		t.Parallel()
+
+		s := &mockOrderSingleSubmitter{}
+		g := newBracket(s)
+
+		entry := s.tickets[0]
+		entry.fill(50, 100)
+
+		tp, sl := s.tickets[1], s.tickets[2]
+		tp.fill(50, 110)
+		checkLeg(t, "take profit", tp, status.Filled, 50)
+		checkLeg(t, "stop loss", sl, status.Canceled, 50)
+
+		entry.fill(50, 100)
+
+		if len(s.tickets) != 5 {
+			t.Fatalf("entry fill: expected 5 tickets, actual %v", len(s.tickets))
+		}
+
+		tp2, sl2 := s.tickets[3], s.tickets[4]
+		checkLeg(t, "second take profit", tp2, status.New, 50)
+		checkLeg(t, "second stop loss", sl2, status.New, 50)
+
+		if tp2.order.LimitPrice != 110 || sl2.order.StopPrice != 95 {
+			t.Errorf("second exits: unexpected orders %v, %v", tp2.order, sl2.order)
+		}
+
+		sl2.fill(50, 95)
+		checkLeg(t, "second take profit after stop loss", tp2, status.Canceled, 50)
+
+		if !g.Done() || len(g.Tickets()) != 5 {
+			t.Errorf("Done(): expected true with 5 tickets, actual %v with %v tickets", g.Done(), len(g.Tickets()))
+		}
+	})
+
+	t.Run("entry canceled", func(t *testing.T) {
+		t.Parallel()
+
+		s := &mockOrderSingleSubmitter{}
+		g := newBracket(s)
+
+		s.tickets[0].Cancel()
+
+		if len(s.tickets) != 1 || !g.Done() {
+			t.Errorf("entry cancel: expected done with 1 ticket, actual %v with %v tickets", g.Done(), len(s.tickets))
+		}
+	})
+
+	t.Run("group canceled", func(t *testing.T) {
+		t.Parallel()
+
+		s := &mockOrderSingleSubmitter{}
+		g := newBracket(s)
+
+		entry := s.tickets[0]
+		entry.fill(40, 100)
+		g.Cancel()
+
+		checkLeg(t, "entry", entry, status.Canceled, 100)
+		checkLeg(t, "take profit", s.tickets[1], status.Canceled, 40)
+		checkLeg(t, "stop loss", s.tickets[2], status.Canceled, 40)
+
+		if !g.Done() {
+			t.Error("Done(): expected true, actual false")
+		}
+	})
+
+	t.Run("group canceled with pending cancel", func(t *testing.T) {
+		t.Parallel()
+
+		s := &mockOrderSingleSubmitter{pendingCancel: true}
+		g := newBracket(s)
+
+		entry := s.tickets[0]
+		entry.fill(40, 100)
+		g.Cancel()
+
+		for i, m := range s.tickets {
+			if m.status != status.PendingCancel || m.cancels != 1 {
+				t.Errorf("ticket %v: expected %v after 1 cancel request, actual %v after %v",
+					i, status.PendingCancel, m.status, m.cancels)
+			}
+		}
+
+		if g.Done() {
+			t.Error("Done() before cancel confirmation: expected false, actual true")
+		}
+
+		for _, m := range s.tickets {
+			m.confirmCancel()
+		}
+
+		if len(s.tickets) != 3 || !g.Done() {
+			t.Errorf("cancel confirmation: expected done with 3 tickets, actual %v with %v tickets",
+				g.Done(), len(s.tickets))
+		}
+	})
+}
+
+func TestOrderSingleGroupSubmit(t *testing.T) {
+	t.Parallel()
+
+	s := &mockOrderSingleSubmitter{fail: true}
+
+	g, _ := NewOneCancelsOther(s,
+		testGroupOrder(sides.Sell, types.Limit, 100, 110), testGroupOrder(sides.Sell, types.Stop, 100, 90))
+	s.group = g
+
+	if err := g.Submit(); !errors.Is(err, errMockSubmit) {
+		t.Errorf("Submit(): expected %v, actual %v", errMockSubmit, err)
+	}
+
+	if !g.Done() || len(g.Tickets()) != 0 {
+		t.Errorf("Submit(): expected canceled group, actual done %v with %v tickets", g.Done(), len(g.Tickets()))
+	}
+
+	if err := g.Submit(); !errors.Is(err, errGroupSubmitted) {
+		t.Errorf("Submit(): expected %v, actual %v", errGroupSubmitted, err)
+	}
+
+	other := &mockOrderSingleTicket{status: status.New}
+	if err := g.Update(other); err != nil {
+		t.Errorf("Update(): expected no error for a foreign ticket, actual %v", err)
+	}
+}
+
+func TestOrderSingleGroupErrors(t *testing.T) {
+	t.Parallel()
+
+	s := &mockOrderSingleSubmitter{}
+	buy := testGroupOrder(sides.Buy, types.Limit, 100, 100)
+	sell := testGroupOrder(sides.Sell, types.Limit, 100, 110)
+	stop := testGroupOrder(sides.Sell, types.Stop, 100, 90)
+	empty := testGroupOrder(sides.Sell, types.Limit, 0, 110)
+
+	tests := []struct {
+		name string
+		err  error
+		fn   func() (*OrderSingleGroup, error)
+	}{
+		{"oco one", errGroupLegs, func() (*OrderSingleGroup, error) { return NewOneCancelsOther(s, sell) }},
+		{"oco quantity", errGroupQuantity, func() (*OrderSingleGroup, error) { return NewOneCancelsOther(s, sell, empty) }},
+		{"oto one", errGroupLegs, func() (*OrderSingleGroup, error) { return NewOneTriggersOther(s, buy) }},
+		{"oto quantity", errGroupQuantity, func() (*OrderSingleGroup, error) { return NewOneTriggersOther(s, buy, empty) }},
+		{"bracket take profit", errBracketTakeProfit, func() (*OrderSingleGroup, error) {
+			return NewBracket(s, buy, stop, stop)
+		}},
+		{"bracket stop loss", errBracketStopLoss, func() (*OrderSingleGroup, error) {
+			return NewBracket(s, buy, sell, sell)
+		}},
+		{"bracket side", errBracketSide, func() (*OrderSingleGroup, error) {
+			return NewBracket(s, buy, testGroupOrder(sides.Buy, types.Limit, 100, 110), stop)
+		}},
+		{"bracket quantity", errGroupQuantity, func() (*OrderSingleGroup, error) {
+			return NewBracket(s, testGroupOrder(sides.Buy, types.Limit, 0, 100), sell, stop)
+		}},
+	}
+
+	for _, tt := range tests {
+		g, err := tt.fn()
+		if g != nil || !errors.Is(err, tt.err) || !errors.Is(err, errInvalidGroup) {
+			t.Errorf("%v: expected %v, actual %v", tt.name, tt.err, err)
+		}
+	}
+}
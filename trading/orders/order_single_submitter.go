@@ -0,0 +1,9 @@
+package orders
+
+// OrderSingleSubmitter places orders in a single instrument with a broker.
+type OrderSingleSubmitter interface {
+	// Submit places a new order and returns a ticket to track it.
+	//
+	// Execution reports of the order are delivered via the ticket.
+	Submit(order OrderSingle) (OrderSingleTicket, error)
+}
@@ -125,6 +125,12 @@ func (t OrderStatus) IsKnown() bool {
 	return t >= Accepted && t <= Canceled
 }
 
+// IsTerminal determines if this order status is a terminal one,
+// that is Rejected, Filled, Expired or Canceled.
+func (t OrderStatus) IsTerminal() bool {
+	return t == Rejected || t == Filled || t == Expired || t == Canceled
+}
+
 // MarshalJSON implements the Marshaler interface.
 func (t OrderStatus) MarshalJSON() ([]byte, error) {
 	s := t.String()
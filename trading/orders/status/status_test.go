@@ -69,6 +69,37 @@ func TestIsKnown(t *testing.T) {
 	}
 }
 
+func TestIsTerminal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		os      OrderStatus
+		boolean bool
+	}{
+		{Accepted, false},
+		{PendingNew, false},
+		{New, false},
+		{Rejected, true},
+		{PartiallyFilled, false},
+		{Filled, true},
+		{Expired, true},
+		{PendingReplace, false},
+		{PendingCancel, false},
+		{Canceled, true},
+		{OrderStatus(0), false},
+		{OrderStatus(9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.os.IsTerminal()
+
+		if exp != act {
+			t.Errorf("'%v'.IsTerminal(): expected '%v', actual '%v'", tt.os, exp, act)
+		}
+	}
+}
+
 func TestMarshalJSON(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,131 @@
+package trailing
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"mbg/trading/data"
+)
+
+// Distance is a trailing distance between the best price reached by the market
+// and the stop price.
+//
+// A distance may keep a state updated by the bars and should not be shared between stops.
+type Distance interface {
+	// validate checks the parameters of the distance.
+	validate() error
+
+	// distance returns the trailing distance at a given reference price,
+	// or false if the distance is not available yet.
+	distance(price float64) (float64, bool)
+
+	// update updates the distance with a new bar.
+	update(bar *data.Bar)
+}
+
+// Absolute is a trailing distance in the instrument's price units.
+type Absolute float64
+
+// Percent is a trailing distance in percent of the reference price, e.g. 2.5 for 2.5%.
+type Percent float64
+
+// ATR is a volatility trailing distance, a multiple of the Wilder's average true range
+// of the bars.
+//
+// The average true range is the simple average of the first Length true ranges
+// followed by the Wilder's smoothing
+//
+//	ATRᵢ = (ATRᵢ₋₁·(ℓ-1) + TRᵢ) / ℓ,
+//
+// where the true range TRᵢ is the largest of the high-low range of the bar and the distances
+// from the previous close to the high and the low. The distance is not available
+// until Length bars are received.
+type ATR struct {
+	// Length is the number of bars ℓ the average true range is calculated over.
+	Length int
+
+	// Multiple is the number of average true ranges in the distance.
+	Multiple float64
+
+	value     float64
+	prevClose float64
+	count     int
+}
+
+var (
+	errInvalidDistance = errors.New("invalid trailing distance")
+	errAbsolute        = fmt.Errorf("absolute distance should be positive: %w", errInvalidDistance)
+	errPercent         = fmt.Errorf("percent distance should be in the range (0, 100): %w", errInvalidDistance)
+	errATRLength       = fmt.Errorf("ATR length should be positive: %w", errInvalidDistance)
+	errATRMultiple     = fmt.Errorf("ATR multiple should be positive: %w", errInvalidDistance)
+)
+
+func (a Absolute) validate() error {
+	if a <= 0 {
+		return errAbsolute
+	}
+
+	return nil
+}
+
+func (a Absolute) distance(_ float64) (float64, bool) {
+	return float64(a), true
+}
+
+func (a Absolute) update(_ *data.Bar) {}
+
+func (p Percent) validate() error {
+	if p <= 0 || p >= 100 { //nolint:gomnd
+		return errPercent
+	}
+
+	return nil
+}
+
+func (p Percent) distance(price float64) (float64, bool) {
+	return price * float64(p) / 100, true //nolint:gomnd
+}
+
+func (p Percent) update(_ *data.Bar) {}
+
+func (a *ATR) validate() error {
+	switch {
+	case a.Length < 1:
+		return errATRLength
+	case a.Multiple <= 0:
+		return errATRMultiple
+	}
+
+	return nil
+}
+
+func (a *ATR) distance(_ float64) (float64, bool) {
+	if a.count < a.Length {
+		return 0, false
+	}
+
+	return a.Multiple * a.value, true
+}
+
+func (a *ATR) update(bar *data.Bar) {
+	tr := bar.High - bar.Low
+	if a.count > 0 {
+		tr = math.Max(tr, math.Max(math.Abs(bar.High-a.prevClose), math.Abs(bar.Low-a.prevClose)))
+	}
+
+	a.prevClose = bar.Close
+	a.count++
+
+	l := float64(a.Length)
+
+	switch {
+	case a.count < a.Length:
+		a.value += tr
+	case a.count == a.Length:
+		a.value = (a.value + tr) / l
+	default:
+		a.value = (a.value*(l-1) + tr) / l
+	}
+}
@@ -0,0 +1,220 @@
+// Package trailing implements a trailing stop manager which moves the stop prices
+// of the orders after the market.
+package trailing
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"mbg/trading/data"
+	"mbg/trading/orders"
+	"mbg/trading/orders/status"
+	"mbg/trading/orders/types"
+)
+
+// Manager trails the stop prices of the stop, stop-limit and trailing stop orders in a single instrument.
+//
+// The sell stops trail the highest price reached since the stop was added, the buy stops
+// trail the lowest one. A stop price only moves in the direction of the market,
+// that is up for the sell stops and down for the buy stops.
+//
+// The new stop price is rounded to the MinPriceIncrement of the order's instrument away from
+// the market, down for the sell stops and up for the buy stops. Every stop adjustment is made
+// via the CancelReplace of the ticket, so it is reported as a replace on the ticket.
+//
+// The limit price of a stop-limit order moves together with the stop price, keeping the offset
+// between them, so that the order stays marketable in the same way when the stop is triggered.
+//
+// The completed orders are removed automatically, the orders with a pending cancel or
+// a pending replace are not adjusted. The manager is not safe for a concurrent use.
+type Manager struct {
+	stops []*stop
+}
+
+// stop is a trailed stop order.
+type stop struct {
+	ticket   orders.OrderSingleTicket
+	distance Distance
+	sell     bool
+	extreme  float64
+}
+
+var (
+	errDuplicate = errors.New("order is already trailed")
+	errSide      = errors.New("order side should be either a buy or a sell")
+	errType      = errors.New("order type should be a stop, a stop-limit or a trailing stop")
+)
+
+// NewManager creates a new trailing stop manager.
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+// Add starts trailing the stop price of a stop, a stop-limit or a trailing stop order
+// with a given distance.
+//
+// If the distance is nil, the absolute TrailingDistance of the order is used.
+func (m *Manager) Add(ticket orders.OrderSingleTicket, distance Distance) error {
+	for _, s := range m.stops {
+		if s.ticket == ticket {
+			return errDuplicate
+		}
+	}
+
+	o := ticket.Order()
+
+	switch o.Type {
+	case types.Stop, types.StopLimit, types.TrailingStop:
+	default:
+		return fmt.Errorf("%v: %w", o.Type, errType)
+	}
+
+	if distance == nil {
+		distance = Absolute(o.TrailingDistance)
+	}
+
+	if err := distance.validate(); err != nil {
+		return fmt.Errorf("cannot trail order: %w", err)
+	}
+
+	if !o.Side.IsBuy() && !o.Side.IsSell() {
+		return errSide
+	}
+
+	m.stops = append(m.stops, &stop{ticket: ticket, distance: distance, sell: o.Side.IsSell()})
+
+	return nil
+}
+
+// Remove stops trailing the stop price of an order.
+func (m *Manager) Remove(ticket orders.OrderSingleTicket) {
+	for i, s := range m.stops {
+		if s.ticket == ticket {
+			m.stops = append(m.stops[:i], m.stops[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// Len returns the number of the trailed orders.
+func (m *Manager) Len() int {
+	return len(m.stops)
+}
+
+// UpdateQuote trails the stops after a quote, the sell stops after the bid and the buy stops after the ask.
+func (m *Manager) UpdateQuote(quote *data.Quote) {
+	m.trail(quote.Bid, quote.Ask)
+}
+
+// UpdateTrade trails the stops after the price of a trade.
+func (m *Manager) UpdateTrade(trade *data.Trade) {
+	m.trail(trade.Price, trade.Price)
+}
+
+// UpdateBar updates the volatility distances with a bar and trails the stops,
+// the sell stops after the high and the buy stops after the low.
+func (m *Manager) UpdateBar(bar *data.Bar) {
+	for _, s := range m.stops {
+		s.distance.update(bar)
+	}
+
+	m.trail(bar.High, bar.Low)
+}
+
+// trail moves the stop prices after the best prices of the sell and the buy stops.
+func (m *Manager) trail(sell, buy float64) {
+	active := m.stops[:0]
+
+	for _, s := range m.stops {
+		if !s.ticket.Status().IsTerminal() {
+			active = append(active, s)
+		}
+	}
+
+	for i := len(active); i < len(m.stops); i++ {
+		m.stops[i] = nil
+	}
+
+	m.stops = active
+
+	// Adjusting a ticket may re-enter the manager, so iterate over a copy.
+	for _, s := range append([]*stop(nil), active...) {
+		if s.sell {
+			s.trail(sell)
+		} else {
+			s.trail(buy)
+		}
+	}
+}
+
+// trail moves the stop price after a given market price.
+func (s *stop) trail(price float64) {
+	if price <= 0 {
+		return
+	}
+
+	switch {
+	case s.extreme == 0, s.sell && price > s.extreme, !s.sell && price < s.extreme:
+		s.extreme = price
+	}
+
+	if st := s.ticket.Status(); st == status.PendingCancel || st == status.PendingReplace {
+		return
+	}
+
+	d, ok := s.distance.distance(s.extreme)
+	if !ok {
+		return
+	}
+
+	o := s.ticket.Order()
+
+	var p float64
+	if s.sell {
+		p = roundToTick(o, s.extreme-d, math.Floor)
+		if p <= 0 || (o.StopPrice != 0 && p <= o.StopPrice) {
+			return
+		}
+	} else {
+		p = roundToTick(o, s.extreme+d, math.Ceil)
+		if o.StopPrice != 0 && p >= o.StopPrice {
+			return
+		}
+	}
+
+	if o.Type == types.StopLimit && o.StopPrice != 0 && o.LimitPrice != 0 {
+		o.LimitPrice = roundToTick(o, o.LimitPrice+p-o.StopPrice, math.Round)
+	}
+
+	o.StopPrice = p
+	s.ticket.CancelReplace(o)
+}
+
+// roundToTick rounds a price to a multiple of the minimum price increment of the order's instrument
+// and then to its price precision to remove the floating point noise.
+func roundToTick(o orders.OrderSingle, price float64, round func(float64) float64) float64 {
+	const epsilon = 1e-9
+
+	if o.Instrument == nil {
+		return price
+	}
+
+	if tick := o.Instrument.MinPriceIncrement(); tick > 0 {
+		n := price / tick
+		if r := math.Round(n); math.Abs(n-r) < epsilon {
+			n = r
+		}
+
+		price = round(n) * tick
+	}
+
+	if precision := o.Instrument.PricePrecision(); precision > 0 {
+		factor := math.Pow10(precision)
+		price = math.Round(price*factor) / factor
+	}
+
+	return price
+}
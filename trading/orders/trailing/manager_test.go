@@ -0,0 +1,243 @@
+//nolint:testpackage
+package trailing
+
+//nolint:gofumpt
+import (
+	"errors"
+	"testing"
+
+	"mbg/trading/data"
+	"mbg/trading/instruments"
+	"mbg/trading/orders"
+	"mbg/trading/orders/sides"
+	"mbg/trading/orders/status"
+	"mbg/trading/orders/types"
+)
+
+func testStop(side sides.Side, stopPrice, distance float64) *mockOrderSingleTicket {
+	mi := &instruments.MutableInstrument{PricePrecision: 2, MinPriceIncrement: 0.05}
+
+	return &mockOrderSingleTicket{status: status.New, order: orders.OrderSingle{
+		Instrument: mi.Instrument(), Side: side, Type: types.TrailingStop, Quantity: 100,
+		StopPrice: stopPrice, TrailingDistance: distance,
+	}}
+}
+
+func checkReplaced(t *testing.T, name string, m *mockOrderSingleTicket, exp ...float64) {
+	t.Helper()
+
+	if len(m.replaced) != len(exp) {
+		t.Errorf("%v: expected stop prices %v, actual %v", name, exp, m.replaced)
+
+		return
+	}
+
+	for i := range exp {
+		if m.replaced[i] != exp[i] {
+			t.Errorf("%v: expected stop prices %v, actual %v", name, exp, m.replaced)
+
+			return
+		}
+	}
+}
+
+func TestManagerAbsolute(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager()
+	sell := testStop(sides.Sell, 95, 2)
+	buy := testStop(sides.Buy, 0, 2)
+
+	if err := m.Add(sell, nil); err != nil {
+		t.Fatalf("Add(): unexpected error %v", err)
+	}
+
+	if err := m.Add(buy, Absolute(1.5)); err != nil {
+		t.Fatalf("Add(): unexpected error %v", err)
+	}
+
+	m.UpdateTrade(&data.Trade{Price: 100})
+	checkReplaced(t, "sell at 100", sell, 98)
+	checkReplaced(t, "buy at 100", buy, 101.5)
+
+	m.UpdateTrade(&data.Trade{Price: 99})
+	checkReplaced(t, "sell at 99", sell, 98)
+	checkReplaced(t, "buy at 99", buy, 101.5, 100.5)
+
+	m.UpdateTrade(&data.Trade{Price: 101.03})
+	checkReplaced(t, "sell at 101.03", sell, 98, 99)
+	checkReplaced(t, "buy at 101.03", buy, 101.5, 100.5)
+
+	m.UpdateQuote(&data.Quote{Bid: 101.12, Ask: 98.91})
+	checkReplaced(t, "sell at bid 101.12", sell, 98, 99, 99.1)
+	checkReplaced(t, "buy at ask 98.91", buy, 101.5, 100.5, 100.45)
+
+	m.UpdateBar(&data.Bar{High: 102, Low: 98.8, Close: 101})
+	checkReplaced(t, "sell at high 102", sell, 98, 99, 99.1, 100)
+	checkReplaced(t, "buy at low 98.8", buy, 101.5, 100.5, 100.45, 100.3)
+}
+
+func TestManagerStopLimit(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager()
+
+	sell := testStop(sides.Sell, 95, 2)
+	sell.order.Type = types.StopLimit
+	sell.order.LimitPrice = 94.5
+
+	buy := testStop(sides.Buy, 105, 2)
+	buy.order.Type = types.StopLimit
+	buy.order.LimitPrice = 105.25
+
+	stop := testStop(sides.Sell, 95, 2)
+	stop.order.Type = types.Stop
+
+	for _, s := range []*mockOrderSingleTicket{sell, buy, stop} {
+		if err := m.Add(s, nil); err != nil {
+			t.Fatalf("Add(): unexpected error %v", err)
+		}
+	}
+
+	m.UpdateTrade(&data.Trade{Price: 100})
+	checkReplaced(t, "sell at 100", sell, 98)
+	checkReplaced(t, "buy at 100", buy, 102)
+	checkReplaced(t, "stop at 100", stop, 98)
+
+	m.UpdateTrade(&data.Trade{Price: 101.03})
+	checkReplaced(t, "sell at 101.03", sell, 98, 99)
+
+	if sell.order.LimitPrice != 98.5 {
+		t.Errorf("sell limit price: expected 98.5, actual %v", sell.order.LimitPrice)
+	}
+
+	if buy.order.LimitPrice != 102.25 {
+		t.Errorf("buy limit price: expected 102.25, actual %v", buy.order.LimitPrice)
+	}
+
+	if stop.order.LimitPrice != 0 {
+		t.Errorf("stop limit price: expected 0, actual %v", stop.order.LimitPrice)
+	}
+}
+
+func TestManagerPercent(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager()
+	sell := testStop(sides.Sell, 0, 0)
+
+	if err := m.Add(sell, Percent(2.5)); err != nil {
+		t.Fatalf("Add(): unexpected error %v", err)
+	}
+
+	m.UpdateTrade(&data.Trade{Price: 100})
+	m.UpdateTrade(&data.Trade{Price: 110})
+	m.UpdateTrade(&data.Trade{Price: 105})
+	checkReplaced(t, "percent", sell, 97.5, 107.25)
+}
+
+func TestManagerATR(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager()
+	sell := testStop(sides.Sell, 0, 0)
+
+	if err := m.Add(sell, &ATR{Length: 3, Multiple: 2}); err != nil {
+		t.Fatalf("Add(): unexpected error %v", err)
+	}
+
+	m.UpdateBar(&data.Bar{High: 101, Low: 99, Close: 100})
+	m.UpdateBar(&data.Bar{High: 103, Low: 100, Close: 102})
+	checkReplaced(t, "not primed", sell)
+
+	// True ranges 2, 3 and 4 (from the previous close 102 to the low 98).
+	m.UpdateBar(&data.Bar{High: 102, Low: 98, Close: 99})
+	checkReplaced(t, "primed", sell, 97)
+
+	// True range 7, ATR (3·2 + 7) / 3 = 4.333.
+	m.UpdateBar(&data.Bar{High: 106, Low: 100, Close: 105})
+	checkReplaced(t, "smoothed", sell, 97, 97.3)
+}
+
+func TestManagerLifecycle(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager()
+	a := testStop(sides.Sell, 0, 1)
+	b := testStop(sides.Sell, 0, 1)
+	c := testStop(sides.Sell, 0, 1)
+
+	_ = m.Add(a, nil)
+	_ = m.Add(b, nil)
+	_ = m.Add(c, nil)
+
+	if err := m.Add(a, nil); !errors.Is(err, errDuplicate) {
+		t.Errorf("Add(): expected %v, actual %v", errDuplicate, err)
+	}
+
+	b.status = status.PendingReplace
+	c.Cancel()
+	m.UpdateTrade(&data.Trade{Price: 100})
+
+	checkReplaced(t, "active", a, 99)
+	checkReplaced(t, "pending replace", b)
+	checkReplaced(t, "canceled", c)
+
+	if m.Len() != 2 {
+		t.Errorf("Len(): expected 2, actual %v", m.Len())
+	}
+
+	b.status = status.New
+	m.Remove(a)
+	m.UpdateTrade(&data.Trade{Price: 101})
+
+	checkReplaced(t, "removed", a, 99)
+	checkReplaced(t, "resumed", b, 100)
+
+	if m.Len() != 1 {
+		t.Errorf("Len(): expected 1, actual %v", m.Len())
+	}
+}
+
+func TestManagerErrors(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager()
+
+	tests := []struct {
+		name     string
+		distance Distance
+		err      error
+	}{
+		{"order distance", nil, errAbsolute},
+		{"absolute", Absolute(-1), errAbsolute},
+		{"percent", Percent(0), errPercent},
+		{"percent 100", Percent(100), errPercent},
+		{"atr length", &ATR{Multiple: 1}, errATRLength},
+		{"atr multiple", &ATR{Length: 14}, errATRMultiple},
+	}
+
+	for _, tt := range tests {
+		if err := m.Add(testStop(sides.Sell, 0, 0), tt.distance); !errors.Is(err, tt.err) ||
+			!errors.Is(err, errInvalidDistance) {
+			t.Errorf("%v: expected %v, actual %v", tt.name, tt.err, err)
+		}
+	}
+
+	if err := m.Add(testStop(sides.Side(0), 0, 1), nil); !errors.Is(err, errSide) {
+		t.Errorf("unknown side: expected %v, actual %v", errSide, err)
+	}
+
+	for _, typ := range []types.OrderType{types.Market, types.Limit, types.MarketIfTouched, types.OrderType(0)} {
+		s := testStop(sides.Sell, 95, 1)
+		s.order.Type = typ
+
+		if err := m.Add(s, nil); !errors.Is(err, errType) {
+			t.Errorf("%v: expected %v, actual %v", typ, errType, err)
+		}
+	}
+
+	if m.Len() != 0 {
+		t.Errorf("Len(): expected 0, actual %v", m.Len())
+	}
+}
@@ -0,0 +1,56 @@
+package trailing
+
+//nolint:gofumpt
+import (
+	"mbg/trading/orders"
+	"mbg/trading/orders/status"
+)
+
+// mockOrderSingleTicket is a mock ticket which replaces the order immediately
+// and records the replacements.
+type mockOrderSingleTicket struct {
+	order    orders.OrderSingle
+	status   status.OrderStatus
+	replaced []float64
+}
+
+// Order is the underlying order for this ticket.
+func (m *mockOrderSingleTicket) Order() orders.OrderSingle {
+	return m.order
+}
+
+// ClientOrderID is a unique identifier for an order as assigned by the buy-side.
+func (m *mockOrderSingleTicket) ClientOrderID() string {
+	return ""
+}
+
+// OrderID is a unique identifier for an order as assigned by the sell-side.
+func (m *mockOrderSingleTicket) OrderID() string {
+	return ""
+}
+
+// Status is the current state of an order as understood by the broker.
+func (m *mockOrderSingleTicket) Status() status.OrderStatus {
+	return m.status
+}
+
+// LastReport is the last order report, nil if not any.
+func (m *mockOrderSingleTicket) LastReport() orders.OrderSingleExecutionReport {
+	return nil
+}
+
+// Reports provides a collection of all order reports in the chronological order.
+func (m *mockOrderSingleTicket) Reports() []orders.OrderSingleExecutionReport {
+	return nil
+}
+
+// CancelReplace replaces the order immediately and records its stop price.
+func (m *mockOrderSingleTicket) CancelReplace(replacementOrder orders.OrderSingle) {
+	m.order = replacementOrder
+	m.replaced = append(m.replaced, replacementOrder.StopPrice)
+}
+
+// Cancel cancels the order immediately.
+func (m *mockOrderSingleTicket) Cancel() {
+	m.status = status.Canceled
+}
@@ -0,0 +1,109 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// TickBand is a price band of a tick size table.
+type TickBand struct {
+	// From is the lowest price of the band, inclusive.
+	// The band extends up to the From price of the next band.
+	From float64
+
+	// Tick is the tick size (minimum price increment) in the band.
+	Tick float64
+}
+
+// TickTable is a tick size table by price band, e.g. a tick size regime of a venue.
+// The bands are sorted by the From price in ascending order.
+type TickTable []TickBand
+
+// USEquityTickTable is the tick size table of the US equities according
+// to the SEC Regulation NMS Rule 612: 0.01 for the prices of 1 and above, 0.0001 below.
+//
+//nolint:gochecknoglobals
+var USEquityTickTable = TickTable{{From: 0, Tick: 0.0001}, {From: 1, Tick: 0.01}}
+
+// The MiFID II tick size regime (RTS 11) has six liquidity bands, the band 6 being the most liquid.
+const (
+	MiFIDMinLiquidityBand = 1
+	MiFIDMaxLiquidityBand = 6
+)
+
+var (
+	errInvalidTickTable   = errors.New("invalid tick size table")
+	errMiFIDLiquidityBand = errors.New("MiFID II liquidity band should be from 1 to 6")
+)
+
+//nolint:gochecknoglobals
+var (
+	// mifidPrices are the lower bounds of the price ranges of the MiFID II tick size table.
+	mifidPrices = []float64{
+		0, 0.1, 0.2, 0.5, 1, 2, 5, 10, 20, 50, 100, 200, 500,
+		1000, 2000, 5000, 10000, 20000, 50000,
+	}
+
+	// mifidTicks is the 1-2-5 sequence of the MiFID II tick sizes. The tick size of the lowest
+	// price range is mifidTicks[6-band], the higher ranges take the subsequent values.
+	mifidTicks = []float64{
+		0.00001, 0.00002, 0.00005, 0.0001, 0.0002, 0.0005, 0.001, 0.002, 0.005, 0.01, 0.02, 0.05,
+		0.1, 0.2, 0.5, 1, 2, 5, 10, 20, 50, 100, 200, 500,
+	}
+)
+
+// MiFIDTickTable returns the MiFID II tick size table (Commission Delegated Regulation (EU) 2017/588, RTS 11)
+// of the shares and the depositary receipts for a given liquidity band, 1 to 6.
+//
+// The liquidity band is determined by the average daily number of transactions in the most
+// liquid market of the instrument, e.g. the band 6 is for the instruments with 9000 and more
+// transactions a day. The tick size of the band 1 is 0.0005 for the prices below 0.1
+// and 500 for the prices of 50000 and above, every next band divides the tick sizes
+// by 2 or 2.5 to 0.00001 and 10 for the band 6.
+func MiFIDTickTable(liquidityBand int) (TickTable, error) {
+	if liquidityBand < MiFIDMinLiquidityBand || liquidityBand > MiFIDMaxLiquidityBand {
+		return nil, errMiFIDLiquidityBand
+	}
+
+	offset := MiFIDMaxLiquidityBand - liquidityBand
+	table := make(TickTable, len(mifidPrices))
+
+	for i, p := range mifidPrices {
+		table[i] = TickBand{From: p, Tick: mifidTicks[offset+i]}
+	}
+
+	return table, nil
+}
+
+// Tick returns the tick size in the band of a given price, or zero if the price is below
+// the first band. The tick size of a negative price is the one of its absolute value.
+func (t TickTable) Tick(price float64) float64 {
+	price = math.Abs(price)
+	tick := 0.
+
+	for _, b := range t {
+		if price < b.From {
+			break
+		}
+
+		tick = b.Tick
+	}
+
+	return tick
+}
+
+// Validate checks the bands are sorted and have positive tick sizes.
+func (t TickTable) Validate() error {
+	for i, b := range t {
+		if b.Tick <= 0 {
+			return fmt.Errorf("band %v tick size should be positive: %w", i, errInvalidTickTable)
+		}
+
+		if i > 0 && b.From <= t[i-1].From {
+			return fmt.Errorf("band %v should start above the previous band: %w", i, errInvalidTickTable)
+		}
+	}
+
+	return nil
+}
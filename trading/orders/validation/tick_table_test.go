@@ -0,0 +1,87 @@
+//nolint:testpackage
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMiFIDTickTable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		band  int
+		price float64
+		tick  float64
+	}{
+		{1, 0.05, 0.0005},
+		{1, 0.1, 0.001},
+		{1, 9.99, 0.05},
+		{1, 10, 0.1},
+		{1, 50000, 500},
+		{3, 0, 0.0001},
+		{3, 15, 0.02},
+		{4, 45.5, 0.02},
+		{5, 1.5, 0.0005},
+		{6, 0.09, 0.00001},
+		{6, 99.99, 0.01},
+		{6, 100, 0.02},
+		{6, -100, 0.02},
+		{6, 1e6, 10},
+	}
+
+	for _, tt := range tests {
+		table, err := MiFIDTickTable(tt.band)
+		if err != nil {
+			t.Fatalf("MiFIDTickTable(%v): unexpected error %v", tt.band, err)
+		}
+
+		if act := table.Tick(tt.price); act != tt.tick {
+			t.Errorf("MiFIDTickTable(%v).Tick(%v): expected %v, actual %v", tt.band, tt.price, tt.tick, act)
+		}
+
+		if err := table.Validate(); err != nil {
+			t.Errorf("MiFIDTickTable(%v).Validate(): unexpected error %v", tt.band, err)
+		}
+	}
+
+	for _, band := range []int{0, 7} {
+		if _, err := MiFIDTickTable(band); !errors.Is(err, errMiFIDLiquidityBand) {
+			t.Errorf("MiFIDTickTable(%v): expected %v, actual %v", band, errMiFIDLiquidityBand, err)
+		}
+	}
+}
+
+func TestTickTable(t *testing.T) {
+	t.Parallel()
+
+	if act := USEquityTickTable.Tick(0.9999); act != 0.0001 {
+		t.Errorf("USEquityTickTable.Tick(0.9999): expected 0.0001, actual %v", act)
+	}
+
+	if act := USEquityTickTable.Tick(1); act != 0.01 {
+		t.Errorf("USEquityTickTable.Tick(1): expected 0.01, actual %v", act)
+	}
+
+	if act := (TickTable{{From: 1, Tick: 0.1}}).Tick(0.5); act != 0 {
+		t.Errorf("Tick(0.5) below the first band: expected 0, actual %v", act)
+	}
+
+	tests := []struct {
+		name  string
+		table TickTable
+		err   bool
+	}{
+		{"empty", TickTable{}, false},
+		{"us", USEquityTickTable, false},
+		{"zero tick", TickTable{{From: 0, Tick: 0}}, true},
+		{"unsorted", TickTable{{From: 1, Tick: 0.01}, {From: 0, Tick: 0.001}}, true},
+		{"duplicate", TickTable{{From: 1, Tick: 0.01}, {From: 1, Tick: 0.001}}, true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.table.Validate(); (err != nil) != tt.err || (err != nil && !errors.Is(err, errInvalidTickTable)) {
+			t.Errorf("%v: expected error %v, actual %v", tt.name, tt.err, err)
+		}
+	}
+}
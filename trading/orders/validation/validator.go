@@ -0,0 +1,223 @@
+// Package validation validates and normalizes orders against the instrument's tick size and price precision.
+package validation
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"mbg/trading/markets/mics"
+	"mbg/trading/orders"
+	"mbg/trading/orders/tif"
+	"mbg/trading/orders/types"
+)
+
+// Validator validates and normalizes the orders in single instruments.
+//
+// An order is checked to have:
+//   - a known instrument, side, type and time in force and a positive quantity
+//     not less than the minimum quantity;
+//   - the prices required by its type: the limit price for the limit, the limit-on-close,
+//     the stop-limit and the limit-if-touched orders, the stop price for the stop,
+//     the stop-limit, the market-if-touched and the limit-if-touched orders,
+//     and the trailing distance for the trailing stop orders;
+//   - the limit and the stop prices, if set, on the tick and within the price precision
+//     of the instrument;
+//   - the expiration time after the current time for the good-till-date orders.
+//
+// The tick size is taken from the tick size table of the instrument's venue (MIC), if any,
+// otherwise it is the MinPriceIncrement of the instrument. The price precision is the
+// PricePrecision of the instrument; zero precision and zero tick size are not checked.
+type Validator struct {
+	// TickTables are the tick size tables by price band of the venues,
+	// e.g. the MiFID II tick size regimes of the European exchanges.
+	TickTables map[mics.MIC]TickTable
+}
+
+const epsilon = 1e-9
+
+var (
+	errInvalidOrder     = errors.New("invalid order")
+	errInstrument       = fmt.Errorf("instrument should be set: %w", errInvalidOrder)
+	errSide             = fmt.Errorf("side should be known: %w", errInvalidOrder)
+	errType             = fmt.Errorf("type should be known: %w", errInvalidOrder)
+	errTimeInForce      = fmt.Errorf("time in force should be known: %w", errInvalidOrder)
+	errQuantity         = fmt.Errorf("quantity should be positive: %w", errInvalidOrder)
+	errMinimumQuantity  = fmt.Errorf("minimum quantity should not exceed quantity: %w", errInvalidOrder)
+	errLimitPrice       = fmt.Errorf("limit price should be set: %w", errInvalidOrder)
+	errStopPrice        = fmt.Errorf("stop price should be set: %w", errInvalidOrder)
+	errTrailingDistance = fmt.Errorf("trailing distance should be positive: %w", errInvalidOrder)
+	errExpirationTime   = fmt.Errorf("good-till-date expiration time should be set: %w", errInvalidOrder)
+	errExpirationPast   = fmt.Errorf("good-till-date expiration time should be in the future: %w", errInvalidOrder)
+	errOffTick          = fmt.Errorf("price should be a multiple of the tick size: %w", errInvalidOrder)
+	errPrecision        = fmt.Errorf("price should be within the price precision: %w", errInvalidOrder)
+)
+
+// Validate checks an order at a given current time without changing it.
+func (v *Validator) Validate(order orders.OrderSingle, now time.Time) error {
+	if err := validateFields(order, now); err != nil {
+		return err
+	}
+
+	if err := v.validatePrice("limit", order, order.LimitPrice); err != nil {
+		return err
+	}
+
+	return v.validatePrice("stop", order, order.StopPrice)
+}
+
+// Normalize rounds the off-tick limit and stop prices of an order to the nearest valid prices
+// which are not more aggressive, and then validates the order at a given current time.
+//
+// The limit prices of the buy orders and the stop prices of the sell orders are rounded down,
+// the limit prices of the sell orders and the stop prices of the buy orders are rounded up.
+func (v *Validator) Normalize(order orders.OrderSingle, now time.Time) (orders.OrderSingle, error) {
+	if order.Instrument != nil {
+		sell := order.Side.IsSell()
+		order.LimitPrice = v.round(order, order.LimitPrice, sell)
+		order.StopPrice = v.round(order, order.StopPrice, !sell)
+	}
+
+	if err := v.Validate(order, now); err != nil {
+		return order, err
+	}
+
+	return order, nil
+}
+
+// Tick returns the tick size of an order at a given price.
+func (v *Validator) Tick(order orders.OrderSingle, price float64) float64 {
+	if order.Instrument == nil {
+		return 0
+	}
+
+	if t, ok := v.TickTables[order.Instrument.MIC()]; ok {
+		return t.Tick(price)
+	}
+
+	return order.Instrument.MinPriceIncrement()
+}
+
+// ValidateTickTables checks all tick size tables of the validator.
+func (v *Validator) ValidateTickTables() error {
+	for mic, t := range v.TickTables {
+		if err := t.Validate(); err != nil {
+			return fmt.Errorf("%v: %w", mic, err)
+		}
+	}
+
+	return nil
+}
+
+//nolint:cyclop
+func validateFields(order orders.OrderSingle, now time.Time) error {
+	switch {
+	case order.Instrument == nil:
+		return errInstrument
+	case !order.Side.IsKnown():
+		return errSide
+	case !order.Type.IsKnown():
+		return errType
+	case !order.TimeInForce.IsKnown():
+		return errTimeInForce
+	case order.Quantity <= 0:
+		return errQuantity
+	case order.MinimumQuantity > order.Quantity:
+		return errMinimumQuantity
+	}
+
+	switch order.Type {
+	case types.Limit, types.LimitOnClose, types.StopLimit, types.LimitIfTouched:
+		if order.LimitPrice == 0 {
+			return errLimitPrice
+		}
+	case types.TrailingStop:
+		if order.TrailingDistance <= 0 {
+			return errTrailingDistance
+		}
+	}
+
+	switch order.Type {
+	case types.Stop, types.StopLimit, types.MarketIfTouched, types.LimitIfTouched:
+		if order.StopPrice == 0 {
+			return errStopPrice
+		}
+	}
+
+	if order.TimeInForce == tif.GoodTillDate {
+		switch {
+		case order.ExpirationTime.IsZero():
+			return errExpirationTime
+		case !order.ExpirationTime.After(now):
+			return errExpirationPast
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validatePrice(name string, order orders.OrderSingle, price float64) error {
+	if price == 0 {
+		return nil
+	}
+
+	if tick := v.Tick(order, price); tick > 0 && !isMultiple(price, tick) {
+		return fmt.Errorf("%s price %v, tick size %v: %w", name, price, tick, errOffTick)
+	}
+
+	if precision := order.Instrument.PricePrecision(); precision > 0 && !isMultiple(price, math.Pow10(-precision)) {
+		return fmt.Errorf("%s price %v, precision %v: %w", name, price, precision, errPrecision)
+	}
+
+	return nil
+}
+
+// round rounds a price to the tick size and then to the price precision, up or down.
+func (v *Validator) round(order orders.OrderSingle, price float64, up bool) float64 {
+	if price == 0 {
+		return 0
+	}
+
+	if tick := v.Tick(order, price); tick > 0 {
+		price = roundToMultiple(price, tick, up)
+	}
+
+	if precision := order.Instrument.PricePrecision(); precision > 0 {
+		price = roundToMultiple(price, math.Pow10(-precision), up)
+	}
+
+	return price
+}
+
+// isMultiple tells if a value is a multiple of a step, tolerating the floating point noise.
+func isMultiple(value, step float64) bool {
+	n := value / step
+
+	return math.Abs(n-math.Round(n)) < epsilon*math.Max(1, math.Abs(n))
+}
+
+// roundToMultiple rounds a value up or down to a multiple of a step,
+// tolerating the floating point noise.
+func roundToMultiple(value, step float64, up bool) float64 {
+	n := value / step
+
+	switch {
+	case math.Abs(n-math.Round(n)) < epsilon*math.Max(1, math.Abs(n)):
+		n = math.Round(n)
+	case up:
+		n = math.Ceil(n)
+	default:
+		n = math.Floor(n)
+	}
+
+	// Dividing by the reciprocal of a decimal step gives the shortest decimal representation.
+	if step < 1 {
+		if r := math.Round(1 / step); math.Abs(r-1/step) < epsilon*r {
+			return n / r
+		}
+	}
+
+	return n * step
+}
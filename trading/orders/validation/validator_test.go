@@ -0,0 +1,163 @@
+//nolint:testpackage
+package validation
+
+//nolint:gofumpt
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"mbg/trading/instruments"
+	"mbg/trading/markets/mics"
+	"mbg/trading/orders"
+	"mbg/trading/orders/sides"
+	"mbg/trading/orders/tif"
+	"mbg/trading/orders/types"
+)
+
+//nolint:gochecknoglobals
+var (
+	testNow   = time.Date(2021, time.November, 1, 10, 0, 0, 0, time.UTC)
+	testXNYS  = (&instruments.MutableInstrument{MIC: mics.XNYS, PricePrecision: 2, MinPriceIncrement: 0.05}).Instrument()
+	testXETR  = (&instruments.MutableInstrument{MIC: mics.XETR, PricePrecision: 3, MinPriceIncrement: 0.001}).Instrument()
+	testNoTck = (&instruments.MutableInstrument{MIC: mics.XNYS}).Instrument()
+)
+
+func testValidator(t *testing.T) *Validator {
+	t.Helper()
+
+	band6, err := MiFIDTickTable(MiFIDMaxLiquidityBand)
+	if err != nil {
+		t.Fatalf("MiFIDTickTable(): unexpected error %v", err)
+	}
+
+	v := &Validator{TickTables: map[mics.MIC]TickTable{mics.XETR: band6}}
+	if err := v.ValidateTickTables(); err != nil {
+		t.Fatalf("ValidateTickTables(): unexpected error %v", err)
+	}
+
+	return v
+}
+
+func testOrder(typ types.OrderType, side sides.Side, limit, stop float64) orders.OrderSingle {
+	return orders.OrderSingle{
+		Instrument: testXNYS, Type: typ, Side: side, TimeInForce: tif.Day,
+		Quantity: 100, LimitPrice: limit, StopPrice: stop,
+	}
+}
+
+func TestValidatorValidate(t *testing.T) {
+	t.Parallel()
+
+	v := testValidator(t)
+
+	with := func(o orders.OrderSingle, f func(*orders.OrderSingle)) orders.OrderSingle {
+		f(&o)
+
+		return o
+	}
+
+	limit := testOrder(types.Limit, sides.Buy, 100.05, 0)
+
+	tests := []struct {
+		name  string
+		order orders.OrderSingle
+		err   error
+	}{
+		{"market", testOrder(types.Market, sides.Buy, 0, 0), nil},
+		{"limit", limit, nil},
+		{"stop", testOrder(types.Stop, sides.Sell, 0, 95.1), nil},
+		{"stop limit", testOrder(types.StopLimit, sides.Sell, 94.5, 95), nil},
+		{"limit if touched", testOrder(types.LimitIfTouched, sides.Buy, 94.5, 95), nil},
+		{"trailing stop", with(testOrder(types.TrailingStop, sides.Sell, 0, 0), func(o *orders.OrderSingle) {
+			o.TrailingDistance = 1
+		}), nil},
+		{"no instrument", with(limit, func(o *orders.OrderSingle) { o.Instrument = nil }), errInstrument},
+		{"no side", with(limit, func(o *orders.OrderSingle) { o.Side = 0 }), errSide},
+		{"no type", with(limit, func(o *orders.OrderSingle) { o.Type = 0 }), errType},
+		{"no time in force", with(limit, func(o *orders.OrderSingle) { o.TimeInForce = 0 }), errTimeInForce},
+		{"no quantity", with(limit, func(o *orders.OrderSingle) { o.Quantity = 0 }), errQuantity},
+		{"minimum quantity", with(limit, func(o *orders.OrderSingle) { o.MinimumQuantity = 200 }), errMinimumQuantity},
+		{"limit without price", testOrder(types.Limit, sides.Buy, 0, 0), errLimitPrice},
+		{"limit on close without price", testOrder(types.LimitOnClose, sides.Buy, 0, 0), errLimitPrice},
+		{"stop without price", testOrder(types.Stop, sides.Buy, 0, 0), errStopPrice},
+		{"market if touched without price", testOrder(types.MarketIfTouched, sides.Buy, 0, 0), errStopPrice},
+		{"stop limit without limit", testOrder(types.StopLimit, sides.Buy, 0, 95), errLimitPrice},
+		{"stop limit without stop", testOrder(types.StopLimit, sides.Buy, 95, 0), errStopPrice},
+		{"trailing stop without distance", testOrder(types.TrailingStop, sides.Sell, 0, 0), errTrailingDistance},
+		{"good till date", with(limit, func(o *orders.OrderSingle) {
+			o.TimeInForce = tif.GoodTillDate
+			o.ExpirationTime = testNow.Add(time.Hour)
+		}), nil},
+		{"good till date without expiration", with(limit, func(o *orders.OrderSingle) {
+			o.TimeInForce = tif.GoodTillDate
+		}), errExpirationTime},
+		{"good till date expired", with(limit, func(o *orders.OrderSingle) {
+			o.TimeInForce = tif.GoodTillDate
+			o.ExpirationTime = testNow
+		}), errExpirationPast},
+		{"off-tick limit", testOrder(types.Limit, sides.Buy, 100.03, 0), errOffTick},
+		{"off-tick stop", testOrder(types.StopLimit, sides.Buy, 100.05, 100.01), errOffTick},
+		{"xetr band tick", with(limit, func(o *orders.OrderSingle) {
+			o.Instrument = testXETR
+			o.LimitPrice = 45.005
+		}), nil},
+		{"xetr off band tick", with(limit, func(o *orders.OrderSingle) {
+			o.Instrument = testXETR
+			o.LimitPrice = 145.005
+		}), errOffTick},
+		{"xetr precision", with(limit, func(o *orders.OrderSingle) {
+			o.Instrument = testXETR
+			o.LimitPrice = 0.00001
+		}), errPrecision},
+		{"no tick", with(limit, func(o *orders.OrderSingle) {
+			o.Instrument = testNoTck
+			o.LimitPrice = 100.123456
+		}), nil},
+	}
+
+	for _, tt := range tests {
+		if err := v.Validate(tt.order, testNow); !errors.Is(err, tt.err) ||
+			(err != nil && !errors.Is(err, errInvalidOrder)) {
+			t.Errorf("%v: expected %v, actual %v", tt.name, tt.err, err)
+		}
+	}
+}
+
+func TestValidatorNormalize(t *testing.T) {
+	t.Parallel()
+
+	v := testValidator(t)
+
+	tests := []struct {
+		name        string
+		order       orders.OrderSingle
+		limit, stop float64
+	}{
+		{"buy limit", testOrder(types.Limit, sides.Buy, 100.03, 0), 100, 0},
+		{"sell limit", testOrder(types.Limit, sides.Sell, 100.03, 0), 100.05, 0},
+		{"on tick", testOrder(types.Limit, sides.Sell, 100.05, 0), 100.05, 0},
+		{"buy stop limit", testOrder(types.StopLimit, sides.Buy, 101.07, 100.91), 101.05, 100.95},
+		{"sell stop limit", testOrder(types.StopLimit, sides.SellShort, 98.91, 99.07), 98.95, 99.05},
+		{"xetr band", orders.OrderSingle{
+			Instrument: testXETR, Type: types.Limit, Side: sides.Buy, TimeInForce: tif.Day, Quantity: 1, LimitPrice: 145.039,
+		}, 145.02, 0},
+		{"xetr band up", orders.OrderSingle{
+			Instrument: testXETR, Type: types.Limit, Side: sides.Sell, TimeInForce: tif.Day, Quantity: 1, LimitPrice: 99.996,
+		}, 100, 0},
+		{"xetr precision", orders.OrderSingle{
+			Instrument: testXETR, Type: types.Limit, Side: sides.Sell, TimeInForce: tif.Day, Quantity: 1, LimitPrice: 0.01234,
+		}, 0.013, 0},
+	}
+
+	for _, tt := range tests {
+		o, err := v.Normalize(tt.order, testNow)
+		if err != nil || o.LimitPrice != tt.limit || o.StopPrice != tt.stop {
+			t.Errorf("%v: expected %v %v, actual %v %v (%v)", tt.name, tt.limit, tt.stop, o.LimitPrice, o.StopPrice, err)
+		}
+	}
+
+	if _, err := v.Normalize(testOrder(types.Limit, sides.Buy, 0, 0), testNow); !errors.Is(err, errLimitPrice) {
+		t.Errorf("limit without price: expected %v, actual %v", errLimitPrice, err)
+	}
+}
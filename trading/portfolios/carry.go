@@ -0,0 +1,45 @@
+package portfolios
+
+//nolint:gofumpt
+import (
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments"
+	"mbg/trading/instruments/types"
+)
+
+// Carrier calculates the carry (roll) accrued by an open position.
+type Carrier interface {
+	// Carry returns the signed amount of carry accrued by a position of a given signed quantity
+	// in an instrument at a given time, and the currency of the amount.
+	// Returns false if there is no carry for the instrument.
+	Carry(instr instruments.Instrument, quantity float64, t time.Time) (float64, currencies.Currency, bool)
+}
+
+// AccrueCarry credits (debits) the account with the carry accrued by all open Forex positions at a given time.
+//
+// This is typically called once per business day at the rollover time.
+// The amounts will be converted into the home currency if the indicated currencies differ from the home one.
+func (p *Portfolio) AccrueCarry(t time.Time, c Carrier) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for instr, pos := range p.positions {
+		if instr.Type() != types.Forex {
+			continue
+		}
+
+		pos.mu.RLock()
+		qty := pos.quantitySigned
+		pos.mu.RUnlock()
+
+		if qty == 0 {
+			continue
+		}
+
+		if amount, cur, ok := c.Carry(instr, qty, t); ok {
+			p.account.add(t, amount, cur, "Carry "+instr.Symbol())
+		}
+	}
+}
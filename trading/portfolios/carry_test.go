@@ -0,0 +1,133 @@
+//nolint:testpackage
+package portfolios
+
+//nolint:gofumpt
+import (
+	"testing"
+	"time"
+
+	"mbg/trading/currencies"
+	"mbg/trading/instruments"
+	"mbg/trading/instruments/types"
+	"mbg/trading/orders"
+	"mbg/trading/orders/reports"
+	"mbg/trading/orders/sides"
+	"mbg/trading/portfolios/accounts/actions"
+	"mbg/trading/portfolios/roundtrips/matchings"
+)
+
+type mockCarrier struct {
+	amount     float64
+	quantities []float64
+}
+
+func (m *mockCarrier) Carry(instr instruments.Instrument, quantity float64, _ time.Time,
+) (float64, currencies.Currency, bool) {
+	m.quantities = append(m.quantities, quantity)
+
+	return m.amount * quantity, instr.Currency(), true
+}
+
+//nolint:funlen
+func TestPortfolioAccrueCarry(t *testing.T) {
+	t.Parallel()
+
+	const fmtVal = "%v: expected %v, actual %v"
+
+	converter := currencies.NewUpdatableConverter()
+	converter.Update(currencies.EUR, currencies.USD, 2)
+
+	eurusd := instruments.MutableInstrument{Symbol: "EURUSD", Type: types.Forex, Currency: currencies.USD}
+	stock := instruments.MutableInstrument{Symbol: "ABC", Type: types.Stock, Currency: currencies.USD}
+	t0 := time.Date(2021, time.March, 1, 17, 0, 0, 0, time.UTC)
+
+	fill := func(instr instruments.Instrument, side sides.Side, qty float64) *mockOrderSingleExecutionReport {
+		return &mockOrderSingleExecutionReport{
+			transactionTime: t0, reportType: reports.Filled, commissionCurrency: currencies.EUR,
+			lastFillPrice: 1, lastFillQuantity: qty,
+			order: orders.OrderSingle{Instrument: instr, Side: side},
+		}
+	}
+
+	t.Run("long forex position", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewPortfolio("holder", 1000, currencies.EUR, converter, matchings.FirstInFirstOut)
+		p.OrderSingleExecution(fill(eurusd.Instrument(), sides.Buy, 100))
+		p.OrderSingleExecution(fill(stock.Instrument(), sides.Buy, 10))
+
+		n := len(p.account.TransactionHistory())
+		c := &mockCarrier{amount: -0.01}
+		p.AccrueCarry(t0.AddDate(0, 0, 1), c)
+
+		if len(c.quantities) != 1 || c.quantities[0] != 100 {
+			t.Errorf(fmtVal, "carrier quantities", []float64{100}, c.quantities)
+		}
+
+		th := p.account.TransactionHistory()
+		if len(th) != n+1 {
+			t.Fatalf(fmtVal, "transactions", n+1, len(th))
+		}
+
+		tr := th[n]
+		if tr.Action() != actions.Debit {
+			t.Errorf(fmtVal, "action", actions.Debit, tr.Action())
+		}
+
+		if tr.Currency() != currencies.USD {
+			t.Errorf(fmtVal, "currency", currencies.USD, tr.Currency())
+		}
+
+		if tr.Amount() != 1 {
+			t.Errorf(fmtVal, "amount", 1, tr.Amount())
+		}
+
+		if tr.AmountConverted() != 0.5 {
+			t.Errorf(fmtVal, "amount converted", 0.5, tr.AmountConverted())
+		}
+
+		if tr.Note() != "Carry EURUSD" {
+			t.Errorf(fmtVal, "note", "Carry EURUSD", tr.Note())
+		}
+	})
+
+	t.Run("short forex position", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewPortfolio("holder", 1000, currencies.EUR, converter, matchings.FirstInFirstOut)
+		p.OrderSingleExecution(fill(eurusd.Instrument(), sides.SellShort, 100))
+
+		n := len(p.account.TransactionHistory())
+		p.AccrueCarry(t0.AddDate(0, 0, 1), &mockCarrier{amount: -0.01})
+
+		th := p.account.TransactionHistory()
+		if len(th) != n+1 {
+			t.Fatalf(fmtVal, "transactions", n+1, len(th))
+		}
+
+		if th[n].Action() != actions.Credit {
+			t.Errorf(fmtVal, "action", actions.Credit, th[n].Action())
+		}
+	})
+
+	t.Run("closed forex position", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewPortfolio("holder", 1000, currencies.EUR, converter, matchings.FirstInFirstOut)
+		instr := eurusd.Instrument()
+		p.OrderSingleExecution(fill(instr, sides.Buy, 100))
+		p.OrderSingleExecution(fill(instr, sides.Sell, 100))
+
+		n := len(p.account.TransactionHistory())
+		c := &mockCarrier{amount: -0.01}
+		p.AccrueCarry(t0.AddDate(0, 0, 1), c)
+
+		if len(c.quantities) != 0 {
+			t.Errorf(fmtVal, "carrier quantities", 0, len(c.quantities))
+		}
+
+		if len(p.account.TransactionHistory()) != n {
+			t.Errorf(fmtVal, "transactions", n, len(p.account.TransactionHistory()))
+		}
+	})
+}
@@ -0,0 +1,135 @@
+// Package businessdays implements business day arithmetic on top of holiday calendars.
+//
+// The calendars are expected to have business days, otherwise the functions
+// searching for a business day never return.
+package businessdays
+
+//nolint:gofumpt
+import (
+	"time"
+
+	"mbg/trading/time/businessdays/conventions"
+	"mbg/trading/time/holidays"
+)
+
+// IsBusinessDay checks if a given date is a business day in a calendar.
+func IsBusinessDay(c holidays.Calendarer, t time.Time) bool {
+	return !c.IsHoliday(t)
+}
+
+// Next returns the first business day strictly after a given date.
+//
+// The time of day of the given date is preserved.
+func Next(c holidays.Calendarer, t time.Time) time.Time {
+	t = t.AddDate(0, 0, 1)
+	for c.IsHoliday(t) {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t
+}
+
+// Previous returns the first business day strictly before a given date.
+//
+// The time of day of the given date is preserved.
+func Previous(c holidays.Calendarer, t time.Time) time.Time {
+	t = t.AddDate(0, 0, -1)
+	for c.IsHoliday(t) {
+		t = t.AddDate(0, 0, -1)
+	}
+
+	return t
+}
+
+// Add moves a given date by n business days forward (positive n) or backward (negative n).
+//
+// If n is zero, the date is returned unchanged, even if it is a holiday.
+// The time of day of the given date is preserved.
+func Add(c holidays.Calendarer, t time.Time, n int) time.Time {
+	for ; n > 0; n-- {
+		t = Next(c, t)
+	}
+
+	for ; n < 0; n++ {
+		t = Previous(c, t)
+	}
+
+	return t
+}
+
+// Between returns the number of business days in the half-open interval [from, to).
+//
+// If to is before from, the number of business days in [to, from) is returned negated.
+// Only the dates are compared, the time of day is ignored.
+func Between(c holidays.Calendarer, from, to time.Time) int {
+	from, to = midnight(from), midnight(to.In(from.Location()))
+
+	sign := 1
+	if to.Before(from) {
+		from, to = to, from
+		sign = -1
+	}
+
+	n := 0
+
+	for t := from; t.Before(to); t = t.AddDate(0, 0, 1) {
+		if !c.IsHoliday(t) {
+			n++
+		}
+	}
+
+	return sign * n
+}
+
+//nolint:exhaustive
+// Adjust rolls a given date according to a business day convention.
+//
+// A business day is returned unchanged.
+// An unknown convention is treated as Unadjusted.
+func Adjust(c holidays.Calendarer, t time.Time, conv conventions.Convention) time.Time {
+	if !c.IsHoliday(t) {
+		return t
+	}
+
+	switch conv {
+	case conventions.Following:
+		return Next(c, t)
+	case conventions.ModifiedFollowing:
+		if n := Next(c, t); n.Month() == t.Month() {
+			return n
+		}
+
+		return Previous(c, t)
+	case conventions.Preceding:
+		return Previous(c, t)
+	case conventions.ModifiedPreceding:
+		if p := Previous(c, t); p.Month() == t.Month() {
+			return p
+		}
+
+		return Next(c, t)
+	default:
+		return t
+	}
+}
+
+// EndOfMonth returns the last business day of the month of a given date.
+//
+// The time of day of the given date is preserved.
+func EndOfMonth(c holidays.Calendarer, t time.Time) time.Time {
+	// The first day of the next month.
+	f := t.AddDate(0, 1, 1-t.Day())
+
+	return Previous(c, f)
+}
+
+// IsEndOfMonth checks if a given date is the last business day of its month.
+func IsEndOfMonth(c holidays.Calendarer, t time.Time) bool {
+	return !c.IsHoliday(t) && Next(c, t).Month() != t.Month()
+}
+
+func midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
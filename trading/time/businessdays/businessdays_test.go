@@ -0,0 +1,190 @@
+//nolint:testpackage
+package businessdays
+
+//nolint:gofumpt
+import (
+	"testing"
+	"time"
+
+	"mbg/trading/time/businessdays/conventions"
+	"mbg/trading/time/holidays/calendars"
+)
+
+func date(y, m, d int) time.Time {
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNextPrevious(t *testing.T) {
+	t.Parallel()
+
+	c := calendars.TARGET{}
+
+	tests := []struct {
+		t, next, prev time.Time
+	}{
+		// Wednesday.
+		{date(2021, 6, 9), date(2021, 6, 10), date(2021, 6, 8)},
+		// Friday and Monday.
+		{date(2021, 6, 11), date(2021, 6, 14), date(2021, 6, 10)},
+		{date(2021, 6, 14), date(2021, 6, 15), date(2021, 6, 11)},
+		// Saturday.
+		{date(2021, 6, 12), date(2021, 6, 14), date(2021, 6, 11)},
+		// Thursday before Easter, Good Friday and Easter Monday are holidays.
+		{date(2021, 4, 1), date(2021, 4, 6), date(2021, 3, 31)},
+		// December 24th, Christmas and Boxing days on Saturday and Sunday.
+		{date(2021, 12, 24), date(2021, 12, 27), date(2021, 12, 23)},
+		// Time of day is preserved.
+		{
+			time.Date(2021, 6, 11, 13, 14, 15, 0, time.UTC),
+			time.Date(2021, 6, 14, 13, 14, 15, 0, time.UTC),
+			time.Date(2021, 6, 10, 13, 14, 15, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		if act := Next(c, tt.t); !act.Equal(tt.next) {
+			t.Errorf("Next(%v): expected %v, actual %v", tt.t, tt.next, act)
+		}
+
+		if act := Previous(c, tt.t); !act.Equal(tt.prev) {
+			t.Errorf("Previous(%v): expected %v, actual %v", tt.t, tt.prev, act)
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	c := calendars.TARGET{}
+
+	tests := []struct {
+		t   time.Time
+		n   int
+		exp time.Time
+	}{
+		{date(2021, 6, 9), 0, date(2021, 6, 9)},
+		{date(2021, 6, 12), 0, date(2021, 6, 12)},
+		{date(2021, 6, 9), 1, date(2021, 6, 10)},
+		{date(2021, 6, 9), 3, date(2021, 6, 14)},
+		{date(2021, 6, 9), 10, date(2021, 6, 23)},
+		{date(2021, 6, 9), -3, date(2021, 6, 4)},
+		{date(2021, 6, 12), 1, date(2021, 6, 14)},
+		{date(2021, 6, 12), -1, date(2021, 6, 11)},
+		{date(2021, 3, 31), 2, date(2021, 4, 6)},
+		{date(2021, 4, 7), -2, date(2021, 4, 1)},
+	}
+
+	for _, tt := range tests {
+		if act := Add(c, tt.t, tt.n); !act.Equal(tt.exp) {
+			t.Errorf("Add(%v, %v): expected %v, actual %v", tt.t, tt.n, tt.exp, act)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	c := calendars.TARGET{}
+
+	tests := []struct {
+		from, to time.Time
+		exp      int
+	}{
+		{date(2021, 6, 9), date(2021, 6, 9), 0},
+		{date(2021, 6, 9), date(2021, 6, 10), 1},
+		{date(2021, 6, 7), date(2021, 6, 14), 5},
+		{date(2021, 6, 14), date(2021, 6, 7), -5},
+		{date(2021, 6, 12), date(2021, 6, 14), 0},
+		{date(2021, 3, 29), date(2021, 4, 12), 8},
+		{date(2021, 1, 1), date(2022, 1, 1), 258},
+		{time.Date(2021, 6, 9, 23, 0, 0, 0, time.UTC), time.Date(2021, 6, 10, 1, 0, 0, 0, time.UTC), 1},
+	}
+
+	for _, tt := range tests {
+		if act := Between(c, tt.from, tt.to); act != tt.exp {
+			t.Errorf("Between(%v, %v): expected %v, actual %v", tt.from, tt.to, tt.exp, act)
+		}
+
+		if tt.exp > 0 {
+			if act := Add(c, Adjust(c, tt.from, conventions.Following), tt.exp); act.Before(tt.to) {
+				t.Errorf("Add(%v, %v): expected not before %v, actual %v", tt.from, tt.exp, tt.to, act)
+			}
+		}
+	}
+}
+
+func TestAdjust(t *testing.T) {
+	t.Parallel()
+
+	c := calendars.TARGET{}
+
+	tests := []struct {
+		t    time.Time
+		conv conventions.Convention
+		exp  time.Time
+	}{
+		// A business day is never rolled.
+		{date(2021, 6, 9), conventions.Following, date(2021, 6, 9)},
+		{date(2021, 6, 9), conventions.Preceding, date(2021, 6, 9)},
+
+		// Saturday, June 12th.
+		{date(2021, 6, 12), conventions.Unadjusted, date(2021, 6, 12)},
+		{date(2021, 6, 12), conventions.Following, date(2021, 6, 14)},
+		{date(2021, 6, 12), conventions.ModifiedFollowing, date(2021, 6, 14)},
+		{date(2021, 6, 12), conventions.Preceding, date(2021, 6, 11)},
+		{date(2021, 6, 12), conventions.ModifiedPreceding, date(2021, 6, 11)},
+
+		// Saturday, July 31st.
+		{date(2021, 7, 31), conventions.Following, date(2021, 8, 2)},
+		{date(2021, 7, 31), conventions.ModifiedFollowing, date(2021, 7, 30)},
+
+		// Saturday, May 1st.
+		{date(2021, 5, 1), conventions.Preceding, date(2021, 4, 30)},
+		{date(2021, 5, 1), conventions.ModifiedPreceding, date(2021, 5, 3)},
+
+		// Unknown convention.
+		{date(2021, 6, 12), conventions.Convention(0), date(2021, 6, 12)},
+	}
+
+	for _, tt := range tests {
+		if act := Adjust(c, tt.t, tt.conv); !act.Equal(tt.exp) {
+			t.Errorf("Adjust(%v, %v): expected %v, actual %v", tt.t, tt.conv, tt.exp, act)
+		}
+	}
+}
+
+func TestEndOfMonth(t *testing.T) {
+	t.Parallel()
+
+	c := calendars.TARGET{}
+
+	tests := []struct {
+		t   time.Time
+		exp time.Time
+	}{
+		{date(2021, 6, 9), date(2021, 6, 30)},
+		{date(2021, 7, 1), date(2021, 7, 30)},
+		{date(2021, 7, 31), date(2021, 7, 30)},
+		{date(2021, 12, 1), date(2021, 12, 31)},
+		{date(2022, 12, 1), date(2022, 12, 30)},
+		{date(2024, 2, 29), date(2024, 2, 29)},
+	}
+
+	for _, tt := range tests {
+		if act := EndOfMonth(c, tt.t); !act.Equal(tt.exp) {
+			t.Errorf("EndOfMonth(%v): expected %v, actual %v", tt.t, tt.exp, act)
+		}
+
+		if !IsEndOfMonth(c, tt.exp) {
+			t.Errorf("IsEndOfMonth(%v): expected true, actual false", tt.exp)
+		}
+	}
+
+	if IsEndOfMonth(c, date(2021, 7, 31)) {
+		t.Error("IsEndOfMonth(2021-07-31): expected false for a holiday, actual true")
+	}
+
+	if IsEndOfMonth(c, date(2021, 7, 29)) {
+		t.Error("IsEndOfMonth(2021-07-29): expected false, actual true")
+	}
+}
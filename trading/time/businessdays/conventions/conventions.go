@@ -0,0 +1,105 @@
+// Package conventions enumerates business day conventions used to roll a date falling on a holiday.
+package conventions
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Convention enumerates business day conventions used to roll a date falling on a holiday.
+type Convention int
+
+const (
+	// Unadjusted does not roll a date.
+	Unadjusted Convention = iota + 1
+
+	// Following rolls a date to the first following business day.
+	Following
+
+	// ModifiedFollowing rolls a date to the first following business day
+	// unless it belongs to the next month, in which case the date is rolled to the first preceding business day.
+	ModifiedFollowing
+
+	// Preceding rolls a date to the first preceding business day.
+	Preceding
+
+	// ModifiedPreceding rolls a date to the first preceding business day
+	// unless it belongs to the previous month, in which case the date is rolled to the first following business day.
+	ModifiedPreceding
+	last
+)
+
+const (
+	unknown           = "unknown"
+	unadjusted        = "unadjusted"
+	following         = "following"
+	modifiedFollowing = "modifiedFollowing"
+	preceding         = "preceding"
+	modifiedPreceding = "modifiedPreceding"
+)
+
+var errUnknownConvention = errors.New("unknown business day convention")
+
+// String implements the fmt.Stringer interface.
+func (c Convention) String() string {
+	switch c {
+	case Unadjusted:
+		return unadjusted
+	case Following:
+		return following
+	case ModifiedFollowing:
+		return modifiedFollowing
+	case Preceding:
+		return preceding
+	case ModifiedPreceding:
+		return modifiedPreceding
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this business day convention is known.
+func (c Convention) IsKnown() bool {
+	return c >= Unadjusted && c < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (c Convention) MarshalJSON() ([]byte, error) {
+	str := c.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownConvention)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (c *Convention) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case unadjusted:
+		*c = Unadjusted
+	case following:
+		*c = Following
+	case modifiedFollowing:
+		*c = ModifiedFollowing
+	case preceding:
+		*c = Preceding
+	case modifiedPreceding:
+		*c = ModifiedPreceding
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownConvention)
+	}
+
+	return nil
+}
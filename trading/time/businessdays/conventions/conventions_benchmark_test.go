@@ -0,0 +1,29 @@
+//nolint:testpackage
+package conventions
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := ModifiedPreceding
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := ModifiedPreceding
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var c Convention
+
+	bs := []byte("\"modifiedPreceding\"")
+	for i := 0; i < b.N; i++ {
+		_ = c.UnmarshalJSON(bs)
+	}
+}
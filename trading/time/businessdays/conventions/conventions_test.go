@@ -0,0 +1,148 @@
+//nolint:testpackage
+package conventions
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c    Convention
+		text string
+	}{
+		{Unadjusted, unadjusted},
+		{Following, following},
+		{ModifiedFollowing, modifiedFollowing},
+		{Preceding, preceding},
+		{ModifiedPreceding, modifiedPreceding},
+		{last, unknown},
+		{Convention(0), unknown},
+		{Convention(9999), unknown},
+		{Convention(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.c.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.c, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c       Convention
+		boolean bool
+	}{
+		{Unadjusted, true},
+		{Following, true},
+		{ModifiedFollowing, true},
+		{Preceding, true},
+		{ModifiedPreceding, true},
+		{last, false},
+		{Convention(0), false},
+		{Convention(9999), false},
+		{Convention(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.c.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.c, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		c         Convention
+		json      string
+		succeeded bool
+	}{
+		{Unadjusted, "\"unadjusted\"", true},
+		{Following, "\"following\"", true},
+		{ModifiedFollowing, "\"modifiedFollowing\"", true},
+		{Preceding, "\"preceding\"", true},
+		{ModifiedPreceding, "\"modifiedPreceding\"", true},
+		{last, nilstr, false},
+		{Convention(9999), nilstr, false},
+		{Convention(-9999), nilstr, false},
+		{Convention(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.c.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.c, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.c)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.c, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Convention
+	tests := []struct {
+		c         Convention
+		json      string
+		succeeded bool
+	}{
+		{Unadjusted, "\"unadjusted\"", true},
+		{Following, "\"following\"", true},
+		{ModifiedFollowing, "\"modifiedFollowing\"", true},
+		{Preceding, "\"preceding\"", true},
+		{ModifiedPreceding, "\"modifiedPreceding\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.c
+		bs := []byte(tt.json)
+
+		var c Convention
+
+		err := c.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != c {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, c)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package businessdays
+
+import (
+	"time"
+)
+
+// IsIMMDate checks if a given date is an International Money Market (IMM) date,
+// the third Wednesday of March, June, September or December.
+func IsIMMDate(t time.Time) bool {
+	if t.Weekday() != time.Wednesday || t.Month()%3 != 0 {
+		return false
+	}
+
+	d := t.Day()
+
+	return d >= 15 && d <= 21
+}
+
+// NextIMMDate returns the first International Money Market (IMM) date strictly after a given date.
+//
+// The returned date is at midnight in the location of the given date.
+func NextIMMDate(t time.Time) time.Time {
+	y, m, _ := t.Date()
+
+	// The first quarterly month not before the month of the given date.
+	m = (m + 2) / 3 * 3
+
+	for {
+		if d := immDate(y, m, t.Location()); d.After(t) {
+			return d
+		}
+
+		m += 3
+		if m > time.December {
+			m -= 12
+			y++
+		}
+	}
+}
+
+// PreviousIMMDate returns the last International Money Market (IMM) date strictly before a given date.
+//
+// The returned date is at midnight in the location of the given date.
+func PreviousIMMDate(t time.Time) time.Time {
+	y, m, _ := t.Date()
+
+	// The last quarterly month not after the month of the given date.
+	m = m / 3 * 3
+	if m == 0 {
+		m = time.December
+		y--
+	}
+
+	for {
+		if d := immDate(y, m, t.Location()); d.Before(t) {
+			return d
+		}
+
+		m -= 3
+		if m < time.January {
+			m += 12
+			y--
+		}
+	}
+}
+
+// immDate returns the third Wednesday of a given month.
+func immDate(y int, m time.Month, loc *time.Location) time.Time {
+	const (
+		daysInWeek    = 7
+		thirdWeekDays = 14
+	)
+
+	first := time.Date(y, m, 1, 0, 0, 0, 0, loc)
+	d := 1 + (int(time.Wednesday)-int(first.Weekday())+daysInWeek)%daysInWeek + thirdWeekDays
+
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
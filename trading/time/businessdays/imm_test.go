@@ -0,0 +1,61 @@
+//nolint:testpackage
+package businessdays
+
+import (
+	"testing"
+	"time"
+)
+
+func parse(t *testing.T, s string) time.Time {
+	t.Helper()
+
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t.Fatalf("cannot parse %v: %v", s, err)
+	}
+
+	return d
+}
+
+func TestIMMDates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		d, next, prev string
+		is            bool
+	}{
+		{"2021-01-10", "2021-03-17", "2020-12-16", false},
+		{"2021-03-16", "2021-03-17", "2020-12-16", false},
+		{"2021-03-17", "2021-06-16", "2020-12-16", true},
+		{"2021-03-18", "2021-06-16", "2021-03-17", false},
+		{"2021-06-16", "2021-09-15", "2021-03-17", true},
+		{"2021-09-15", "2021-12-15", "2021-06-16", true},
+		{"2021-12-15", "2022-03-16", "2021-09-15", true},
+		{"2021-12-20", "2022-03-16", "2021-12-15", false},
+		{"2022-02-28", "2022-03-16", "2021-12-15", false},
+		{"2022-06-15", "2022-09-21", "2022-03-16", true},
+		{"2022-06-22", "2022-09-21", "2022-06-15", false},
+	}
+
+	for _, tt := range tests {
+		d := parse(t, tt.d)
+
+		if act := IsIMMDate(d); act != tt.is {
+			t.Errorf("IsIMMDate(%v): expected %v, actual %v", tt.d, tt.is, act)
+		}
+
+		if act, exp := NextIMMDate(d), parse(t, tt.next); !act.Equal(exp) {
+			t.Errorf("NextIMMDate(%v): expected %v, actual %v", tt.d, tt.next, act)
+		}
+
+		if act, exp := PreviousIMMDate(d), parse(t, tt.prev); !act.Equal(exp) {
+			t.Errorf("PreviousIMMDate(%v): expected %v, actual %v", tt.d, tt.prev, act)
+		}
+	}
+
+	// An IMM date with a time of day is strictly after the IMM date at midnight.
+	d := time.Date(2021, 3, 17, 12, 0, 0, 0, time.UTC)
+	if act, exp := PreviousIMMDate(d), parse(t, "2021-03-17"); !act.Equal(exp) {
+		t.Errorf("PreviousIMMDate(%v): expected %v, actual %v", d, exp, act)
+	}
+}
@@ -0,0 +1,47 @@
+package businessdays
+
+//nolint:gofumpt
+import (
+	"time"
+
+	"mbg/trading/time/holidays"
+)
+
+// Union joins several holiday calendars so that a date is a holiday
+// if it is a holiday in any of the calendars.
+//
+// This is a typical joint calendar for a cross-market settlement,
+// which requires all markets to be open.
+type Union []holidays.Calendarer
+
+// IsHoliday implements Calendarer interface.
+func (u Union) IsHoliday(t time.Time) bool {
+	for _, c := range u {
+		if c.IsHoliday(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Intersection joins several holiday calendars so that a date is a holiday
+// only if it is a holiday in all of the calendars.
+//
+// An empty intersection has no holidays.
+type Intersection []holidays.Calendarer
+
+// IsHoliday implements Calendarer interface.
+func (in Intersection) IsHoliday(t time.Time) bool {
+	if len(in) == 0 {
+		return false
+	}
+
+	for _, c := range in {
+		if !c.IsHoliday(t) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,57 @@
+//nolint:testpackage
+package businessdays
+
+//nolint:gofumpt
+import (
+	"testing"
+
+	"mbg/trading/time/holidays/calendars"
+)
+
+func TestUnionIntersection(t *testing.T) {
+	t.Parallel()
+
+	u := Union{calendars.UnitedStates{}, calendars.TARGET{}}
+	i := Intersection{calendars.UnitedStates{}, calendars.TARGET{}}
+
+	tests := []struct {
+		d     string
+		u, in bool
+	}{
+		// Independence Day, Monday.
+		{"2022-07-04", true, false},
+		// Easter Monday.
+		{"2022-04-18", true, false},
+		// Good Friday.
+		{"2022-04-15", true, true},
+		// Saturday.
+		{"2022-07-02", true, true},
+		// Wednesday.
+		{"2022-07-06", false, false},
+	}
+
+	for _, tt := range tests {
+		d := parse(t, tt.d)
+
+		if act := u.IsHoliday(d); act != tt.u {
+			t.Errorf("Union.IsHoliday(%v): expected %v, actual %v", tt.d, tt.u, act)
+		}
+
+		if act := i.IsHoliday(d); act != tt.in {
+			t.Errorf("Intersection.IsHoliday(%v): expected %v, actual %v", tt.d, tt.in, act)
+		}
+	}
+
+	if (Union{}).IsHoliday(parse(t, "2022-07-02")) {
+		t.Error("empty Union: expected no holidays")
+	}
+
+	if (Intersection{}).IsHoliday(parse(t, "2022-07-02")) {
+		t.Error("empty Intersection: expected no holidays")
+	}
+
+	// Settlement on T+2 over the US Independence Day.
+	if act, exp := Add(u, parse(t, "2022-07-01"), 2), parse(t, "2022-07-06"); !act.Equal(exp) {
+		t.Errorf("Add(Union, 2022-07-01, 2): expected %v, actual %v", exp, act)
+	}
+}
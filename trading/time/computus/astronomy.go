@@ -0,0 +1,247 @@
+package computus
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// The low-precision astronomical algorithms below follow Jean Meeus, "Astronomical Algorithms", 2nd edition.
+// The instants of new moons are accurate to about a minute and the instants of solar terms to about
+// a quarter of an hour, so a computed date may be off by one day when an event happens close to midnight.
+
+const (
+	astronomicalFirstYear = 1800
+	astronomicalLastYear  = 2200
+
+	unixEpochJulianDay = 2440587.5
+	j2000JulianDay     = 2451545.0
+	secondsPerDay      = 86400
+	daysPerCentury     = 36525
+	synodicMonth       = 29.530588861
+	tropicalYear       = 365.242189
+	degreesPerCircle   = 360
+	halfCircle         = 180
+	newMoonEpoch       = 2451550.09766
+	k1236              = 1236.85
+)
+
+var (
+	errAstronomicalInvalidBefore = errors.New("astronomical algorithm is invalid before 1800 AD")
+	errAstronomicalInvalidAfter  = errors.New("astronomical algorithm is invalid after 2200 AD")
+)
+
+func validateAstronomicalYear(year int) error {
+	if year < astronomicalFirstYear {
+		return errAstronomicalInvalidBefore
+	}
+
+	if year > astronomicalLastYear {
+		return errAstronomicalInvalidAfter
+	}
+
+	return nil
+}
+
+// julianDay converts a time instant to the Julian day.
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/secondsPerDay + unixEpochJulianDay
+}
+
+// fromJulianDay converts a Julian day to a UTC time instant.
+func fromJulianDay(jd float64) time.Time {
+	s := (jd - unixEpochJulianDay) * secondsPerDay
+
+	return time.Unix(int64(math.Round(s)), 0).UTC()
+}
+
+// localDate returns the date of a Julian day (universal time) in a time zone given by its offset in seconds.
+// The date is returned at midnight in UTC.
+func localDate(jd float64, offset int) time.Time {
+	y, m, d := fromJulianDay(jd).In(time.FixedZone("", offset)).Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+//nolint:gomnd
+// deltaT returns an approximate difference in seconds between the terrestrial (dynamical) time
+// and the universal time, using the polynomial expressions of Espenak and Meeus.
+func deltaT(year float64) float64 {
+	switch {
+	case year < 1860:
+		t := year - 1800
+
+		return 13.72 - 0.332447*t + 0.0068612*t*t + 0.0041116*t*t*t - 0.00037436*t*t*t*t +
+			0.0000121272*t*t*t*t*t - 0.0000001699*t*t*t*t*t*t + 0.000000000875*t*t*t*t*t*t*t
+	case year < 1900:
+		t := year - 1860
+
+		return 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t - 0.0004473624*t*t*t*t + t*t*t*t*t/233174
+	case year < 1920:
+		t := year - 1900
+
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case year < 1941:
+		t := year - 1920
+
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case year < 1961:
+		t := year - 1950
+
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case year < 1986:
+		t := year - 1975
+
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case year < 2005:
+		t := year - 2000
+
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case year < 2050:
+		t := year - 2000
+
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case year < 2150:
+		u := (year - 1820) / 100
+
+		return -20 + 32*u*u - 0.5628*(2150-year)
+	default:
+		u := (year - 1820) / 100
+
+		return -20 + 32*u*u
+	}
+}
+
+// toUniversal converts a Julian ephemeris day (dynamical time) to a Julian day (universal time).
+func toUniversal(jde float64) float64 {
+	year := 2000 + (jde-j2000JulianDay)/tropicalYear
+
+	return jde - deltaT(year)/secondsPerDay
+}
+
+func sinDeg(x float64) float64 {
+	return math.Sin(x * math.Pi / halfCircle)
+}
+
+// normalizeDegrees reduces an angle to the range [-180, 180).
+func normalizeDegrees(x float64) float64 {
+	x = math.Mod(x+halfCircle, degreesPerCircle)
+	if x < 0 {
+		x += degreesPerCircle
+	}
+
+	return x - halfCircle
+}
+
+//nolint:gomnd
+// sunApparentLongitude returns the apparent geocentric longitude of the Sun in degrees
+// for a given Julian ephemeris day (Meeus, chapter 25, low accuracy).
+func sunApparentLongitude(jde float64) float64 {
+	t := (jde - j2000JulianDay) / daysPerCentury
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := 357.52911 + 35999.05029*t - 0.0001537*t*t
+	c := (1.914602-0.004817*t-0.000014*t*t)*sinDeg(m) + (0.019993-0.000101*t)*sinDeg(2*m) + 0.000289*sinDeg(3*m)
+	omega := 125.04 - 1934.136*t
+
+	return l0 + c - 0.00569 - 0.00478*sinDeg(omega)
+}
+
+// solarTerm returns the Julian day (universal time) when the apparent longitude of the Sun
+// reaches a given value in degrees during a given year. The longitude 0 is the March equinox.
+func solarTerm(year int, longitude float64) float64 {
+	const (
+		marchEquinoxDay = 80 // An approximate day of year of the March equinox.
+		iterations      = 20
+		tolerance       = 1e-7
+	)
+
+	jde := julianDay(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)) + marchEquinoxDay +
+		math.Mod(longitude, degreesPerCircle)/degreesPerCircle*tropicalYear
+
+	for i := 0; i < iterations; i++ {
+		d := normalizeDegrees(longitude - sunApparentLongitude(jde))
+		jde += d / degreesPerCircle * tropicalYear
+
+		if math.Abs(d) < tolerance {
+			break
+		}
+	}
+
+	return toUniversal(jde)
+}
+
+//nolint:gomnd,funlen
+// newMoon returns the Julian day (universal time) of the new moon with a given lunation number,
+// where lunation 0 is the new moon of 6 January 2000 (Meeus, chapter 49).
+func newMoon(k float64) float64 {
+	t := k / k1236
+	t2 := t * t
+	t3 := t2 * t
+	t4 := t3 * t
+
+	jde := newMoonEpoch + synodicMonth*k + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := 2.5534 + 29.10535670*k - 0.0000014*t2 - 0.00000011*t3
+	mp := 201.5643 + 385.81693528*k + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4
+	f := 160.7108 + 390.67050284*k - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4
+	o := 124.7746 - 1.56375588*k + 0.0020672*t2 + 0.00000215*t3
+
+	jde += -0.40720*sinDeg(mp) +
+		0.17241*e*sinDeg(m) +
+		0.01608*sinDeg(2*mp) +
+		0.01039*sinDeg(2*f) +
+		0.00739*e*sinDeg(mp-m) -
+		0.00514*e*sinDeg(mp+m) +
+		0.00208*e*e*sinDeg(2*m) -
+		0.00111*sinDeg(mp-2*f) -
+		0.00057*sinDeg(mp+2*f) +
+		0.00056*e*sinDeg(2*mp+m) -
+		0.00042*sinDeg(3*mp) +
+		0.00042*e*sinDeg(m+2*f) +
+		0.00038*e*sinDeg(m-2*f) -
+		0.00024*e*sinDeg(2*mp-m) -
+		0.00017*sinDeg(o) -
+		0.00007*sinDeg(mp+2*m) +
+		0.00004*sinDeg(2*mp-2*f) +
+		0.00004*sinDeg(3*m) +
+		0.00003*sinDeg(mp+m-2*f) +
+		0.00003*sinDeg(2*mp+2*f) -
+		0.00003*sinDeg(mp+m+2*f) +
+		0.00003*sinDeg(mp-m+2*f) -
+		0.00002*sinDeg(mp-m-2*f) -
+		0.00002*sinDeg(3*mp+m) +
+		0.00002*sinDeg(4*mp)
+
+	// Planetary arguments.
+	jde += 0.000325*sinDeg(299.77+0.107408*k-0.009173*t2) +
+		0.000165*sinDeg(251.88+0.016321*k) +
+		0.000164*sinDeg(251.83+26.651886*k) +
+		0.000126*sinDeg(349.42+36.412478*k) +
+		0.000110*sinDeg(84.66+18.206239*k) +
+		0.000062*sinDeg(141.74+53.303771*k) +
+		0.000060*sinDeg(207.14+2.453732*k) +
+		0.000056*sinDeg(154.84+7.306860*k) +
+		0.000047*sinDeg(34.52+27.261239*k) +
+		0.000042*sinDeg(207.19+0.121824*k) +
+		0.000040*sinDeg(291.34+1.844379*k) +
+		0.000037*sinDeg(161.72+24.198154*k) +
+		0.000035*sinDeg(239.56+25.513099*k) +
+		0.000023*sinDeg(331.55+3.592518*k)
+
+	return toUniversal(jde)
+}
+
+// lunation returns the lunation number of the last new moon on or before a given Julian day (universal time).
+func lunation(jd float64) float64 {
+	k := math.Floor((jd - newMoonEpoch) / synodicMonth)
+
+	for newMoon(k+1) <= jd {
+		k++
+	}
+
+	for newMoon(k) > jd {
+		k--
+	}
+
+	return k
+}
@@ -0,0 +1,154 @@
+package computus
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	chinaOffset         = 8 * 60 * 60 // China Standard Time, UTC+8.
+	winterSolstice      = 270
+	qingmingLongitude   = 15
+	principalTermDegree = 30
+	monthsPerYear       = 12
+	leapYearMonths      = 13
+	chineseMonth11      = 11
+	chineseMaxDay       = 30
+)
+
+var errInvalidChineseDate = errors.New("invalid Chinese lunar date")
+
+// ChineseDate computes the Gregorian date of a day of a (non-leap) month of the Chinese lunisolar calendar
+// for a given Gregorian year (1800 – 2200 AD). Month 1 day 1 is the Chinese (Lunar) New Year.
+//
+// The calendar follows the rules of the 1645 reform: months start on the day of the new moon in China Standard
+// Time, the month containing the winter solstice is month 11, and in a year with 13 months the first month
+// without a principal solar term is a leap month.
+func ChineseDate(year, month, day int) (time.Time, error) {
+	if err := validateAstronomicalYear(year); err != nil {
+		return time.Time{}, err
+	}
+
+	if month < 1 || month > monthsPerYear || day < 1 || day > chineseMaxDay {
+		return time.Time{}, errInvalidChineseDate
+	}
+
+	// Months 11 and 12 start the next suì, the period between two winter solstices.
+	sui := year
+	if month >= chineseMonth11 {
+		sui++
+	}
+
+	starts, leap := chineseMonths(sui)
+	number := chineseMonth11
+
+	for i := 0; i < len(starts)-1; i++ {
+		if i > 0 && i != leap {
+			number = number%monthsPerYear + 1
+		}
+
+		if number != month || i == leap {
+			continue
+		}
+
+		t := starts[i].AddDate(0, 0, day-1)
+		if !t.Before(starts[i+1]) {
+			return time.Time{}, errInvalidChineseDate
+		}
+
+		return t, nil
+	}
+
+	return time.Time{}, errInvalidChineseDate
+}
+
+// ChineseNewYear computes the date (1800 – 2200 AD) of the Chinese (Lunar) New Year, the first day of the first month.
+func ChineseNewYear(year int) (time.Time, error) {
+	return ChineseDate(year, 1, 1)
+}
+
+// Qingming computes the date (1800 – 2200 AD) of the Qingming (Ching Ming, Tomb-Sweeping) Festival,
+// the solar term when the apparent longitude of the Sun reaches 15 degrees.
+func Qingming(year int) (time.Time, error) {
+	if err := validateAstronomicalYear(year); err != nil {
+		return time.Time{}, err
+	}
+
+	return localDate(solarTerm(year, qingmingLongitude), chinaOffset), nil
+}
+
+// DragonBoatFestival computes the date (1800 – 2200 AD) of the Dragon Boat (Tuen Ng) Festival,
+// the fifth day of the fifth month of the Chinese calendar.
+func DragonBoatFestival(year int) (time.Time, error) {
+	const m, d = 5, 5
+
+	return ChineseDate(year, m, d)
+}
+
+// MidAutumnFestival computes the date (1800 – 2200 AD) of the Mid-Autumn Festival,
+// the fifteenth day of the eighth month of the Chinese calendar.
+func MidAutumnFestival(year int) (time.Time, error) {
+	const m, d = 8, 15
+
+	return ChineseDate(year, m, d)
+}
+
+// ChungYeungFestival computes the date (1800 – 2200 AD) of the Chung Yeung (Double Ninth) Festival,
+// the ninth day of the ninth month of the Chinese calendar.
+func ChungYeungFestival(year int) (time.Time, error) {
+	const m, d = 9, 9
+
+	return ChineseDate(year, m, d)
+}
+
+// chineseMonths returns the first days of the Chinese lunar months from the month 11 preceding
+// the winter solstice of the previous year up to and including the month 11 preceding the winter
+// solstice of a given year, and the index of the leap month or -1 if there is none.
+func chineseMonths(year int) ([]time.Time, int) {
+	k1 := lunation(chineseDayEnd(solarTerm(year-1, winterSolstice)))
+	k2 := lunation(chineseDayEnd(solarTerm(year, winterSolstice)))
+
+	n := int(k2 - k1)
+	starts := make([]time.Time, n+1)
+
+	for i := range starts {
+		starts[i] = localDate(newMoon(k1+float64(i)), chinaOffset)
+	}
+
+	leap := -1
+
+	if n == leapYearMonths {
+		for i := 0; i < n; i++ {
+			if !hasPrincipalTerm(starts[i], starts[i+1]) {
+				leap = i
+
+				break
+			}
+		}
+	}
+
+	return starts, leap
+}
+
+// chineseDayEnd returns the Julian day (universal time) of the end of the China Standard Time day
+// containing a given Julian day.
+func chineseDayEnd(jd float64) float64 {
+	y, m, d := localDate(jd, chinaOffset).Date()
+
+	return julianDay(time.Date(y, m, d+1, 0, 0, 0, 0, time.FixedZone("", chinaOffset))) - 1.0/secondsPerDay
+}
+
+// hasPrincipalTerm checks if the apparent longitude of the Sun reaches a multiple of 30 degrees
+// in the China Standard Time days from the start (inclusive) to the end (exclusive).
+func hasPrincipalTerm(start, end time.Time) bool {
+	lon := func(t time.Time) float64 {
+		y, m, d := t.Date()
+		jd := julianDay(time.Date(y, m, d, 0, 0, 0, 0, time.FixedZone("", chinaOffset)))
+		jde := jd + deltaT(float64(y))/secondsPerDay
+
+		return math.Floor(math.Mod(sunApparentLongitude(jde), degreesPerCircle) / principalTermDegree)
+	}
+
+	return lon(start) != lon(end)
+}
@@ -0,0 +1,86 @@
+//nolint:testpackage
+package computus
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:funlen
+func TestChineseCalendar(t *testing.T) {
+	t.Parallel()
+
+	// Hong Kong Observatory and HKEX holiday announcements.
+	verifyRange(t, []time.Time{
+		date(1985, 2, 20), date(2000, 2, 5), date(2017, 1, 28), date(2018, 2, 16), date(2019, 2, 5),
+		date(2020, 1, 25), date(2021, 2, 12), date(2022, 2, 1), date(2023, 1, 22), date(2024, 2, 10),
+		date(2025, 1, 29), date(2026, 2, 17), date(2033, 1, 31), date(2034, 2, 19),
+	}, ChineseNewYear, "ChineseNewYear")
+
+	verifyRange(t, []time.Time{
+		date(2017, 4, 4), date(2018, 4, 5), date(2019, 4, 5), date(2020, 4, 4), date(2021, 4, 4),
+		date(2022, 4, 5), date(2023, 4, 5), date(2024, 4, 4), date(2025, 4, 4), date(2026, 4, 5),
+	}, Qingming, "Qingming")
+
+	// 2020 and 2023 have a leap month before the fifth month.
+	verifyRange(t, []time.Time{
+		date(2017, 5, 30), date(2018, 6, 18), date(2019, 6, 7), date(2020, 6, 25), date(2021, 6, 14),
+		date(2022, 6, 3), date(2023, 6, 22), date(2024, 6, 10), date(2025, 5, 31), date(2026, 6, 19),
+	}, DragonBoatFestival, "DragonBoatFestival")
+
+	// 2025 has a leap sixth month.
+	verifyRange(t, []time.Time{
+		date(2017, 10, 4), date(2018, 9, 24), date(2019, 9, 13), date(2020, 10, 1), date(2021, 9, 21),
+		date(2022, 9, 10), date(2023, 9, 29), date(2024, 9, 17), date(2025, 10, 6), date(2026, 9, 25),
+	}, MidAutumnFestival, "MidAutumnFestival")
+
+	verifyRange(t, []time.Time{
+		date(2017, 10, 28), date(2018, 10, 17), date(2019, 10, 7), date(2020, 10, 25), date(2021, 10, 14),
+		date(2022, 10, 4), date(2023, 10, 23), date(2024, 10, 11), date(2025, 10, 29), date(2026, 10, 18),
+	}, ChungYeungFestival, "ChungYeungFestival")
+
+	for _, f := range []func(int) (time.Time, error){
+		ChineseNewYear, Qingming, DragonBoatFestival, MidAutumnFestival, ChungYeungFestival,
+	} {
+		verifyYearError(t, f, 1799, "Chinese")
+		verifyYearError(t, f, 2201, "Chinese")
+	}
+}
+
+func TestChineseDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		y, m, d int
+		exp     time.Time
+	}{
+		// The twelfth month of the year 2021 starts the year 2022 suì.
+		{2021, 12, 1, date(2022, 1, 3)},
+		{2021, 11, 1, date(2021, 12, 4)},
+		{2022, 1, 1, date(2022, 2, 1)},
+		{2022, 1, 15, date(2022, 2, 15)},
+	}
+
+	for _, tt := range tests {
+		act, err := ChineseDate(tt.y, tt.m, tt.d)
+		if err != nil {
+			t.Errorf("ChineseDate(%v, %v, %v): unexpected error %v", tt.y, tt.m, tt.d, err)
+
+			continue
+		}
+
+		if !act.Equal(tt.exp) {
+			t.Errorf("ChineseDate(%v, %v, %v): expected %v, actual %v", tt.y, tt.m, tt.d, tt.exp, act)
+		}
+	}
+
+	for _, tt := range []struct{ y, m, d int }{
+		{2022, 0, 1}, {2022, 13, 1}, {2022, 1, 0}, {2022, 1, 31},
+		// The second month of 2022 has 29 days.
+		{2022, 2, 30},
+	} {
+		if _, err := ChineseDate(tt.y, tt.m, tt.d); err == nil {
+			t.Errorf("ChineseDate(%v, %v, %v): expected error, actual nil", tt.y, tt.m, tt.d)
+		}
+	}
+}
@@ -6,6 +6,9 @@
 //
 // The Roman Catholic Church since 1583 has been using 21 March under the Gregorian calendar to calculate the
 // date of Easter, while the Eastern Orthodox continued and continue to use 20 March under the Julian Calendar.
+//
+// The package also computes the dates of the lunar and lunisolar holidays: the Chinese calendar festivals,
+// the Islamic Eid al-Fitr and Eid al-Adha (tabular calendar), the Hindu Diwali and the Japanese equinox days.
 package computus
 
 import (
@@ -64,3 +64,39 @@ func BenchmarkOrthodoxEasterSundayYearDay(b *testing.B) {
 		j++
 	}
 }
+
+func BenchmarkChineseNewYear(b *testing.B) {
+	j := astronomicalFirstYear
+	for i := 0; i < b.N; i++ {
+		if j > astronomicalLastYear {
+			j = astronomicalFirstYear
+		}
+
+		_, _ = ChineseNewYear(j)
+		j++
+	}
+}
+
+func BenchmarkDiwali(b *testing.B) {
+	j := astronomicalFirstYear
+	for i := 0; i < b.N; i++ {
+		if j > astronomicalLastYear {
+			j = astronomicalFirstYear
+		}
+
+		_, _ = Diwali(j)
+		j++
+	}
+}
+
+func BenchmarkEidAlFitr(b *testing.B) {
+	j := astronomicalFirstYear
+	for i := 0; i < b.N; i++ {
+		if j > astronomicalLastYear {
+			j = astronomicalFirstYear
+		}
+
+		_, _ = EidAlFitr(j)
+		j++
+	}
+}
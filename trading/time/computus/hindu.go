@@ -0,0 +1,36 @@
+package computus
+
+import (
+	"time"
+)
+
+const (
+	indiaOffset = 5*60*60 + 30*60 // India Standard Time, UTC+5:30.
+
+	// The sidereal longitude of the Sun at Tula Sankranti, the Sun's entry into Libra.
+	tulaSankranti = 180
+
+	// The Lahiri ayanamsa (precession of the tropical zodiac) at J2000 and its annual rate in degrees.
+	ayanamsaJ2000 = 23.853
+	ayanamsaRate  = 0.013969
+
+	// The hour of the evening in India Standard Time at which the lunar day (tithi) is taken for the festival.
+	pradoshHours = 18
+)
+
+// Diwali computes the date (1800 – 2200 AD) of Diwali (Lakshmi Puja), the new moon day (Amavasya)
+// ending the lunar month in which the Sun enters the sidereal sign of Libra.
+//
+// The day is the one whose evening in India Standard Time precedes the instant of the new moon.
+// The calculation approximates the length of the new moon lunar day by a solar day, so the result
+// may differ by a day from the almanacs when the new moon happens around the evening.
+func Diwali(year int) (time.Time, error) {
+	if err := validateAstronomicalYear(year); err != nil {
+		return time.Time{}, err
+	}
+
+	ayanamsa := ayanamsaJ2000 + ayanamsaRate*float64(year-2000) //nolint:gomnd
+	jd := newMoon(lunation(solarTerm(year, tulaSankranti+ayanamsa)) + 1)
+
+	return localDate(jd-pradoshHours/24.0, indiaOffset), nil //nolint:gomnd
+}
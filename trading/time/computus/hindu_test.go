@@ -0,0 +1,20 @@
+//nolint:testpackage
+package computus
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiwali(t *testing.T) {
+	t.Parallel()
+
+	// National Stock Exchange of India, Diwali Laxmi Pujan.
+	verifyRange(t, []time.Time{
+		date(2017, 10, 19), date(2018, 11, 7), date(2019, 10, 27), date(2020, 11, 14),
+		date(2021, 11, 4), date(2022, 10, 24), date(2023, 11, 12), date(2024, 11, 1), date(2026, 11, 8),
+	}, Diwali, "Diwali")
+
+	verifyYearError(t, Diwali, 1799, "Diwali")
+	verifyYearError(t, Diwali, 2201, "Diwali")
+}
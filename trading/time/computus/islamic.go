@@ -0,0 +1,101 @@
+package computus
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	islamicEpoch        = 227015 // Fixed day number of 1 Muharram 1 AH, 16 July 622 AD (Julian).
+	islamicFirstYear    = 623    // The first Gregorian year fully covered by the Islamic calendar.
+	islamicMonths       = 12
+	islamicMaxDay       = 30
+	islamicShawwal      = 10
+	islamicDhuAlHijjah  = 12
+	islamicYearsInCycle = 30
+	islamicLeapYears    = 11
+)
+
+var (
+	errInvalidIslamicDate   = errors.New("invalid Islamic date")
+	errIslamicInvalidBefore = errors.New("algorithm is invalid before 623 AD")
+)
+
+// IslamicDate computes the Gregorian date of a date of the tabular (arithmetic) Islamic calendar.
+//
+// The tabular calendar has 11 leap years in a 30-year cycle. The actual religious dates depend
+// on the sighting of the crescent moon and may differ from the tabular ones by a day or two,
+// so exchange calendars should be checked against official announcements.
+func IslamicDate(year, month, day int) (time.Time, error) {
+	if year < 1 || month < 1 || month > islamicMonths || day < 1 || day > islamicMonthLength(year, month) {
+		return time.Time{}, errInvalidIslamicDate
+	}
+
+	// Reingold and Dershowitz, "Calendrical Calculations".
+	rd := day + 29*(month-1) + (6*month-1)/11 + (year-1)*354 + (3+11*year)/islamicYearsInCycle + islamicEpoch - 1 //nolint:gomnd
+
+	return time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, rd-1), nil
+}
+
+// EidAlFitr computes the dates of the Eid al-Fitr (1 Shawwal of the tabular Islamic calendar) falling in
+// a given Gregorian year (greater than 623 AD). Since the Islamic year is about 11 days shorter than
+// the Gregorian one, there are years with no or two occurrences.
+func EidAlFitr(year int) ([]time.Time, error) {
+	return islamicDatesInYear(year, islamicShawwal, 1)
+}
+
+// EidAlAdha computes the dates of the Eid al-Adha (10 Dhu al-Hijjah of the tabular Islamic calendar) falling in
+// a given Gregorian year (greater than 623 AD). Since the Islamic year is about 11 days shorter than
+// the Gregorian one, there are years with no or two occurrences.
+func EidAlAdha(year int) ([]time.Time, error) {
+	const d = 10
+
+	return islamicDatesInYear(year, islamicDhuAlHijjah, d)
+}
+
+func islamicDatesInYear(year, month, day int) ([]time.Time, error) {
+	if year < islamicFirstYear {
+		return nil, errIslamicInvalidBefore
+	}
+
+	const (
+		gregorianEpoch = 622
+		cycleIslamic   = 33
+		cycleGregorian = 32
+	)
+
+	h := (year - gregorianEpoch) * cycleIslamic / cycleGregorian
+
+	var ts []time.Time
+
+	for y := h - 1; y <= h+2; y++ {
+		if y < 1 {
+			continue
+		}
+
+		t, err := IslamicDate(y, month, day)
+		if err != nil {
+			return nil, err
+		}
+
+		if t.Year() == year {
+			ts = append(ts, t)
+		}
+	}
+
+	return ts, nil
+}
+
+func islamicMonthLength(year, month int) int {
+	const short = 29
+
+	if month%2 == 1 {
+		return islamicMaxDay
+	}
+
+	if month == islamicDhuAlHijjah && (14+11*year)%islamicYearsInCycle < islamicLeapYears { //nolint:gomnd
+		return islamicMaxDay
+	}
+
+	return short
+}
@@ -0,0 +1,98 @@
+//nolint:testpackage
+package computus
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIslamicDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		y, m, d int
+		exp     time.Time
+	}{
+		// Reingold and Dershowitz, "Calendrical Calculations".
+		{1364, 12, 6, date(1945, 11, 12)},
+		{1, 1, 1, date(622, 7, 19)},
+		{1445, 1, 1, date(2023, 7, 19)},
+	}
+
+	for _, tt := range tests {
+		act, err := IslamicDate(tt.y, tt.m, tt.d)
+		if err != nil {
+			t.Errorf("IslamicDate(%v, %v, %v): unexpected error %v", tt.y, tt.m, tt.d, err)
+
+			continue
+		}
+
+		if !act.Equal(tt.exp) {
+			t.Errorf("IslamicDate(%v, %v, %v): expected %v, actual %v", tt.y, tt.m, tt.d, tt.exp, act)
+		}
+	}
+
+	// 1444 is a common year, 1445 is a leap year.
+	for _, tt := range []struct{ y, m, d int }{
+		{0, 1, 1}, {1445, 0, 1}, {1445, 13, 1}, {1445, 1, 0}, {1445, 2, 30}, {1444, 12, 30},
+	} {
+		if _, err := IslamicDate(tt.y, tt.m, tt.d); err == nil {
+			t.Errorf("IslamicDate(%v, %v, %v): expected error, actual nil", tt.y, tt.m, tt.d)
+		}
+	}
+
+	if _, err := IslamicDate(1445, 12, 30); err != nil {
+		t.Errorf("IslamicDate(1445, 12, 30): unexpected error %v", err)
+	}
+}
+
+func TestEid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		y    int
+		fitr []time.Time
+		adha []time.Time
+	}{
+		{2021, []time.Time{date(2021, 5, 13)}, []time.Time{date(2021, 7, 20)}},
+		{2023, []time.Time{date(2023, 4, 22)}, []time.Time{date(2023, 6, 29)}},
+		{2024, []time.Time{date(2024, 4, 10)}, []time.Time{date(2024, 6, 17)}},
+		{2000, []time.Time{date(2000, 1, 8), date(2000, 12, 28)}, []time.Time{date(2000, 3, 16)}},
+	}
+
+	for _, tt := range tests {
+		verifyDates(t, tt.y, tt.fitr, EidAlFitr, "EidAlFitr")
+		verifyDates(t, tt.y, tt.adha, EidAlAdha, "EidAlAdha")
+	}
+
+	if _, err := EidAlFitr(622); err == nil {
+		t.Error("EidAlFitr(622): expected error, actual nil")
+	}
+
+	if _, err := EidAlAdha(622); err == nil {
+		t.Error("EidAlAdha(622): expected error, actual nil")
+	}
+}
+
+func verifyDates(t *testing.T, y int, exp []time.Time, f func(int) ([]time.Time, error), s string) {
+	t.Helper()
+
+	act, err := f(y)
+	if err != nil {
+		t.Errorf("%v(%v): unexpected error %v", s, y, err)
+
+		return
+	}
+
+	if len(act) != len(exp) {
+		t.Errorf("%v(%v): expected %v, actual %v", s, y, exp, act)
+
+		return
+	}
+
+	for i := range exp {
+		if !act[i].Equal(exp[i]) {
+			t.Errorf("%v(%v): expected %v, actual %v", s, y, exp, act)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package computus
+
+import (
+	"time"
+)
+
+const (
+	japanOffset     = 9 * 60 * 60 // Japan Standard Time, UTC+9.
+	vernalEquinox   = 0
+	autumnalEquinox = 180
+)
+
+// VernalEquinoxDay computes the date (1800 – 2200 AD) of the Japanese Vernal Equinox Day (Shunbun no Hi),
+// the day of the March equinox in Japan Standard Time.
+//
+// The official date is announced a year in advance by the National Astronomical Observatory of Japan
+// and is based on the same astronomical computation.
+func VernalEquinoxDay(year int) (time.Time, error) {
+	if err := validateAstronomicalYear(year); err != nil {
+		return time.Time{}, err
+	}
+
+	return localDate(solarTerm(year, vernalEquinox), japanOffset), nil
+}
+
+// AutumnalEquinoxDay computes the date (1800 – 2200 AD) of the Japanese Autumnal Equinox Day (Shūbun no Hi),
+// the day of the September equinox in Japan Standard Time.
+func AutumnalEquinoxDay(year int) (time.Time, error) {
+	if err := validateAstronomicalYear(year); err != nil {
+		return time.Time{}, err
+	}
+
+	return localDate(solarTerm(year, autumnalEquinox), japanOffset), nil
+}
@@ -0,0 +1,25 @@
+//nolint:testpackage
+package computus
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEquinoxDays(t *testing.T) {
+	t.Parallel()
+
+	// National Astronomical Observatory of Japan.
+	verifyRange(t, []time.Time{
+		date(2017, 3, 20), date(2018, 3, 21), date(2019, 3, 21), date(2020, 3, 20), date(2021, 3, 20),
+		date(2022, 3, 21), date(2023, 3, 21), date(2024, 3, 20), date(2025, 3, 20), date(2026, 3, 20),
+	}, VernalEquinoxDay, "VernalEquinoxDay")
+
+	verifyRange(t, []time.Time{
+		date(2017, 9, 23), date(2018, 9, 23), date(2019, 9, 23), date(2020, 9, 22), date(2021, 9, 23),
+		date(2022, 9, 23), date(2023, 9, 23), date(2024, 9, 22), date(2025, 9, 23), date(2026, 9, 23),
+	}, AutumnalEquinoxDay, "AutumnalEquinoxDay")
+
+	verifyYearError(t, VernalEquinoxDay, 1799, "VernalEquinoxDay")
+	verifyYearError(t, AutumnalEquinoxDay, 2201, "AutumnalEquinoxDay")
+}
@@ -40,21 +40,25 @@ const (
 
 	// Iceland is the generic Icelandic exchange holiday schedule.
 	Iceland
+
+	// FederalReserve is the US Federal Reserve banks (Fedwire) holiday schedule.
+	FederalReserve
 	last
 )
 
 const (
-	unknown      = "unknown"
-	noHolidays   = "noHolidays"
-	weekendsOnly = "weekendsOnly"
-	target       = "target"
-	euronext     = "euronext"
-	unitedStates = "unitedStates"
-	switzerland  = "switzerland"
-	sweden       = "sweden"
-	denmark      = "denmark"
-	norway       = "norway"
-	iceland      = "iceland"
+	unknown        = "unknown"
+	noHolidays     = "noHolidays"
+	weekendsOnly   = "weekendsOnly"
+	target         = "target"
+	euronext       = "euronext"
+	unitedStates   = "unitedStates"
+	switzerland    = "switzerland"
+	sweden         = "sweden"
+	denmark        = "denmark"
+	norway         = "norway"
+	iceland        = "iceland"
+	federalReserve = "federalReserve"
 )
 
 var errUnknownCalendar = errors.New("unknown holiday calendar")
@@ -83,6 +87,8 @@ func (c Calendar) String() string {
 		return norway
 	case Iceland:
 		return iceland
+	case FederalReserve:
+		return federalReserve
 	default:
 		return unknown
 	}
@@ -137,6 +143,8 @@ func (c *Calendar) UnmarshalJSON(data []byte) error {
 		*c = Norway
 	case iceland:
 		*c = Iceland
+	case federalReserve:
+		*c = FederalReserve
 	default:
 		return fmt.Errorf("cannot unmarshal '%s': %w", s, errUnknownCalendar)
 	}
@@ -22,6 +22,7 @@ func TestCalendarString(t *testing.T) {
 		{Denmark, denmark},
 		{Norway, norway},
 		{Iceland, iceland},
+		{FederalReserve, federalReserve},
 		{last, unknown},
 		{Calendar(0), noHolidays},
 		{Calendar(9999), unknown},
@@ -55,6 +56,7 @@ func TestCalendarIsKnown(t *testing.T) {
 		{Denmark, true},
 		{Norway, true},
 		{Iceland, true},
+		{FederalReserve, true},
 		{last, false},
 		{Calendar(0), true},
 		{Calendar(9999), false},
@@ -90,6 +92,7 @@ func TestCalendarMarshalJSON(t *testing.T) {
 		{Denmark, "\"denmark\"", true},
 		{Norway, "\"norway\"", true},
 		{Iceland, "\"iceland\"", true},
+		{FederalReserve, "\"federalReserve\"", true},
 		{last, nilstr, false},
 		{Calendar(9999), nilstr, false},
 		{Calendar(-9999), nilstr, false},
@@ -138,6 +141,7 @@ func TestCalendarUnmarshalJSON(t *testing.T) {
 		{Denmark, "\"denmark\"", true},
 		{Norway, "\"norway\"", true},
 		{Iceland, "\"iceland\"", true},
+		{FederalReserve, "\"federalReserve\"", true},
 		{zero, "\"unknown\"", false},
 		{zero, "\"foobar\"", false},
 	}
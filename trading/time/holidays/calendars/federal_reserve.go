@@ -0,0 +1,125 @@
+package calendars
+
+import (
+	"time"
+)
+
+// FederalReserve implements the US Federal Reserve banks holiday calendar,
+// the settlement calendar of the US dollar payments (Fedwire).
+//
+// The holidays (apart from weekends) are:
+// New Year's Day (January 1st),
+// Martin Luther King's Birthday (third Monday in January, since 1986),
+// Washington's Birthday (third Monday in February, February 22nd before 1971),
+// Memorial Day (last Monday in May, May 30th before 1971),
+// Juneteenth National Independence Day (June 19th, since 2022),
+// Independence Day (July 4th),
+// Labour Day (first Monday in September),
+// Columbus Day (second Monday in October, October 12th before 1971),
+// Veterans Day (November 11th, fourth Monday in October from 1971 to 1977),
+// Thanksgiving Day (fourth Thursday in November),
+// Christmas Day (December 25th).
+//
+// Holidays falling on Sunday are observed on the following Monday.
+// Holidays falling on Saturday are not observed, the preceding Friday is a business day.
+//
+// Unlike the New York Stock Exchange calendar, the Good Friday is a business day.
+//
+// See https://www.frbservices.org/about/holiday-schedules.
+type FederalReserve struct{}
+
+// IsHoliday implements Calendarer interface.
+//
+//nolint:cyclop,gocognit,gocyclo,gomnd
+func (FederalReserve) IsHoliday(t time.Time) bool {
+	dow := t.Weekday()
+	if dow == time.Saturday || dow == time.Sunday {
+		return true
+	}
+
+	y, m, d := t.Date()
+
+	switch m {
+	case time.January:
+		// New Year's Day.
+		if isObservedOnMonday(1, d, dow) {
+			return true
+		}
+
+		// Martin Luther King's Birthday (third Monday in January).
+		if y >= 1986 && dow == time.Monday && d >= 15 && d <= 21 {
+			return true
+		}
+	case time.February:
+		// Washington's Birthday (third Monday in February).
+		if y >= 1971 {
+			if dow == time.Monday && d >= 15 && d <= 21 {
+				return true
+			}
+		} else if isObservedOnMonday(22, d, dow) {
+			return true
+		}
+	case time.May:
+		// Memorial Day (last Monday in May).
+		if y >= 1971 {
+			if dow == time.Monday && d >= 25 {
+				return true
+			}
+		} else if isObservedOnMonday(30, d, dow) {
+			return true
+		}
+	case time.June:
+		// Juneteenth National Independence Day.
+		if y >= 2022 && isObservedOnMonday(19, d, dow) {
+			return true
+		}
+	case time.July:
+		// Independence Day.
+		if isObservedOnMonday(4, d, dow) {
+			return true
+		}
+	case time.September:
+		// Labour Day (first Monday in September).
+		if dow == time.Monday && d <= 7 {
+			return true
+		}
+	case time.October:
+		// Columbus Day (second Monday in October).
+		if y >= 1971 {
+			if dow == time.Monday && d >= 8 && d <= 14 {
+				return true
+			}
+		} else if isObservedOnMonday(12, d, dow) {
+			return true
+		}
+
+		// Veterans Day (fourth Monday in October).
+		if y >= 1971 && y <= 1977 && dow == time.Monday && d >= 22 && d <= 28 {
+			return true
+		}
+	case time.November:
+		// Veterans Day.
+		if (y < 1971 || y > 1977) && isObservedOnMonday(11, d, dow) {
+			return true
+		}
+
+		// Thanksgiving Day (fourth Thursday in November).
+		if dow == time.Thursday && d >= 22 && d <= 28 {
+			return true
+		}
+	case time.December:
+		// Christmas Day.
+		if isObservedOnMonday(25, d, dow) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isObservedOnMonday checks if a day of month is a fixed-date holiday observed
+// on the following Monday when it falls on Sunday.
+// The day of month is expected to be a weekday.
+func isObservedOnMonday(holiday, d int, dow time.Weekday) bool {
+	return d == holiday || (d == holiday+1 && dow == time.Monday)
+}
@@ -0,0 +1,92 @@
+//nolint:testpackage
+package calendars
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:funlen
+func TestIsHolidayFederalReserve(t *testing.T) {
+	t.Parallel()
+
+	c := FederalReserve{}
+
+	wellKnown := []struct {
+		t time.Time
+		s string
+	}{
+		{date(1968, 2, 22), washingtonsBirthday},
+		{date(1968, 5, 30), memorialDay},
+		{date(1967, 10, 12), columbusDay},
+		{date(1968, 11, 11), veteransDay},
+		{date(1975, 10, 27), veteransDay},
+
+		{date(2022, 1, 17), martinLutherKingDay},
+		{date(2022, 2, 21), washingtonsBirthday},
+		{date(2022, 5, 30), memorialDay},
+		{date(2022, 6, 20), juneteenth},
+		{date(2022, 7, 4), independenceDay},
+		{date(2022, 9, 5), labourDay},
+		{date(2022, 10, 10), columbusDay},
+		{date(2022, 11, 11), veteransDay},
+		{date(2022, 11, 24), thanksgivingDay},
+		{date(2022, 12, 26), christmasDay},
+
+		{date(2023, 1, 2), newYearsDay},
+		{date(2023, 1, 16), martinLutherKingDay},
+		{date(2023, 2, 20), washingtonsBirthday},
+		{date(2023, 5, 29), memorialDay},
+		{date(2023, 6, 19), juneteenth},
+		{date(2023, 7, 4), independenceDay},
+		{date(2023, 9, 4), labourDay},
+		{date(2023, 10, 9), columbusDay},
+		{date(2023, 11, 23), thanksgivingDay},
+		{date(2023, 12, 25), christmasDay},
+
+		{date(2024, 1, 1), newYearsDay},
+		{date(2024, 1, 15), martinLutherKingDay},
+		{date(2024, 2, 19), washingtonsBirthday},
+		{date(2024, 5, 27), memorialDay},
+		{date(2024, 6, 19), juneteenth},
+		{date(2024, 7, 4), independenceDay},
+		{date(2024, 9, 2), labourDay},
+		{date(2024, 10, 14), columbusDay},
+		{date(2024, 11, 11), veteransDay},
+		{date(2024, 11, 28), thanksgivingDay},
+		{date(2024, 12, 25), christmasDay},
+	}
+
+	for _, tt := range wellKnown {
+		verify(t, c, tt.t, 0, true, tt.s)
+	}
+
+	wellKnownWorkdays := []struct {
+		t time.Time
+		s string
+	}{
+		{date(1985, 1, 21), martinLutherKingDay},
+		{date(1975, 11, 11), veteransDay},
+		{date(2012, 10, 29), closure},
+		{date(2021, 6, 18), juneteenth},
+		{date(2021, 12, 31), newYearsDay},
+		{date(2022, 12, 23), christmasDay},
+		{date(2023, 11, 10), veteransDay},
+		{date(2024, 3, 29), goodFriday},
+		{date(2025, 1, 9), closure},
+		{date(2025, 4, 18), goodFriday},
+	}
+
+	for _, tt := range wellKnownWorkdays {
+		verify(t, c, tt.t, 0, false, tt.s)
+	}
+
+	// Fixed dates.
+	verifyFixedDateOrWeekend(t, c, 1, 1, newYearsDay, always) // New Year's Day.
+	verifyFixedDateOrWeekend(t, c, 6, 19, juneteenth, func(y int) bool { return y >= 2022 })
+	verifyFixedDateOrWeekend(t, c, 7, 4, independenceDay, always) // Independence Day.
+	verifyFixedDateOrWeekend(t, c, 11, 11, veteransDay, func(y int) bool { return y < 1971 || y > 1977 })
+	verifyFixedDateOrWeekend(t, c, 12, 25, christmasDay, always) // Christmas Day.
+
+	verifyWorkday(t, c, 3, 10)
+}
@@ -26,6 +26,18 @@ func computusFridayMonday(y, doy int) bool {
 	return doy == es-2 || doy == es+1
 }
 
+// Checks if a date is the Good Friday.
+// Returns false before 1583.
+func computusGoodFriday(y, doy int) bool {
+	es, err := computus.EasterSundayYearDay(y)
+	if err != nil {
+		// This can only happen if year is less then 1583.
+		return false
+	}
+
+	return doy == es-2
+}
+
 // Checks for Maundy Thursday, Good Friday, Easter Monday, Ascension Day, Whit (Pentecost) Monday.
 // Returns false before 1583.
 func computusMaundyFridayMondayAscensionPentecost(y, yd int) bool {
@@ -42,6 +54,21 @@ func computusMaundyFridayMondayAscensionPentecost(y, yd int) bool {
 		yd == es+50 // Whit (Pentecost) Monday, 50 days after Easter.
 }
 
+// Checks for Good Friday, Easter Monday, Ascension Day, Whit (Pentecost) Monday.
+// Returns false before 1583.
+func computusFridayMondayAscensionPentecost(y, yd int) bool {
+	es, err := computus.EasterSundayYearDay(y)
+	if err != nil {
+		// This can only happen if year is less then 1583.
+		return false
+	}
+
+	return yd == es-2 || // Good Friday.
+		yd == es+1 || // Easter Monday.
+		yd == es+39 || // Ascension Day, 39 days after Easter.
+		yd == es+50 // Whit (Pentecost) Monday, 50 days after Easter.
+}
+
 /*
 // Checks if a date is the Good Friday or Easter Monday.
 func checkEaasterFridayMonday(y, doy int) (bool, bool, error) {
@@ -37,6 +37,16 @@ const (
 	noHolidays           = "No holidays"
 	constitutionDay      = "Constitution Day"
 	commonPrayerDay      = "Common Prayer Day"
+	berchtoldsDay        = "Berchtoldstag"
+	martinLutherKingDay  = "Martin Luther King's Birthday"
+	washingtonsBirthday  = "Washington's Birthday"
+	memorialDay          = "Memorial Day"
+	juneteenth           = "Juneteenth National Independence Day"
+	thanksgivingDay      = "Thanksgiving Day"
+	electionDay          = "Presidential Election Day"
+	columbusDay          = "Columbus Day"
+	veteransDay          = "Veterans Day"
+	closure              = "Closure"
 	dateFmt              = "Mon, Jan 2, 2006"
 )
 
@@ -0,0 +1,43 @@
+package calendars
+
+//nolint:gofumpt
+import (
+	"errors"
+	"fmt"
+
+	"mbg/trading/time/holidays"
+)
+
+var errUnknownCalendar = errors.New("unknown holiday calendar")
+
+// Lookup returns the Calendarer implementing a given holiday calendar.
+//
+//nolint:exhaustive,cyclop
+func Lookup(c holidays.Calendar) (holidays.Calendarer, error) {
+	switch c {
+	case holidays.NoHolidays:
+		return NoHolidays{}, nil
+	case holidays.WeekendsOnly:
+		return WeekendsOnly{}, nil
+	case holidays.TARGET:
+		return TARGET{}, nil
+	case holidays.Euronext:
+		return EuroNext{}, nil
+	case holidays.UnitedStates:
+		return UnitedStates{}, nil
+	case holidays.Switzerland:
+		return Switzerland{}, nil
+	case holidays.Sweden:
+		return Sweden{}, nil
+	case holidays.Denmark:
+		return Denmark{}, nil
+	case holidays.Norway:
+		return Norway{}, nil
+	case holidays.Iceland:
+		return Iceland{}, nil
+	case holidays.FederalReserve:
+		return FederalReserve{}, nil
+	default:
+		return nil, fmt.Errorf("%v: %w", c, errUnknownCalendar)
+	}
+}
@@ -0,0 +1,47 @@
+//nolint:testpackage
+package calendars
+
+//nolint:gofumpt
+import (
+	"testing"
+
+	"mbg/trading/time/holidays"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c   holidays.Calendar
+		exp holidays.Calendarer
+	}{
+		{holidays.NoHolidays, NoHolidays{}},
+		{holidays.WeekendsOnly, WeekendsOnly{}},
+		{holidays.TARGET, TARGET{}},
+		{holidays.Euronext, EuroNext{}},
+		{holidays.UnitedStates, UnitedStates{}},
+		{holidays.Switzerland, Switzerland{}},
+		{holidays.Sweden, Sweden{}},
+		{holidays.Denmark, Denmark{}},
+		{holidays.Norway, Norway{}},
+		{holidays.Iceland, Iceland{}},
+		{holidays.FederalReserve, FederalReserve{}},
+	}
+
+	for _, tt := range tests {
+		act, err := Lookup(tt.c)
+		if err != nil {
+			t.Errorf("Lookup(%v): expected no error, actual %v", tt.c, err)
+		}
+
+		if act != tt.exp {
+			t.Errorf("Lookup(%v): expected %T, actual %T", tt.c, tt.exp, act)
+		}
+	}
+
+	for _, c := range []holidays.Calendar{holidays.Calendar(-1), holidays.Calendar(9999)} {
+		if _, err := Lookup(c); err == nil {
+			t.Errorf("Lookup(%v): expected error, actual nil", c)
+		}
+	}
+}
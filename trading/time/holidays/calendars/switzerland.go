@@ -0,0 +1,48 @@
+package calendars
+
+import (
+	"time"
+)
+
+// Switzerland implements a SIX Swiss Exchange holiday calendar.
+//
+// The holidays (apart from weekends) are:
+// New Year's Day, Berchtoldstag (January 2nd), Good Friday, Easter Monday,
+// Labour Day, Ascension Day, Whit (Pentecost) Monday, Swiss National Day (August 1st),
+// Christmas Eve, Christmas Day, St. Stephen's Day, New Year's Eve.
+//
+// Valid for the following ISO 10383 Market Identifier Codes:
+// XSWX, XBRN, XSCO, XSTX.
+//
+// See https://www.six-group.com/en/products-services/the-swiss-stock-exchange/trading/trading-provisions/trading-hours.html.
+type Switzerland struct{}
+
+// IsHoliday implements Calendarer interface.
+//
+//nolint:cyclop
+func (Switzerland) IsHoliday(t time.Time) bool {
+	if checkWeekend(t) {
+		return true
+	}
+
+	y, m, d := t.Date()
+
+	switch {
+	case
+		// New Year's Day, Berchtoldstag.
+		m == time.January && (d == 1 || d == 2),
+
+		// Labour Day.
+		m == time.May && d == 1,
+
+		// Swiss National Day.
+		m == time.August && d == 1,
+
+		// Christmas Eve, Christmas Day, St. Stephen's Day, New Year's Eve.
+		m == time.December && (d == 24 || d == 25 || d == 26 || d == 31):
+		return true
+	}
+
+	// Good Friday, Easter Monday, Ascension Day, Whit (Pentecost) Monday.
+	return computusFridayMondayAscensionPentecost(y, t.YearDay())
+}
@@ -0,0 +1,73 @@
+//nolint:testpackage
+package calendars
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:funlen
+func TestIsHolidaySwitzerland(t *testing.T) {
+	t.Parallel()
+
+	c := Switzerland{}
+
+	wellKnown := []struct {
+		t time.Time
+		s string
+	}{
+		{date(2019, 1, 1), newYearsDay},
+		{date(2019, 1, 2), berchtoldsDay},
+		{date(2019, 4, 19), goodFriday},
+		{date(2019, 4, 22), easterMonday},
+		{date(2019, 5, 1), labourDay},
+		{date(2019, 5, 30), ascensionDay},
+		{date(2019, 6, 10), whitMonday},
+		{date(2019, 8, 1), nationalDay},
+		{date(2019, 12, 24), christmasEve},
+		{date(2019, 12, 25), christmasDay},
+		{date(2019, 12, 26), boxingDay},
+		{date(2019, 12, 31), newYearsEve},
+
+		{date(2020, 1, 1), newYearsDay},
+		{date(2020, 1, 2), berchtoldsDay},
+		{date(2020, 4, 10), goodFriday},
+		{date(2020, 4, 13), easterMonday},
+		{date(2020, 5, 1), labourDay},
+		{date(2020, 5, 21), ascensionDay},
+		{date(2020, 6, 1), whitMonday},
+		{date(2020, 12, 24), christmasEve},
+		{date(2020, 12, 25), christmasDay},
+		{date(2020, 12, 31), newYearsEve},
+
+		{date(2021, 1, 1), newYearsDay},
+		{date(2021, 4, 2), goodFriday},
+		{date(2021, 4, 5), easterMonday},
+		{date(2021, 5, 13), ascensionDay},
+		{date(2021, 5, 24), whitMonday},
+		{date(2021, 12, 24), christmasEve},
+		{date(2021, 12, 31), newYearsEve},
+	}
+
+	for _, tt := range wellKnown {
+		verify(t, c, tt.t, 0, true, tt.s)
+	}
+
+	// Fixed dates.
+	verifyFixedDateOrWeekend(t, c, 1, 1, newYearsDay, always)    // New Year's Day.
+	verifyFixedDateOrWeekend(t, c, 1, 2, berchtoldsDay, always)  // Berchtoldstag.
+	verifyFixedDateOrWeekend(t, c, 5, 1, labourDay, always)      // Labour Day.
+	verifyFixedDateOrWeekend(t, c, 8, 1, nationalDay, always)    // Swiss National Day.
+	verifyFixedDateOrWeekend(t, c, 12, 24, christmasEve, always) // Christmas Eve.
+	verifyFixedDateOrWeekend(t, c, 12, 25, christmasDay, always) // Christmas Day.
+	verifyFixedDateOrWeekend(t, c, 12, 26, boxingDay, always)    // St. Stephen's Day.
+	verifyFixedDateOrWeekend(t, c, 12, 31, newYearsEve, always)  // New Year's Eve.
+
+	// Computus.
+	verifyComputus(t, c, -2, goodFriday, always)            // Good Friday.
+	verifyComputus(t, c, 1, easterMonday, always)           // Easter Monday.
+	verifyComputusOrWeekend(t, c, 39, ascensionDay, always) // Ascension Day, 39 days after Easter.
+	verifyComputus(t, c, 50, whitMonday, always)            // Whit (Pentecost) Monday, 50 days after Easter.
+
+	verifyWorkday(t, c, 10, 2)
+}
@@ -0,0 +1,180 @@
+package calendars
+
+import (
+	"time"
+)
+
+// UnitedStates implements a New York Stock Exchange holiday calendar.
+//
+// The holidays (apart from weekends) are:
+// New Year's Day (January 1st, moved to Monday if on Sunday),
+// Martin Luther King's Birthday (third Monday in January, since 1998),
+// Washington's Birthday (third Monday in February, February 22nd before 1971),
+// Good Friday,
+// Memorial Day (last Monday in May, May 30th before 1971),
+// Juneteenth National Independence Day (June 19th, since 2022),
+// Independence Day (July 4th),
+// Labour Day (first Monday in September),
+// Thanksgiving Day (fourth Thursday in November),
+// Presidential Election Day (every year until 1968, every four years until 1980),
+// Christmas Day (December 25th).
+//
+// Apart from New Year's Day, holidays falling on Saturday are observed on the preceding
+// Friday and holidays falling on Sunday are observed on the following Monday.
+//
+// The historic one-off closures since 1961 are included as well.
+//
+// Valid for the following ISO 10383 Market Identifier Codes:
+// XNYS, ARCX, XASE, XNAS, XCBO, BATS, IEXG.
+//
+// See https://www.nyse.com/markets/hours-calendars.
+type UnitedStates struct{}
+
+// IsHoliday implements Calendarer interface.
+//
+//nolint:cyclop,gocognit,gocyclo,gomnd
+func (UnitedStates) IsHoliday(t time.Time) bool {
+	dow := t.Weekday()
+	if dow == time.Saturday || dow == time.Sunday {
+		return true
+	}
+
+	y, m, d := t.Date()
+
+	switch m {
+	case time.January:
+		// New Year's Day (possibly moved to Monday if on Sunday).
+		if d == 1 || (d == 2 && dow == time.Monday) {
+			return true
+		}
+
+		// Martin Luther King's Birthday (third Monday in January).
+		if y >= 1998 && dow == time.Monday && d >= 15 && d <= 21 {
+			return true
+		}
+	case time.February:
+		// Washington's Birthday (third Monday in February).
+		if y >= 1971 {
+			if dow == time.Monday && d >= 15 && d <= 21 {
+				return true
+			}
+		} else if isObserved(22, d, dow) {
+			return true
+		}
+	case time.May:
+		// Memorial Day (last Monday in May).
+		if y >= 1971 {
+			if dow == time.Monday && d >= 25 {
+				return true
+			}
+		} else if isObserved(30, d, dow) {
+			return true
+		}
+	case time.June:
+		// Juneteenth National Independence Day.
+		if y >= 2022 && isObserved(19, d, dow) {
+			return true
+		}
+	case time.July:
+		// Independence Day.
+		if isObserved(4, d, dow) {
+			return true
+		}
+	case time.September:
+		// Labour Day (first Monday in September).
+		if dow == time.Monday && d <= 7 {
+			return true
+		}
+	case time.November:
+		// Thanksgiving Day (fourth Thursday in November).
+		if dow == time.Thursday && d >= 22 && d <= 28 {
+			return true
+		}
+
+		// Presidential Election Day (Tuesday after the first Monday in November).
+		if (y <= 1968 || (y <= 1980 && y%4 == 0)) && dow == time.Tuesday && d >= 2 && d <= 8 {
+			return true
+		}
+	case time.December:
+		// Christmas Day.
+		if isObserved(25, d, dow) {
+			return true
+		}
+	}
+
+	// Good Friday.
+	if computusGoodFriday(y, t.YearDay()) {
+		return true
+	}
+
+	return unitedStatesClosure(y, m, d, dow)
+}
+
+// isObserved checks if a day of month is a fixed-date holiday observed on the preceding
+// Friday when it falls on Saturday, or on the following Monday when it falls on Sunday.
+// The day of month is expected to be a weekday.
+func isObserved(holiday, d int, dow time.Weekday) bool {
+	return d == holiday ||
+		(d == holiday-1 && dow == time.Friday) ||
+		(d == holiday+1 && dow == time.Monday)
+}
+
+// unitedStatesClosure checks for historic one-off closures of the New York Stock Exchange.
+//
+//nolint:cyclop,gomnd
+func unitedStatesClosure(y int, m time.Month, d int, dow time.Weekday) bool {
+	switch y {
+	case 2025:
+		// National Day of Mourning for President Carter.
+		return m == time.January && d == 9
+	case 2018:
+		// President George H.W. Bush's funeral.
+		return m == time.December && d == 5
+	case 2012:
+		// Hurricane Sandy.
+		return m == time.October && (d == 29 || d == 30)
+	case 2007:
+		// President Ford's funeral.
+		return m == time.January && d == 2
+	case 2004:
+		// President Reagan's funeral.
+		return m == time.June && d == 11
+	case 2001:
+		// September 11-14, 2001.
+		return m == time.September && d >= 11 && d <= 14
+	case 1994:
+		// President Nixon's funeral.
+		return m == time.April && d == 27
+	case 1985:
+		// Hurricane Gloria.
+		return m == time.September && d == 27
+	case 1977:
+		// New York City blackout.
+		return m == time.July && d == 14
+	case 1973:
+		// President Johnson's funeral.
+		return m == time.January && d == 25
+	case 1972:
+		// President Truman's funeral.
+		return m == time.December && d == 28
+	case 1969:
+		// Snow, President Eisenhower's funeral, first lunar landing.
+		return (m == time.February && d == 10) || (m == time.March && d == 31) || (m == time.July && d == 21)
+	case 1968:
+		// Day of mourning for Martin Luther King, day after Independence Day,
+		// Wednesdays during the paperwork crisis from June 12th to December 31st.
+		return (m == time.April && d == 9) || (m == time.July && d == 5) ||
+			(dow == time.Wednesday && (m > time.June || (m == time.June && d >= 12)))
+	case 1965:
+		// Christmas Eve.
+		return m == time.December && d == 24
+	case 1963:
+		// President Kennedy's funeral.
+		return m == time.November && d == 25
+	case 1961:
+		// Day before Decoration Day.
+		return m == time.May && d == 29
+	default:
+		return false
+	}
+}
@@ -0,0 +1,147 @@
+//nolint:testpackage
+package calendars
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:funlen
+func TestIsHolidayUnitedStates(t *testing.T) {
+	t.Parallel()
+
+	c := UnitedStates{}
+
+	wellKnown := []struct {
+		t time.Time
+		s string
+	}{
+		{date(1963, 11, 25), closure},
+		{date(1968, 2, 22), washingtonsBirthday},
+		{date(1968, 4, 9), closure},
+		{date(1968, 5, 30), memorialDay},
+		{date(1968, 7, 5), closure},
+		{date(1968, 8, 14), closure},
+		{date(1968, 11, 5), electionDay},
+		{date(1969, 7, 21), closure},
+		{date(1976, 11, 2), electionDay},
+		{date(1977, 7, 14), closure},
+		{date(1980, 11, 4), electionDay},
+		{date(1985, 9, 27), closure},
+		{date(1994, 4, 27), closure},
+		{date(2001, 9, 11), closure},
+		{date(2001, 9, 14), closure},
+		{date(2004, 6, 11), closure},
+		{date(2007, 1, 2), closure},
+		{date(2012, 10, 29), closure},
+		{date(2012, 10, 30), closure},
+		{date(2018, 12, 5), closure},
+
+		{date(2019, 1, 1), newYearsDay},
+		{date(2019, 1, 21), martinLutherKingDay},
+		{date(2019, 2, 18), washingtonsBirthday},
+		{date(2019, 4, 19), goodFriday},
+		{date(2019, 5, 27), memorialDay},
+		{date(2019, 7, 4), independenceDay},
+		{date(2019, 9, 2), labourDay},
+		{date(2019, 11, 28), thanksgivingDay},
+		{date(2019, 12, 25), christmasDay},
+
+		{date(2020, 1, 1), newYearsDay},
+		{date(2020, 1, 20), martinLutherKingDay},
+		{date(2020, 2, 17), washingtonsBirthday},
+		{date(2020, 4, 10), goodFriday},
+		{date(2020, 5, 25), memorialDay},
+		{date(2020, 7, 3), independenceDay},
+		{date(2020, 9, 7), labourDay},
+		{date(2020, 11, 26), thanksgivingDay},
+		{date(2020, 12, 25), christmasDay},
+
+		{date(2021, 1, 1), newYearsDay},
+		{date(2021, 1, 18), martinLutherKingDay},
+		{date(2021, 2, 15), washingtonsBirthday},
+		{date(2021, 4, 2), goodFriday},
+		{date(2021, 5, 31), memorialDay},
+		{date(2021, 7, 5), independenceDay},
+		{date(2021, 9, 6), labourDay},
+		{date(2021, 11, 25), thanksgivingDay},
+		{date(2021, 12, 24), christmasDay},
+
+		{date(2022, 1, 17), martinLutherKingDay},
+		{date(2022, 2, 21), washingtonsBirthday},
+		{date(2022, 4, 15), goodFriday},
+		{date(2022, 5, 30), memorialDay},
+		{date(2022, 6, 20), juneteenth},
+		{date(2022, 7, 4), independenceDay},
+		{date(2022, 9, 5), labourDay},
+		{date(2022, 11, 24), thanksgivingDay},
+		{date(2022, 12, 26), christmasDay},
+
+		{date(2023, 1, 2), newYearsDay},
+		{date(2023, 1, 16), martinLutherKingDay},
+		{date(2023, 2, 20), washingtonsBirthday},
+		{date(2023, 4, 7), goodFriday},
+		{date(2023, 5, 29), memorialDay},
+		{date(2023, 6, 19), juneteenth},
+		{date(2023, 7, 4), independenceDay},
+		{date(2023, 9, 4), labourDay},
+		{date(2023, 11, 23), thanksgivingDay},
+		{date(2023, 12, 25), christmasDay},
+
+		{date(2024, 1, 1), newYearsDay},
+		{date(2024, 1, 15), martinLutherKingDay},
+		{date(2024, 2, 19), washingtonsBirthday},
+		{date(2024, 3, 29), goodFriday},
+		{date(2024, 5, 27), memorialDay},
+		{date(2024, 6, 19), juneteenth},
+		{date(2024, 7, 4), independenceDay},
+		{date(2024, 9, 2), labourDay},
+		{date(2024, 11, 28), thanksgivingDay},
+		{date(2024, 12, 25), christmasDay},
+
+		{date(2025, 1, 1), newYearsDay},
+		{date(2025, 1, 9), closure},
+		{date(2025, 1, 20), martinLutherKingDay},
+		{date(2025, 2, 17), washingtonsBirthday},
+		{date(2025, 4, 18), goodFriday},
+		{date(2025, 5, 26), memorialDay},
+		{date(2025, 6, 19), juneteenth},
+		{date(2025, 7, 4), independenceDay},
+		{date(2025, 9, 1), labourDay},
+		{date(2025, 11, 27), thanksgivingDay},
+		{date(2025, 12, 25), christmasDay},
+	}
+
+	for _, tt := range wellKnown {
+		verify(t, c, tt.t, 0, true, tt.s)
+	}
+
+	wellKnownWorkdays := []struct {
+		t time.Time
+		s string
+	}{
+		{date(1997, 1, 20), martinLutherKingDay},
+		{date(2010, 12, 31), newYearsDay},
+		{date(2019, 11, 5), electionDay},
+		{date(2021, 6, 18), juneteenth},
+		{date(2021, 12, 31), newYearsDay},
+		{date(2022, 6, 17), juneteenth},
+		{date(2022, 12, 23), christmasDay},
+	}
+
+	for _, tt := range wellKnownWorkdays {
+		verify(t, c, tt.t, 0, false, tt.s)
+	}
+
+	// Fixed dates.
+	verifyFixedDateOrWeekend(t, c, 1, 1, newYearsDay, always) // New Year's Day.
+	// Juneteenth since 2022, June 19th, 1968 was a paperwork crisis Wednesday.
+	verifyFixedDateOrWeekend(t, c, 6, 19, juneteenth, func(y int) bool { return y >= 2022 || y == 1968 })
+	verifyFixedDateOrWeekend(t, c, 7, 4, independenceDay, always) // Independence Day.
+	verifyFixedDateOrWeekend(t, c, 12, 25, christmasDay, always)  // Christmas Day.
+
+	// Computus.
+	verifyComputus(t, c, -2, goodFriday, always) // Good Friday.
+
+	verifyWorkday(t, c, 3, 10)
+}
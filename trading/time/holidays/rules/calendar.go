@@ -0,0 +1,204 @@
+// Package rules implements holiday calendars defined by declarative rules.
+//
+// The rules are fixed dates, n-th weekdays of a month, offsets relative to the Easter Sunday
+// and one-off closures, each one optionally shifted to an observed date when falling on a weekend
+// and limited to a range of years.
+//
+// The definitions can be loaded from JSON files, so that new exchange calendars can be added without
+// writing code, and hand-coded calendars can be cross-checked against them with the Differences function.
+package rules
+
+//nolint:gofumpt
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"mbg/trading/time/holidays"
+)
+
+// Definition is a declarative definition of a holiday calendar.
+type Definition struct {
+	// Name is a name of the calendar.
+	Name string `json:"name"`
+
+	// Weekend lists weekend days, 0 is Sunday.
+	// If empty, Saturday and Sunday are weekend days.
+	Weekend []time.Weekday `json:"weekend,omitempty"`
+
+	// Rules lists holiday rules.
+	Rules []Rule `json:"rules"`
+}
+
+// Holiday is a named holiday date.
+type Holiday struct {
+	Name string    `json:"name"`
+	Date time.Time `json:"date"`
+}
+
+// Calendar is a holiday calendar defined by rules.
+//
+// It implements the holidays.Calendarer interface.
+type Calendar struct {
+	name    string
+	weekend [daysInWeek]bool
+	rules   []Rule
+}
+
+var errNoDefinition = errors.New("definition is nil")
+
+// New creates a new calendar from a definition.
+func New(def *Definition) (*Calendar, error) {
+	const errFmt = "cannot create calendar: %w"
+
+	if def == nil {
+		return nil, fmt.Errorf(errFmt, errNoDefinition)
+	}
+
+	c := Calendar{name: def.Name, rules: make([]Rule, len(def.Rules))}
+
+	if len(def.Weekend) == 0 {
+		c.weekend[time.Saturday] = true
+		c.weekend[time.Sunday] = true
+	}
+
+	for _, wd := range def.Weekend {
+		if wd < time.Sunday || wd > time.Saturday {
+			return nil, fmt.Errorf(errFmt, fmt.Errorf("weekend %d: %w", wd, errInvalidWeekday))
+		}
+
+		c.weekend[wd] = true
+	}
+
+	for i := range def.Rules {
+		r := def.Rules[i]
+		if err := r.validate(); err != nil {
+			return nil, fmt.Errorf(errFmt, fmt.Errorf("rule %d '%s': %w", i, r.Name, err))
+		}
+
+		c.rules[i] = r
+	}
+
+	return &c, nil
+}
+
+// Load creates a new calendar from a JSON definition.
+func Load(r io.Reader) (*Calendar, error) {
+	var def Definition
+
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&def); err != nil {
+		return nil, fmt.Errorf("cannot decode calendar definition: %w", err)
+	}
+
+	return New(&def)
+}
+
+// LoadFile creates a new calendar from a JSON definition file.
+func LoadFile(name string) (*Calendar, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open calendar definition: %w", err)
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+// Name returns the name of the calendar.
+func (c *Calendar) Name() string {
+	return c.name
+}
+
+// IsHoliday implements Calendarer interface.
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	if c.weekend[t.Weekday()] {
+		return true
+	}
+
+	_, ok := c.holiday(t)
+
+	return ok
+}
+
+// HolidayName returns the name of the rule which makes a given date a holiday.
+// Returns false if the date is not a holiday or it is a weekend day not covered by any rule.
+func (c *Calendar) HolidayName(t time.Time) (string, bool) {
+	r, ok := c.holiday(t)
+	if !ok {
+		return "", false
+	}
+
+	return r.Name, true
+}
+
+// Holidays returns the observed holidays falling in a given year, sorted by date.
+// Weekend days are not included.
+func (c *Calendar) Holidays(year int) []Holiday {
+	var hs []Holiday
+
+	for i := range c.rules {
+		r := &c.rules[i]
+
+		// Observed dates can move across the year boundary.
+		for y := year - 1; y <= year+1; y++ {
+			if t, ok := r.observed(y); ok && t.Year() == year {
+				hs = append(hs, Holiday{Name: r.Name, Date: t})
+			}
+		}
+	}
+
+	sort.SliceStable(hs, func(i, j int) bool { return hs[i].Date.Before(hs[j].Date) })
+
+	return hs
+}
+
+// holiday returns the first rule observed on a given date.
+func (c *Calendar) holiday(t time.Time) (*Rule, bool) {
+	y, m, d := t.Date()
+
+	for i := range c.rules {
+		r := &c.rules[i]
+
+		if o, ok := r.observed(y); ok && o.Month() == m && o.Day() == d && o.Year() == y {
+			return r, true
+		}
+
+		// Observed dates can move across the year boundary.
+		var adj int
+
+		switch m {
+		case time.January:
+			adj = y - 1
+		case time.December:
+			adj = y + 1
+		default:
+			continue
+		}
+
+		if o, ok := r.observed(adj); ok && o.Month() == m && o.Day() == d && o.Year() == y {
+			return r, true
+		}
+	}
+
+	return nil, false
+}
+
+// Differences returns the dates from a given range (inclusive) on which two calendars disagree.
+func Differences(a, b holidays.Calendarer, from, to time.Time) []time.Time {
+	var ds []time.Time
+
+	for t := from; !t.After(to); t = t.AddDate(0, 0, 1) {
+		if a.IsHoliday(t) != b.IsHoliday(t) {
+			ds = append(ds, t)
+		}
+	}
+
+	return ds
+}
@@ -0,0 +1,232 @@
+//nolint:testpackage
+package rules
+
+//nolint:gofumpt
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"mbg/trading/time/holidays"
+	"mbg/trading/time/holidays/calendars"
+	"mbg/trading/time/holidays/rules/kinds"
+	"mbg/trading/time/holidays/rules/observances"
+)
+
+func date(y, m, d int) time.Time {
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendarIsHoliday(t *testing.T) {
+	t.Parallel()
+
+	c, err := New(&Definition{
+		Name: "test",
+		Rules: []Rule{
+			{Name: "New Year's Day", Kind: kinds.FixedDate, Month: time.January, Day: 1,
+				Observance: observances.NearestWeekday},
+			{Name: "Leap Day", Kind: kinds.FixedDate, Month: time.February, Day: 29},
+			{Name: "Third Monday", Kind: kinds.NthWeekday, Month: time.January, Weekday: time.Monday, Nth: 3},
+			{Name: "Fifth Friday", Kind: kinds.NthWeekday, Month: time.April, Weekday: time.Friday, Nth: 5},
+			{Name: "Last Monday", Kind: kinds.NthWeekday, Month: time.May, Weekday: time.Monday, Nth: -1},
+			{Name: "Good Friday", Kind: kinds.Easter, Offset: -2},
+			{Name: "Orthodox Easter Monday", Kind: kinds.OrthodoxEaster, Offset: 1},
+			{Name: "Boxing Day", Kind: kinds.FixedDate, Month: time.December, Day: 26,
+				Observance: observances.NextMonday, From: 2000, To: 2021},
+			{Name: "Storm", Kind: kinds.OneOff, Year: 2021, Month: time.March, Day: 3},
+		},
+	})
+	if err != nil {
+		t.Fatalf("New(): unexpected error %v", err)
+	}
+
+	tests := []struct {
+		t    time.Time
+		exp  bool
+		name string
+	}{
+		{date(2021, 1, 1), true, "New Year's Day"},
+		{date(2021, 12, 31), true, "New Year's Day"}, // January 1st, 2022 is Saturday.
+		{date(2023, 1, 2), true, "New Year's Day"},   // January 1st, 2023 is Sunday.
+		{date(2024, 2, 29), true, "Leap Day"},
+		{date(2021, 1, 18), true, "Third Monday"},
+		{date(2021, 1, 11), false, ""},
+		{date(2021, 4, 30), true, "Fifth Friday"},
+		{date(2022, 4, 29), true, "Fifth Friday"},
+		{date(2021, 5, 31), true, "Last Monday"},
+		{date(2021, 5, 24), false, ""},
+		{date(2021, 4, 2), true, "Good Friday"},
+		{date(2021, 5, 3), true, "Orthodox Easter Monday"},
+		{date(2021, 12, 27), true, "Boxing Day"}, // Sunday.
+		{date(2020, 12, 28), true, "Boxing Day"}, // Saturday.
+		{date(2022, 12, 26), false, ""},          // After the last year.
+		{date(2021, 3, 3), true, "Storm"},
+		{date(2022, 3, 3), false, ""},
+		{date(2021, 6, 12), true, ""}, // Saturday.
+	}
+
+	for _, tt := range tests {
+		if act := c.IsHoliday(tt.t); act != tt.exp {
+			t.Errorf("IsHoliday(%v): expected %v, actual %v", tt.t, tt.exp, act)
+		}
+
+		if name, _ := c.HolidayName(tt.t); name != tt.name {
+			t.Errorf("HolidayName(%v): expected '%v', actual '%v'", tt.t, tt.name, name)
+		}
+	}
+
+	if n := c.Name(); n != "test" {
+		t.Errorf("Name(): expected 'test', actual '%v'", n)
+	}
+}
+
+func TestCalendarHolidays(t *testing.T) {
+	t.Parallel()
+
+	c, err := New(&Definition{
+		Rules: []Rule{
+			{Name: "Christmas Day", Kind: kinds.FixedDate, Month: time.December, Day: 25},
+			{Name: "New Year's Day", Kind: kinds.FixedDate, Month: time.January, Day: 1,
+				Observance: observances.NearestWeekday},
+			{Name: "Good Friday", Kind: kinds.Easter, Offset: -2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("New(): unexpected error %v", err)
+	}
+
+	exp := []Holiday{
+		{"Good Friday", date(2021, 4, 2)},
+		{"Christmas Day", date(2021, 12, 25)},
+		{"New Year's Day", date(2021, 12, 31)},
+	}
+
+	// New Year's Day 2021 is Friday and is a holiday as well.
+	hs := c.Holidays(2021)
+	if len(hs) != len(exp)+1 {
+		t.Fatalf("Holidays(2021): expected %v holidays, actual %v", len(exp)+1, hs)
+	}
+
+	for i, e := range exp {
+		if h := hs[i+1]; h.Name != e.Name || !h.Date.Equal(e.Date) {
+			t.Errorf("Holidays(2021)[%v]: expected %v, actual %v", i+1, e, h)
+		}
+	}
+}
+
+func TestCalendarWeekend(t *testing.T) {
+	t.Parallel()
+
+	c, err := New(&Definition{Weekend: []time.Weekday{time.Friday, time.Saturday}})
+	if err != nil {
+		t.Fatalf("New(): unexpected error %v", err)
+	}
+
+	if !c.IsHoliday(date(2021, 6, 11)) || !c.IsHoliday(date(2021, 6, 12)) || c.IsHoliday(date(2021, 6, 13)) {
+		t.Error("IsHoliday(): expected Friday and Saturday weekend")
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r   Rule
+		err error
+	}{
+		{Rule{}, errUnknownKind},
+		{Rule{Kind: kinds.Easter, Observance: observances.Observance(99)}, errUnknownObservance},
+		{Rule{Kind: kinds.Easter, From: 2021, To: 2020}, errInvalidYears},
+		{Rule{Kind: kinds.FixedDate, Month: time.February, Day: 30}, errInvalidDate},
+		{Rule{Kind: kinds.FixedDate, Month: 13, Day: 1}, errInvalidDate},
+		{Rule{Kind: kinds.NthWeekday, Month: 0, Nth: 1}, errInvalidDate},
+		{Rule{Kind: kinds.NthWeekday, Month: 1, Weekday: 7, Nth: 1}, errInvalidWeekday},
+		{Rule{Kind: kinds.NthWeekday, Month: 1, Nth: 0}, errInvalidNth},
+		{Rule{Kind: kinds.NthWeekday, Month: 1, Nth: 6}, errInvalidNth},
+		{Rule{Kind: kinds.OneOff, Month: 1, Day: 1}, errMissingYear},
+		{Rule{Kind: kinds.OneOff, Year: 2021, Month: 2, Day: 29}, errInvalidDate},
+	}
+
+	for _, tt := range tests {
+		if _, err := New(&Definition{Rules: []Rule{tt.r}}); !errors.Is(err, tt.err) {
+			t.Errorf("New(%+v): expected error %v, actual %v", tt.r, tt.err, err)
+		}
+	}
+
+	if _, err := New(nil); !errors.Is(err, errNoDefinition) {
+		t.Errorf("New(nil): expected error %v, actual %v", errNoDefinition, err)
+	}
+
+	if _, err := New(&Definition{Weekend: []time.Weekday{8}}); !errors.Is(err, errInvalidWeekday) {
+		t.Errorf("New(weekend 8): expected error %v, actual %v", errInvalidWeekday, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	const def = `{"name": "x", "rules": [
+		{"name": "Labour Day", "kind": "nthWeekday", "month": 9, "weekday": 1, "nth": 1},
+		{"name": "Independence Day", "kind": "fixedDate", "month": 7, "day": 4, "observance": "nearestWeekday"}
+	]}`
+
+	c, err := Load(strings.NewReader(def))
+	if err != nil {
+		t.Fatalf("Load(): unexpected error %v", err)
+	}
+
+	if !c.IsHoliday(date(2021, 9, 6)) || !c.IsHoliday(date(2021, 7, 5)) || c.IsHoliday(date(2021, 7, 6)) {
+		t.Error("Load(): unexpected holidays")
+	}
+
+	for _, s := range []string{
+		`{"rules": [{"name": "x", "kind": "unknownKind"}]}`,
+		`{"rules": [{"name": "x", "kind": "easter", "extra": 1}]}`,
+		`{"rules": [{"name": "x", "kind": "fixedDate", "month": 2, "day": 31}]}`,
+		`{`,
+	} {
+		if _, err := Load(strings.NewReader(s)); err == nil {
+			t.Errorf("Load(%v): expected error, actual nil", s)
+		}
+	}
+
+	if _, err := LoadFile("definitions/no_such_file.json"); err == nil {
+		t.Error("LoadFile(): expected error for a missing file, actual nil")
+	}
+}
+
+func TestDefinitionsMatchCalendars(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		file     string
+		cal      holidays.Calendarer
+		from, to time.Time
+	}{
+		{"definitions/united_states.json", calendars.UnitedStates{}, date(1969, 1, 1), date(2100, 12, 31)},
+		{"definitions/switzerland.json", calendars.Switzerland{}, date(1600, 1, 1), date(2100, 12, 31)},
+	}
+
+	for _, tt := range tests {
+		c, err := LoadFile(tt.file)
+		if err != nil {
+			t.Errorf("LoadFile(%v): unexpected error %v", tt.file, err)
+
+			continue
+		}
+
+		if ds := Differences(c, tt.cal, tt.from, tt.to); len(ds) != 0 {
+			t.Errorf("%v: expected no differences, actual %v", tt.file, ds)
+		}
+	}
+}
+
+func TestDifferences(t *testing.T) {
+	t.Parallel()
+
+	ds := Differences(calendars.WeekendsOnly{}, calendars.NoHolidays{}, date(2021, 6, 9), date(2021, 6, 13))
+	if len(ds) != 2 || !ds[0].Equal(date(2021, 6, 12)) || !ds[1].Equal(date(2021, 6, 13)) {
+		t.Errorf("Differences(): expected June 12th and 13th, actual %v", ds)
+	}
+}
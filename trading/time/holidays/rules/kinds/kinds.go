@@ -0,0 +1,103 @@
+// Package kinds enumerates kinds of holiday rules.
+package kinds
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Kind enumerates kinds of holiday rules.
+type Kind int
+
+const (
+	// FixedDate is a holiday on the same month and day every year.
+	FixedDate Kind = iota + 1
+
+	// NthWeekday is a holiday on the n-th (or the last) weekday of a month, e.g. the third Monday in January.
+	NthWeekday
+
+	// Easter is a holiday on a fixed number of days relative to the Western Easter Sunday.
+	Easter
+
+	// OrthodoxEaster is a holiday on a fixed number of days relative to the Orthodox Easter Sunday.
+	OrthodoxEaster
+
+	// OneOff is a single non-recurring closure on a given date.
+	OneOff
+	last
+)
+
+const (
+	unknown        = "unknown"
+	fixedDate      = "fixedDate"
+	nthWeekday     = "nthWeekday"
+	easter         = "easter"
+	orthodoxEaster = "orthodoxEaster"
+	oneOff         = "oneOff"
+)
+
+var errUnknownKind = errors.New("unknown holiday rule kind")
+
+// String implements the fmt.Stringer interface.
+func (k Kind) String() string {
+	switch k {
+	case FixedDate:
+		return fixedDate
+	case NthWeekday:
+		return nthWeekday
+	case Easter:
+		return easter
+	case OrthodoxEaster:
+		return orthodoxEaster
+	case OneOff:
+		return oneOff
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this holiday rule kind is known.
+func (k Kind) IsKnown() bool {
+	return k >= FixedDate && k < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (k Kind) MarshalJSON() ([]byte, error) {
+	str := k.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownKind)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (k *Kind) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case fixedDate:
+		*k = FixedDate
+	case nthWeekday:
+		*k = NthWeekday
+	case easter:
+		*k = Easter
+	case orthodoxEaster:
+		*k = OrthodoxEaster
+	case oneOff:
+		*k = OneOff
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownKind)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+//nolint:testpackage
+package kinds
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := OneOff
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := OneOff
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var k Kind
+
+	bs := []byte("\"oneOff\"")
+	for i := 0; i < b.N; i++ {
+		_ = k.UnmarshalJSON(bs)
+	}
+}
@@ -0,0 +1,148 @@
+//nolint:testpackage
+package kinds
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		k    Kind
+		text string
+	}{
+		{FixedDate, fixedDate},
+		{NthWeekday, nthWeekday},
+		{Easter, easter},
+		{OrthodoxEaster, orthodoxEaster},
+		{OneOff, oneOff},
+		{last, unknown},
+		{Kind(0), unknown},
+		{Kind(9999), unknown},
+		{Kind(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.k.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.k, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		k       Kind
+		boolean bool
+	}{
+		{FixedDate, true},
+		{NthWeekday, true},
+		{Easter, true},
+		{OrthodoxEaster, true},
+		{OneOff, true},
+		{last, false},
+		{Kind(0), false},
+		{Kind(9999), false},
+		{Kind(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.k.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.k, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		k         Kind
+		json      string
+		succeeded bool
+	}{
+		{FixedDate, "\"fixedDate\"", true},
+		{NthWeekday, "\"nthWeekday\"", true},
+		{Easter, "\"easter\"", true},
+		{OrthodoxEaster, "\"orthodoxEaster\"", true},
+		{OneOff, "\"oneOff\"", true},
+		{last, nilstr, false},
+		{Kind(9999), nilstr, false},
+		{Kind(-9999), nilstr, false},
+		{Kind(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.k.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.k, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.k)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.k, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Kind
+	tests := []struct {
+		k         Kind
+		json      string
+		succeeded bool
+	}{
+		{FixedDate, "\"fixedDate\"", true},
+		{NthWeekday, "\"nthWeekday\"", true},
+		{Easter, "\"easter\"", true},
+		{OrthodoxEaster, "\"orthodoxEaster\"", true},
+		{OneOff, "\"oneOff\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.k
+		bs := []byte(tt.json)
+
+		var k Kind
+
+		err := k.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != k {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, k)
+		}
+	}
+}
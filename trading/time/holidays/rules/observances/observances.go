@@ -0,0 +1,96 @@
+// Package observances enumerates rules to shift a holiday falling on a weekend to an observed date.
+package observances
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Observance enumerates rules to shift a holiday falling on a weekend to an observed date.
+type Observance int
+
+const (
+	// Actual observes a holiday on its actual date, a holiday falling on a weekend is lost.
+	Actual Observance = iota + 1
+
+	// SundayToMonday observes a holiday falling on Sunday on the following Monday.
+	SundayToMonday
+
+	// NearestWeekday observes a holiday falling on Saturday on the preceding Friday
+	// and a holiday falling on Sunday on the following Monday.
+	NearestWeekday
+
+	// NextMonday observes a holiday falling on Saturday or Sunday on the following Monday.
+	NextMonday
+	last
+)
+
+const (
+	unknown        = "unknown"
+	actual         = "actual"
+	sundayToMonday = "sundayToMonday"
+	nearestWeekday = "nearestWeekday"
+	nextMonday     = "nextMonday"
+)
+
+var errUnknownObservance = errors.New("unknown holiday observance")
+
+// String implements the fmt.Stringer interface.
+func (o Observance) String() string {
+	switch o {
+	case Actual:
+		return actual
+	case SundayToMonday:
+		return sundayToMonday
+	case NearestWeekday:
+		return nearestWeekday
+	case NextMonday:
+		return nextMonday
+	default:
+		return unknown
+	}
+}
+
+// IsKnown determines if this holiday observance is known.
+func (o Observance) IsKnown() bool {
+	return o >= Actual && o < last
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (o Observance) MarshalJSON() ([]byte, error) {
+	str := o.String()
+	if str == unknown {
+		return nil, fmt.Errorf("cannot marshal '%s': %w", str, errUnknownObservance)
+	}
+
+	const extra = 2 // Two bytes for quotes.
+
+	b := make([]byte, 0, len(str)+extra)
+	b = append(b, '"')
+	b = append(b, str...)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+func (o *Observance) UnmarshalJSON(data []byte) error {
+	d := bytes.Trim(data, "\"")
+	str := string(d)
+
+	switch str {
+	case actual:
+		*o = Actual
+	case sundayToMonday:
+		*o = SundayToMonday
+	case nearestWeekday:
+		*o = NearestWeekday
+	case nextMonday:
+		*o = NextMonday
+	default:
+		return fmt.Errorf("cannot unmarshal '%s': %w", str, errUnknownObservance)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+//nolint:testpackage
+package observances
+
+import (
+	"testing"
+)
+
+func BenchmarkString(b *testing.B) {
+	act := NextMonday
+	for i := 0; i < b.N; i++ {
+		_ = act.String()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	act := NextMonday
+	for i := 0; i < b.N; i++ {
+		_, _ = act.MarshalJSON()
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var o Observance
+
+	bs := []byte("\"nextMonday\"")
+	for i := 0; i < b.N; i++ {
+		_ = o.UnmarshalJSON(bs)
+	}
+}
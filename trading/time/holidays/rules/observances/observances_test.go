@@ -0,0 +1,144 @@
+//nolint:testpackage
+package observances
+
+import (
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		o    Observance
+		text string
+	}{
+		{Actual, actual},
+		{SundayToMonday, sundayToMonday},
+		{NearestWeekday, nearestWeekday},
+		{NextMonday, nextMonday},
+		{last, unknown},
+		{Observance(0), unknown},
+		{Observance(9999), unknown},
+		{Observance(-9999), unknown},
+	}
+
+	for _, tt := range tests {
+		exp := tt.text
+		act := tt.o.String()
+
+		if exp != act {
+			t.Errorf("'%v'.String(): expected '%v', actual '%v'", tt.o, exp, act)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		o       Observance
+		boolean bool
+	}{
+		{Actual, true},
+		{SundayToMonday, true},
+		{NearestWeekday, true},
+		{NextMonday, true},
+		{last, false},
+		{Observance(0), false},
+		{Observance(9999), false},
+		{Observance(-9999), false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.boolean
+		act := tt.o.IsKnown()
+
+		if exp != act {
+			t.Errorf("'%v'.IsKnown(): expected '%v', actual '%v'", tt.o, exp, act)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var nilstr string
+	tests := []struct {
+		o         Observance
+		json      string
+		succeeded bool
+	}{
+		{Actual, "\"actual\"", true},
+		{SundayToMonday, "\"sundayToMonday\"", true},
+		{NearestWeekday, "\"nearestWeekday\"", true},
+		{NextMonday, "\"nextMonday\"", true},
+		{last, nilstr, false},
+		{Observance(9999), nilstr, false},
+		{Observance(-9999), nilstr, false},
+		{Observance(0), nilstr, false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.json
+		bs, err := tt.o.MarshalJSON()
+
+		if err != nil && tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected success '%v', got error %v", tt.o, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("'%v'.MarshalJSON(): expected error, got success", tt.o)
+
+			continue
+		}
+
+		act := string(bs)
+		if exp != act {
+			t.Errorf("'%v'.MarshalJSON(): expected '%v', actual '%v'", tt.o, exp, act)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var zero Observance
+	tests := []struct {
+		o         Observance
+		json      string
+		succeeded bool
+	}{
+		{Actual, "\"actual\"", true},
+		{SundayToMonday, "\"sundayToMonday\"", true},
+		{NearestWeekday, "\"nearestWeekday\"", true},
+		{NextMonday, "\"nextMonday\"", true},
+		{zero, "\"unknown\"", false},
+		{zero, "\"foobar\"", false},
+	}
+
+	for _, tt := range tests {
+		exp := tt.o
+		bs := []byte(tt.json)
+
+		var o Observance
+
+		err := o.UnmarshalJSON(bs)
+		if err != nil && tt.succeeded {
+			t.Errorf("UnmarshalJSON('%v'): expected success '%v', got error %v", tt.json, exp, err)
+
+			continue
+		}
+
+		if err == nil && !tt.succeeded {
+			t.Errorf("MarshalJSON('%v'): expected error, got success", tt.json)
+
+			continue
+		}
+
+		if exp != o {
+			t.Errorf("MarshalJSON('%v'): expected '%v', actual '%v'", tt.json, exp, o)
+		}
+	}
+}
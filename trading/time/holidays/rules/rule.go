@@ -0,0 +1,222 @@
+package rules
+
+//nolint:gofumpt
+import (
+	"errors"
+	"time"
+
+	"mbg/trading/time/computus"
+	"mbg/trading/time/holidays/rules/kinds"
+	"mbg/trading/time/holidays/rules/observances"
+)
+
+// Rule is a declarative definition of a holiday.
+type Rule struct {
+	// Name is a human-readable name of the holiday.
+	Name string `json:"name"`
+
+	// Kind is a kind of the rule.
+	Kind kinds.Kind `json:"kind"`
+
+	// Year is a year of a OneOff closure.
+	Year int `json:"year,omitempty"`
+
+	// Month is a month of a FixedDate, NthWeekday or OneOff rule, 1 is January.
+	Month time.Month `json:"month,omitempty"`
+
+	// Day is a day of month of a FixedDate or OneOff rule.
+	Day int `json:"day,omitempty"`
+
+	// Weekday is a weekday of a NthWeekday rule, 0 is Sunday.
+	Weekday time.Weekday `json:"weekday,omitempty"`
+
+	// Nth is an ordinal of a weekday in a month of a NthWeekday rule, from 1 to 5.
+	// The value -1 denotes the last weekday of a month.
+	Nth int `json:"nth,omitempty"`
+
+	// Offset is a number of days relative to the Easter Sunday of an Easter or OrthodoxEaster rule.
+	// For instance, Good Friday is -2 and Easter Monday is 1.
+	Offset int `json:"offset,omitempty"`
+
+	// Observance shifts a holiday falling on a weekend to an observed date.
+	// If zero, the holiday is observed on its actual date.
+	Observance observances.Observance `json:"observance,omitempty"`
+
+	// From is the first year the rule is valid for, inclusive. Zero means no lower bound.
+	From int `json:"from,omitempty"`
+
+	// To is the last year the rule is valid for, inclusive. Zero means no upper bound.
+	To int `json:"to,omitempty"`
+}
+
+const (
+	daysInWeek = 7
+	lastNth    = -1
+	maxNth     = 5
+)
+
+var (
+	errUnknownKind       = errors.New("unknown kind")
+	errUnknownObservance = errors.New("unknown observance")
+	errInvalidDate       = errors.New("invalid month or day")
+	errInvalidWeekday    = errors.New("invalid weekday")
+	errInvalidNth        = errors.New("nth should be from 1 to 5 or -1")
+	errInvalidYears      = errors.New("from year should not be after to year")
+	errMissingYear       = errors.New("one-off closure should have a year")
+)
+
+//nolint:cyclop
+// validate checks if the rule is consistent.
+func (r *Rule) validate() error {
+	if !r.Kind.IsKnown() {
+		return errUnknownKind
+	}
+
+	if r.Observance != 0 && !r.Observance.IsKnown() {
+		return errUnknownObservance
+	}
+
+	if r.From != 0 && r.To != 0 && r.From > r.To {
+		return errInvalidYears
+	}
+
+	switch r.Kind {
+	case kinds.FixedDate:
+		// February 29th is allowed and happens on leap years only.
+		if !isValidDate(2000, r.Month, r.Day) {
+			return errInvalidDate
+		}
+	case kinds.NthWeekday:
+		if r.Month < time.January || r.Month > time.December {
+			return errInvalidDate
+		}
+
+		if r.Weekday < time.Sunday || r.Weekday > time.Saturday {
+			return errInvalidWeekday
+		}
+
+		if r.Nth != lastNth && (r.Nth < 1 || r.Nth > maxNth) {
+			return errInvalidNth
+		}
+	case kinds.OneOff:
+		if r.Year == 0 {
+			return errMissingYear
+		}
+
+		if !isValidDate(r.Year, r.Month, r.Day) {
+			return errInvalidDate
+		}
+	case kinds.Easter, kinds.OrthodoxEaster:
+	}
+
+	return nil
+}
+
+// isValid checks if the rule is valid for a given year.
+func (r *Rule) isValid(y int) bool {
+	return (r.From == 0 || y >= r.From) && (r.To == 0 || y <= r.To)
+}
+
+// date returns the actual date of the holiday in a given year in UTC.
+// Returns false if there is no holiday in this year.
+func (r *Rule) date(y int) (time.Time, bool) {
+	if !r.isValid(y) {
+		return time.Time{}, false
+	}
+
+	switch r.Kind {
+	case kinds.FixedDate:
+		if !isValidDate(y, r.Month, r.Day) {
+			return time.Time{}, false
+		}
+
+		return time.Date(y, r.Month, r.Day, 0, 0, 0, 0, time.UTC), true
+	case kinds.NthWeekday:
+		return nthWeekday(y, r.Month, r.Weekday, r.Nth)
+	case kinds.Easter:
+		return easter(y, r.Offset, computus.EasterSunday)
+	case kinds.OrthodoxEaster:
+		return easter(y, r.Offset, computus.OrthodoxEasterSunday)
+	case kinds.OneOff:
+		if y != r.Year {
+			return time.Time{}, false
+		}
+
+		return time.Date(y, r.Month, r.Day, 0, 0, 0, 0, time.UTC), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// observed returns the observed date of the holiday in a given year in UTC.
+// Returns false if there is no holiday in this year.
+func (r *Rule) observed(y int) (time.Time, bool) {
+	t, ok := r.date(y)
+	if !ok {
+		return t, false
+	}
+
+	switch wd := t.Weekday(); r.Observance {
+	case observances.SundayToMonday:
+		if wd == time.Sunday {
+			return t.AddDate(0, 0, 1), true
+		}
+	case observances.NearestWeekday:
+		if wd == time.Saturday {
+			return t.AddDate(0, 0, -1), true
+		} else if wd == time.Sunday {
+			return t.AddDate(0, 0, 1), true
+		}
+	case observances.NextMonday:
+		if wd == time.Saturday {
+			return t.AddDate(0, 0, 2), true
+		} else if wd == time.Sunday {
+			return t.AddDate(0, 0, 1), true
+		}
+	case observances.Actual:
+	}
+
+	return t, true
+}
+
+func isValidDate(y int, m time.Month, d int) bool {
+	if m < time.January || m > time.December || d < 1 {
+		return false
+	}
+
+	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	return t.Month() == m && t.Day() == d
+}
+
+func nthWeekday(y int, m time.Month, wd time.Weekday, nth int) (time.Time, bool) {
+	if nth == lastNth {
+		// The last day of the month.
+		t := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC)
+		d := (int(t.Weekday()) - int(wd) + daysInWeek) % daysInWeek
+
+		return t.AddDate(0, 0, -d), true
+	}
+
+	t := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	d := (int(wd)-int(t.Weekday())+daysInWeek)%daysInWeek + (nth-1)*daysInWeek
+
+	t = t.AddDate(0, 0, d)
+	if t.Month() != m {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+func easter(y, offset int, f func(int) (time.Time, error)) (time.Time, bool) {
+	t, err := f(y)
+	if err != nil {
+		// This can only happen if the year is out of the supported range.
+		return time.Time{}, false
+	}
+
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d+offset, 0, 0, 0, 0, time.UTC), true
+}
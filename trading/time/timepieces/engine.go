@@ -0,0 +1,231 @@
+package timepieces
+
+import (
+	"container/heap"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// reminder is a named action scheduled at an absolute time.
+type reminder struct {
+	name   string
+	action func()
+	at     time.Time
+	seq    int64
+}
+
+// reminderQueue is a min-heap of reminders ordered by time and then by the order of addition.
+type reminderQueue []*reminder
+
+func (q reminderQueue) Len() int { return len(q) }
+
+func (q reminderQueue) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].seq < q[j].seq
+	}
+
+	return q[i].at.Before(q[j].at)
+}
+
+func (q reminderQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *reminderQueue) Push(x any) { *q = append(*q, x.(*reminder)) } //nolint:forcetypeassert
+
+func (q *reminderQueue) Pop() any {
+	old := *q
+	n := len(old) - 1
+	r := old[n]
+	old[n] = nil
+	*q = old[:n]
+
+	return r
+}
+
+// dayEvent is a new day, a session start or a session end event.
+type dayEvent struct {
+	at     time.Time
+	notify func(time.Time)
+}
+
+// engine keeps the current time of a timepiece and fires the day events and the reminders
+// in chronological order when the time advances. Day events fire before reminders due at the same time,
+// reminders due at the same time fire in the order they were added.
+type engine struct {
+	mu        sync.Mutex
+	params    Params
+	now       time.Time
+	day       time.Time
+	events    []dayEvent
+	next      int
+	reminders reminderQueue
+	seq       int64
+}
+
+func (e *engine) init(p *Params, now time.Time) {
+	if p != nil {
+		e.params = *p
+	}
+
+	if e.params.Location == nil {
+		e.params.Location = time.UTC
+	}
+
+	e.now = now.In(e.params.Location)
+	e.setDay(e.now)
+
+	// The events at or before the initial time are considered past.
+	for e.next < len(e.events) && !e.events[e.next].at.After(e.now) {
+		e.next++
+	}
+}
+
+// setDay computes the day events of the day containing a given time.
+func (e *engine) setDay(t time.Time) {
+	y, m, d := t.Date()
+	p := &e.params
+
+	e.day = time.Date(y, m, d, 0, 0, 0, 0, p.Location)
+	e.events = append(e.events[:0], dayEvent{e.day, p.OnNewDay})
+	e.next = 0
+
+	if p.SessionEnd > p.SessionStart && (p.Calendar == nil || !p.Calendar.IsHoliday(e.day)) {
+		e.events = append(e.events,
+			dayEvent{wallClock(y, m, d, p.SessionStart, p.Location), p.OnSessionStart},
+			dayEvent{wallClock(y, m, d, p.SessionEnd, p.Location), p.OnSessionEnd})
+	}
+}
+
+// nextDayEvent returns the next day event, moving to the next day if all events of the current day are past.
+func (e *engine) nextDayEvent() dayEvent {
+	if e.next >= len(e.events) {
+		y, m, d := e.day.Date()
+		e.setDay(time.Date(y, m, d+1, 0, 0, 0, 0, e.params.Location))
+	}
+
+	return e.events[e.next]
+}
+
+// nextAt returns the time of the next event.
+func (e *engine) nextAt() time.Time {
+	at := e.nextDayEvent().at
+	if len(e.reminders) > 0 && e.reminders[0].at.Before(at) {
+		at = e.reminders[0].at
+	}
+
+	return at
+}
+
+// pop removes the next event due at or before a given time, returning its time and the action to execute.
+func (e *engine) pop(limit time.Time) (time.Time, func(), bool) {
+	de := e.nextDayEvent()
+
+	if len(e.reminders) > 0 && e.reminders[0].at.Before(de.at) {
+		if r := e.reminders[0]; !r.at.After(limit) {
+			heap.Pop(&e.reminders)
+
+			return r.at, r.action, true
+		}
+
+		return time.Time{}, nil, false
+	}
+
+	if de.at.After(limit) {
+		return time.Time{}, nil, false
+	}
+
+	e.next++
+
+	if de.notify == nil {
+		return de.at, nil, true
+	}
+
+	return de.at, func() { de.notify(de.at) }, true
+}
+
+// advance fires all events due at or before a given time in chronological order.
+// The actions are executed without holding the lock, so they can add or remove reminders.
+func (e *engine) advance(t time.Time) {
+	t = t.In(e.params.Location)
+
+	for {
+		e.mu.Lock()
+
+		at, action, ok := e.pop(t)
+		if !ok {
+			if t.After(e.now) {
+				e.now = t
+			}
+
+			e.mu.Unlock()
+
+			return
+		}
+
+		if at.After(e.now) {
+			e.now = at
+		}
+
+		e.mu.Unlock()
+
+		if action != nil {
+			action()
+		}
+	}
+}
+
+func (e *engine) current() time.Time {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.now
+}
+
+func (e *engine) isHoliday(t time.Time) bool {
+	return e.params.Calendar != nil && e.params.Calendar.IsHoliday(t)
+}
+
+// AddReminder adds a reminder action at a given absolute time.
+// A reminder added at a past time fires on the next time advance.
+func (e *engine) AddReminder(name string, action func(), t time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.seq++
+	heap.Push(&e.reminders, &reminder{name: name, action: action, at: t, seq: e.seq})
+}
+
+// RemoveReminder removes a first occurrence of a not executed reminder action.
+// The actions are matched by the name and by the code pointer of the function,
+// so closures created by the same function literal are indistinguishable.
+func (e *engine) RemoveReminder(name string, action func()) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ptr := funcPointer(action)
+	first := -1
+
+	for i, r := range e.reminders {
+		if r.name == name && funcPointer(r.action) == ptr && (first < 0 || e.reminders.Less(i, first)) {
+			first = i
+		}
+	}
+
+	if first >= 0 {
+		heap.Remove(&e.reminders, first)
+	}
+}
+
+func funcPointer(f func()) uintptr {
+	if f == nil {
+		return 0
+	}
+
+	return reflect.ValueOf(f).Pointer()
+}
+
+// wallClock returns the time at a given offset from midnight as read on a wall clock,
+// so that daylight saving time transitions do not shift it.
+func wallClock(y int, m time.Month, d int, offset time.Duration, loc *time.Location) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, int(offset), loc)
+}
@@ -0,0 +1,34 @@
+package timepieces
+
+//nolint:gofumpt
+import (
+	"time"
+
+	"mbg/trading/time/holidays"
+)
+
+// Params describes the trading day of a timepiece and the callbacks notified about its events.
+type Params struct {
+	// Calendar is a holiday calendar, there are no session events on holidays.
+	// If nil, every day is a business day.
+	Calendar holidays.Calendarer
+
+	// Location is a time zone of the trading day. If nil, UTC is used.
+	Location *time.Location
+
+	// SessionStart is an offset from midnight of the beginning of the day session.
+	SessionStart time.Duration
+
+	// SessionEnd is an offset from midnight of the end of the day session.
+	// If it is not after the SessionStart, there are no session events.
+	SessionEnd time.Duration
+
+	// OnNewDay, if not nil, is notified at the beginning of every day, including holidays.
+	OnNewDay func(time.Time)
+
+	// OnSessionStart, if not nil, is notified at the beginning of the day session on business days.
+	OnSessionStart func(time.Time)
+
+	// OnSessionEnd, if not nil, is notified at the end of the day session on business days.
+	OnSessionEnd func(time.Time)
+}
@@ -1,11 +1,97 @@
 package timepieces
 
 import (
+	"sync"
 	"time"
 )
 
-// RealtimeTimepiece is a local real-time timepiece.
+// RealtimeTimepiece is a local real-time timepiece backed by the wall clock.
+//
+// The day events and the reminders are fired on a separate goroutine when they become due.
 type RealtimeTimepiece struct {
-	// Now gets the current date and time.
-	now time.Time
+	engine
+	tick    sync.Mutex
+	timer   *time.Timer
+	stopped bool
+}
+
+// NewRealtimeTimepiece creates a new real-time timepiece and starts firing its events.
+// The events due before the creation are not fired.
+func NewRealtimeTimepiece(p *Params) *RealtimeTimepiece {
+	r := &RealtimeTimepiece{}
+	r.init(p, time.Now())
+	r.schedule()
+
+	return r
+}
+
+// Now gets the current date and time.
+func (r *RealtimeTimepiece) Now() time.Time {
+	return time.Now().In(r.params.Location)
+}
+
+// IsHoliday indicates whether the current date is weekend or a holiday.
+func (r *RealtimeTimepiece) IsHoliday() bool {
+	return r.isHoliday(r.Now())
+}
+
+// AddReminder adds a reminder action at a given absolute time.
+// A reminder added at a past time fires immediately.
+func (r *RealtimeTimepiece) AddReminder(name string, action func(), t time.Time) {
+	r.engine.AddReminder(name, action, t)
+	r.schedule()
+}
+
+// Stop stops firing the events. It does not wait for the currently executing actions.
+func (r *RealtimeTimepiece) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stopped = true
+
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+}
+
+// schedule arms the timer to the time of the next event.
+func (r *RealtimeTimepiece) schedule() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopped {
+		return
+	}
+
+	d := time.Until(r.nextAt())
+
+	if r.timer == nil {
+		r.timer = time.AfterFunc(d, r.fire)
+	} else {
+		r.timer.Reset(d)
+	}
+}
+
+// fire executes the due events, the mutex guarantees their chronological order.
+// A stopped timepiece fires nothing, even if the timer has already expired.
+func (r *RealtimeTimepiece) fire() {
+	r.tick.Lock()
+
+	if r.isStopped() {
+		r.tick.Unlock()
+
+		return
+	}
+
+	r.advance(time.Now())
+	r.tick.Unlock()
+
+	r.schedule()
+}
+
+func (r *RealtimeTimepiece) isStopped() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.stopped
 }
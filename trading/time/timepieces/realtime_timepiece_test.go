@@ -0,0 +1,101 @@
+//nolint:testpackage
+package timepieces
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRealtimeTimepiece(t *testing.T) {
+	t.Parallel()
+
+	r := NewRealtimeTimepiece(nil)
+	defer r.Stop()
+
+	var (
+		mu    sync.Mutex
+		order []string
+		wg    sync.WaitGroup
+	)
+
+	add := func(name string, at time.Time) {
+		r.AddReminder(name, func() {
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			wg.Done()
+		}, at)
+	}
+
+	now := r.Now()
+
+	wg.Add(3)
+	add("second", now.Add(40*time.Millisecond))
+	add("first", now.Add(20*time.Millisecond))
+	add("past", now.Add(-time.Hour))
+
+	removed := func() { t.Error("removed reminder fired") }
+	r.AddReminder("removed", removed, now.Add(10*time.Millisecond))
+	r.RemoveReminder("removed", removed)
+
+	done := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reminders did not fire")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(order) != 3 || order[0] != "past" || order[1] != "first" || order[2] != "second" {
+		t.Errorf("expected [past first second], actual %v", order)
+	}
+
+	if d := time.Since(r.Now()); d < 0 || d > time.Second {
+		t.Errorf("Now(): expected the wall clock, actual difference %v", d)
+	}
+
+	if r.IsHoliday() {
+		t.Error("IsHoliday(): expected false without a calendar")
+	}
+}
+
+func TestRealtimeTimepieceStop(t *testing.T) {
+	t.Parallel()
+
+	r := NewRealtimeTimepiece(nil)
+	r.Stop()
+
+	fired := make(chan struct{}, 1)
+	r.AddReminder("x", func() { fired <- struct{}{} }, r.Now())
+
+	select {
+	case <-fired:
+		t.Error("reminder fired after Stop()")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestRealtimeTimepieceStopExpiredTimer(t *testing.T) {
+	t.Parallel()
+
+	r := NewRealtimeTimepiece(nil)
+	fired := false
+	r.engine.AddReminder("x", func() { fired = true }, r.Now())
+	r.Stop()
+
+	// The timer may expire just before Stop() and fire afterwards.
+	r.fire()
+
+	if fired {
+		t.Error("reminder fired by an expired timer after Stop()")
+	}
+}
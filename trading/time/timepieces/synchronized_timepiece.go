@@ -1,83 +1,44 @@
 package timepieces
 
 import (
-	"fmt"
 	"time"
 )
 
-// SynchronizedTimepiece is an externally synchronized step-time timepiece.
+// SynchronizedTimepiece is an externally synchronized step-time timepiece,
+// driven for instance by the timestamps of historical data.
+//
+// The day events and the reminders are fired synchronously within the Synchronize call.
 type SynchronizedTimepiece struct {
-	// Now gets the current date and time.
-	now             time.Time
-	bod             time.Time
-	mon             int
-	day             int
-	sec             int
-	nsec            int
-	secSessionStart int
-	secSessionEnd   int
-	durSessionStart time.Duration
-	durSessionEnd   time.Duration
+	engine
 }
 
-func (st SynchronizedTimepiece) Synchronize(t time.Time) {
-	if !t.After(st.now) {
-		return
-	}
-
-	y, m, day := t.Date()
-	mon := int(m) + y*12 //nolint:gomnd
-
-	sec := daySeconds(t)
-	nsec := t.Nanosecond()
-
-	if mon > st.mon || day > st.day { //nolint:nestif
-		// delta := time.Sub(st.now)
-		if st.sec <= st.secSessionEnd {
-			if st.sec < st.secSessionStart {
-				st.sessionStarted(st.bod.Add(st.durSessionStart))
-			}
-
-			st.sessionEnded(st.bod.Add(st.durSessionEnd))
-		}
-	} else if sec > st.sec || nsec > st.nsec {
-		// Within the same day.
-		if st.sec < st.secSessionStart {
-			if sec >= st.secSessionStart {
-				st.sessionStarted(st.bod.Add(st.durSessionStart))
-				if sec > st.secSessionEnd {
-					st.sessionEnded(st.bod.Add(st.durSessionEnd))
-				}
-			}
-		} else if st.sec <= st.secSessionEnd && sec > st.secSessionEnd {
-			st.sessionEnded(st.bod.Add(st.durSessionEnd))
-		}
+// NewSynchronizedTimepiece creates a new synchronized timepiece starting at a given time.
+// The events due at or before the start are not fired.
+func NewSynchronizedTimepiece(p *Params, start time.Time) *SynchronizedTimepiece {
+	s := &SynchronizedTimepiece{}
+	s.init(p, start)
 
-		st.now = t
-		st.bod = beginOfDay(t)
-		st.mon = mon
-		st.day = day
-		st.sec = sec
-		st.nsec = nsec
-	}
+	return s
 }
 
-func (st SynchronizedTimepiece) sessionStarted(t time.Time) {
-	fmt.Printf("session started: %v\n", t) //nolint:forbidigo
-}
+// Synchronize moves the current time forward, firing all events due at or before the given time
+// in chronological order. While an event action executes, Now returns the time of the event.
+//
+// A time not after the current one is ignored.
+func (s *SynchronizedTimepiece) Synchronize(t time.Time) {
+	if !t.After(s.Now()) {
+		return
+	}
 
-func (st SynchronizedTimepiece) sessionEnded(t time.Time) {
-	fmt.Printf("session ended: %v\n", t) //nolint:forbidigo
+	s.advance(t)
 }
 
-func beginOfDay(t time.Time) time.Time {
-	y, m, d := t.Date()
-
-	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+// Now gets the current date and time.
+func (s *SynchronizedTimepiece) Now() time.Time {
+	return s.current()
 }
 
-func daySeconds(t time.Time) int {
-	h, m, s := t.Clock()
-
-	return s + m*60 + h*3600
+// IsHoliday indicates whether the current date is weekend or a holiday.
+func (s *SynchronizedTimepiece) IsHoliday() bool {
+	return s.isHoliday(s.Now())
 }
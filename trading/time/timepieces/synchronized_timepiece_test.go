@@ -0,0 +1,173 @@
+//nolint:testpackage
+package timepieces
+
+//nolint:gofumpt
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"mbg/trading/time/holidays/calendars"
+)
+
+var (
+	_ Timepiece = (*SynchronizedTimepiece)(nil)
+	_ Timepiece = (*RealtimeTimepiece)(nil)
+)
+
+func utc(d, h, m int) time.Time {
+	// June 2021: 11th is Friday, 12th and 13th is weekend.
+	return time.Date(2021, time.June, d, h, m, 0, 0, time.UTC)
+}
+
+func newLogged(log *[]string) *SynchronizedTimepiece {
+	var s *SynchronizedTimepiece
+
+	p := &Params{
+		Calendar:     calendars.WeekendsOnly{},
+		SessionStart: 9 * time.Hour,
+		SessionEnd:   17 * time.Hour,
+		OnNewDay: func(t time.Time) {
+			*log = append(*log, fmt.Sprintf("day %v", t.Format("02 15:04")))
+		},
+		OnSessionStart: func(t time.Time) {
+			*log = append(*log, fmt.Sprintf("start %v now %v", t.Format("02 15:04"), s.Now().Format("02 15:04")))
+		},
+		OnSessionEnd: func(t time.Time) {
+			*log = append(*log, fmt.Sprintf("end %v", t.Format("02 15:04")))
+		},
+	}
+
+	s = NewSynchronizedTimepiece(p, utc(11, 10, 0))
+
+	return s
+}
+
+func verifyLog(t *testing.T, act, exp []string) {
+	t.Helper()
+
+	if len(act) != len(exp) {
+		t.Errorf("expected %v, actual %v", exp, act)
+
+		return
+	}
+
+	for i := range exp {
+		if act[i] != exp[i] {
+			t.Errorf("[%v]: expected '%v', actual '%v'", i, exp[i], act[i])
+		}
+	}
+}
+
+func TestSynchronizedTimepieceDayEvents(t *testing.T) {
+	t.Parallel()
+
+	var log []string
+
+	s := newLogged(&log)
+
+	// Going back in time is ignored.
+	s.Synchronize(utc(11, 9, 0))
+
+	if !s.Now().Equal(utc(11, 10, 0)) {
+		t.Errorf("Now(): expected %v, actual %v", utc(11, 10, 0), s.Now())
+	}
+
+	s.Synchronize(utc(14, 12, 0))
+
+	verifyLog(t, log, []string{
+		"end 11 17:00",
+		"day 12 00:00",
+		"day 13 00:00",
+		"day 14 00:00",
+		"start 14 09:00 now 14 09:00",
+	})
+
+	if !s.Now().Equal(utc(14, 12, 0)) {
+		t.Errorf("Now(): expected %v, actual %v", utc(14, 12, 0), s.Now())
+	}
+
+	if s.IsHoliday() {
+		t.Error("IsHoliday(): expected false on Monday")
+	}
+
+	s.Synchronize(utc(19, 0, 0))
+
+	if !s.IsHoliday() {
+		t.Error("IsHoliday(): expected true on Saturday")
+	}
+}
+
+func TestSynchronizedTimepieceReminders(t *testing.T) {
+	t.Parallel()
+
+	var log []string
+
+	s := newLogged(&log)
+
+	add := func(name string, at time.Time) {
+		s.AddReminder(name, func() { log = append(log, name) }, at)
+	}
+
+	add("b", utc(11, 17, 0))
+	add("a", utc(11, 12, 0))
+	add("c", utc(11, 17, 0))
+	add("past", utc(11, 8, 0))
+
+	// A reminder adding another reminder, which is due within the same synchronization.
+	s.AddReminder("chain", func() {
+		log = append(log, "chain")
+		add("chained", utc(11, 18, 0))
+	}, utc(11, 16, 0))
+
+	removed := func() { log = append(log, "removed") }
+	s.AddReminder("x", removed, utc(11, 13, 0))
+	s.RemoveReminder("x", removed)
+	s.RemoveReminder("x", removed)
+
+	s.Synchronize(utc(11, 23, 0))
+
+	verifyLog(t, log, []string{"past", "a", "chain", "end 11 17:00", "b", "c", "chained"})
+}
+
+func TestSynchronizedTimepieceNoSessions(t *testing.T) {
+	t.Parallel()
+
+	days := 0
+	s := NewSynchronizedTimepiece(&Params{OnNewDay: func(time.Time) { days++ }}, utc(1, 0, 0))
+	s.Synchronize(utc(30, 12, 0))
+
+	if days != 29 {
+		t.Errorf("expected 29 new days, actual %v", days)
+	}
+
+	if s.IsHoliday() {
+		t.Error("IsHoliday(): expected false without a calendar")
+	}
+
+	if s := NewSynchronizedTimepiece(nil, utc(1, 0, 0)); s.Now().Location() != time.UTC {
+		t.Errorf("expected UTC location, actual %v", s.Now().Location())
+	}
+}
+
+func TestSynchronizedTimepieceLocation(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("JST", 9*60*60)
+
+	var starts []time.Time
+
+	s := NewSynchronizedTimepiece(&Params{
+		Location:       loc,
+		SessionStart:   9 * time.Hour,
+		SessionEnd:     15 * time.Hour,
+		OnSessionStart: func(t time.Time) { starts = append(starts, t) },
+	}, utc(6, 12, 0))
+
+	// June 6, 12:00 UTC is 21:00 JST, June 7, 23:00 UTC is June 8, 08:00 JST.
+	s.Synchronize(utc(7, 23, 0))
+
+	if len(starts) != 1 || !starts[0].Equal(time.Date(2021, 6, 7, 9, 0, 0, 0, loc)) {
+		t.Errorf("expected a session start at 09:00 JST on June 7th, actual %v", starts)
+	}
+}